@@ -134,6 +134,18 @@ func main() {
 				oapi_codegen.CartDeleteCartPositionMethod,
 				oapi_codegen.CartDeleteCartPositionPath,
 			),
+			auth.NewRequiredRoute(
+				oapi_codegen.CartGetPromoCodeMethod,
+				oapi_codegen.CartGetPromoCodePath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.CartApplyPromoCodeMethod,
+				oapi_codegen.CartApplyPromoCodePath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.CartRemovePromoCodeMethod,
+				oapi_codegen.CartRemovePromoCodePath,
+			),
 		).
 		Build()
 	if err != nil {
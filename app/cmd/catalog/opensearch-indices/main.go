@@ -75,6 +75,9 @@ func createProductsIndex(ctx context.Context, client *opensearch.Client) error {
           "rating": {
             "type": "float"
           },
+          "rating_version": {
+            "type": "long"
+          },
           "picture": {
             "type": "keyword",
             "index": false
@@ -88,11 +91,34 @@ func createProductsIndex(ctx context.Context, client *opensearch.Client) error {
           "ad_boost": {
             "type": "float"
           },
+          "ad_boost_version": {
+            "type": "long"
+          },
+          "category_paths": {
+            "type": "keyword"
+          },
           "available": {
             "type": "boolean"
           },
           "stock_qty": {
             "type": "integer"
+          },
+          "skus": {
+            "type": "nested",
+            "properties": {
+              "id": {
+                "type": "keyword"
+              },
+              "options": {
+                "type": "object"
+              },
+              "price": {
+                "type": "float"
+              },
+              "stock": {
+                "type": "integer"
+              }
+            }
           }
         }
       }
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -17,12 +18,16 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/bratushkadan/floral/internal/auth/setup"
+	"github.com/bratushkadan/floral/internal/orders/carrier"
+	"github.com/bratushkadan/floral/internal/orders/payment"
 	"github.com/bratushkadan/floral/internal/orders/presentation"
 	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
 	"github.com/bratushkadan/floral/internal/orders/service"
 	"github.com/bratushkadan/floral/internal/orders/store"
+	products_store "github.com/bratushkadan/floral/internal/products/store"
 	"github.com/bratushkadan/floral/pkg/cfg"
 	"github.com/bratushkadan/floral/pkg/logging"
+	"github.com/bratushkadan/floral/pkg/s3aws"
 	xgin "github.com/bratushkadan/floral/pkg/xhttp/gin"
 	"github.com/bratushkadan/floral/pkg/xhttp/gin/middleware/auth"
 	ydbpkg "github.com/bratushkadan/floral/pkg/ydb"
@@ -47,6 +52,9 @@ func main() {
 		setup.EnvKeyYdbEndpoint,
 		setup.EnvKeyAuthTokenPublicKey,
 		setup.EnvKeyYoomoneyNotificationSecret,
+		setup.EnvKeyAwsAccessKeyId,
+		setup.EnvKeyAwsSecretAccessKey,
+		setup.EnvKeyStorePicturesBucket,
 	)
 
 	authMethod := cfg.EnvDefault(setup.EnvKeyYdbAuthMethod, ydbpkg.YdbAuthMethodMetadata)
@@ -70,6 +78,19 @@ func main() {
 		logger.Fatal("new orders store", zap.Error(err))
 	}
 
+	s3client, err := s3aws.New(ctx, env[setup.EnvKeyAwsAccessKeyId], env[setup.EnvKeyAwsSecretAccessKey])
+	if err != nil {
+		logger.Fatal("failed to setup s3 client", zap.Error(err))
+	}
+	returnPhotoStore, err := products_store.NewPicturesBuilder().
+		Bucket(env[setup.EnvKeyStorePicturesBucket]).
+		PathPrefix("return-photos").
+		S3Client(s3client).
+		Build()
+	if err != nil {
+		logger.Fatal("failed to setup return photo store", zap.Error(err))
+	}
+
 	gin.SetMode(gin.ReleaseMode)
 	gin.DefaultWriter = io.Discard
 	r := gin.Default()
@@ -87,16 +108,57 @@ func main() {
 	r.GET("/ready", readinessHandler)
 	r.GET("/health", readinessHandler)
 
+	yoomoneyOAuthToken := cfg.EnvDefault(setup.EnvKeyYoomoneyOAuthToken, "")
+	if yoomoneyOAuthToken == "" {
+		logger.Warn("yoomoney oauth token is not set, yoomoney payments are not refunded automatically")
+	}
+	yoomoney, err := payment.NewYoomoneyBuilder().
+		NotificationSecret(env[setup.EnvKeyYoomoneyNotificationSecret]).
+		OAuthToken(yoomoneyOAuthToken).
+		Wallet(cfg.EnvDefault(setup.EnvKeyYoomoneyWallet, "")).
+		Build()
+	if err != nil {
+		logger.Fatal("new yoomoney payment provider", zap.Error(err))
+	}
+	paymentProviders := payment.NewRegistry().Register(yoomoney)
+
+	// Sandbox provider must never be enabled in production: anyone knowing the secret can pay for orders.
+	if sandboxSecret := cfg.EnvDefault(setup.EnvKeyPaymentSandboxSecret, ""); sandboxSecret != "" {
+		sandbox, err := payment.NewSandbox(sandboxSecret)
+		if err != nil {
+			logger.Fatal("new sandbox payment provider", zap.Error(err))
+		}
+		paymentProviders.Register(sandbox)
+		logger.Warn("sandbox payment provider is enabled")
+	}
+
+	carriers := carrier.NewRegistry()
+	// Fake carrier must never be enabled in production: anyone knowing the secret can deliver shipments.
+	if fakeCarrierSecret := cfg.EnvDefault(setup.EnvKeyCarrierFakeSecret, ""); fakeCarrierSecret != "" {
+		fake, err := carrier.NewFake(fakeCarrierSecret)
+		if err != nil {
+			logger.Fatal("new fake carrier", zap.Error(err))
+		}
+		carriers.Register(fake)
+		logger.Warn("fake carrier is enabled")
+	}
+	orderCompletionDelayDays, err := strconv.Atoi(cfg.EnvDefault(setup.EnvKeyOrderCompletionDelayDays, "14"))
+	if err != nil {
+		logger.Fatal("parse order completion delay days", zap.Error(err))
+	}
+
 	svc, err := service.NewBuilder().
 		Logger(logger).
 		Store(store).
-		YoomoneyPaymentNotificationSecret(env[setup.EnvKeyYoomoneyNotificationSecret]).
+		PaymentProviders(paymentProviders).
+		Carriers(carriers).
+		OrderCompletionDelay(time.Duration(orderCompletionDelayDays) * 24 * time.Hour).
 		Build()
 	if err != nil {
 		logger.Fatal("new cart service", zap.Error(err))
 	}
 
-	apiImpl := &presentation.ApiImpl{Logger: logger, Service: svc}
+	apiImpl := &presentation.ApiImpl{Logger: logger, Service: svc, ReturnPhotoStore: returnPhotoStore}
 
 	bearerAuthenticator, err := auth.NewJwtBearerAuthenticator(env[setup.EnvKeyAuthTokenPublicKey])
 	if err != nil {
@@ -130,10 +192,74 @@ func main() {
 				oapi_codegen.OrdersUpdateOrderMethod,
 				oapi_codegen.OrdersUpdateOrderPath,
 			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersUpdateShipmentMethod,
+				oapi_codegen.OrdersUpdateShipmentPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersListReturnsMethod,
+				oapi_codegen.OrdersListReturnsPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersCreateReturnMethod,
+				oapi_codegen.OrdersCreateReturnPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersGetReturnMethod,
+				oapi_codegen.OrdersGetReturnPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersUpdateReturnMethod,
+				oapi_codegen.OrdersUpdateReturnPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersUploadReturnPhotoMethod,
+				oapi_codegen.OrdersUploadReturnPhotoPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersListAddressesMethod,
+				oapi_codegen.OrdersListAddressesPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersCreateAddressMethod,
+				oapi_codegen.OrdersCreateAddressPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersGetAddressMethod,
+				oapi_codegen.OrdersGetAddressPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersUpdateAddressMethod,
+				oapi_codegen.OrdersUpdateAddressPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersDeleteAddressMethod,
+				oapi_codegen.OrdersDeleteAddressPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersListCouponsMethod,
+				oapi_codegen.OrdersListCouponsPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersCreateCouponMethod,
+				oapi_codegen.OrdersCreateCouponPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersGetCouponMethod,
+				oapi_codegen.OrdersGetCouponPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersDeleteCouponMethod,
+				oapi_codegen.OrdersDeleteCouponPath,
+			),
 			auth.NewRequiredRoute(
 				oapi_codegen.OrdersListOrdersMethod,
 				oapi_codegen.OrdersListOrdersPath,
 			),
+			auth.NewRequiredRoute(
+				oapi_codegen.OrdersListSellerOrdersMethod,
+				oapi_codegen.OrdersListSellerOrdersPath,
+			),
 			auth.NewRequiredRoute(
 				oapi_codegen.OrdersCreateOrderMethod,
 				oapi_codegen.OrdersCreateOrderPath,
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/bratushkadan/floral/internal/auth/setup"
+	"github.com/bratushkadan/floral/internal/orders/carrier"
+	"github.com/bratushkadan/floral/pkg/cfg"
+	"github.com/google/uuid"
+)
+
+func main() {
+	env := cfg.AssertEnv(setup.EnvKeyCarrierFakeSecret, "TRACKING_NUMBER")
+
+	apiUrl := cfg.EnvDefault("API_URL", "http://localhost:8080") + "/api/v1/order/carrier-events/" + carrier.CarrierFake
+
+	fake, err := carrier.NewFake(env[setup.EnvKeyCarrierFakeSecret])
+	if err != nil {
+		log.Fatal("Error creating fake carrier:", err)
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"events": []map[string]any{{
+			"id":              uuid.NewString(),
+			"tracking_number": env["TRACKING_NUMBER"],
+			"type":            cfg.EnvDefault("EVENT_TYPE", string(carrier.EventTypeDelivered)),
+			"occurred_at":     time.Now().Format(time.RFC3339),
+		}},
+	})
+	if err != nil {
+		log.Fatal("Error encoding request body:", err)
+	}
+
+	req, err := http.NewRequest("POST", apiUrl, bytes.NewReader(body))
+	if err != nil {
+		log.Fatal("Error creating request:", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(carrier.FakeSignatureHeader, fake.Sign(body))
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Fatal("Error sending request:", err)
+	}
+
+	var data map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		log.Fatal("Error reading response:", err)
+	}
+
+	fmt.Print("response data: ")
+	prettyData, err := json.MarshalIndent(&data, "", "  ")
+	if err != nil {
+		log.Fatal("Error encoding response to stdout: ", err)
+	}
+
+	io.Copy(os.Stdout, bytes.NewReader(prettyData))
+}
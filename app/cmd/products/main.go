@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -102,8 +103,19 @@ func main() {
 	r.GET("/ready", readinessHandler)
 	r.GET("/health", readinessHandler)
 
+	// Products are held longer than orders await payment, so holds of unpaid orders are released by their cancellation.
+	reservationTtl, err := time.ParseDuration(cfg.EnvDefault(setup.EnvKeyProductsReservationTtl, "2h"))
+	if err != nil {
+		logger.Fatal("parse products reservation ttl", zap.Error(err))
+	}
+
+	adCampaignHourlyRate, err := strconv.ParseFloat(cfg.EnvDefault(setup.EnvKeyProductsAdCampaignHourlyRate, "1"), 64)
+	if err != nil {
+		logger.Fatal("parse ad campaign hourly rate", zap.Error(err))
+	}
+
 	// base32 </dev/urandom | head -c32
-	svc := service.New(productsStore, pictureStore, logger, "puqsyuv4jxjd74rs43yj3lyegcji2qpe")
+	svc := service.New(productsStore, pictureStore, logger, "puqsyuv4jxjd74rs43yj3lyegcji2qpe", reservationTtl, adCampaignHourlyRate)
 
 	apiImpl := &presentation.ApiImpl{Logger: logger, ProductsService: svc, PictureStore: pictureStore}
 
@@ -152,6 +164,42 @@ func main() {
 				oapi_codegen.ProductsDeletePictureMethod,
 				oapi_codegen.ProductsDeletePicturePath,
 			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsListCampaignsMethod,
+				oapi_codegen.ProductsListCampaignsPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsCreateCampaignMethod,
+				oapi_codegen.ProductsCreateCampaignPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsCancelCampaignMethod,
+				oapi_codegen.ProductsCancelCampaignPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsCreateSkuMethod,
+				oapi_codegen.ProductsCreateSkuPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsUpdateSkuMethod,
+				oapi_codegen.ProductsUpdateSkuPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsDeleteSkuMethod,
+				oapi_codegen.ProductsDeleteSkuPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsCreateCategoryMethod,
+				oapi_codegen.ProductsCreateCategoryPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsUpdateCategoryMethod,
+				oapi_codegen.ProductsUpdateCategoryPath,
+			),
+			auth.NewRequiredRoute(
+				oapi_codegen.ProductsDeleteCategoryMethod,
+				oapi_codegen.ProductsDeleteCategoryPath,
+			),
 		).
 		Build()
 	if err != nil {
@@ -28,6 +28,17 @@ const (
 	EnvKeyAuthTokenPublicKeyPath  = "APP_AUTH_TOKEN_PUBLIC_KEY_PATH"
 
 	EnvKeyYoomoneyNotificationSecret = "YOOMONEY_NOTIFICATIONS_SECRET"
+	EnvKeyYoomoneyOAuthToken         = "YOOMONEY_OAUTH_TOKEN"
+	EnvKeyYoomoneyWallet             = "YOOMONEY_WALLET"
+
+	EnvKeyPaymentSandboxSecret = "PAYMENT_SANDBOX_SECRET"
+
+	EnvKeyCarrierFakeSecret = "CARRIER_FAKE_SECRET"
+
+	EnvKeyOrderCompletionDelayDays = "ORDER_COMPLETION_DELAY_DAYS"
+
+	EnvKeyProductsReservationTtl       = "PRODUCTS_RESERVATION_TTL"
+	EnvKeyProductsAdCampaignHourlyRate = "PRODUCTS_AD_CAMPAIGN_HOURLY_RATE"
 )
 
 const (
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
@@ -22,6 +23,40 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CreateProductCampaignResStatus.
+const (
+	CreateProductCampaignResStatusActive    CreateProductCampaignResStatus = "active"
+	CreateProductCampaignResStatusCancelled CreateProductCampaignResStatus = "cancelled"
+	CreateProductCampaignResStatusExhausted CreateProductCampaignResStatus = "exhausted"
+	CreateProductCampaignResStatusFinished  CreateProductCampaignResStatus = "finished"
+	CreateProductCampaignResStatusScheduled CreateProductCampaignResStatus = "scheduled"
+)
+
+// Defines values for ListProductCampaignsResCampaignStatus.
+const (
+	ListProductCampaignsResCampaignStatusActive    ListProductCampaignsResCampaignStatus = "active"
+	ListProductCampaignsResCampaignStatusCancelled ListProductCampaignsResCampaignStatus = "cancelled"
+	ListProductCampaignsResCampaignStatusExhausted ListProductCampaignsResCampaignStatus = "exhausted"
+	ListProductCampaignsResCampaignStatusFinished  ListProductCampaignsResCampaignStatus = "finished"
+	ListProductCampaignsResCampaignStatusScheduled ListProductCampaignsResCampaignStatus = "scheduled"
+)
+
+// Defines values for OrderEventMessageType.
+const (
+	OrderCancelled OrderEventMessageType = "order.cancelled"
+	OrderCompleted OrderEventMessageType = "order.completed"
+	OrderCreated   OrderEventMessageType = "order.created"
+	OrderDelivered OrderEventMessageType = "order.delivered"
+	OrderPaid      OrderEventMessageType = "order.paid"
+	OrderShipped   OrderEventMessageType = "order.shipped"
+)
+
+// Defines values for OrdersCreateCouponReqDiscountType.
+const (
+	Fixed   OrdersCreateCouponReqDiscountType = "fixed"
+	Percent OrdersCreateCouponReqDiscountType = "percent"
+)
+
 // AuthenticateReq defines model for AuthenticateReq.
 type AuthenticateReq struct {
 	Email    string `json:"email"`
@@ -34,13 +69,26 @@ type AuthenticateRes struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// CancelProductCampaignRes defines model for CancelProductCampaignRes.
+type CancelProductCampaignRes struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// CartApplyPromoCodeReq defines model for CartApplyPromoCodeReq.
+type CartApplyPromoCodeReq struct {
+	// Code promo code, case insensitive
+	Code string `json:"code"`
+}
+
 // CartClearCartRes defines model for CartClearCartRes.
 type CartClearCartRes = map[string]interface{}
 
 // CartClearCartResPosition defines model for CartClearCartResPosition.
 type CartClearCartResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CartDeleteCartPositionRes defines model for CartDeleteCartPositionRes.
@@ -50,8 +98,9 @@ type CartDeleteCartPositionRes struct {
 
 // CartDeleteCartPositionResPosition defines model for CartDeleteCartPositionResPosition.
 type CartDeleteCartPositionResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CartGetCartPositionsRes defines model for CartGetCartPositionsRes.
@@ -61,10 +110,21 @@ type CartGetCartPositionsRes struct {
 
 // CartGetCartPositionsResPosition defines model for CartGetCartPositionsResPosition.
 type CartGetCartPositionsResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
+// CartPromoCodeRes promo code applied to the cart. Codes stay applied until removed, orders are discounted with the coupon
+// of the code if it's applicable to them. Discounts are previewed with the orders coupon preview.
+type CartPromoCodeRes struct {
+	AppliedAt time.Time `json:"applied_at"`
+	Code      string    `json:"code"`
+}
+
+// CartRemovePromoCodeRes defines model for CartRemovePromoCodeRes.
+type CartRemovePromoCodeRes = map[string]interface{}
+
 // CartSetCartPositionRes defines model for CartSetCartPositionRes.
 type CartSetCartPositionRes struct {
 	SetPosition CartSetCartPositionResPosition `json:"set_position"`
@@ -72,14 +132,23 @@ type CartSetCartPositionRes struct {
 
 // CartSetCartPositionResPosition defines model for CartSetCartPositionResPosition.
 type CartSetCartPositionResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CatalogGetRes defines model for CatalogGetRes.
 type CatalogGetRes struct {
-	NextPageToken *string                `json:"next_page_token"`
-	Products      []CatalogGetResProduct `json:"products"`
+	// Categories category facets, numbers of found products by category paths
+	Categories    []CatalogGetResCategory `json:"categories"`
+	NextPageToken *string                 `json:"next_page_token"`
+	Products      []CatalogGetResProduct  `json:"products"`
+}
+
+// CatalogGetResCategory defines model for CatalogGetResCategory.
+type CatalogGetResCategory struct {
+	Count int    `json:"count"`
+	Path  string `json:"path"`
 }
 
 // CatalogGetResProduct defines model for CatalogGetResProduct.
@@ -103,29 +172,104 @@ type CreateAccessTokenRes struct {
 	ExpiresAt   string `json:"expires_at"`
 }
 
+// CreateProductCampaignReq defines model for CreateProductCampaignReq.
+type CreateProductCampaignReq struct {
+	// Boost Catalog ranking multiplier applied while the campaign is active
+	Boost float64 `json:"boost"`
+
+	// Budget Budget the campaign spends while active, the campaign is exhausted once it's spent
+	Budget   float64   `json:"budget"`
+	EndsAt   time.Time `json:"ends_at"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// CreateProductCampaignRes defines model for CreateProductCampaignRes.
+type CreateProductCampaignRes struct {
+	Boost     float64 `json:"boost"`
+	Budget    float64 `json:"budget"`
+	CreatedAt string  `json:"created_at"`
+	EndsAt    string  `json:"ends_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+
+	// Spent Budget spent by the campaign so far
+	Spent     float64                        `json:"spent"`
+	StartsAt  string                         `json:"starts_at"`
+	Status    CreateProductCampaignResStatus `json:"status"`
+	UpdatedAt string                         `json:"updated_at"`
+}
+
+// CreateProductCampaignResStatus defines model for CreateProductCampaignRes.Status.
+type CreateProductCampaignResStatus string
+
+// CreateProductCategoryReq defines model for CreateProductCategoryReq.
+type CreateProductCategoryReq struct {
+	Name string `json:"name"`
+
+	// ParentId id of the parent category, the category is created at the root if absent
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Position position of the category among its siblings, the category is put after its siblings if absent
+	Position *int `json:"position,omitempty"`
+
+	// Slug unique lowercase latin letters and digits separated by hyphens, i.e. "garden-roses"
+	Slug string `json:"slug"`
+}
+
+// CreateProductCategoryRes defines model for CreateProductCategoryRes.
+type CreateProductCategoryRes = ProductCategory
+
 // CreateProductReq defines model for CreateProductReq.
 type CreateProductReq struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	Description string                 `json:"description"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Price       float64                `json:"price"`
-	Stock       int                    `json:"stock"`
+
+	// Options option axes of the product variants, skus are added with "POST /api/v1/products/{product_id}/skus"
+	Options *[]GetProductResOption `json:"options,omitempty"`
+	Price   float64                `json:"price"`
+	Stock   int                    `json:"stock"`
 }
 
 // CreateProductRes defines model for CreateProductRes.
 type CreateProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	CreatedAt   string                 `json:"created_at"`
 	Description string                 `json:"description"`
 	Id          string                 `json:"id"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Pictures    GetProductResPictures  `json:"pictures"`
-	Price       float64                `json:"price"`
-	SellerId    string                 `json:"seller_id"`
-	Stock       int                    `json:"stock"`
-	UpdatedAt   string                 `json:"updated_at"`
+
+	// Options option axes of the product variants, i.e. size or colour
+	Options  []GetProductResOption `json:"options"`
+	Pictures GetProductResPictures `json:"pictures"`
+	Price    float64               `json:"price"`
+	SellerId string                `json:"seller_id"`
+
+	// Skus variants of the product, stock of the product with skus is the sum of their stocks
+	Skus      []GetProductResSku `json:"skus"`
+	Stock     int                `json:"stock"`
+	UpdatedAt string             `json:"updated_at"`
 }
 
+// CreateProductSkuReq defines model for CreateProductSkuReq.
+type CreateProductSkuReq struct {
+	// Options values of every product option by option names
+	Options map[string]string `json:"options"`
+
+	// PictureIds ids of the product pictures of the sku
+	PictureIds *[]string `json:"picture_ids,omitempty"`
+	Price      float64   `json:"price"`
+	Stock      int       `json:"stock"`
+}
+
+// CreateProductSkuRes defines model for CreateProductSkuRes.
+type CreateProductSkuRes = GetProductResSku
+
 // CreateSellerAccountReq defines model for CreateSellerAccountReq.
 type CreateSellerAccountReq struct {
 	AccessToken string `json:"access_token"`
@@ -155,6 +299,11 @@ type CreateUserAccountRes struct {
 	Name  string  `json:"name"`
 }
 
+// DeleteProductCategoryRes defines model for DeleteProductCategoryRes.
+type DeleteProductCategoryRes struct {
+	Id string `json:"id"`
+}
+
 // DeleteProductPictureRes defines model for DeleteProductPictureRes.
 type DeleteProductPictureRes struct {
 	Id string `json:"id"`
@@ -165,6 +314,11 @@ type DeleteProductRes struct {
 	Id string `json:"id"`
 }
 
+// DeleteProductSkuRes defines model for DeleteProductSkuRes.
+type DeleteProductSkuRes struct {
+	Id string `json:"id"`
+}
+
 // Err defines model for Err.
 type Err struct {
 	Code    int    `json:"code"`
@@ -195,8 +349,16 @@ type FeedbackDeleteProductReviewRes struct {
 
 // FeedbackGetProductRatingRes defines model for FeedbackGetProductRatingRes.
 type FeedbackGetProductRatingRes struct {
-	ProductId string `json:"product_id"`
-	Rating    int    `json:"rating"`
+	Distribution []FeedbackGetProductRatingResDistributionBucket `json:"distribution"`
+	ProductId    string                                          `json:"product_id"`
+	Rating       float64                                         `json:"rating"`
+	ReviewsCount int                                             `json:"reviews_count"`
+}
+
+// FeedbackGetProductRatingResDistributionBucket defines model for FeedbackGetProductRatingResDistributionBucket.
+type FeedbackGetProductRatingResDistributionBucket struct {
+	Count int `json:"count"`
+	Stars int `json:"stars"`
 }
 
 // FeedbackGetProductReviewRes defines model for FeedbackGetProductReviewRes.
@@ -210,17 +372,6 @@ type FeedbackGetProductReviewRes struct {
 	UserId    string  `json:"user_id"`
 }
 
-// FeedbackListProductRatingsRes defines model for FeedbackListProductRatingsRes.
-type FeedbackListProductRatingsRes struct {
-	Ratings []FeedbackListProductRatingsResRating `json:"ratings"`
-}
-
-// FeedbackListProductRatingsResRating defines model for FeedbackListProductRatingsResRating.
-type FeedbackListProductRatingsResRating struct {
-	ProductId *string `json:"product_id,omitempty"`
-	Rating    int     `json:"rating"`
-}
-
 // FeedbackListProductReviewsRes defines model for FeedbackListProductReviewsRes.
 type FeedbackListProductReviewsRes struct {
 	NextPageToken *string                               `json:"next_page_token"`
@@ -246,23 +397,38 @@ type FeedbackUpdateProductReviewReq struct {
 
 // FeedbackUpdateProductReviewRes defines model for FeedbackUpdateProductReviewRes.
 type FeedbackUpdateProductReviewRes struct {
-	Id     string   `json:"id"`
-	Rating *float64 `json:"rating,omitempty"`
-	Review *string  `json:"review,omitempty"`
+	Id        string  `json:"id"`
+	Rating    float64 `json:"rating"`
+	Review    string  `json:"review"`
+	UpdatedAt string  `json:"updated_at"`
 }
 
 // GetProductRes defines model for GetProductRes.
 type GetProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	CreatedAt   string                 `json:"created_at"`
 	Description string                 `json:"description"`
 	Id          string                 `json:"id"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Pictures    GetProductResPictures  `json:"pictures"`
-	Price       float64                `json:"price"`
-	SellerId    string                 `json:"seller_id"`
-	Stock       int                    `json:"stock"`
-	UpdatedAt   string                 `json:"updated_at"`
+
+	// Options option axes of the product variants, i.e. size or colour
+	Options  []GetProductResOption `json:"options"`
+	Pictures GetProductResPictures `json:"pictures"`
+	Price    float64               `json:"price"`
+	SellerId string                `json:"seller_id"`
+
+	// Skus variants of the product, stock of the product with skus is the sum of their stocks
+	Skus      []GetProductResSku `json:"skus"`
+	Stock     int                `json:"stock"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// GetProductResOption defines model for GetProductResOption.
+type GetProductResOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
 }
 
 // GetProductResPicture defines model for GetProductResPicture.
@@ -274,6 +440,49 @@ type GetProductResPicture struct {
 // GetProductResPictures defines model for GetProductResPictures.
 type GetProductResPictures = []GetProductResPicture
 
+// GetProductResSku defines model for GetProductResSku.
+type GetProductResSku struct {
+	Id string `json:"id"`
+
+	// Options values of the product options by option names
+	Options map[string]string `json:"options"`
+
+	// PictureIds ids of the product pictures of the sku
+	PictureIds []string `json:"picture_ids"`
+	Price      float64  `json:"price"`
+	Stock      int      `json:"stock"`
+}
+
+// ListProductCampaignsRes defines model for ListProductCampaignsRes.
+type ListProductCampaignsRes struct {
+	Campaigns []ListProductCampaignsResCampaign `json:"campaigns"`
+}
+
+// ListProductCampaignsResCampaign defines model for ListProductCampaignsResCampaign.
+type ListProductCampaignsResCampaign struct {
+	Boost     float64 `json:"boost"`
+	Budget    float64 `json:"budget"`
+	CreatedAt string  `json:"created_at"`
+	EndsAt    string  `json:"ends_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+
+	// Spent Budget spent by the campaign so far
+	Spent     float64                               `json:"spent"`
+	StartsAt  string                                `json:"starts_at"`
+	Status    ListProductCampaignsResCampaignStatus `json:"status"`
+	UpdatedAt string                                `json:"updated_at"`
+}
+
+// ListProductCampaignsResCampaignStatus defines model for ListProductCampaignsResCampaign.Status.
+type ListProductCampaignsResCampaignStatus string
+
+// ListProductCategoriesRes defines model for ListProductCategoriesRes.
+type ListProductCategoriesRes struct {
+	Categories []ProductCategory `json:"categories"`
+}
+
 // ListProductsRes defines model for ListProductsRes.
 type ListProductsRes struct {
 	NextPageToken *string                  `json:"next_page_token"`
@@ -282,6 +491,8 @@ type ListProductsRes struct {
 
 // ListProductsResProduct defines model for ListProductsResProduct.
 type ListProductsResProduct struct {
+	// CategoryId id of the product category
+	CategoryId *string `json:"category_id,omitempty"`
 	Id         string  `json:"id"`
 	Name       string  `json:"name"`
 	PictureUrl string  `json:"picture_url"`
@@ -289,6 +500,173 @@ type ListProductsResProduct struct {
 	SellerId   string  `json:"seller_id"`
 }
 
+// OrderEventActor defines model for OrderEventActor.
+type OrderEventActor struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// OrderEventItem defines model for OrderEventItem.
+type OrderEventItem struct {
+	Count     int    `json:"count"`
+	ProductId string `json:"product_id"`
+	SellerId  string `json:"seller_id"`
+}
+
+// OrderEventMessage order lifecycle domain event published to orders/order_events_topic
+type OrderEventMessage struct {
+	Actor OrderEventActor `json:"actor"`
+
+	// Id unique event id, consumers deduplicate redelivered events by it
+	Id             string           `json:"id"`
+	Items          []OrderEventItem `json:"items"`
+	OccurredAt     time.Time        `json:"occurred_at"`
+	OrderId        string           `json:"order_id"`
+	PreviousStatus *string          `json:"previous_status"`
+	Reason         string           `json:"reason"`
+
+	// SchemaVersion incremented on breaking changes of the event schema
+	SchemaVersion int                   `json:"schema_version"`
+	Status        string                `json:"status"`
+	Type          OrderEventMessageType `json:"type"`
+	UserId        string                `json:"user_id"`
+}
+
+// OrderEventMessageType defines model for OrderEventMessage.Type.
+type OrderEventMessageType string
+
+// OrdersAddress defines model for OrdersAddress.
+type OrdersAddress struct {
+	City          string  `json:"city"`
+	Comment       *string `json:"comment,omitempty"`
+	Country       string  `json:"country"`
+	CreatedAt     string  `json:"created_at"`
+	Id            string  `json:"id"`
+	Phone         string  `json:"phone"`
+	PostalCode    string  `json:"postal_code"`
+	RecipientName string  `json:"recipient_name"`
+	Street        string  `json:"street"`
+	UpdatedAt     string  `json:"updated_at"`
+	UserId        string  `json:"user_id"`
+}
+
+// OrdersAddressReq defines model for OrdersAddressReq.
+type OrdersAddressReq struct {
+	City string `json:"city"`
+
+	// Comment note for the courier
+	Comment *string `json:"comment,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 country code
+	Country       string `json:"country"`
+	Phone         string `json:"phone"`
+	PostalCode    string `json:"postal_code"`
+	RecipientName string `json:"recipient_name"`
+
+	// Street street, building and apartment
+	Street string `json:"street"`
+}
+
+// OrdersCost order cost computed at order creation, absent for orders placed before it was introduced.
+// Amounts are in minor units of the currency (e.g. kopecks), prices include VAT.
+type OrdersCost struct {
+	CurrencyIso4217 int   `json:"currency_iso_4217"`
+	Discount        int64 `json:"discount"`
+	ShippingFee     int64 `json:"shipping_fee"`
+
+	// Subtotal sum of the order items prices
+	Subtotal int64 `json:"subtotal"`
+
+	// Total amount to pay, subtotal less discount plus shipping fee
+	Total int64 `json:"total"`
+
+	// Vat VAT included in the total
+	Vat int64 `json:"vat"`
+}
+
+// OrdersCoupon defines model for OrdersCoupon.
+type OrdersCoupon struct {
+	Code         string `json:"code"`
+	CreatedAt    string `json:"created_at"`
+	DiscountType string `json:"discount_type"`
+
+	// DiscountValue percent from 1 to 100 or fixed amount in minor units of the currency
+	DiscountValue int64   `json:"discount_value"`
+	ExpiresAt     *string `json:"expires_at,omitempty"`
+
+	// MaxUses maximum redemptions of the coupon, 0 is unlimited
+	MaxUses int64 `json:"max_uses"`
+
+	// MaxUsesPerUser maximum redemptions of the coupon by a user, 0 is unlimited
+	MaxUsesPerUser int64 `json:"max_uses_per_user"`
+
+	// MinSubtotal minimum sum of the order items prices in minor units of the currency
+	MinSubtotal int64  `json:"min_subtotal"`
+	OwnerId     string `json:"owner_id"`
+
+	// OwnerType subject type of the coupon creator
+	OwnerType  string   `json:"owner_type"`
+	ProductIds []string `json:"product_ids"`
+	SellerIds  []string `json:"seller_ids"`
+	StartsAt   *string  `json:"starts_at,omitempty"`
+
+	// Uses redemptions of the coupon by orders that aren't cancelled
+	Uses int64 `json:"uses"`
+}
+
+// OrdersCouponPreviewRes discount the coupon gives to the items. Coupons that are not applicable to the items don't discount them.
+// Amounts are in minor units of the currency, shipping isn't included.
+type OrdersCouponPreviewRes struct {
+	Applicable      bool   `json:"applicable"`
+	Code            string `json:"code"`
+	CurrencyIso4217 int    `json:"currency_iso_4217"`
+	Discount        int64  `json:"discount"`
+
+	// Reason why the coupon isn't applicable
+	Reason   *string `json:"reason,omitempty"`
+	Subtotal int64   `json:"subtotal"`
+	Total    int64   `json:"total"`
+}
+
+// OrdersCreateCouponReq defines model for OrdersCreateCouponReq.
+type OrdersCreateCouponReq struct {
+	// Code promo code of 3 to 32 latin letters, digits, "-" or "_", case insensitive
+	Code         string                            `json:"code"`
+	DiscountType OrdersCreateCouponReqDiscountType `json:"discount_type"`
+
+	// DiscountValue percent from 1 to 100 or fixed amount in minor units of the currency
+	DiscountValue int64      `json:"discount_value"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+
+	// MaxUses maximum redemptions of the coupon, 0 is unlimited
+	MaxUses *int64 `json:"max_uses,omitempty"`
+
+	// MaxUsesPerUser maximum redemptions of the coupon by a user, 0 is unlimited
+	MaxUsesPerUser *int64 `json:"max_uses_per_user,omitempty"`
+
+	// MinSubtotal minimum sum of the order items prices in minor units of the currency
+	MinSubtotal *int64 `json:"min_subtotal,omitempty"`
+
+	// ProductIds discount items of the products only
+	ProductIds *[]string `json:"product_ids,omitempty"`
+
+	// SellerIds discount items of the sellers only, coupons of sellers discount items of the seller
+	SellerIds *[]string  `json:"seller_ids,omitempty"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+}
+
+// OrdersCreateCouponReqDiscountType defines model for OrdersCreateCouponReq.DiscountType.
+type OrdersCreateCouponReqDiscountType string
+
+// OrdersCreateOrderReq defines model for OrdersCreateOrderReq.
+type OrdersCreateOrderReq struct {
+	// AddressId address book entry to deliver the order to, required unless the order is picked up
+	AddressId *string `json:"address_id,omitempty"`
+
+	// DeliveryMethod "courier", "post" or "pickup"
+	DeliveryMethod string `json:"delivery_method"`
+}
+
 // OrdersCreateOrderRes defines model for OrdersCreateOrderRes.
 type OrdersCreateOrderRes struct {
 	Operation OrdersCreateOrderResOperation `json:"operation"`
@@ -305,26 +683,95 @@ type OrdersCreateOrderResOperation struct {
 	UserId    string  `json:"user_id"`
 }
 
+// OrdersCreateReturnReq defines model for OrdersCreateReturnReq.
+type OrdersCreateReturnReq struct {
+	Items  []OrdersCreateReturnReqItem `json:"items"`
+	Reason string                      `json:"reason"`
+}
+
+// OrdersCreateReturnReqItem defines model for OrdersCreateReturnReqItem.
+type OrdersCreateReturnReqItem struct {
+	Count     int    `json:"count"`
+	ProductId string `json:"product_id"`
+
+	// SkuId returned sku, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
+}
+
+// OrdersDeleteAddressRes defines model for OrdersDeleteAddressRes.
+type OrdersDeleteAddressRes struct {
+	Id string `json:"id"`
+}
+
+// OrdersDeleteCouponRes defines model for OrdersDeleteCouponRes.
+type OrdersDeleteCouponRes struct {
+	Code string `json:"code"`
+}
+
+// OrdersDelivery delivery of the order, absent for orders placed before delivery was introduced
+type OrdersDelivery struct {
+	// Address snapshot of the address book entry taken when the order was placed
+	Address *OrdersDeliveryAddress `json:"address,omitempty"`
+	Method  string                 `json:"method"`
+}
+
+// OrdersDeliveryAddress snapshot of the address book entry taken when the order was placed
+type OrdersDeliveryAddress struct {
+	AddressId     string  `json:"address_id"`
+	City          string  `json:"city"`
+	Comment       *string `json:"comment,omitempty"`
+	Country       string  `json:"country"`
+	Phone         string  `json:"phone"`
+	PostalCode    string  `json:"postal_code"`
+	RecipientName string  `json:"recipient_name"`
+	Street        string  `json:"street"`
+}
+
 // OrdersGetOperationRes defines model for OrdersGetOperationRes.
 type OrdersGetOperationRes struct {
-	CreatedAt string  `json:"created_at"`
-	Details   *string `json:"details,omitempty"`
-	Id        string  `json:"id"`
-	OrderId   *string `json:"order_id,omitempty"`
-	Status    string  `json:"status"`
-	Type      string  `json:"type"`
-	UpdatedAt string  `json:"updated_at"`
-	UserId    string  `json:"user_id"`
+	CreatedAt string `json:"created_at"`
+
+	// Details Why the operation is aborted, or for completed operations why the order is placed without
+	// the discount of the promo code applied to the cart ("promo_code_rejected: <reason>")
+	Details *string `json:"details,omitempty"`
+	Id      string  `json:"id"`
+	OrderId *string `json:"order_id,omitempty"`
+
+	// Payment how to pay for the order, present only while the order awaits payment
+	Payment   *OrdersPayment `json:"payment,omitempty"`
+	Status    string         `json:"status"`
+	Type      string         `json:"type"`
+	UpdatedAt string         `json:"updated_at"`
+	UserId    string         `json:"user_id"`
 }
 
 // OrdersGetOrderRes defines model for OrdersGetOrderRes.
 type OrdersGetOrderRes struct {
-	CreatedAt string                  `json:"created_at"`
-	Id        string                  `json:"id"`
-	Items     []OrdersGetOrderResItem `json:"items"`
-	Status    string                  `json:"status"`
-	UpdatedAt string                  `json:"updated_at"`
-	UserId    string                  `json:"user_id"`
+	// AllowedTransitions statuses the requesting subject may set with order update
+	AllowedTransitions []string `json:"allowed_transitions"`
+
+	// Cost order cost computed at order creation, absent for orders placed before it was introduced.
+	// Amounts are in minor units of the currency (e.g. kopecks), prices include VAT.
+	Cost      *OrdersCost `json:"cost,omitempty"`
+	CreatedAt string      `json:"created_at"`
+
+	// Delivery delivery of the order, absent for orders placed before delivery was introduced
+	Delivery *OrdersDelivery         `json:"delivery,omitempty"`
+	Id       string                  `json:"id"`
+	Items    []OrdersGetOrderResItem `json:"items"`
+
+	// Payment how to pay for the order, present only while the order awaits payment
+	Payment *OrdersPayment `json:"payment,omitempty"`
+
+	// PromoCode promo code redeemed by the order
+	PromoCode *string                     `json:"promo_code,omitempty"`
+	Shipments []OrdersGetOrderResShipment `json:"shipments"`
+	Status    string                      `json:"status"`
+
+	// StatusHistory order status transitions, oldest first
+	StatusHistory []OrdersGetOrderResStatusHistoryEntry `json:"status_history"`
+	UpdatedAt     string                                `json:"updated_at"`
+	UserId        string                                `json:"user_id"`
 }
 
 // OrdersGetOrderResItem defines model for OrdersGetOrderResItem.
@@ -335,6 +782,42 @@ type OrdersGetOrderResItem struct {
 	Price      float64 `json:"price"`
 	ProductId  string  `json:"product_id"`
 	SellerId   string  `json:"seller_id"`
+	SkuId      *string `json:"sku_id,omitempty"`
+}
+
+// OrdersGetOrderResShipment defines model for OrdersGetOrderResShipment.
+type OrdersGetOrderResShipment struct {
+	Carrier  *string `json:"carrier,omitempty"`
+	SellerId string  `json:"seller_id"`
+	Status   string  `json:"status"`
+
+	// TrackingNumber carrier tracking number, set once the shipment is shipped
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// OrdersGetOrderResStatusHistoryEntry defines model for OrdersGetOrderResStatusHistoryEntry.
+type OrdersGetOrderResStatusHistoryEntry struct {
+	ActorId string `json:"actor_id"`
+
+	// ActorType subject type of the actor, "system" for transitions made by the service itself
+	ActorType string `json:"actor_type"`
+	CreatedAt string `json:"created_at"`
+
+	// FromStatus null for order creation
+	FromStatus *string `json:"from_status"`
+	Reason     string  `json:"reason"`
+	Status     string  `json:"status"`
+}
+
+// OrdersListAddressesRes defines model for OrdersListAddressesRes.
+type OrdersListAddressesRes struct {
+	Addresses []OrdersAddress `json:"addresses"`
+}
+
+// OrdersListCouponsRes defines model for OrdersListCouponsRes.
+type OrdersListCouponsRes struct {
+	Coupons []OrdersCoupon `json:"coupons"`
 }
 
 // OrdersListOrdersRes defines model for OrdersListOrdersRes.
@@ -351,6 +834,7 @@ type OrdersListOrdersResItem struct {
 	Price      float64 `json:"price"`
 	ProductId  string  `json:"product_id"`
 	SellerId   string  `json:"seller_id"`
+	SkuId      *string `json:"sku_id,omitempty"`
 }
 
 // OrdersListOrdersResOrder defines model for OrdersListOrdersResOrder.
@@ -363,9 +847,126 @@ type OrdersListOrdersResOrder struct {
 	UserId    string                    `json:"user_id"`
 }
 
+// OrdersListReturnsRes defines model for OrdersListReturnsRes.
+type OrdersListReturnsRes struct {
+	Returns []OrdersReturn `json:"returns"`
+}
+
+// OrdersListSellerOrdersRes defines model for OrdersListSellerOrdersRes.
+type OrdersListSellerOrdersRes struct {
+	NextPageToken *string                          `json:"next_page_token"`
+	Orders        []OrdersListSellerOrdersResOrder `json:"orders"`
+}
+
+// OrdersListSellerOrdersResOrder defines model for OrdersListSellerOrdersResOrder.
+type OrdersListSellerOrdersResOrder struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+
+	// Items seller items of the order
+	Items    []OrdersListOrdersResItem `json:"items"`
+	Shipment OrdersGetOrderResShipment `json:"shipment"`
+
+	// Status order status
+	Status string `json:"status"`
+	UserId string `json:"user_id"`
+}
+
+// OrdersPayment how to pay for the order, present only while the order awaits payment
+type OrdersPayment struct {
+	// Amount amount left to pay
+	Amount          float64                 `json:"amount"`
+	Checkouts       []OrdersPaymentCheckout `json:"checkouts"`
+	CurrencyIso4217 int                     `json:"currency_iso_4217"`
+}
+
+// OrdersPaymentCheckout defines model for OrdersPaymentCheckout.
+type OrdersPaymentCheckout struct {
+	// Form form to submit instead of following the checkout link
+	Form     *OrdersPaymentCheckoutForm `json:"form,omitempty"`
+	Provider string                     `json:"provider"`
+
+	// Url link to pay for the order with
+	Url string `json:"url"`
+}
+
+// OrdersPaymentCheckoutForm form to submit instead of following the checkout link
+type OrdersPaymentCheckoutForm struct {
+	Action string            `json:"action"`
+	Method string            `json:"method"`
+	Params map[string]string `json:"params"`
+}
+
+// OrdersPreviewCouponReq defines model for OrdersPreviewCouponReq.
+type OrdersPreviewCouponReq struct {
+	Items []OrdersPreviewCouponReqItem `json:"items"`
+}
+
+// OrdersPreviewCouponReqItem defines model for OrdersPreviewCouponReqItem.
+type OrdersPreviewCouponReqItem struct {
+	Count int `json:"count"`
+
+	// Price price of the product or its sku
+	Price     float64 `json:"price"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// OrdersProcessCarrierEventsReq tracking events webhook, its format is specific to the carrier
+type OrdersProcessCarrierEventsReq = map[string]interface{}
+
+// OrdersProcessCarrierEventsRes defines model for OrdersProcessCarrierEventsRes.
+type OrdersProcessCarrierEventsRes = map[string]interface{}
+
+// OrdersProcessPaymentReq payment notification, its format is specific to the payment provider
+type OrdersProcessPaymentReq = map[string]interface{}
+
+// OrdersProcessPaymentRes defines model for OrdersProcessPaymentRes.
+type OrdersProcessPaymentRes = map[string]interface{}
+
+// OrdersReturn defines model for OrdersReturn.
+type OrdersReturn struct {
+	// AllowedTransitions statuses the requesting subject may set with return update
+	AllowedTransitions []string `json:"allowed_transitions"`
+
+	// Comment seller comment on the return resolution
+	Comment   *string             `json:"comment,omitempty"`
+	CreatedAt string              `json:"created_at"`
+	Id        string              `json:"id"`
+	Items     []OrdersReturnItem  `json:"items"`
+	OrderId   string              `json:"order_id"`
+	Photos    []OrdersReturnPhoto `json:"photos"`
+	Reason    string              `json:"reason"`
+
+	// RefundAmount amount refunded to the buyer once the seller receives returned items
+	RefundAmount float64 `json:"refund_amount"`
+	SellerId     string  `json:"seller_id"`
+	Status       string  `json:"status"`
+	UpdatedAt    string  `json:"updated_at"`
+	UserId       string  `json:"user_id"`
+}
+
+// OrdersReturnItem defines model for OrdersReturnItem.
+type OrdersReturnItem struct {
+	Count     int     `json:"count"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// OrdersReturnPhoto defines model for OrdersReturnPhoto.
+type OrdersReturnPhoto struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
 // OrdersUpdateOrderReq defines model for OrdersUpdateOrderReq.
 type OrdersUpdateOrderReq struct {
-	Status string `json:"status"`
+	// Reason recorded in the order status history; required to complete cancellation of an order whose payments are not refunded (the manual refund reason)
+	Reason *string `json:"reason,omitempty"`
+	Status string  `json:"status"`
 }
 
 // OrdersUpdateOrderRes defines model for OrdersUpdateOrderRes.
@@ -374,6 +975,98 @@ type OrdersUpdateOrderRes struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+// OrdersUpdateReturnReq defines model for OrdersUpdateReturnReq.
+type OrdersUpdateReturnReq struct {
+	// Comment seller comment, required to reject the return
+	Comment *string `json:"comment,omitempty"`
+	Status  string  `json:"status"`
+}
+
+// OrdersUpdateShipmentReq defines model for OrdersUpdateShipmentReq.
+type OrdersUpdateShipmentReq struct {
+	// Carrier required to ship the shipment unless the order is picked up
+	Carrier *string `json:"carrier,omitempty"`
+	Status  string  `json:"status"`
+
+	// TrackingNumber required to ship the shipment unless the order is picked up
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+}
+
+// OrdersUpdateShipmentRes defines model for OrdersUpdateShipmentRes.
+type OrdersUpdateShipmentRes struct {
+	Carrier *string `json:"carrier,omitempty"`
+	OrderId string  `json:"order_id"`
+
+	// OrderStatus order status derived from its shipments
+	OrderStatus    string  `json:"order_status"`
+	SellerId       string  `json:"seller_id"`
+	Status         string  `json:"status"`
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// OrdersUploadReturnPhotoRes defines model for OrdersUploadReturnPhotoRes.
+type OrdersUploadReturnPhotoRes struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
+// PrivateApplyAdCampaignsReq defines model for PrivateApplyAdCampaignsReq.
+type PrivateApplyAdCampaignsReq = map[string]interface{}
+
+// PrivateApplyAdCampaignsRes defines model for PrivateApplyAdCampaignsRes.
+type PrivateApplyAdCampaignsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductRatingsReq defines model for PrivateCatalogSyncProductRatingsReq.
+type PrivateCatalogSyncProductRatingsReq struct {
+	Messages []PrivateCatalogSyncProductRatingsReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductRatingsReqMessage defines model for PrivateCatalogSyncProductRatingsReqMessage.
+type PrivateCatalogSyncProductRatingsReqMessage struct {
+	ProductId    string  `json:"product_id"`
+	Rating       float64 `json:"rating"`
+	ReviewsCount int     `json:"reviews_count"`
+
+	// Version version of the product rating, ratings older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductRatingsRes defines model for PrivateCatalogSyncProductRatingsRes.
+type PrivateCatalogSyncProductRatingsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsAdBoostReq defines model for PrivateCatalogSyncProductsAdBoostReq.
+type PrivateCatalogSyncProductsAdBoostReq struct {
+	Messages []PrivateCatalogSyncProductsAdBoostReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsAdBoostReqMessage defines model for PrivateCatalogSyncProductsAdBoostReqMessage.
+type PrivateCatalogSyncProductsAdBoostReqMessage struct {
+	AdBoost   float64 `json:"ad_boost"`
+	ProductId string  `json:"product_id"`
+
+	// Version time the ad boost was computed at in unix milliseconds, ad boosts older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductsAdBoostRes defines model for PrivateCatalogSyncProductsAdBoostRes.
+type PrivateCatalogSyncProductsAdBoostRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsPurchasesReq defines model for PrivateCatalogSyncProductsPurchasesReq.
+type PrivateCatalogSyncProductsPurchasesReq struct {
+	Messages []PrivateCatalogSyncProductsPurchasesReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsPurchasesReqMessage defines model for PrivateCatalogSyncProductsPurchasesReqMessage.
+type PrivateCatalogSyncProductsPurchasesReqMessage struct {
+	ProductId        string `json:"product_id"`
+	Purchases30d     int    `json:"purchases_30d"`
+	PurchasesAlltime int    `json:"purchases_alltime"`
+}
+
+// PrivateCatalogSyncProductsPurchasesRes defines model for PrivateCatalogSyncProductsPurchasesRes.
+type PrivateCatalogSyncProductsPurchasesRes = map[string]interface{}
+
 // PrivateClearCartPositionsReq defines model for PrivateClearCartPositionsReq.
 type PrivateClearCartPositionsReq struct {
 	Messages []PrivateClearCartPositionsReqMessage `json:"messages"`
@@ -381,7 +1074,9 @@ type PrivateClearCartPositionsReq struct {
 
 // PrivateClearCartPositionsReqMessage defines model for PrivateClearCartPositionsReqMessage.
 type PrivateClearCartPositionsReqMessage struct {
-	UserId string `json:"user_id"`
+	// OrderId the order the cart is cleared for, the cart is cleared once per order; messages without order id are always applied
+	OrderId *string `json:"order_id,omitempty"`
+	UserId  string  `json:"user_id"`
 }
 
 // PrivateClearCartPositionsRes defines model for PrivateClearCartPositionsRes.
@@ -394,12 +1089,13 @@ type PrivateFeedbackProcessCompletedOrderReq struct {
 
 // PrivateFeedbackProcessCompletedOrderReqMessage defines model for PrivateFeedbackProcessCompletedOrderReqMessage.
 type PrivateFeedbackProcessCompletedOrderReqMessage struct {
-	OrderId  string                                          `json:"order_id"`
-	Products PrivateFeedbackProcessCompletedOrderReqProducts `json:"products"`
+	OrderId  string                                           `json:"order_id"`
+	Products []PrivateFeedbackProcessCompletedOrderReqProduct `json:"products"`
+	UserId   string                                           `json:"user_id"`
 }
 
-// PrivateFeedbackProcessCompletedOrderReqProducts defines model for PrivateFeedbackProcessCompletedOrderReqProducts.
-type PrivateFeedbackProcessCompletedOrderReqProducts struct {
+// PrivateFeedbackProcessCompletedOrderReqProduct defines model for PrivateFeedbackProcessCompletedOrderReqProduct.
+type PrivateFeedbackProcessCompletedOrderReqProduct struct {
 	Id string `json:"id"`
 }
 
@@ -412,6 +1108,12 @@ type PrivateOrderBatchCancelUnpaidOrdersReq = map[string]interface{}
 // PrivateOrderBatchCancelUnpaidOrdersRes defines model for PrivateOrderBatchCancelUnpaidOrdersRes.
 type PrivateOrderBatchCancelUnpaidOrdersRes = map[string]interface{}
 
+// PrivateOrderBatchCompleteDeliveredOrdersReq defines model for PrivateOrderBatchCompleteDeliveredOrdersReq.
+type PrivateOrderBatchCompleteDeliveredOrdersReq = map[string]interface{}
+
+// PrivateOrderBatchCompleteDeliveredOrdersRes defines model for PrivateOrderBatchCompleteDeliveredOrdersRes.
+type PrivateOrderBatchCompleteDeliveredOrdersRes = map[string]interface{}
+
 // PrivateOrderCancelOperationsReq defines model for PrivateOrderCancelOperationsReq.
 type PrivateOrderCancelOperationsReq struct {
 	Messages []PrivateOrderCancelOperationsReqMessage `json:"messages"`
@@ -426,6 +1128,26 @@ type PrivateOrderCancelOperationsReqMessage struct {
 // PrivateOrderCancelOperationsRes defines model for PrivateOrderCancelOperationsRes.
 type PrivateOrderCancelOperationsRes = map[string]interface{}
 
+// PrivateOrderProcessPaymentNotificationsReq defines model for PrivateOrderProcessPaymentNotificationsReq.
+type PrivateOrderProcessPaymentNotificationsReq struct {
+	Messages []PrivateOrderProcessPaymentNotificationsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessPaymentNotificationsReqMessage defines model for PrivateOrderProcessPaymentNotificationsReqMessage.
+type PrivateOrderProcessPaymentNotificationsReqMessage struct {
+	Amount          float64   `json:"amount"`
+	CurrencyIso4217 int       `json:"currency_iso_4217"`
+	Datetime        time.Time `json:"datetime"`
+	OrderId         string    `json:"order_id"`
+
+	// PaymentId payment id derived from the provider operation id, duplicate notifications share it
+	PaymentId    *string                `json:"payment_id,omitempty"`
+	ProviderMeta map[string]interface{} `json:"provider_meta"`
+}
+
+// PrivateOrderProcessPaymentNotificationsRes defines model for PrivateOrderProcessPaymentNotificationsRes.
+type PrivateOrderProcessPaymentNotificationsRes = map[string]interface{}
+
 // PrivateOrderProcessPublishedCartPositionsReq defines model for PrivateOrderProcessPublishedCartPositionsReq.
 type PrivateOrderProcessPublishedCartPositionsReq struct {
 	Messages []PrivateOrderProcessPublishedCartPositionsReqMessage `json:"messages"`
@@ -433,19 +1155,29 @@ type PrivateOrderProcessPublishedCartPositionsReq struct {
 
 // PrivateOrderProcessPublishedCartPositionsReqCartPosition defines model for PrivateOrderProcessPublishedCartPositionsReqCartPosition.
 type PrivateOrderProcessPublishedCartPositionsReqCartPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // PrivateOrderProcessPublishedCartPositionsReqMessage defines model for PrivateOrderProcessPublishedCartPositionsReqMessage.
 type PrivateOrderProcessPublishedCartPositionsReqMessage struct {
 	CartPositions []PrivateOrderProcessPublishedCartPositionsReqCartPosition `json:"cart_positions"`
 	OperationId   string                                                     `json:"operation_id"`
+
+	// PromoCode promo code applied to the cart, orders of the operation are discounted with its coupon
+	PromoCode *string `json:"promo_code,omitempty"`
 }
 
 // PrivateOrderProcessPublishedCartPositionsRes defines model for PrivateOrderProcessPublishedCartPositionsRes.
 type PrivateOrderProcessPublishedCartPositionsRes = map[string]interface{}
 
+// PrivateOrderProcessRefundsReq defines model for PrivateOrderProcessRefundsReq.
+type PrivateOrderProcessRefundsReq = map[string]interface{}
+
+// PrivateOrderProcessRefundsRes defines model for PrivateOrderProcessRefundsRes.
+type PrivateOrderProcessRefundsRes = map[string]interface{}
+
 // PrivateOrderProcessReservedProductsReq defines model for PrivateOrderProcessReservedProductsReq.
 type PrivateOrderProcessReservedProductsReq struct {
 	Messages []PrivateOrderProcessReservedProductsReqMessage `json:"messages"`
@@ -465,6 +1197,9 @@ type PrivateOrderProcessReservedProductsReqProduct struct {
 	Picture  *string `json:"picture,omitempty"`
 	Price    float64 `json:"price"`
 	SellerId string  `json:"seller_id"`
+
+	// SkuId reserved sku, price and picture are the ones of the sku
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateOrderProcessReservedProductsRes defines model for PrivateOrderProcessReservedProductsRes.
@@ -478,11 +1213,20 @@ type PrivateOrderProcessUnreservedProductsReq struct {
 // PrivateOrderProcessUnreservedProductsReqMessage defines model for PrivateOrderProcessUnreservedProductsReqMessage.
 type PrivateOrderProcessUnreservedProductsReqMessage struct {
 	OrderId string `json:"order_id"`
+
+	// ReturnId return whose received items are restocked, absent for cancelled orders
+	ReturnId *string `json:"return_id,omitempty"`
 }
 
 // PrivateOrderProcessUnreservedProductsRes defines model for PrivateOrderProcessUnreservedProductsRes.
 type PrivateOrderProcessUnreservedProductsRes = map[string]interface{}
 
+// PrivateOrderPublishProductsPurchasesStatsReq defines model for PrivateOrderPublishProductsPurchasesStatsReq.
+type PrivateOrderPublishProductsPurchasesStatsReq = map[string]interface{}
+
+// PrivateOrderPublishProductsPurchasesStatsRes defines model for PrivateOrderPublishProductsPurchasesStatsRes.
+type PrivateOrderPublishProductsPurchasesStatsRes = map[string]interface{}
+
 // PrivatePublishCartPositionsReq defines model for PrivatePublishCartPositionsReq.
 type PrivatePublishCartPositionsReq struct {
 	Messages []PrivatePublishCartPositionsReqMessage `json:"messages"`
@@ -497,6 +1241,24 @@ type PrivatePublishCartPositionsReqMessage struct {
 // PrivatePublishCartPositionsRes defines model for PrivatePublishCartPositionsRes.
 type PrivatePublishCartPositionsRes = map[string]interface{}
 
+// PrivateRelayOutboxReq defines model for PrivateRelayOutboxReq.
+type PrivateRelayOutboxReq = map[string]interface{}
+
+// PrivateRelayOutboxRes defines model for PrivateRelayOutboxRes.
+type PrivateRelayOutboxRes struct {
+	// Relayed Number of messages published to topics
+	Relayed int `json:"relayed"`
+}
+
+// PrivateReleaseExpiredReservationsReq defines model for PrivateReleaseExpiredReservationsReq.
+type PrivateReleaseExpiredReservationsReq = map[string]interface{}
+
+// PrivateReleaseExpiredReservationsRes defines model for PrivateReleaseExpiredReservationsRes.
+type PrivateReleaseExpiredReservationsRes struct {
+	// Released Number of released reservation holds
+	Released int `json:"released"`
+}
+
 // PrivateReserveProductsReq defines model for PrivateReserveProductsReq.
 type PrivateReserveProductsReq struct {
 	Messages []PrivateReserveProductsReqMessage `json:"messages"`
@@ -504,19 +1266,39 @@ type PrivateReserveProductsReq struct {
 
 // PrivateReserveProductsReqMessage defines model for PrivateReserveProductsReqMessage.
 type PrivateReserveProductsReqMessage struct {
-	OperationId string                             `json:"operation_id"`
-	Products    []PrivateReserveProductsReqProduct `json:"products"`
+	OperationId string `json:"operation_id"`
+
+	// OrderId id of the order to be created of the reserved products, products are held for it until it's paid
+	OrderId  string                             `json:"order_id"`
+	Products []PrivateReserveProductsReqProduct `json:"products"`
 }
 
 // PrivateReserveProductsReqProduct defines model for PrivateReserveProductsReqProduct.
 type PrivateReserveProductsReqProduct struct {
 	Count int    `json:"count"`
 	Id    string `json:"id"`
+
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateReserveProductsRes defines model for PrivateReserveProductsRes.
 type PrivateReserveProductsRes = map[string]interface{}
 
+// PrivateSellProductsReq defines model for PrivateSellProductsReq.
+type PrivateSellProductsReq struct {
+	Messages []PrivateSellProductsReqMessage `json:"messages"`
+}
+
+// PrivateSellProductsReqMessage defines model for PrivateSellProductsReqMessage.
+type PrivateSellProductsReqMessage struct {
+	// OrderId paid order whose reserved products are sold
+	OrderId string `json:"order_id"`
+}
+
+// PrivateSellProductsRes defines model for PrivateSellProductsRes.
+type PrivateSellProductsRes = map[string]interface{}
+
 // PrivateUnreserveProductsReq defines model for PrivateUnreserveProductsReq.
 type PrivateUnreserveProductsReq struct {
 	Messages []PrivateUnreserveProductsReqMessage `json:"messages"`
@@ -526,17 +1308,41 @@ type PrivateUnreserveProductsReq struct {
 type PrivateUnreserveProductsReqMessage struct {
 	OrderId  string                               `json:"order_id"`
 	Products []PrivateUnreserveProductsReqProduct `json:"products"`
+
+	// ReturnId return whose received items are restocked, absent for cancelled orders
+	ReturnId *string `json:"return_id,omitempty"`
 }
 
 // PrivateUnreserveProductsReqProduct defines model for PrivateUnreserveProductsReqProduct.
 type PrivateUnreserveProductsReqProduct struct {
 	Count int    `json:"count"`
 	Id    string `json:"id"`
+
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateUnreserveProductsRes defines model for PrivateUnreserveProductsRes.
 type PrivateUnreserveProductsRes = map[string]interface{}
 
+// ProductCategory defines model for ProductCategory.
+type ProductCategory struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+
+	// ParentId id of the parent category, absent for root categories
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Path slugs of the category ancestors and the category joined with "/", i.e. "flowers/roses"
+	Path string `json:"path"`
+
+	// Position position of the category among its siblings
+	Position  int    `json:"position"`
+	Slug      string `json:"slug"`
+	UpdatedAt string `json:"updated_at"`
+}
+
 // ReplaceRefreshTokenReq defines model for ReplaceRefreshTokenReq.
 type ReplaceRefreshTokenReq struct {
 	RefreshToken string `json:"refresh_token"`
@@ -548,28 +1354,63 @@ type ReplaceRefreshTokenRes struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// UpdateProductCategoryReq defines model for UpdateProductCategoryReq.
+type UpdateProductCategoryReq struct {
+	Name *string `json:"name,omitempty"`
+
+	// ParentId id of the new parent category, empty string moves the category to the root
+	ParentId *string `json:"parent_id,omitempty"`
+	Position *int    `json:"position,omitempty"`
+	Slug     *string `json:"slug,omitempty"`
+}
+
+// UpdateProductCategoryRes defines model for UpdateProductCategoryRes.
+type UpdateProductCategoryRes = ProductCategory
+
 // UpdateProductReq defines model for UpdateProductReq.
 type UpdateProductReq struct {
+	// CategoryId id of the product category
+	CategoryId  *string                 `json:"category_id,omitempty"`
 	Description *string                 `json:"description,omitempty"`
 	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
 	Name        *string                 `json:"name,omitempty"`
-	Price       *float64                `json:"price,omitempty"`
+
+	// Options option axes of the product variants, values of existing skus must stay valid
+	Options *[]GetProductResOption `json:"options,omitempty"`
+	Price   *float64               `json:"price,omitempty"`
 
 	// StockDelta The amount of "in stock" product count change, either of:
 	// - positive: stock amount is increased (seller releases more products)
 	// - negative: stock amount is decreased (item purchased)
+	//
+	// Stock of the product with skus is changed with sku updates.
 	StockDelta *int `json:"stock_delta,omitempty"`
 }
 
 // UpdateProductRes defines model for UpdateProductRes.
 type UpdateProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                 `json:"category_id,omitempty"`
 	Description *string                 `json:"description,omitempty"`
 	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
 	Name        *string                 `json:"name,omitempty"`
+	Options     *[]GetProductResOption  `json:"options,omitempty"`
 	Price       *float64                `json:"price,omitempty"`
 	Stock       *int                    `json:"stock,omitempty"`
 }
 
+// UpdateProductSkuReq defines model for UpdateProductSkuReq.
+type UpdateProductSkuReq struct {
+	PictureIds *[]string `json:"picture_ids,omitempty"`
+	Price      *float64  `json:"price,omitempty"`
+
+	// StockDelta The amount of sku stock change, negative values withdraw products from stock
+	StockDelta *int `json:"stock_delta,omitempty"`
+}
+
+// UpdateProductSkuRes defines model for UpdateProductSkuRes.
+type UpdateProductSkuRes = GetProductResSku
+
 // UploadProductPictureRes defines model for UploadProductPictureRes.
 type UploadProductPictureRes struct {
 	Id  string `json:"id"`
@@ -581,10 +1422,19 @@ type Error struct {
 	Errors []Err `json:"errors"`
 }
 
+// CartDeleteCartPositionParams defines parameters for CartDeleteCartPosition.
+type CartDeleteCartPositionParams struct {
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `form:"sku_id,omitempty" json:"sku_id,omitempty"`
+}
+
 // CartSetCartPositionParams defines parameters for CartSetCartPosition.
 type CartSetCartPositionParams struct {
 	// Count product positions count
 	Count int `form:"count" json:"count"`
+
+	// SkuId sku of the product, required for products with skus. Each sku of the product is a separate cart position
+	SkuId *string `form:"sku_id,omitempty" json:"sku_id,omitempty"`
 }
 
 // PrivateCartsClearContentsJSONRequestBody defines body for PrivateCartsClearContents for application/json ContentType.
@@ -593,6 +1443,12 @@ type PrivateCartsClearContentsJSONRequestBody = PrivateClearCartPositionsReq
 // PrivateCartPublishContentsJSONRequestBody defines body for PrivateCartPublishContents for application/json ContentType.
 type PrivateCartPublishContentsJSONRequestBody = PrivatePublishCartPositionsReq
 
+// PrivateCartRelayOutboxJSONRequestBody defines body for PrivateCartRelayOutbox for application/json ContentType.
+type PrivateCartRelayOutboxJSONRequestBody = PrivateRelayOutboxReq
+
+// CartApplyPromoCodeJSONRequestBody defines body for CartApplyPromoCode for application/json ContentType.
+type CartApplyPromoCodeJSONRequestBody = CartApplyPromoCodeReq
+
 // Method & Path constants for routes.
 // Clear carts contents
 const PrivateCartsClearContentsMethod = "POST"
@@ -602,6 +1458,10 @@ const PrivateCartsClearContentsPath = "/api/private/v1/cart/clear-contents"
 const PrivateCartPublishContentsMethod = "POST"
 const PrivateCartPublishContentsPath = "/api/private/v1/cart/publish-contents"
 
+// Relay outbox
+const PrivateCartRelayOutboxMethod = "POST"
+const PrivateCartRelayOutboxPath = "/api/private/v1/cart/relay-outbox"
+
 // Clear cart
 const CartClearCartMethod = "DELETE"
 const CartClearCartPath = "/api/v1/cart/:user_id/positions"
@@ -618,6 +1478,18 @@ const CartDeleteCartPositionPath = "/api/v1/cart/:user_id/positions/:product_id"
 const CartSetCartPositionMethod = "PUT"
 const CartSetCartPositionPath = "/api/v1/cart/:user_id/positions/:product_id"
 
+// Remove promo code
+const CartRemovePromoCodeMethod = "DELETE"
+const CartRemovePromoCodePath = "/api/v1/cart/:user_id/promo-code"
+
+// Get promo code
+const CartGetPromoCodeMethod = "GET"
+const CartGetPromoCodePath = "/api/v1/cart/:user_id/promo-code"
+
+// Apply promo code
+const CartApplyPromoCodeMethod = "PUT"
+const CartApplyPromoCodePath = "/api/v1/cart/:user_id/promo-code"
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Clear carts contents
@@ -626,6 +1498,9 @@ type ServerInterface interface {
 	// Publish carts contents
 	// (POST /api/private/v1/cart/publish-contents)
 	PrivateCartPublishContents(c *gin.Context)
+	// Relay outbox
+	// (POST /api/private/v1/cart/relay-outbox)
+	PrivateCartRelayOutbox(c *gin.Context)
 	// Clear cart
 	// (DELETE /api/v1/cart/{user_id}/positions)
 	CartClearCart(c *gin.Context, userId string)
@@ -634,10 +1509,19 @@ type ServerInterface interface {
 	CartGetCartPositions(c *gin.Context, userId string)
 	// Delete cart position
 	// (DELETE /api/v1/cart/{user_id}/positions/{product_id})
-	CartDeleteCartPosition(c *gin.Context, userId string, productId string)
+	CartDeleteCartPosition(c *gin.Context, userId string, productId string, params CartDeleteCartPositionParams)
 	// Set cart position
 	// (PUT /api/v1/cart/{user_id}/positions/{product_id})
 	CartSetCartPosition(c *gin.Context, userId string, productId string, params CartSetCartPositionParams)
+	// Remove promo code
+	// (DELETE /api/v1/cart/{user_id}/promo-code)
+	CartRemovePromoCode(c *gin.Context, userId string)
+	// Get promo code
+	// (GET /api/v1/cart/{user_id}/promo-code)
+	CartGetPromoCode(c *gin.Context, userId string)
+	// Apply promo code
+	// (PUT /api/v1/cart/{user_id}/promo-code)
+	CartApplyPromoCode(c *gin.Context, userId string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -675,6 +1559,19 @@ func (siw *ServerInterfaceWrapper) PrivateCartPublishContents(c *gin.Context) {
 	siw.Handler.PrivateCartPublishContents(c)
 }
 
+// PrivateCartRelayOutbox operation middleware
+func (siw *ServerInterfaceWrapper) PrivateCartRelayOutbox(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PrivateCartRelayOutbox(c)
+}
+
 // CartClearCart operation middleware
 func (siw *ServerInterfaceWrapper) CartClearCart(c *gin.Context) {
 
@@ -752,6 +1649,17 @@ func (siw *ServerInterfaceWrapper) CartDeleteCartPosition(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CartDeleteCartPositionParams
+
+	// ------------- Optional query parameter "sku_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sku_id", c.Request.URL.Query(), &params.SkuId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sku_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -759,7 +1667,7 @@ func (siw *ServerInterfaceWrapper) CartDeleteCartPosition(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CartDeleteCartPosition(c, userId, productId)
+	siw.Handler.CartDeleteCartPosition(c, userId, productId, params)
 }
 
 // CartSetCartPosition operation middleware
@@ -805,6 +1713,14 @@ func (siw *ServerInterfaceWrapper) CartSetCartPosition(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "sku_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sku_id", c.Request.URL.Query(), &params.SkuId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sku_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -815,6 +1731,84 @@ func (siw *ServerInterfaceWrapper) CartSetCartPosition(c *gin.Context) {
 	siw.Handler.CartSetCartPosition(c, userId, productId, params)
 }
 
+// CartRemovePromoCode operation middleware
+func (siw *ServerInterfaceWrapper) CartRemovePromoCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CartRemovePromoCode(c, userId)
+}
+
+// CartGetPromoCode operation middleware
+func (siw *ServerInterfaceWrapper) CartGetPromoCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CartGetPromoCode(c, userId)
+}
+
+// CartApplyPromoCode operation middleware
+func (siw *ServerInterfaceWrapper) CartApplyPromoCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CartApplyPromoCode(c, userId)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -844,62 +1838,153 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 
 	router.POST(options.BaseURL+"/api/private/v1/cart/clear-contents", wrapper.PrivateCartsClearContents)
 	router.POST(options.BaseURL+"/api/private/v1/cart/publish-contents", wrapper.PrivateCartPublishContents)
+	router.POST(options.BaseURL+"/api/private/v1/cart/relay-outbox", wrapper.PrivateCartRelayOutbox)
 	router.DELETE(options.BaseURL+"/api/v1/cart/:user_id/positions", wrapper.CartClearCart)
 	router.GET(options.BaseURL+"/api/v1/cart/:user_id/positions", wrapper.CartGetCartPositions)
 	router.DELETE(options.BaseURL+"/api/v1/cart/:user_id/positions/:product_id", wrapper.CartDeleteCartPosition)
 	router.PUT(options.BaseURL+"/api/v1/cart/:user_id/positions/:product_id", wrapper.CartSetCartPosition)
+	router.DELETE(options.BaseURL+"/api/v1/cart/:user_id/promo-code", wrapper.CartRemovePromoCode)
+	router.GET(options.BaseURL+"/api/v1/cart/:user_id/promo-code", wrapper.CartGetPromoCode)
+	router.PUT(options.BaseURL+"/api/v1/cart/:user_id/promo-code", wrapper.CartApplyPromoCode)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+wd227cuPVXBLUPXUAT2dl0U8xbNnWDoF3EcBKgQBIMaOnMDBPdQlKOXcP/XpAUdaUk",
-	"UqNRJlm/ySPy3G88pOh7N0jjLE0gYdRd37sEaJYmFMQfF4SkhD8EacIgYfwRZVmEA8RwmvifaZrw32iw",
-	"hxiJt2GI+SsUXZI0A8Iwh7RFEQXPzWo/3bvAgYsnzCAWD38lsHXX7l/8iiZfwqb+BSHug+eyuwzctYsI",
-	"QXfuw4PnEviaYwKhu/6gQH4qh6XXnyFg7gMfGAINCM44de5aDhUACgQc/4uc7SFhnD24gq+2DMUIR/yh",
-	"QE4ZwcmOE50hSr+lJNS8bHMgYNRmdHnxWmRSWzJvM0yAbhDT0kpgS4DuNyz9Ask4wc3hXh26jvSXiLCX",
-	"ESDCH0xoH4VwmVIsdWolhSDNk7oAcMJgB8LEMpKGecA22EBftbFeAbOP7X9CBAz4kyLZXnehgBFushrT",
-	"Q07Ti7eUWpuhDgYrdn4YZbwCVied2qtCCcg8gvXgrVQxEt0qjBZc/TAaeduk3V4hFJiVX3QR9jpFA7Q5",
-	"Az+I7BmK0t0rYPYiT+CWbTK0gypbJHkUoesI3DUjeRW/a7lQkmXjNjUCL+XscV9RWLwOkaNCUDjsZKFV",
-	"iecmKAZ9TYADlhOQUb1emeSEFwAGcsSBmL1NScxzuRumOZ9Qjk3y+BpIRzTCHgRZCohWIgQQgxdBAJS+",
-	"43Kzr4cOqiQMabK1WCQm95LkDVdHLYobwMZLH0F9YVz20mwYiYbwGBgKEUO1lxXufis0tiLPpSwNvuji",
-	"UksshW3VCa6Rp+CMG18pKlslB2J62Ffhjkmyx5MnCli6+WiUewWs4vdSTbLVEEQRkE0PA73689w8C/sl",
-	"posfFSZPr++S7wHVe3VNNYjoN4q3AvGLQCQ0ey8a9X/JmCjwDBd3/ao3XvUVEjRY/HUKE0Gt1+TLWHp0",
-	"thVujxB6U08/ie/pAeo9qpaUelTuHFqia3g5LWHL5VsRcop4Y0+jSR2Kw3ECFsV8QYh1RR6CPnDGQCna",
-	"GWhDgKjG6+j6F0B4jYIvrex3g+HbhOILMU6GWd4gAolBiSaBlhOsuZg5k/ckusFVkjeTaEaSpufmtC8V",
-	"6xy1sVpTU72OxO1SptJFy9em6eIAj1N01IocwdeErst01cboFsd57K7/7rkxTuTzmTdWzTYUU8A35PHR",
-	"6L+n0f8H06a1TWjySUrMexWDuOXTaOtC4bTm7apU8Uk7lIEP1dkTJkCX6U1JeztM3SW98mlc3QVOs0aV",
-	"CcbHaPMdos17MexUijY7Muk8rc6jlJw9FUWjXfLYHnpsD7k6Cc1j1zmJDNnmI01pM88yWs46acVzazmB",
-	"nuheTovEI+3m9GBZZD9no7eW+Rx1YDen7nXKhepk6WT1hoRAqFysi2d7y+HPyGTXVYfrTTm5zVkF1pTu",
-	"N3VCjl4CpRxrfzBFLKfaV/KHYxc7YkhJR73esQmqUsqvgJWiPUK6ZQhH9FHyfZKf5pTTbLoM4kbRvEPj",
-	"awaxLjMN6GRW0VeiLqUvGTlQ9oKv2Q5xLJJCRhZoFhmmsWzSFHaS06HtXClPnpXl0zLFiYgStsbcIFI8",
-	"jBYnBR6z0kSD5dG45jYuqbdTjZdd5Z9ixOwXtWwhFKHRtsPRy1d7Y1uOM6SCzkWF3VK3SvHD+eSS4BvE",
-	"oDy1XDufaSu/Yv/Q3OaGcP9RbEaORbgSaYc5z71dMbSj0pUFpg3KsPtphOs/qm1TC+aNTVsNnIte2m3q",
-	"GEBSPbdLkgZA6cs0zsTZ6omuM1X1Y2QsYgWmRFiuPIcWAvXmxAxiUg2FoeZEFU1Lyo4hrMsaZwts4B5O",
-	"8TT/EZN/RyzYv0RJANH7JEM4VKnz6xFgHkCnBFcukxcM7X3oF/HrMeS2Z1z7ewFlO8goAzRGV02GGVk8",
-	"wFYKL7nMryNM9xB+p5rAiJblrGiMkvrfp/tFxVzcTnOgAJHqS5XjGERDDZqVi52ftghuTT+WdA/33Sug",
-	"QG4grLYZvofXaqhY3F8HaJi4jWBS0M0osQmbULMa6QBJs0W5Az5OWnQjy74DdLCwD48F7xNyEtFAS8fi",
-	"8WCQitmWd7oe7BzOqKN+moUUuec71XY92BexhhHcM2cF46ZQa0lwSI9Iz+E0Oyki0vKho4t4EevoR3si",
-	"5UKXQNMKoWVhJQHziGmZokCXlacvdTpcTPORMiov7yU61Iv4yRDiI3VJD5WLsZ8ogg70kSEafiQv0fBh",
-	"6SdXkEUogCv5Cf2pfK+vpeqHuq6odVx6XKIxTuq/np/oV/ybECKJpHn3xLs9OCjmhuykW+ejixNHjP/o",
-	"OoWnOsLMnWCPkh14DmC2B+Kk2/XHZOXIRs4NrOUsBQpTBycBAUQhdP4m13gOgYj/QJ04JaCg0184mAR2",
-	"SA8mhBIMD1ZOlpNgz//+xdV+dzKmUPqzKLTnWgYN/1GKwqN89nv4QWnRMAhygtndW55tJLJrQATIi5zt",
-	"BWpupntAofjsXQrQ/e+Kv04J/h8qzper/JPhf8OdvP8NJ9tUkIdZxN9dBGnsvLh87XruDRAqHeDsyfmT",
-	"s6KNmfDounZ/fXL25Ex87832giAfZdgvIrB/c+4HiDA/iACRVXFPnroginU9TGwvO3wKdcrRtY7S69Bd",
-	"l1vRfJTcj65GclkCZb+n4Z3VzXxTDwlw4QkV1u4FfHp2tgRuqru4rxJgKT+H5uL6A0cRKc+VblEesT70",
-	"JT/+RXUTYB7HiNz1a0mlX/5C5N3bVWEHK2ErjOTw4OkNJJNrRgMTKVaXLfQOog7cCNrLunLAbtQSdRHD",
-	"6et1LGM6fatxnfFws6m2Gw41E72mphvKtthG9+WZQj+TTalNoPbTN6k62mYGUAz3rxEL9qtAbGGucrHj",
-	"vapOR9pAKs2N+hKcLYCCo1WmdmZWXESrxpbVFHiqa7eqr2emAMqTA0CpGX4BY8LMEv/I3JtzH+Vs7wdp",
-	"ssUkvlCXhdyu7gI+eocYfEN3q6C4YzUGtk9Dyo32zdt34oQK3vFkKoDWoIpgdV90xx78hmbk5ZCN7ouI",
-	"O407OUWqJCgGJgzsQ+d+MwrEEVWAyOU8rVaZvPZZZlkzyPO9VUho1xefjhhiOveVDmakqQGlKHqEsOrl",
-	"zodPnDldWurEGK+jeVEHVt/DcHEgnBSdAPf6Gj378uwZO89+Y+gpi85i9FxswJAbHMAGyVtr5Fj0GZ4D",
-	"fp59+S3Knp5tv/7j+a/bqsYS1koiGacKHCK0tgm6QREOEZM3Chd/wFU9Kylb3AHTG1n7gsufztZ095Ie",
-	"LY+Zmt0rYLLgqm/U/7TmZxAL/fvqKMjDWGDsXpS7uNV6bQxqMd+HpHnpycl4h/4KZY1/yIFNoz22l2hx",
-	"/tRhOst7wnTrKtyf1t578ZSRwlHHFwTSrzmQuwqretePsLxW5VzT3jq2t2luZNa42tt2cji2n3UR/lmS",
-	"kbij2F3f134sV4zlAqKemvzySg6LKeUdOLo5xVvtFP9ePhRJsTa9s3i8r2+V6ger9WnPG/9e7SK1ptdW",
-	"bZpf25l7bIhfu6DDeKx/3wHOoxgVKzb1jxt074P2BceDg4o9q54Bjas+dcNId3eGD3sovakd3Gr/dgKn",
-	"iVO4RxXOOHduNyaqTa3uhFJV3UnFhdzdOcoLdFMI040Xy7L2YHnivzu8MLteLpzC1rszlYu4D58e/h8A",
-	"AP//naPYz1VlAAA=",
+	"H4sIAAAAAAAC/+x9a2/kNrLoXyF0L7AJoHbbM9nkXu8nZzKbG9zdHWNmsucAcdCgpepuxpKokJTtXsP/",
+	"/YAviZKoZz88mcwny81XsVhVLBarik9BRNOcZpAJHlw+BQx4TjMO6p+3jFEmPyKaCciE/MR5npAIC0Kz",
+	"5W+cZvI3Hm0hxao0jokswsk1ozkwQWRPa5xwCIPc+ekpANm5+iICUvXxvxmsg8vgfy0rmJa6b758y1jw",
+	"HAZil0NwGWDG8C54fg4DBr8XhEEcXP5iu/y1rEZvf4NIBM+yYgw8YiSX0AWXuqrqwAwgx78qxBYyIacH",
+	"7+H3qRNKMUnkhxmcC0ayjQQ6x5w/UBZ7CpszUH04LdpzCRtg8qlgPuaEAV9h4YWVwZoB364EvYNsGOB6",
+	"9dDt3Qf6G5xFIGGMi0i8wWmOySabPgcSe2HnAouCDwNN4qCs7IeSias8T3bXjKb0DY1nUENEY5B/62SX",
+	"yw6RLAtRhDkgknHIOBHkHoIwSPHjPyDbiG1w+fpVGKQks/9ehANzUuN1TeZNApjJjzGoHuzhmnKi5zMR",
+	"I0Xm0hzJBGxAcXWuCWLVta53hb+ogQOnm9AM14WRHyABAfLLzmY6Fcaqj3iVO/joE2Gd49rP1oRaI0ya",
+	"zuewTj+CcGfFp6+Sxd34raZj3GqVBrahasQJs/ocFssRl8Or1CUYkdIwIEaCIrEFFGEmzpDslSMu8K4s",
+	"LzJBEsQgpfcQh4iyGBhHmAGKCVeAQoweiNjqbmiR0+wmo2vzbwyIrBERf+HI6DS3CZhB0zP0g+lD95gz",
+	"uCfw4HZoxtP92gpnN1nQXCIDr9lw15Sl8iuIsYCFICkEYXtx7O4xQuaH7gBdK/NeYWnS+nj7+VAn3Onc",
+	"yEFMkpftATuFZa3rX0dP4I/PeAIndPMjiOmrEWEBG8rK/czlSFO2Q2scgeAhyor0VlI8XaM1LbIYGQA5",
+	"ut2hsnaOxZYH4VhJ68D+xnTRlq9hkMGjWOV4A5VemhVJIlk2uBSsAA8PWfAmyH0HGqOjDgt7O0roYrMN",
+	"8eDSldM/HB1isXVKushM1hpNYBYtB9HXM5yCtyAnkSiYR38uWBKEY5aeRFCXtrSQDcq6mpj9JwMFlu3E",
+	"ixEGWMBVFAHnH+XqTj8e7HXMGgnTVGmAVeNOkML+o2MD4lpnw+dCBX3rXDgVq7eUctGmGkPBiOHsjmQb",
+	"lBaJIHLPZKUy8bAlCWjNwIyOCEc4MgezNh2l+JGkRRpcXpyrg5r5p0VgYXBbxBvwQPW9+r0+Js8hi7mB",
+	"Ro8etqCCxy0uuNRuaBaBVmFkQ6HwHCUFJ/fwTwuSZhHPBGyFcw/MEopJCgsXmIkpTRrkoleuRJbbYQXN",
+	"BMrhcylnUGK4CzqicqTgi7vMLQ6iW2UdUnNIvYAkAdZZmkPWTYuqVG7ndaKkaI2Zlwta063RQY+BBjJJ",
+	"er8o61tcJBAHYVBxG8kI36rfImUw0uUl3TuEUPVd5HE3on1ivqZfVVgLPbRomMuA7yfO2lLXwBlBtnrr",
+	"ny7wundQzCCzNFJfaxIjcwjSlUrdzUoa/Z+UNGZGCGsxxSgV8tCEb7lGSHtYR5muj2pL7NjlMDil2QYR",
+	"wREntwnJNrwNR14IhNcCWK1eDRSPOHNUIZ4UG482kZHfC0AJfQCmrHEJFiRDCQihjpJZjGKyUUNCjplC",
+	"xe0ObXf5FjIeInIGZ+gm2GAWQ7ZglAO/CQZFnYLFaBkTSGOybt8veCarZLMISi8PWlOmiaemInvGENt2",
+	"9xJfvE02WQRcULNMtaLfKMnsSf0mWN4E5Uqt1VLzZedSHYiCgz4a3F9yuRTkQByWuvxcQTTDzG2QMEQY",
+	"uv8SZz7M19p60JSCwDEW2CmsptFJtzQvjX914HQBwo/Am1DeY0ZwJs+8/K7QBiAcxxVJXb/78BEtcU6W",
+	"9xdL04gvn6oN5XkpGyoCG3Xy/BFEuQL8Xe63Mk451oQBFzS6850LGwRliMjFjYNq28/waaiC/+UoaEDk",
+	"DRFYh0h8CbpT8oqT/wCiDEU0oQU7NC3pI/a07q5to6nE2K+T3hUePFlkNJAUIkWRTdQpzlTcSrgq4EVq",
+	"6hCmm/BZGPxwV/jQ18lec8S5o3t62bFcqxpnWgozCGyy6h7bwIe7YvpO4BC8v9UQRwb3OCk0U8A9SFOi",
+	"WVvDMbc7+yWRxAPPLAyiViTmPlnSYjiLWPs7vytcImnBexCB3KepNsijWmK7prqXcSt4oFv1Qy6si3zT",
+	"72ezrgNrqdi7a0HrU+xe3g9KVFxFylo7nUcHLXxaFMmisb4tPQeGsU4vRuaN8H1pNDTQhvV5jcYeP5iD",
+	"TwcSOo3L3SD+zPdY3qOukl0ee+ro81DyzOXTQrb2l9j7jD3mGo/EwxAYverlAHi5kQ+3U40bXPoRzvPk",
+	"amt6KXCON+Mv6m19H1x/B4hvcXTXOE1Jp4IZt0tYSDAun/ouLv46cG+hPRqUxlK5pX1z/n+/HTJwmdHL",
+	"HiZP9zSmrgE7eh8Oe3A1zboTBgXvOhgN2qxt07CF8Wm6v12LhkSYtxZ7sKaFwzl/qXlNByImcuTbwh71",
+	"Rx37eob/wenv+yK6A+HXGmcTlJcpzzsJja86r/37nEgaZGJ7Cev4mrg0HtwczIOBC6xdxAeEVtfsdfs+",
+	"5wbPxL4IoZcUQv8gvL4SM1w+53gLGZaYLC288OqvQd8hO+Y4b6ExI34h2Rcg2Z9VtT+cyjZtPgcyJp2E",
+	"OnwU0F7qgdWtWYG/3Gl8udP4cqfxB7vT8FHNPPea3kCs0Ni3a6rDQIuUZD/pqhcDOoJBnhlicJrXlefs",
+	"/sK6YMnI5ZY1x8I2XsfyzsxDuC3i/nLv8dncezjarvXu5HM2ZNN0NO11jGu/B3X7asQJs7LfX5xXvziv",
+	"fsLOqzXqtQ59+0YfjeLK+qi7EVxYDjEwkROZGSYHJTVAnBGWNMa20DHKi516ZgYMrfway+EU+55wIZcJ",
+	"7SbnguVD+zsWA3t7D5m4igRlh9Fb9A9DoKvS0GuKD4PHhcAbrumI3GMBK5yT4NcaxFJ/PV3M5Pg16ZSM",
+	"HUbgcbP9Z3XVNyGqWcUIo4SsIdpFCaCYpphk0r0pEygvbhO1U8igY1WTL9WflSqX7gw5idrhxJZS+qRG",
+	"k7BKlvI6wGt4SByiiGa8SIFxFENc6EwvgBjEkJB7YBDrukp5Jd4IgFKojZJuDXLyqKQ0igrGJoZPazR2",
+	"qiBwT2jBV9WGPsI6jHmHhUVPZXUPjHvdxkkWMUgh0/Fa6JYBVkFo0RZnm0pV12ugO/O7j3clN6k43uol",
+	"avpnZkcPDDrOckyqf1zVRP/CtyTPnf/LJa/a0DRX+Sj8KsxYg2wDYaGWokYelSvnmmlL1aW5dKHhhnKB",
+	"LP3V6WY6z/OrOGbAJ2s0ROy8KxTRNIVMdJQVmWAd7eZZ6Lc069gnKRc4WXWkF5BojEhOIBOrzq2WCwYg",
+	"jm+yr5a/AZSdX4W5UCO+hK0+z2m6bW35Z8RDGApw7O+vzs/DIXuQQx916ZFRASp8xuSwYARYPVHQxfn5",
+	"edhLVfUef/rwDr2++PbbxQXCSb7Fi1fI1EXWTcWBvQb5q7CH1qbkLmoRojufbwcbt6l0IrorGq7jRv8e",
+	"otuCJLEU0jK2COeYidREmVXj/HVwnNZt315k3E2sb8zpf7JiElEukJTqhQnyMz9LdiE0q4VvqSKO8gRH",
+	"MgoO1pQBIgI9YI5IJpTSBfHZTXaVVmlTSIZSklGGioxUVm8lmLNoh76Cs80ZuqM5RHf86xAptVl2FyVF",
+	"DOjfVx99+VRs8xXhdPXNq4vv/BqlTQJT0xpIJr79xr+9yu2PZJvVGmBsk+JWUIETDx2VhnqDUrUrmfkF",
+	"4ZjOO3rGCrtSY8zxLkQWBJQA52XeG5QnBUd2RkjOaNSY99jDE/+++mhXJJYLKielgRvRZ/M43lo6B4vO",
+	"kjWWQ0NmcdLHCDIXz37ufeNv3gywq47zllND2YHbmM2BRYq9GE3RhVzTi/NzeTm2Jo+SH/VS9/PQuIUd",
+	"yPmX4sdVwX1JYMzNszoBpMZubSFQyA7RubydKrKEpERrmyPgsQOucmDyg80YWR5BMJKNZ8JAslU3B5tr",
+	"dtTLyQdZG/qQdZ9VdKGlsKaQUeSPZGkDNYp0lV7cY4Ctn9IG7wbKo/TUdr1GVT/Z9S662YnEFgu5yWR/",
+	"Ecg9zUyWSVrpcTDtrEmTzVtM3aAjh5d8VF5Hfw2nBhU1sTMk6a7ZaOeMOn7LjcLB7IbcA7dJ19Tyyqxr",
+	"sqjCNcqoaGdLMzwRU7kUbtfpJHUgrHYswmVXdtfpTKoW6TN7SVa3lCaAs54EauGRtIfKPlBH9MN25+JY",
+	"z8uB3aebOjJpip4wk+5rwIzfnwe3YkXFmn4OnzxVks5rSX2vX9VzNoQmYUOIboLFTSA305tgJTMATMq2",
+	"+jocsd9ba4vZxtXVz2OHceRTVQXGmdOOrB/0p+44va4wAM+L6A39MDU29Q5hr0Go38JwRLNkN8lJoK4I",
+	"jBlLt9BDhWZJVKEt6GsWhHOVjRlpqIxQHNj0h8Se+p4RnamNTt7rM1OGbim9Q6CsNYIiY6N1yEzQENkJ",
+	"SSqXbapSwqWLyJ0syv0pOFR/u1UKYks9YNwExgYlReqNsk9YKSs7LvIR+W+ag4zEJp8cjw4Mj0kz6hvr",
+	"Xdm4HZJtS8bC/c4F5Oje2b1XHyPuEI5r1DX8VJrvKyPvdButxvJ7EAWboWLMuKdqjmivrHqc+VzNsGWz",
+	"nWY8rN1vjMbKPre0fYE2E7LcNo92EjKIpW+aI6qkibHckkrH10FZMjJHrkaNjm8rTfsnjGxzx7c6MT+M",
+	"nWrsMwAlCEr2Tj0vmmY1lWbYPFw2qxuJg46NbxwT2imYdTTe7Ga36kfO4IbT7HsalniGc76lwmLJt2fj",
+	"O8jQwxYyZ1d+wBZxQb9K0D7MHvq+8WVuDhvL5Ew6PN6tyY8gyp354NF+MQhMEo+C/F/GIFCqESrN7C1l",
+	"QuewV8xUXrRX1WRO2F1Dk9O8JoUlLcRNJgtLRbrS8nvS6qOvbvShWuFqxUBiCOJLdFOcn7+O9J6jvuEm",
+	"+HqCn1a//wXeWdoc5vZrU/lzU14k9c3Ta3EikxjGK8Fw5rxs0bzIlDCC1v3lRIALaVizNuMU7xAHE2Ki",
+	"KUqDPem0FVE+chnVFeWYaKZqexq/E/QQ4hwtz1maLqekuSRcMVuvdYtBDJDqZKMly3tthVuSp/bprnlT",
+	"/GC66DhNd3GcLlptCRfU52WgaUrXQg6phogmMXCB1oRxMTZ6qQ216vj/6dHfqn3AA/+R/FFKAWB9japl",
+	"aCEm9PLrnhLjsK6XJ3Gq3c/vf86LGZ4INGu87s5l2c0eE9GNGSPaVDl1rj27HMORdB1cGbR6nuxQoyJb",
+	"0TzZESpRr3LFK7OamZLUISqnv71CElxcV9vjRKr28PTU7GaCduJVF46/SVX1pY2L77iA9CbQXlgVE6MU",
+	"x2AlNAd2T1Qufg7JekYcrzT8Ow6pdfike2p1yir9c8a8hNHnuDrytTwXNGd5HYSGFerDylIx6iJTBh6Y",
+	"09acEBJsm07cAJ3DY28YRdV//xTMRemcU71qONUQpVoNQm8774ddf50m/EWbCSbOtgak+hicuRlnXPCL",
+	"Z5QvO+xxd1jPkp7COD7nONCmi2ma8mmU0H5Ua3PwDB7X1tqpKNPDjUh/ozvvh10n7fxDCKkGqEcVVd6x",
+	"TspDDR1KwVO/wLXn1oNzm6OUzz7sdilb7sk1CI/AsCX01QFylKp0XRkdJhilt/TBeAuXYQTGep8zUOZ7",
+	"eSvvvH+l548fMBEcWUNHS+9K7W7ndU9OYG19lMdFXkdbiO5oMdmQYXDyxjT3GqnGOHw11b7U7GPtxi6s",
+	"g2tVwjWNMSXCZs3/77Kh1gXuSdxx/jTKRn3hEpLdeelEmQjH3MPpAbtTgXQDPI2gJXIkpLy4TYlAJOMC",
+	"cKxfpZSmFnnuldDbZUJyar4oyp53Rvy3SeoRHJxOywjSn8HagFEOWg7Rg0Ht9DnXtW6OCtQc0i+Xm+Jv",
+	"QC/xdnqkm2qjRTcNrvLE3kzOYp6YUolTJj6a97IaeJkhaeAW/JrRCDh/o21FKvbWxrnV8VNakUzI7wPc",
+	"bim9CxWCNGqUCSmHiKxJ5FwsmSC1SQBwbyqyWgsjO7zAmo1KOihLYEz8Uj+kto0jvEYC0AesUX5f+lJH",
+	"K9fzbnU6YhGNimfKZTCzBkQNxIDTpDDS7EBxrHNklUZ/ZzR578Xklgo6b7xr2dQ3YI/9jcG6yOLVgCql",
+	"a1X3trfFDphjzNVrwiAC5b9futdY1XL/THYnOtr6I799huXSwljHYKVOm4U83N2LQ1QnMAod0OgzZ1OZ",
+	"aMpxGeATyTqnAdNpTGf64nYFdDCIKHMiIWv3nObS72+VW5ugpTOHDVLC9g1BnFkNe0t5uRvxMs6m5Pyv",
+	"5EApzgqcmB+RBu/rjpd4R5n1Tb2R+Jv8zv9MqeEHciSbaojneoSO3PfC2upqnxlnIxwVnH/gNbJmjRlz",
+	"ru4pm3RezVEaLOo3h0OO5XUMjAjHn3/neUJA910cfsBL5F5FRheOMXChGBi5h1gHHqmTj+PLcNSr673k",
+	"gqMseFWEGgZGS4+E4tjZzg6W9Hr/He1aJ6m5yvNkdxU7KSN/b59E+pLbdPbDZ/VjHtb/sMui2rMQMxKn",
+	"mLdxpqQCHATBJu0aMlWUY09KFDQBgGmoOHyC/Z73QsKgM3OVKWiaSPT4ofnLlUOXNBhirRFZP1OagVJl",
+	"+J1yMfkbsli2HqvI9i9r4eQB77htPCOMe8xDJwde3kPxDL+Kv6eUi5dkGgeGF+IaDwRTfUFWUxLhDnBZ",
+	"J1cIkoJx70dqPOW/72bRIZmM63xEKUkSwiGiWczDsvonyTAl7g69lgfjkeuCRVusnIRekEtcKF6KT3ww",
+	"HHSDye0Aq9fncUe6zrIKThLJEVOfomp30Bz38NiaSYsJYPYGM3Ft3tk/JQX6xj4N3fWNPG3y7lGlIUqr",
+	"sGUbkUI4iuTQOiQw9BYo42cOxh3SIyd1nyT2C8r5dkpb8VAInUeQ9pkee49ig4VmWrrm0uYQGCch07FA",
+	"zKfY/TN4jwS1M7P3BAL12tBLgI+B4HmZwueG0u4P8DyWU42/xyLavlFG3J+zHJPY2r9/P0Kfe8Np5v2D",
+	"zeJ7MGC7Ot4DYo2AMhrzhBts1/AnEV5Dg09DgRNy6nnKxnQ/Toq4tatY1gNOcQ9aqV/F/8u57j812fRD",
+	"cjoKGgfHxLN02kp+1uO8NzKlGhZgjwqHSOOu5+tVLE2ZVAFrBm5jxlLeHm4IdhyiKtu960Ii7eEqc53o",
+	"yKWoulql4H1Kr3t37nUwrHfrIO44JHIAZrQvGbzQMWkULKdnyC5I3P9P94LGHFeA+WbTSUsy+VpMrHLq",
+	"uEkdnFbc/71uRP376ehAb09OhtCmUrE+9KWUwqzK72DyPqgbOx3lNZzarI62xiSOtcb7C5f3yuNhP821",
+	"2dUhoOLA7iGuHk16CWHngeLkYq4HhpkJ2w598h6AdsaTWgdlnR6QDrY5zHxP66hvafXlCNMY0TnC1Hjq",
+	"JQYDlZKESjZmzacu571MO+Dqtvd67i9ufs7YJyFwvHCcXOT0QnFAU5/26OpJY2dc94z3rXG6VeTJQD1w",
+	"CnEtS1qZpxyVUX8jDXrHQNk+ZKm3+9Yli8zhsOdW3d/zPJhNpy90POkY/SRsMzD2gXfo8Ybquolpn4sV",
+	"/wzn0cl7SPDuXSFu6eNcIq51YW9ba47FCd6BR6L8S22acjsr77NqLwSq1wD58L2+HWAOLt9DApjDW5Uj",
+	"PNYbWc22dtAe/diR1XvRY+sgVvWGtjSJxyFH9z8PO3I8OP0O3B74JLKje9gDi43uG+Lq1Vib3hrdAjIB",
+	"HLaoVBethh6WX2ov3kKiE8wS6RAsSIKI+IuMbSZxEB7umNFG19iTRUMaOnbCve7uugE69rmiS7Hnd0XD",
+	"m3D/FMB7GqpaSJq3c8h0DKcXDI1RTyIVOsY8lE+I5MpaXEyLuxVPc5rEx9We6/OcRxWl1n160vANfRL6",
+	"6Bv4E3DD8IHX43rxCR0B99wN+ib+ZT/oQ9Nk3lcN39jn7U+RlKfbkIdZ1yWp8yK/qlQ+yF+jV0ZpWSJB",
+	"9ClMWGw9a5sUm+ppG9M1ktTPBWVcGfRqRb9Rktl7jZtgKV8YIWdwhm6CtYziZXzJKAfue2FE5fwu79Ma",
+	"G4opaYOS0myjY57IbUKyDfe/8pkUm73jlbTlUfZUGh1LiA0Cp8UlvweV/vs9rBnw7UeZMGpOkKtqXaXG",
+	"6p9EvfpoqKYGUQ08VrkX0LX3r3wz0DF7DRYeg9mUZO6vF81Z7cehGTy0uRTSXOyQ7gml9N5khigJ3Nwq",
+	"Sg4eYpn+56U6WOB5PP74Fyn4RQoeXgrWqO0QXGrRMkQqesQSi/63tJy2/jRTOMZev6EeSqZ5R3IYXYDw",
+	"IzSfeEP3mBGcSbOIer5MlcMjMclj7gqO0oILxAXeyRpqlUap1D+CKHHP3+Vd3hKTLgylyryKIRG4PceP",
+	"Mloqte9b3AQkQ6r+TVCtiCqNtjjbQIiAiK0yGl7eZAukae0eLnUr2xVRL44zbVP8qkyoogyFHKWUlZjk",
+	"X8tuMthgfzcxlN1I/CEb0BJ/fZPdZB9U7cbalHqtbK/BjssfTfYefuY3bA6xA/9TscOLUmxH+FP/Cn24",
+	"Kw4hs2wS4ckvMx+JLyXhas6wbGg5xsofSd8xww/V8U55Z6pGQTgTkQeK1XcoanSOv0bYdClkXd4w/aoH",
+	"rNUnkkTNfTnHGgva5LpW16Z+wxXiiHQwIthPG7Jz62umh7DN61P0b+wyF4NZ3mtd+ZNJxyDRAFHBiNh9",
+	"kHJFD3YLmAG7KrQWSFTuVcA6rZyWX8F/L2QxZeQ/uJ4oDefk/4O0w0ndNlurREqCiESWvY1oiq6ufwqc",
+	"mOTg/Ozi7FyTK2Q4J8Fl8Prs/OzcqFEKoCXOydIYJ5b3F8sIM7FUMXSLiGbCPhuTm5DpOpmpcDXlkMhR",
+	"WdtxfPopDi6rqEsmuGrwpqpp8tN9T+OdthmpEufpbtnL8jeTZEnL532iIiXy1BLynGbmQYBX5+enGJvr",
+	"hetCYIk/xIsoAs6RBVLLjjUuks5UxuV8lm8Zo5rPeJGmmO26V8lapmSBMkk9LgwdLBStCFbAc+gnEHPn",
+	"PIJEzMV7Y3iEuUkYuSzNyD10Y2/vT0I4XW4gpyEd/+h+4pFkU/nq7ksm/pXak1CUn8GCKk+HYSIpfRro",
+	"WiXSIkJArJIQgVETuM4abfOqqX6NCYOwyvuhk5Icx4vjUlHDSeQ0xFMb1EszqobF28EkjNvrPgSj4vKX",
+	"fJdFC6O2LEz+GAnX/F74AseLMu3HPv2U2QfGd7Q2saZLfZVzmWtfu1X5fuOK2rT8EztsMte45mq05a0M",
+	"01zoe6ZFoaJKF9WTBjN6MrNZxDbkc2Z3JevypYZuagcGvwsT3rWoRWrN7sw6WS0kUS9qgSVz+tMZGvdo",
+	"rq+7F+4F55yOimz/rowu0OaRhZTck/ubR9V29KUUojvJ75HNWzajkz1hMHahhb5GiBeOD9o8aGRzmNFS",
+	"2qlmNCupYqDt/cUSF2K7jGi2Jix9m2JihttFsvYGC3jAu0VEmXFTkPnrudyS3334qJyYyIZkplOnV6U7",
+	"PBmHz+dljd1iSEBAzWVM7fJyey8Vb5sjH4QSQb80NQ7ZNdI2zCy4tFZlcwKrsiJUZz39Cky1GzfPhb8e",
+	"cXevzWzwJDF3NzeHVYUs95j6y6/Pv7qbvTNSc6sPWyuvTv56kSw6MMk0ei+D21v8zd0334iL/FuBX4nk",
+	"PMUytNW8k7fCkTLU6rr4N/gOyHf53bdJ/up8/fv/+e61+4SepFaW6H3VjKFU4iZAynSNBVX7rfkH3rt6",
+	"oKXFDQg/kf0Ioqaef3a01pzgUc8fY8nuRxAoqo/4GZPfCFm4fKqicJ+HBOMPqsxd1ZNTbegJcVWWya5B",
+	"6ska548zz39IAfR7AWxXQWQ8lF6SN9sL2cGdumKdZY7No94xP+tNIi86NokPIP6c3GbHKeUUsoGTPo6y",
+	"Zd0D9r3acxBeP0NvcbRF7ZbyshUjDnLhPFT9iQqIBuF1SIcPzd302KKhPeCfc/dmNKWLKuVD9479HqSz",
+	"1rWs/0ZW/9yUzMb8Ou2VshaqsmEcm07bA/4ZDjl1pEtVW2xdJPhykLTM7Obo8PmS7BCxXhkknY5a5Ur9",
+	"WUjVqFptlO+axFpLlMOUt7F979FJo32GpEeKakA4YhADpNKtagtZ4ymQBEcQe8ldDf/CBH/4K6z2zMwV",
+	"VhO25z8dw2l6+3OwXE2LUZdhweVT/Ufr6dzx+/JJmyacwvLyqjQ2u2aMZflsxYQm+vWGjjam1Ntk+aQ/",
+	"Vi0o9U2EeVpnYTwVnsz//roqvxbvKVo+SYJ5Hq6xzDVYvprO7diTGxDs7ba8gOsoWT7ZsLHnUZWW1UPv",
+	"4ysvn/TH5FHchsvy1cUR7cvHiZZPZRof79CNm8Llk82p6K2t+6p12oNhKc65o/jjOGbAOUyrvHwyn+0p",
+	"ONd1nl+bxsGhKkv3tmx8ZQ+D+1tY78MpdUd3rix2I+t5OtXYlxdZkAm5WYGvXEccXCnfrI8mqqi7kgl+",
+	"7Kign+XvqcbaIVKy2nO527T0oAp66TVqto9Ku5CzC9rmEhu+2G5Qkle7kXk/od3G7hK+Jkz46jPhqaxz",
+	"RberG2brnAUyYr3d0u4GwfOvz/8zAKCarvnM+gAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -2,6 +2,7 @@ package presentation
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -85,7 +86,7 @@ func (api *ApiImpl) CartClearCart(c *gin.Context, userId string) {
 
 	c.JSON(http.StatusOK, oapi_codegen.CartClearCartRes{"message": "ok"})
 }
-func (api *ApiImpl) CartDeleteCartPosition(c *gin.Context, userId string, productId string) {
+func (api *ApiImpl) CartDeleteCartPosition(c *gin.Context, userId string, productId string, params oapi_codegen.CartDeleteCartPositionParams) {
 	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
 	if !ok {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
@@ -107,7 +108,7 @@ func (api *ApiImpl) CartDeleteCartPosition(c *gin.Context, userId string, produc
 		return
 	}
 
-	position, err := api.CartService.DeleteCartPosition(c.Request.Context(), userId, productId)
+	position, err := api.CartService.DeleteCartPosition(c.Request.Context(), userId, productId, params.SkuId)
 	if err != nil {
 		api.Logger.Error("delete cart position", zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
@@ -143,7 +144,7 @@ func (api *ApiImpl) CartSetCartPosition(c *gin.Context, userId string, productId
 		return
 	}
 
-	position, err := api.CartService.SetCartPosition(c.Request.Context(), userId, productId, params.Count)
+	position, err := api.CartService.SetCartPosition(c.Request.Context(), userId, productId, params.SkuId, params.Count)
 	if err != nil {
 		api.Logger.Error("publish carts positions", zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
@@ -153,6 +154,119 @@ func (api *ApiImpl) CartSetCartPosition(c *gin.Context, userId string, productId
 	c.JSON(http.StatusOK, oapi_codegen.CartSetCartPositionRes{SetPosition: position})
 }
 
+func (api *ApiImpl) CartGetPromoCode(c *gin.Context, userId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	if !slices.Contains([]string{shared_api.SubjectTypeUser, shared_api.SubjectTypeAdmin}, accessToken.SubjectType) {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+		})
+		return
+	}
+	if accessToken.SubjectType == shared_api.SubjectTypeUser && userId != accessToken.SubjectId {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+		})
+		return
+	}
+
+	promoCode, err := api.CartService.GetPromoCode(c.Request.Context(), userId)
+	if err != nil {
+		api.Logger.Error("get promo code", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: "failed to get promo code"}))
+		return
+	}
+
+	if promoCode == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: "no promo code is applied"}))
+		return
+	}
+
+	c.JSON(http.StatusOK, promoCode)
+}
+func (api *ApiImpl) CartApplyPromoCode(c *gin.Context, userId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	if !slices.Contains([]string{shared_api.SubjectTypeUser, shared_api.SubjectTypeAdmin}, accessToken.SubjectType) {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+		})
+		return
+	}
+	if accessToken.SubjectType == shared_api.SubjectTypeUser && userId != accessToken.SubjectId {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+		})
+		return
+	}
+
+	var reqBody oapi_codegen.CartApplyPromoCodeReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
+		return
+	}
+
+	promoCode, err := api.CartService.ApplyPromoCode(c.Request.Context(), userId, reqBody)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidPromoCode) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
+			return
+		}
+		api.Logger.Error("apply promo code", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: "failed to apply promo code"}))
+		return
+	}
+
+	if promoCode == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: "no promo code is applied"}))
+		return
+	}
+
+	c.JSON(http.StatusOK, promoCode)
+}
+func (api *ApiImpl) CartRemovePromoCode(c *gin.Context, userId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	if !slices.Contains([]string{shared_api.SubjectTypeUser, shared_api.SubjectTypeAdmin}, accessToken.SubjectType) {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+		})
+		return
+	}
+	if accessToken.SubjectType == shared_api.SubjectTypeUser && userId != accessToken.SubjectId {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+		})
+		return
+	}
+
+	if err := api.CartService.RemovePromoCode(c.Request.Context(), userId); err != nil {
+		api.Logger.Error("remove promo code", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: "failed to remove promo code"}))
+		return
+	}
+
+	c.JSON(http.StatusOK, oapi_codegen.CartRemovePromoCodeRes{"message": "ok"})
+}
+
 func (api *ApiImpl) PrivateCartPublishContents(c *gin.Context) {
 	var reqBody oapi_codegen.PrivateCartPublishContentsJSONRequestBody
 	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
@@ -184,6 +298,23 @@ func (api *ApiImpl) PrivateCartsClearContents(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "clear carts applied"})
 }
 
+func (api *ApiImpl) PrivateCartRelayOutbox(c *gin.Context) {
+	var reqBody oapi_codegen.PrivateCartRelayOutboxJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
+		return
+	}
+
+	relayed, err := api.CartService.RelayOutbox(c.Request.Context())
+	if err != nil {
+		api.Logger.Error("relay outbox", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: "failed to relay outbox"}))
+		return
+	}
+
+	c.JSON(http.StatusOK, oapi_codegen.PrivateRelayOutboxRes{Relayed: relayed})
+}
+
 func (api *ApiImpl) ErrorHandlerValidation(c *gin.Context, message string, code int) {
 	api.Logger.Info("validation handled", zap.String("validation_message", message))
 	c.JSON(code, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: code, Message: message}))
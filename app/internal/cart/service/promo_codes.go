@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/cart/presentation/generated"
+)
+
+var (
+	ErrInvalidPromoCode = errors.New("invalid promo code")
+)
+
+var promoCodeRegexp = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// ApplyPromoCode applies the promo code to the cart. Promo codes are case insensitive.
+// Coupons of the promo codes are owned by the orders service, orders are placed without discount if the coupon
+// doesn't exist or isn't applicable to them.
+func (c *Cart) ApplyPromoCode(ctx context.Context, userId string, req oapi_codegen.CartApplyPromoCodeReq) (*oapi_codegen.CartPromoCodeRes, error) {
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if !promoCodeRegexp.MatchString(code) {
+		return nil, fmt.Errorf(`%w: code must be 3 to 32 latin letters, digits, "-" or "_"`, ErrInvalidPromoCode)
+	}
+
+	if err := c.store.ApplyPromoCode(ctx, userId, code, time.Now()); err != nil {
+		return nil, fmt.Errorf("apply promo code: %w", err)
+	}
+	return c.GetPromoCode(ctx, userId)
+}
+
+// GetPromoCode returns nil response if no promo code is applied.
+func (c *Cart) GetPromoCode(ctx context.Context, userId string) (*oapi_codegen.CartPromoCodeRes, error) {
+	promoCode, err := c.store.GetPromoCode(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve promo code: %w", err)
+	}
+	return promoCode, nil
+}
+
+func (c *Cart) RemovePromoCode(ctx context.Context, userId string) error {
+	return c.store.RemovePromoCode(ctx, userId)
+}
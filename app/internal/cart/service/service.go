@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	oapi_codegen "github.com/bratushkadan/floral/internal/cart/presentation/generated"
 	"github.com/bratushkadan/floral/internal/cart/store"
@@ -52,11 +53,11 @@ func (c *Cart) GetCartPositions(ctx context.Context, userId string) ([]oapi_code
 	return positions, nil
 }
 
-func (c *Cart) SetCartPosition(ctx context.Context, userId, productId string, count int) (oapi_codegen.CartSetCartPositionResPosition, error) {
-	return c.store.SetCartPosition(ctx, userId, productId, count)
+func (c *Cart) SetCartPosition(ctx context.Context, userId, productId string, skuId *string, count int) (oapi_codegen.CartSetCartPositionResPosition, error) {
+	return c.store.SetCartPosition(ctx, userId, productId, skuId, count)
 }
-func (c *Cart) DeleteCartPosition(ctx context.Context, userId, productId string) (*oapi_codegen.CartDeleteCartPositionResPosition, error) {
-	return c.store.DeleteCartPosition(ctx, userId, productId)
+func (c *Cart) DeleteCartPosition(ctx context.Context, userId, productId string, skuId *string) (*oapi_codegen.CartDeleteCartPositionResPosition, error) {
+	return c.store.DeleteCartPosition(ctx, userId, productId, skuId)
 }
 
 func (c *Cart) ClearCart(ctx context.Context, userId string) error {
@@ -64,20 +65,34 @@ func (c *Cart) ClearCart(ctx context.Context, userId string) error {
 	return err
 }
 func (c *Cart) ClearCarts(ctx context.Context, messages []oapi_codegen.PrivateClearCartPositionsReqMessage) error {
-	return c.store.ClearMany(ctx, messages)
+	return c.store.ClearMany(ctx, messages, time.Now())
 }
 
 func (c *Cart) CartsPublishPositions(ctx context.Context, req oapi_codegen.PrivatePublishCartPositionsReq) error {
-	positions, err := c.store.GetCartPositionsMany(ctx, req.Messages)
+	positions, err := c.store.PublishCartPositionsMany(ctx, req.Messages)
 	if err != nil {
-		return fmt.Errorf("get cart positions many: %w", err)
+		return fmt.Errorf("publish cart positions many: %w", err)
 	}
 
 	c.l.Info("carts positions many", zap.Any("positions", positions))
+	c.relayOutbox(ctx)
 
-	if err := c.store.PublishCartContents(ctx, positions); err != nil {
-		return fmt.Errorf("publish cart contents: %w", err)
+	return nil
+}
+
+// RelayOutbox publishes messages of committed state changes left unpublished, i.e. by failed requests.
+func (c *Cart) RelayOutbox(ctx context.Context) (int, error) {
+	relayed, err := c.store.RelayOutbox(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("relay outbox: %w", err)
 	}
+	return relayed, nil
+}
 
-	return nil
+// relayOutbox publishes messages of the committed state change right away.
+// Messages it fails to publish are published by the outbox relay timer.
+func (c *Cart) relayOutbox(ctx context.Context) {
+	if _, err := c.store.RelayOutbox(ctx); err != nil {
+		c.l.Error("relay outbox", zap.Error(err))
+	}
 }
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/cart/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tablePromoCodes = "`cart/promo_codes`"
+)
+
+var queryApplyPromoCode = template.ReplaceAllPairs(`
+DECLARE $user_id AS Utf8;
+DECLARE $code AS Utf8;
+DECLARE $applied_at AS Timestamp;
+
+UPSERT INTO {{table.promo_codes}} (user_id, code, applied_at)
+VALUES ($user_id, $code, $applied_at);
+`,
+	"{{table.promo_codes}}",
+	tablePromoCodes,
+)
+
+// ApplyPromoCode applies the promo code to the cart of the user, replacing the applied one.
+func (c *Cart) ApplyPromoCode(ctx context.Context, userId, code string, appliedAt time.Time) error {
+	return c.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		_, err := tx.Execute(ctx, queryApplyPromoCode, table.NewQueryParameters(
+			table.ValueParam("$user_id", types.UTF8Value(userId)),
+			table.ValueParam("$code", types.UTF8Value(code)),
+			table.ValueParam("$applied_at", types.TimestampValueFromTime(appliedAt)),
+		))
+		return err
+	})
+}
+
+var queryRemovePromoCode = template.ReplaceAllPairs(`
+DECLARE $user_id AS Utf8;
+
+DELETE FROM {{table.promo_codes}}
+WHERE user_id = $user_id;
+`,
+	"{{table.promo_codes}}",
+	tablePromoCodes,
+)
+
+func (c *Cart) RemovePromoCode(ctx context.Context, userId string) error {
+	return c.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		_, err := tx.Execute(ctx, queryRemovePromoCode, table.NewQueryParameters(
+			table.ValueParam("$user_id", types.UTF8Value(userId)),
+		))
+		return err
+	})
+}
+
+var queryGetPromoCode = template.ReplaceAllPairs(`
+DECLARE $user_id AS Utf8;
+
+SELECT code, applied_at
+FROM {{table.promo_codes}}
+WHERE user_id = $user_id;
+`,
+	"{{table.promo_codes}}",
+	tablePromoCodes,
+)
+
+// GetPromoCode returns nil if no promo code is applied to the cart of the user.
+func (c *Cart) GetPromoCode(ctx context.Context, userId string) (*oapi_codegen.CartPromoCodeRes, error) {
+	var out *oapi_codegen.CartPromoCodeRes
+
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	if err := c.db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, res, err := s.Execute(ctx, readTx, queryGetPromoCode, table.NewQueryParameters(
+			table.ValueParam("$user_id", types.UTF8Value(userId)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		out = nil
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				out = &oapi_codegen.CartPromoCodeRes{}
+				if err := res.ScanNamed(
+					named.Required("code", &out.Code),
+					named.Required("applied_at", &out.AppliedAt),
+				); err != nil {
+					return err
+				}
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
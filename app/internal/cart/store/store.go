@@ -2,23 +2,26 @@ package store
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	oapi_codegen "github.com/bratushkadan/floral/internal/cart/presentation/generated"
 	"github.com/bratushkadan/floral/pkg/template"
-	ydbtopic "github.com/bratushkadan/floral/pkg/ydb/topic"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
-	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
 	"go.uber.org/zap"
 )
 
 const (
 	tableCart = "`cart/positions`"
+	// Orders the carts were cleared for
+	tableClearedOrders = "`cart/cleared_orders`"
+	// Path of the outbox table, the outbox quotes it in queries.
+	tableOutbox = "cart/outbox"
 
 	topicCartContents = "cart/cart_contents_topic"
 )
@@ -45,16 +48,16 @@ func (b *CartBuilder) Build() (*Cart, error) {
 		return nil, errors.New("ydb driver is nil")
 	}
 
-	topicCartContents, err := ydbtopic.NewProducer(b.store.db, topicCartContents)
-	if err != nil {
-		return nil, errors.New("setup CartContents topic: %w")
-	}
-	b.store.topicCartContents = topicCartContents
-
 	if b.store.logger == nil {
 		b.store.logger = zap.NewNop()
 	}
 
+	outbox, err := outbox.NewBuilder().Ydb(b.store.db).Table(tableOutbox).Logger(b.store.logger).Build()
+	if err != nil {
+		return nil, fmt.Errorf("setup outbox: %w", err)
+	}
+	b.store.outbox = outbox
+
 	return &b.store, nil
 }
 
@@ -62,7 +65,13 @@ type Cart struct {
 	db     *ydb.Driver
 	logger *zap.Logger
 
-	topicCartContents *topicwriter.Writer
+	// outbox publishes messages of state changes
+	outbox *outbox.Outbox
+}
+
+// RelayOutbox publishes messages of committed state changes to their topics.
+func (c *Cart) RelayOutbox(ctx context.Context) (int, error) {
+	return c.outbox.Relay(ctx)
 }
 
 var queryGetCartPositions = template.ReplaceAllPairs(`
@@ -70,6 +79,7 @@ DECLARE $user_id AS Utf8;
 
 SELECT
     product_id,
+    sku_id,
     count
 FROM {{table.cart}}
 WHERE user_id = $user_id;
@@ -92,14 +102,17 @@ func (c *Cart) GetCartPositions(ctx context.Context, userId string) ([]oapi_code
 		for res.NextResultSet(ctx) {
 			for res.NextRow() {
 				var pos oapi_codegen.CartGetCartPositionsResPosition
+				var skuId string
 				var count uint32
 				if err := res.ScanNamed(
 					named.Required("product_id", &pos.ProductId),
+					named.Required("sku_id", &skuId),
 					named.Required("count", &count),
 				); err != nil {
 					return err
 				}
 				pos.Count = int(count)
+				pos.SkuId = skuIdFromKey(skuId)
 
 				out = append(out, pos)
 			}
@@ -119,24 +132,33 @@ DECLARE $user_ids AS List<Utf8>;
 SELECT
     user_id,
     product_id,
+    sku_id,
     count
 FROM {{table.cart}}
 WHERE user_id IN $user_ids;
-`, "{{table.cart}}", tableCart)
 
-// Private endpoint version for messages array
-func (c *Cart) GetCartPositionsMany(ctx context.Context, messages []oapi_codegen.PrivatePublishCartPositionsReqMessage) ([]oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage, error) {
-	positions := make(map[string][]oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqCartPosition, len(messages))
+SELECT
+    user_id,
+    code
+FROM {{table.promo_codes}}
+WHERE user_id IN $user_ids;
+`, "{{table.cart}}", tableCart, "{{table.promo_codes}}", tablePromoCodes)
 
-	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+// PublishCartPositionsMany reads carts positions and promo codes of the users and publishes them in the same transaction,
+// so published positions are a consistent snapshot of the carts.
+func (c *Cart) PublishCartPositionsMany(ctx context.Context, messages []oapi_codegen.PrivatePublishCartPositionsReqMessage) ([]oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage, error) {
+	var out []oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage
 
 	var userIds []types.Value
 	for _, msg := range messages {
 		userIds = append(userIds, types.UTF8Value(msg.UserId))
 	}
 
-	if err := c.db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
-		_, res, err := s.Execute(ctx, readTx, queryGetCartPositionsMany, table.NewQueryParameters(
+	if err := c.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		positions := make(map[string][]oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqCartPosition, len(messages))
+		promoCodes := make(map[string]string, len(messages))
+
+		res, err := tx.Execute(ctx, queryGetCartPositionsMany, table.NewQueryParameters(
 			table.ValueParam("$user_ids", types.ListValue(userIds...)),
 		))
 		if err != nil {
@@ -144,39 +166,67 @@ func (c *Cart) GetCartPositionsMany(ctx context.Context, messages []oapi_codegen
 		}
 		defer func() { _ = res.Close() }()
 
-		for res.NextResultSet(ctx) {
+		if res.NextResultSet(ctx) {
 			for res.NextRow() {
 				var userId string
 				var pos oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqCartPosition
+				var skuId string
 				var count uint32
 				if err := res.ScanNamed(
 					named.Required("user_id", &userId),
 					named.Required("product_id", &pos.ProductId),
+					named.Required("sku_id", &skuId),
 					named.Required("count", &count),
 				); err != nil {
 					return err
 				}
 				pos.Count = int(count)
+				pos.SkuId = skuIdFromKey(skuId)
 
 				positions[userId] = append(positions[userId], pos)
 			}
 		}
+		if res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var userId, code string
+				if err := res.ScanNamed(
+					named.Required("user_id", &userId),
+					named.Required("code", &code),
+				); err != nil {
+					return err
+				}
+				promoCodes[userId] = code
+			}
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
 
-		return nil
-	}); err != nil {
-		return nil, err
-	}
+		out = make([]oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage, 0, len(messages))
+		for _, msg := range messages {
+			cartPositions, ok := positions[msg.UserId]
+			if !ok {
+				cartPositions = make([]oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqCartPosition, 0)
+			}
+			msgOut := oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage{
+				OperationId:   msg.OperationId,
+				CartPositions: cartPositions,
+			}
+			if code, ok := promoCodes[msg.UserId]; ok {
+				msgOut.PromoCode = &code
+			}
+			out = append(out, msgOut)
+		}
 
-	out := make([]oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage, 0, len(messages))
-	for _, msg := range messages {
-		cartPositions, ok := positions[msg.UserId]
-		if !ok {
-			cartPositions = make([]oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqCartPosition, 0)
+		msgs, err := outbox.NewMessages(topicCartContents, func(m oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage) string {
+			return fmt.Sprintf("operation:%s:cart_contents", m.OperationId)
+		}, out...)
+		if err != nil {
+			return fmt.Errorf("cart contents messages: %w", err)
 		}
-		out = append(out, oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage{
-			OperationId:   msg.OperationId,
-			CartPositions: cartPositions,
-		})
+		return c.outbox.EnqueueTableTx(ctx, tx, msgs...)
+	}); err != nil {
+		return nil, err
 	}
 
 	return out, nil
@@ -185,11 +235,15 @@ func (c *Cart) GetCartPositionsMany(ctx context.Context, messages []oapi_codegen
 var queryClear = template.ReplaceAllPairs(`
 DECLARE $user_id AS Utf8;
 
+DELETE FROM {{table.promo_codes}}
+WHERE user_id = $user_id;
+
 DELETE FROM {{table.cart}}
 WHERE user_id = $user_id
-RETURNING product_id, count;
-`, "{{table.cart}}", tableCart)
+RETURNING product_id, sku_id, count;
+`, "{{table.cart}}", tableCart, "{{table.promo_codes}}", tablePromoCodes)
 
+// Clear deletes cart positions and the promo code applied to the cart.
 func (c *Cart) Clear(ctx context.Context, userId string) ([]oapi_codegen.CartClearCartResPosition, error) {
 	out := make([]oapi_codegen.CartClearCartResPosition, 0)
 
@@ -205,14 +259,17 @@ func (c *Cart) Clear(ctx context.Context, userId string) ([]oapi_codegen.CartCle
 		for res.NextResultSet(ctx) {
 			for res.NextRow() {
 				var pos oapi_codegen.CartClearCartResPosition
+				var skuId string
 				var count uint32
 				if err := res.ScanNamed(
 					named.Required("product_id", &pos.ProductId),
+					named.Required("sku_id", &skuId),
 					named.Required("count", &count),
 				); err != nil {
 					return err
 				}
 				pos.Count = int(count)
+				pos.SkuId = skuIdFromKey(skuId)
 				out = append(out, pos)
 			}
 		}
@@ -225,26 +282,100 @@ func (c *Cart) Clear(ctx context.Context, userId string) ([]oapi_codegen.CartCle
 	return out, nil
 }
 
+var queryListClearedOrders = template.ReplaceAllPairs(`
+DECLARE $order_ids AS List<Utf8>;
+
+SELECT order_id
+FROM {{table.cleared_orders}}
+WHERE order_id IN $order_ids;
+`, "{{table.cleared_orders}}", tableClearedOrders)
+
 var queryClearMany = template.ReplaceAllPairs(`
 DECLARE $user_ids AS List<Utf8>;
+DECLARE $cleared_orders AS List<Struct<
+  order_id:Utf8,
+  user_id:Utf8,
+  cleared_at:Timestamp,
+>>;
+
+DELETE FROM {{table.promo_codes}}
+WHERE user_id IN $user_ids;
 
 DELETE FROM {{table.cart}}
 WHERE user_id IN $user_ids
 RETURNING user_id;
-`, "{{table.cart}}", tableCart)
 
-// Private endpoint version for messages array
-func (c *Cart) ClearMany(ctx context.Context, messages []oapi_codegen.PrivateClearCartPositionsReqMessage) error {
-	var userIds []types.Value
+UPSERT INTO {{table.cleared_orders}}
+SELECT * FROM AS_TABLE($cleared_orders);
+`, "{{table.cart}}", tableCart, "{{table.promo_codes}}", tablePromoCodes, "{{table.cleared_orders}}", tableClearedOrders)
+
+// ClearMany clears carts of the users the orders are created for. A cart is cleared once per order,
+// so a redelivered request doesn't clear positions added after the order was created.
+func (c *Cart) ClearMany(ctx context.Context, messages []oapi_codegen.PrivateClearCartPositionsReqMessage, clearedAt time.Time) error {
+	var orderIds []types.Value
 	for _, msg := range messages {
-		userIds = append(userIds, types.UTF8Value(msg.UserId))
+		if msg.OrderId != nil {
+			orderIds = append(orderIds, types.UTF8Value(*msg.OrderId))
+		}
 	}
 
-	cartClearedUserIdsList := make([]string, len(messages))
+	cartClearedUserIdsList := make([]string, 0, len(messages))
 
 	if err := c.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		cartClearedUserIdsList = cartClearedUserIdsList[:0]
+
+		clearedOrders := make(map[string]struct{})
+		if len(orderIds) > 0 {
+			res, err := tx.Execute(ctx, queryListClearedOrders, table.NewQueryParameters(
+				table.ValueParam("$order_ids", types.ListValue(orderIds...)),
+			))
+			if err != nil {
+				return err
+			}
+			defer func() { _ = res.Close() }()
+
+			for res.NextResultSet(ctx) {
+				for res.NextRow() {
+					var orderId string
+					if err := res.ScanNamed(named.Required("order_id", &orderId)); err != nil {
+						return err
+					}
+					clearedOrders[orderId] = struct{}{}
+				}
+			}
+			if err := res.Err(); err != nil {
+				return err
+			}
+		}
+
+		var userIds []types.Value
+		var records []types.Value
+		for _, msg := range messages {
+			if msg.OrderId != nil {
+				if _, ok := clearedOrders[*msg.OrderId]; ok {
+					c.logger.Info("skip clearing cart for already cleared order", zap.String("user_id", msg.UserId), zap.String("order_id", *msg.OrderId))
+					continue
+				}
+				clearedOrders[*msg.OrderId] = struct{}{}
+				records = append(records, types.StructValue(
+					types.StructFieldValue("order_id", types.UTF8Value(*msg.OrderId)),
+					types.StructFieldValue("user_id", types.UTF8Value(msg.UserId)),
+					types.StructFieldValue("cleared_at", types.TimestampValueFromTime(clearedAt)),
+				))
+			}
+			userIds = append(userIds, types.UTF8Value(msg.UserId))
+		}
+		if len(userIds) == 0 {
+			return nil
+		}
+
 		res, err := tx.Execute(ctx, queryClearMany, table.NewQueryParameters(
 			table.ValueParam("$user_ids", types.ListValue(userIds...)),
+			table.ValueParam("$cleared_orders", emptyListOr(records, types.Struct(
+				types.StructField("order_id", types.TypeUTF8),
+				types.StructField("user_id", types.TypeUTF8),
+				types.StructField("cleared_at", types.TypeTimestamp),
+			))),
 		))
 		if err != nil {
 			return err
@@ -278,21 +409,32 @@ func (c *Cart) ClearMany(ctx context.Context, messages []oapi_codegen.PrivateCle
 	return nil
 }
 
+// emptyListOr types the list of values, empty lists can't infer the type of their items.
+func emptyListOr(values []types.Value, t types.Type) types.Value {
+	if len(values) == 0 {
+		return types.ZeroValue(types.List(t))
+	}
+	return types.ListValue(values...)
+}
+
 var queryDeleteCartPosition = template.ReplaceAllPairs(`
 DECLARE $user_id AS Utf8;
 DECLARE $product_id AS Utf8;
+DECLARE $sku_id AS Utf8;
 
 DELETE FROM {{table.cart}}
-WHERE user_id = $user_id AND product_id = $product_id
-RETURNING product_id, count;`, "{{table.cart}}", tableCart)
+WHERE user_id = $user_id AND product_id = $product_id AND sku_id = $sku_id
+RETURNING product_id, sku_id, count;`, "{{table.cart}}", tableCart)
 
-func (c *Cart) DeleteCartPosition(ctx context.Context, userId, productId string) (*oapi_codegen.CartDeleteCartPositionResPosition, error) {
+// DeleteCartPosition deletes the position of the product sku, nil sku for products without skus.
+func (c *Cart) DeleteCartPosition(ctx context.Context, userId, productId string, skuId *string) (*oapi_codegen.CartDeleteCartPositionResPosition, error) {
 	var out *oapi_codegen.CartDeleteCartPositionResPosition
 
 	if err := c.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
 		res, err := tx.Execute(ctx, queryDeleteCartPosition, table.NewQueryParameters(
 			table.ValueParam("$user_id", types.UTF8Value(userId)),
 			table.ValueParam("$product_id", types.UTF8Value(productId)),
+			table.ValueParam("$sku_id", types.UTF8Value(deref(skuId))),
 		))
 		if err != nil {
 			return err
@@ -302,14 +444,17 @@ func (c *Cart) DeleteCartPosition(ctx context.Context, userId, productId string)
 		for res.NextResultSet(ctx) {
 			for res.NextRow() {
 				var pos oapi_codegen.CartDeleteCartPositionResPosition
+				var skuId string
 				var count uint32
 				if err := res.ScanNamed(
 					named.Required("product_id", &pos.ProductId),
+					named.Required("sku_id", &skuId),
 					named.Required("count", &count),
 				); err != nil {
 					return err
 				}
 				pos.Count = int(count)
+				pos.SkuId = skuIdFromKey(skuId)
 				out = &pos
 			}
 		}
@@ -325,21 +470,24 @@ func (c *Cart) DeleteCartPosition(ctx context.Context, userId, productId string)
 var querySetCartPosition = template.ReplaceAllPairs(`
 DECLARE $user_id AS Utf8;
 DECLARE $product_id AS Utf8;
+DECLARE $sku_id AS Utf8;
 DECLARE $count AS Uint32;
 
-UPSERT INTO {{table.cart}} (user_id, product_id, count)
+UPSERT INTO {{table.cart}} (user_id, product_id, sku_id, count)
 VALUES
-($user_id, $product_id, $count)
-RETURNING product_id, count;
+($user_id, $product_id, $sku_id, $count)
+RETURNING product_id, sku_id, count;
 `, "{{table.cart}}", tableCart)
 
-func (c *Cart) SetCartPosition(ctx context.Context, userId, productId string, count int) (oapi_codegen.CartSetCartPositionResPosition, error) {
+// SetCartPosition replaces the position of the product sku, each sku of the product is a separate position.
+func (c *Cart) SetCartPosition(ctx context.Context, userId, productId string, skuId *string, count int) (oapi_codegen.CartSetCartPositionResPosition, error) {
 	var out oapi_codegen.CartSetCartPositionResPosition
 
 	if err := c.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
 		res, err := tx.Execute(ctx, querySetCartPosition, table.NewQueryParameters(
 			table.ValueParam("$user_id", types.UTF8Value(userId)),
 			table.ValueParam("$product_id", types.UTF8Value(productId)),
+			table.ValueParam("$sku_id", types.UTF8Value(deref(skuId))),
 			table.ValueParam("$count", types.Uint32Value(uint32(count))),
 		))
 		if err != nil {
@@ -349,14 +497,17 @@ func (c *Cart) SetCartPosition(ctx context.Context, userId, productId string, co
 
 		for res.NextResultSet(ctx) {
 			for res.NextRow() {
+				var skuId string
 				var count uint32
 				if err := res.ScanNamed(
 					named.Required("product_id", &out.ProductId),
+					named.Required("sku_id", &skuId),
 					named.Required("count", &count),
 				); err != nil {
 					return err
 				}
 				out.Count = int(count)
+				out.SkuId = skuIdFromKey(skuId)
 			}
 		}
 
@@ -368,16 +519,21 @@ func (c *Cart) SetCartPosition(ctx context.Context, userId, productId string, co
 	return out, nil
 }
 
-func (c *Cart) PublishCartContents(ctx context.Context, messages []oapi_codegen.PrivateOrderProcessPublishedCartPositionsReqMessage) error {
-	marshaledMessages := make([][]byte, 0, len(messages))
-	for _, message := range messages {
-		marshaledPosition, err := json.Marshal(&message)
-		if err != nil {
-			return fmt.Errorf("marshal cart contents message: %w", err)
-		}
-		marshaledMessages = append(marshaledMessages, marshaledPosition)
+// noSkuId keys positions of products without skus, each sku of the product is a separate position.
+const noSkuId = ""
+
+// skuIdFromKey returns the sku id of the position key, nil for products without skus.
+func skuIdFromKey(skuId string) *string {
+	if skuId == noSkuId {
+		return nil
 	}
+	return &skuId
+}
 
-	c.logger.Info("publish cart contents", zap.Any("messages", marshaledMessages))
-	return ydbtopic.Produce(ctx, c.topicCartContents, marshaledMessages...)
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
 }
@@ -24,6 +24,8 @@ type ProductChangeSchema struct {
 	Name                *string  `json:"name"`
 	Description         *string  `json:"description"`
 	PicturesJsonListStr *string  `json:"pictures"`
+	SkusJsonListStr     *string  `json:"skus"`
+	CategoryPath        *string  `json:"category_path"`
 	Price               *float64 `json:"price"`
 	Stock               *uint32  `json:"stock"`
 	CreatedAtUnixMs     *int64   `json:"created_at"`
@@ -36,6 +38,8 @@ type ProductChange struct {
 	Name            string
 	Description     string
 	Pictures        []ProductsChangePicture
+	Skus            []ProductChangeSku
+	CategoryPaths   []string
 	Price           float64
 	Stock           uint32
 	CreatedAtUnixMs int64
@@ -46,6 +50,12 @@ type ProductsChangePicture struct {
 	Id  string `json:"id"`
 	Url string `json:"url"`
 }
+type ProductChangeSku struct {
+	Id      string            `json:"id"`
+	Options map[string]string `json:"options"`
+	Price   float64           `json:"price"`
+	Stock   uint32            `json:"stock"`
+}
 
 type DataStreamProductChangeCdcMessages struct {
 	Messages []ProductChangeCdcMessage `json:"messages"`
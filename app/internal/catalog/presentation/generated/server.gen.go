@@ -20,8 +20,16 @@ import (
 
 // CatalogGetRes defines model for CatalogGetRes.
 type CatalogGetRes struct {
-	NextPageToken *string                `json:"next_page_token"`
-	Products      []CatalogGetResProduct `json:"products"`
+	// Categories category facets, numbers of found products by category paths
+	Categories    []CatalogGetResCategory `json:"categories"`
+	NextPageToken *string                 `json:"next_page_token"`
+	Products      []CatalogGetResProduct  `json:"products"`
+}
+
+// CatalogGetResCategory defines model for CatalogGetResCategory.
+type CatalogGetResCategory struct {
+	Count int    `json:"count"`
+	Path  string `json:"path"`
 }
 
 // CatalogGetResProduct defines model for CatalogGetResProduct.
@@ -40,6 +48,56 @@ type Err struct {
 	Message string `json:"message"`
 }
 
+// PrivateCatalogSyncProductRatingsReq defines model for PrivateCatalogSyncProductRatingsReq.
+type PrivateCatalogSyncProductRatingsReq struct {
+	Messages []PrivateCatalogSyncProductRatingsReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductRatingsReqMessage defines model for PrivateCatalogSyncProductRatingsReqMessage.
+type PrivateCatalogSyncProductRatingsReqMessage struct {
+	ProductId    string  `json:"product_id"`
+	Rating       float64 `json:"rating"`
+	ReviewsCount int     `json:"reviews_count"`
+
+	// Version version of the product rating, ratings older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductRatingsRes defines model for PrivateCatalogSyncProductRatingsRes.
+type PrivateCatalogSyncProductRatingsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsAdBoostReq defines model for PrivateCatalogSyncProductsAdBoostReq.
+type PrivateCatalogSyncProductsAdBoostReq struct {
+	Messages []PrivateCatalogSyncProductsAdBoostReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsAdBoostReqMessage defines model for PrivateCatalogSyncProductsAdBoostReqMessage.
+type PrivateCatalogSyncProductsAdBoostReqMessage struct {
+	AdBoost   float64 `json:"ad_boost"`
+	ProductId string  `json:"product_id"`
+
+	// Version time the ad boost was computed at in unix milliseconds, ad boosts older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductsAdBoostRes defines model for PrivateCatalogSyncProductsAdBoostRes.
+type PrivateCatalogSyncProductsAdBoostRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsPurchasesReq defines model for PrivateCatalogSyncProductsPurchasesReq.
+type PrivateCatalogSyncProductsPurchasesReq struct {
+	Messages []PrivateCatalogSyncProductsPurchasesReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsPurchasesReqMessage defines model for PrivateCatalogSyncProductsPurchasesReqMessage.
+type PrivateCatalogSyncProductsPurchasesReqMessage struct {
+	ProductId        string `json:"product_id"`
+	Purchases30d     int    `json:"purchases_30d"`
+	PurchasesAlltime int    `json:"purchases_alltime"`
+}
+
+// PrivateCatalogSyncProductsPurchasesRes defines model for PrivateCatalogSyncProductsPurchasesRes.
+type PrivateCatalogSyncProductsPurchasesRes = map[string]interface{}
+
 // Error defines model for Error.
 type Error struct {
 	Errors []Err `json:"errors"`
@@ -51,15 +109,48 @@ type CatalogGetParams struct {
 
 	// Filter query search filter
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Category category path, such as "flowers/roses", products of the category and its descendants are returned
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
 }
 
+// PrivateCatalogSyncProductRatingsJSONRequestBody defines body for PrivateCatalogSyncProductRatings for application/json ContentType.
+type PrivateCatalogSyncProductRatingsJSONRequestBody = PrivateCatalogSyncProductRatingsReq
+
+// PrivateCatalogSyncProductsAdBoostJSONRequestBody defines body for PrivateCatalogSyncProductsAdBoost for application/json ContentType.
+type PrivateCatalogSyncProductsAdBoostJSONRequestBody = PrivateCatalogSyncProductsAdBoostReq
+
+// PrivateCatalogSyncProductsPurchasesJSONRequestBody defines body for PrivateCatalogSyncProductsPurchases for application/json ContentType.
+type PrivateCatalogSyncProductsPurchasesJSONRequestBody = PrivateCatalogSyncProductsPurchasesReq
+
 // Method & Path constants for routes.
+// Sync product ratings
+const PrivateCatalogSyncProductRatingsMethod = "POST"
+const PrivateCatalogSyncProductRatingsPath = "/api/private/v1/catalog/sync-product-ratings"
+
+// Sync products ad boost
+const PrivateCatalogSyncProductsAdBoostMethod = "POST"
+const PrivateCatalogSyncProductsAdBoostPath = "/api/private/v1/catalog/sync-products-ad-boost"
+
+// Sync products purchases
+const PrivateCatalogSyncProductsPurchasesMethod = "POST"
+const PrivateCatalogSyncProductsPurchasesPath = "/api/private/v1/catalog/sync-products-purchases"
+
 // Query catalog
 const CatalogGetMethod = "GET"
 const CatalogGetPath = "/api/v1/catalog"
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Sync product ratings
+	// (POST /api/private/v1/catalog/sync-product-ratings)
+	PrivateCatalogSyncProductRatings(c *gin.Context)
+	// Sync products ad boost
+	// (POST /api/private/v1/catalog/sync-products-ad-boost)
+	PrivateCatalogSyncProductsAdBoost(c *gin.Context)
+	// Sync products purchases
+	// (POST /api/private/v1/catalog/sync-products-purchases)
+	PrivateCatalogSyncProductsPurchases(c *gin.Context)
 	// Query catalog
 	// (GET /api/v1/catalog)
 	CatalogGet(c *gin.Context, params CatalogGetParams)
@@ -74,6 +165,45 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// PrivateCatalogSyncProductRatings operation middleware
+func (siw *ServerInterfaceWrapper) PrivateCatalogSyncProductRatings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PrivateCatalogSyncProductRatings(c)
+}
+
+// PrivateCatalogSyncProductsAdBoost operation middleware
+func (siw *ServerInterfaceWrapper) PrivateCatalogSyncProductsAdBoost(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PrivateCatalogSyncProductsAdBoost(c)
+}
+
+// PrivateCatalogSyncProductsPurchases operation middleware
+func (siw *ServerInterfaceWrapper) PrivateCatalogSyncProductsPurchases(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PrivateCatalogSyncProductsPurchases(c)
+}
+
 // CatalogGet operation middleware
 func (siw *ServerInterfaceWrapper) CatalogGet(c *gin.Context) {
 
@@ -98,6 +228,14 @@ func (siw *ServerInterfaceWrapper) CatalogGet(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -135,30 +273,47 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.POST(options.BaseURL+"/api/private/v1/catalog/sync-product-ratings", wrapper.PrivateCatalogSyncProductRatings)
+	router.POST(options.BaseURL+"/api/private/v1/catalog/sync-products-ad-boost", wrapper.PrivateCatalogSyncProductsAdBoost)
+	router.POST(options.BaseURL+"/api/private/v1/catalog/sync-products-purchases", wrapper.PrivateCatalogSyncProductsPurchases)
 	router.GET(options.BaseURL+"/api/v1/catalog", wrapper.CatalogGet)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/5xWS2/jNhD+KwbboxR5H+gWuqVFUCx62DTZQ4FFYIzJsc2EIpkh6Y1r+L8XpGj5ITmx",
-	"c0locZ4fv/nINeOmsUaj9o7Va0borNEO048bIkNxwY32qH1cgrVKcvDS6OrRGR2/Ob7ABtKuEDJugbol",
-	"Y5G8jJFmoBwWzO59WjOMwdNKemzS4lfCGavZL9WupqqN7aobIrYpmF9ZZDUDIlixzaZghM9BEgpW/9iG",
-	"fOjMzPQRuWebaCjQcZI2Vsfq1jQFyAli/j/BgzLzv9DftTVe0I7GFz+xMMeJN0+YYNFBKZgqZLWngF1R",
-	"zpPU89iMJSMC9+eDcFDgbev9JipdlqJXZB+pgg3muAwLKeLfXrsaGhzcsJL7QGnv8JQCqVj12zhKnrxn",
-	"hhrwrGbChOjQ2erQTJF60EjBclnbIEOIROpdBgA3Yr9TqT3OMfG3QedgPgTDUWkpxM5+gNMFc8gDSb+6",
-	"j/RoM08RCOk6+EU6iAjiAkEgbRut2b9l3DYk/0tTvAMJrPwbV+20SD0zqUjpI/DshptmdH37lRVsieTa",
-	"4xlffbgax66MRQ1Wspp9uhpfjSOa4BepoAqsrCzJJXislh8qDuRrrhCozKqSzF7KbFOmOPGgN8Wwsw1T",
-	"Jd3iHe4zRDEF/lQZEkiutmQ4OjeJk6bQo5ikjfMDJvN6Cp4vSg6aoyqDtiBF2Wa4NFKO8T7n3E2Z8UFR",
-	"RrhKa1yi7bvjETqkJYpyX67OC7T1qHOMd3gGfZ5vZke1Dg5pIsWm2m/8DKtqnXPGz8cuSRJjBXNMYhiH",
-	"PU3PV8HqPclMzCdo0KcD/JFH8DkgrXYTeCzDxd4N2lOFY01MsUYOgfhiNJPKp9keStNtno7+UBze+B/H",
-	"44vu+7Mvq6E7OBuMBHhgaXcGQflTcbtCq5vd5R2aBmjFavZPgmV7UgXzMHdJR/OXh4K9lCseiTMHjz9h",
-	"VSZZbo9x2zRInXjBajadgsLPn9XHoKTkH/SjCfEKjVyUHCfAuQnat7bwiF9QfrFPvyn7cTx7/v3Lp9lO",
-	"VxN9SbVKk3O42PBxQUtQUoBv31z5B97hc0Dn/zBilRm/2aNmJ2nbgTmgcRW70/NDNr/lgkuJP90Jn7w7",
-	"6FKt20V/gJKgVN3QuGrdrU8abzXwxE61Tv/77nsqNfD1lSkfhiO/TdwlttW6FzzqjatqCH6B2seBwkED",
-	"bvRMUjPBBqRqJe+QIzw/mhv0CyMcq9ntt/vvrGCG5DyqQEuRfmBC8HjNo6R/zy/UV6wit1+xuEelkF6z",
-	"I7QKON7hjNAtuoybbjKPde16B400epTnbKdmETrWl8P8QnV9h44Ifaet8vR8tmox5EJ+yJ78gPG3RNG+",
-	"eSb1yS5GeZL6ntsBZJuHzf8BAAD//wm0zrq0DQAA",
+	"H4sIAAAAAAAC/8xaW2/juBX+KwTbR2mUuWCncJ+yi6BYFItNM/tQYGdgHJNHNicSqSEpJ6rh/17woosj",
+	"ObGczGSfIovnxnP9KGZHmSorJVFaQxc7qtFUShr0P660Vto9MCUtSuseoaoKwcAKJbOvRkn3zrANluBX",
+	"ORduCYprrSrUVjhJORQGE1oNXu0oOuH+SVgs/cPfNeZ0Qf+W9TZlQbbJrrSm+4TapkK6oKA1NHS/T6jG",
+	"b7XQyOniz1bkl45Mrb4is3TvCDkapkXlrKOLQOoFRAVO/y9goVDrf6G9QTNzOwwsrpWOvw6VxbWG5MDQ",
+	"moTIulyhNkTlJFe15KTSitfMGrJqSEddgd0YmpzmnwPbf4kixh5LqMR7u6xgjUurbtHHT9ZFAasC6cLq",
+	"GjsWY7WQa8fTmndytA6suQ7cT4av05IMvTm2eBzfhE5vf2YIVR1yPEoX0uIafdq5UAxWWs883ICjSqKc",
+	"J61s3TLPSMEn7EiohBInFyrBbK1xnJS1LmhySugF89y50iVYuqBc1Y6how3JPHKG4DSa1QqZ8ogr67lR",
+	"4jgdpBKNgTU+HScvoqefsutaiy1YjAH71EgWw3UDVsi1ucFvM+2O2k6voRNM+C3u4KnK6nSPtprQ+9TC",
+	"2oT68wqXUAn65TQf/Na7fIYrYqEvj+Sy9uJPyjm3za3AO7N8pHi3qI1QclwCccG1YbvBtgmToD+Jfw1R",
+	"BUdN7AakJ/MTEDlREgloJOZWVBXyf5LWy+RO2I2qLWnlOyoo7qAxLTNN+r0JaX/6QJOR4dPdcenrKrro",
+	"4fZfOLxm4M/nCDSX/GeljH3NohnY8EpVM2HBPGcAX66cgBMr44kqO1oVVpQYEp0Tr4/cgSHO17VFTsAS",
+	"IUktxT0pRVEIg0xJbpKO/C9ZMJ3vXjqWL1Yj17VmGzD4qqPlwIrXqpMpG150wFStguX7C34E8HUkUBSu",
+	"IqbIHku4sYCHel/eW7Ny0R19kNVa2OaTS4vAvULQqC/rAHeFpAu6QeCoWzC3oP9N3bLS4n/+FNgXIlTi",
+	"39iE05aQufLWCOvAJb1iqiSX17/SQeOhF2/evrlw3lYVSmfVgr5/c/HmggbA7Q3KoBJZtDzbvs0YaJux",
+	"AkGn8VTqye7TSJN6OQ7M7pNp5qpeFcJszmXXWECTqtqu1P0cVh+4zDSSpTFR0ggwfMbGvn7Yil2cH8AS",
+	"Q2C91rgG14tXDckR+QrYLTGot4IhEdIqEtVR71rt4/Qrp4snZz4NGY3G/qx4M+vo/0wo69Jmnxx+f3h3",
+	"cfEDTTBT3wmuHzjfxY+YmjE0hrTGUs+WQ13YY2Z0+8qu+i8PdVmCbo7E2dVVLNw2mq4RnJ9uJgWedgDi",
+	"6YQzPQBQOTFYFKjdKwZlBWItzZmp1s7OH51rQxT6Wsk2xA3Hs23g+e+fb72y75Bx3cQ7NeU6BuJPNKgH",
+	"yHPVEKW5e/W8XtePzB+eggcg79WS8AAyPJaGfTR+RB5Wg7Ccn4jtQMxCriwqrZzRS2dUgRb50i+cPro7",
+	"gedNfq8tW4Flm5SBZFiktaxA8DQYeKakuJuUYyG2qPFccV3NmCxYN1dA9G9aQVOitKlUVuQxY83ZwgJG",
+	"Q54y0DatlBHPkqcxryV/BrvrOMjT4YfwcwTV8vmiInwd99jUWJgv77ysbrVnrj81Dll0sOAMIc+0QWOB",
+	"YDDF+0q4Sgg+npuBA3E+RGdwOpB0BluXFU/wbt9mUNtNxpTMhS6vShBRXcMctTsU3EGTsnixVqLdKJf1",
+	"9Pr3T3/QhCot1kJGoQOp/mCzqw3qpeD7bFhuJ1Blu/7ku3+cRatSpfEL/gFZ6PKLHV2jnyWHo7y/N/FH",
+	"Qw0lWt/r/oxn1G816qY/oj68MUoGI3N0NfAQkHhZxCBotiG5KKw//E6p6RZnSD+43UvcSN0QMOQzzQt1",
+	"h9pkWhk0n2nSz8X4ebrjBMmJsIY4wSg5SIfhNBKNttYS+RFrW/5H7f3yHQHJ4fXqBO6IBISDhWdji//4",
+	"MPbgcIQoklHd+A87Ie3aTYOQPnfpgq5WUOCHD8W7uhCCvZVfVW2cLwMWXQLziDXQwlf8iOJjdftTUb27",
+	"yL/94+P7vP9Q4lhQFwGVRB2G7scGbaEQHGy4hI8/8GaIWEMlH5ZSfw89+T7bjcq0gzhdSxqWdNZdyMxg",
+	"CfcSR3ji6iRLtgsP42YS5hUDrQXqFLc+CXbx9zStqqtRGztYynauG+2fpsiqYNYU5QBD7brnY/Z3MO3I",
+	"Srbzfx9nHxBloezNLOJsFx5maxkyZtVGWXWiYrMRVRkiFj4lHFP9AE/6HNkKfiTCQdaB0Ec87AaRGcwj",
+	"4FyjMTiPONvFx/EWBqBu4u0jg3K6ioaY6nTiiQKf5oj/G2Dm0J4s3NzW5lS6CaHB+w7uoLRu3uDUOtMI",
+	"Fi/9sfSP+O8sx4niFfERgk8+gx4h01gVwPAGc41m06nbd7Pl4ay/7K13t1pxUvQT2e2OjiFCd/weMXTp",
+	"NWZqZ+eIp513UyzaTtFrO0H8+8GXl548FtvRXZDY1sec7TSg+y/7/w8A0Jb2T4cmAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
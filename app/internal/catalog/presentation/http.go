@@ -25,6 +25,7 @@ type ApiImpl struct {
 func (a ApiImpl) CatalogGet(c *gin.Context, params oapi_codegen.CatalogGetParams) {
 	res, err := a.Service.Search(c.Request.Context(), service.SearchReq{
 		Term:          params.Filter,
+		Category:      params.Category,
 		NextPageToken: params.NextPageToken,
 	})
 	if err != nil {
@@ -54,6 +55,51 @@ func (a ApiImpl) CatalogSync(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("synced %d items", len(body.Messages))})
 }
 
+func (a ApiImpl) PrivateCatalogSyncProductRatings(c *gin.Context) {
+	var body oapi_codegen.PrivateCatalogSyncProductRatingsJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
+		return
+	}
+
+	if err := a.Service.SyncRatings(c.Request.Context(), body.Messages); err != nil {
+		a.Logger.Error("sync product ratings", zap.Any("messages", body.Messages), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to sync product ratings"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("synced %d ratings", len(body.Messages))})
+}
+
+func (a ApiImpl) PrivateCatalogSyncProductsPurchases(c *gin.Context) {
+	var body oapi_codegen.PrivateCatalogSyncProductsPurchasesJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
+		return
+	}
+
+	if err := a.Service.SyncPurchases(c.Request.Context(), body.Messages); err != nil {
+		a.Logger.Error("sync products purchases", zap.Int("messages", len(body.Messages)), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to sync products purchases"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("synced %d products purchases", len(body.Messages))})
+}
+
+func (a ApiImpl) PrivateCatalogSyncProductsAdBoost(c *gin.Context) {
+	var body oapi_codegen.PrivateCatalogSyncProductsAdBoostJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&body); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
+		return
+	}
+
+	if err := a.Service.SyncAdBoost(c.Request.Context(), body.Messages); err != nil {
+		a.Logger.Error("sync products ad boost", zap.Any("messages", body.Messages), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to sync products ad boost"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("synced %d products ad boost", len(body.Messages))})
+}
+
 func (*ApiImpl) ErrorHandlerValidation(c *gin.Context, message string, code int) {
 	c.AbortWithStatusJSON(code, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: code, Message: message}))
 }
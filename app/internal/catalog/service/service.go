@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/bratushkadan/floral/internal/catalog/api"
 	oapi_codegen "github.com/bratushkadan/floral/internal/catalog/presentation/generated"
@@ -35,7 +36,8 @@ func (c *Catalog) Get(ctx context.Context, nextPageToken *string) (oapi_codegen.
 	}
 
 	res := oapi_codegen.CatalogGetRes{
-		Products: make([]oapi_codegen.CatalogGetResProduct, 0, len(out.Products)),
+		Products:   make([]oapi_codegen.CatalogGetResProduct, 0, len(out.Products)),
+		Categories: newCategoriesRes(out.Categories),
 	}
 
 	for _, p := range out.Products {
@@ -53,7 +55,9 @@ func (c *Catalog) Get(ctx context.Context, nextPageToken *string) (oapi_codegen.
 }
 
 type SearchReq struct {
-	Term          *string
+	Term *string
+	// Category path, products of the category and its descendants are found.
+	Category      *string
 	NextPageToken *string
 }
 
@@ -61,6 +65,7 @@ func (c *Catalog) Search(ctx context.Context, req SearchReq) (oapi_codegen.Catal
 	out, err := c.store.Search(ctx, store.SearchDTOInput{
 		NextPageToken: req.NextPageToken,
 		Term:          req.Term,
+		Category:      req.Category,
 	})
 	if err != nil {
 		return oapi_codegen.CatalogGetRes{}, err
@@ -68,6 +73,7 @@ func (c *Catalog) Search(ctx context.Context, req SearchReq) (oapi_codegen.Catal
 
 	res := oapi_codegen.CatalogGetRes{
 		Products:      make([]oapi_codegen.CatalogGetResProduct, 0, len(out.Products)),
+		Categories:    newCategoriesRes(out.Categories),
 		NextPageToken: out.NextPageToken,
 	}
 
@@ -83,6 +89,17 @@ func (c *Catalog) Search(ctx context.Context, req SearchReq) (oapi_codegen.Catal
 	return res, nil
 }
 
+func newCategoriesRes(categories []store.SearchDTOOutputCategory) []oapi_codegen.CatalogGetResCategory {
+	res := make([]oapi_codegen.CatalogGetResCategory, 0, len(categories))
+	for _, category := range categories {
+		res = append(res, oapi_codegen.CatalogGetResCategory{
+			Path:  category.Path,
+			Count: category.Count,
+		})
+	}
+	return res
+}
+
 func (c *Catalog) Sync(ctx context.Context, body api.DataStreamProductChangeCdcMessages) error {
 	var blkBuf bytes.Buffer
 	for _, record := range body.Messages {
@@ -92,6 +109,13 @@ func (c *Catalog) Sync(ctx context.Context, body api.DataStreamProductChangeCdcM
 			if err := json.Unmarshal([]byte(*record.Payload.After.PicturesJsonListStr), &pictures); err != nil {
 				return err
 			}
+			// Products created before variants have no skus.
+			var skus []api.ProductChangeSku
+			if record.Payload.After.SkusJsonListStr != nil {
+				if err := json.Unmarshal([]byte(*record.Payload.After.SkusJsonListStr), &skus); err != nil {
+					return err
+				}
+			}
 			data, err := base64.StdEncoding.DecodeString(record.Payload.After.Id)
 			if err != nil {
 				msg := `failed to decode base64 encoded bytes field "id"`
@@ -112,12 +136,14 @@ func (c *Catalog) Sync(ctx context.Context, body api.DataStreamProductChangeCdcM
 				blkBuf.WriteString(bulkItem)
 			} else {
 				bulkItem, err := newBulkProductUpsert(api.ProductChange{
-					Id:          uuidId,
-					Name:        *record.Payload.After.Name,
-					Description: *record.Payload.After.Description,
-					Price:       *record.Payload.After.Price,
-					Stock:       *record.Payload.After.Stock,
-					Pictures:    pictures,
+					Id:            uuidId,
+					Name:          *record.Payload.After.Name,
+					Description:   *record.Payload.After.Description,
+					Price:         *record.Payload.After.Price,
+					Stock:         *record.Payload.After.Stock,
+					Pictures:      pictures,
+					Skus:          skus,
+					CategoryPaths: categoryPathPrefixes(record.Payload.After.CategoryPath),
 				})
 				if err != nil {
 					msg := "failed to prepare bulk upsert item"
@@ -164,6 +190,145 @@ func (c *Catalog) Sync(ctx context.Context, body api.DataStreamProductChangeCdcM
 
 }
 
+// SyncRatings applies product ratings aggregated by the feedback service.
+// Ratings are delivered at least once and out of order, ratings older than the applied one are skipped.
+// Products missing from the index (deleted or out of stock) are skipped by OpenSearch.
+func (c *Catalog) SyncRatings(ctx context.Context, messages []oapi_codegen.PrivateCatalogSyncProductRatingsReqMessage) error {
+	var blkBuf bytes.Buffer
+	for _, msg := range messages {
+		doc := make(map[string]any)
+		// Unrated products fall back to the "missing" value of the ranking function.
+		if msg.ReviewsCount > 0 {
+			doc["rating"] = msg.Rating
+		} else {
+			doc["rating"] = nil
+		}
+		bulkItem, err := newBulkProductVersionedUpdate(msg.ProductId, "rating_version", msg.Version, doc)
+		if err != nil {
+			return fmt.Errorf("failed to prepare bulk rating update item: %v", err)
+		}
+		blkBuf.WriteString(bulkItem)
+		blkBuf.WriteByte('\n')
+	}
+
+	return c.syncPartialUpdates(ctx, &blkBuf)
+}
+
+// SyncPurchases applies products purchases counters computed by the orders service.
+// Products missing from the index (deleted or out of stock) are skipped by OpenSearch.
+func (c *Catalog) SyncPurchases(ctx context.Context, messages []oapi_codegen.PrivateCatalogSyncProductsPurchasesReqMessage) error {
+	var blkBuf bytes.Buffer
+	for _, msg := range messages {
+		bulkItem, err := newBulkProductPartialUpdate(msg.ProductId, map[string]any{
+			"purchases_alltime": msg.PurchasesAlltime,
+			"purchases_30d":     msg.Purchases30d,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to prepare bulk purchases update item: %v", err)
+		}
+		blkBuf.WriteString(bulkItem)
+		blkBuf.WriteByte('\n')
+	}
+
+	return c.syncPartialUpdates(ctx, &blkBuf)
+}
+
+// SyncAdBoost applies products ad boost of seller ad campaigns.
+// Products missing from the index (deleted or out of stock) are skipped by OpenSearch,
+// ad boosts older than the applied one are skipped.
+func (c *Catalog) SyncAdBoost(ctx context.Context, messages []oapi_codegen.PrivateCatalogSyncProductsAdBoostReqMessage) error {
+	var blkBuf bytes.Buffer
+	for _, msg := range messages {
+		bulkItem, err := newBulkProductVersionedUpdate(msg.ProductId, "ad_boost_version", msg.Version, map[string]any{
+			"ad_boost": msg.AdBoost,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to prepare bulk ad boost update item: %v", err)
+		}
+		blkBuf.WriteString(bulkItem)
+		blkBuf.WriteByte('\n')
+	}
+
+	return c.syncPartialUpdates(ctx, &blkBuf)
+}
+
+func (c *Catalog) syncPartialUpdates(ctx context.Context, blkBuf *bytes.Buffer) error {
+	blk, err := c.store.Sync(ctx, blkBuf)
+	if err != nil {
+		c.logger.Error("failed to sync catalog partial updates", zap.Error(err))
+		return err
+	}
+	if blk.StatusCode > 399 {
+		data, err := io.ReadAll(blk.Body)
+		if err != nil {
+			return fmt.Errorf("failed read OpenSearch bulk response: %v", err)
+		}
+		c.logger.Error("failed to perform bulk operation in OpenSearch", zap.Int("status", blk.StatusCode), zap.ByteString("response_body", data))
+		return fmt.Errorf("failed to perform bulk operation in OpenSearch: status %d", blk.StatusCode)
+	}
+
+	return nil
+}
+
+// newBulkProductPartialUpdate does not upsert: fields computed by other services
+// must not create catalog documents without product data.
+func newBulkProductPartialUpdate(productId string, doc map[string]any) (string, error) {
+	update := map[string]map[string]string{
+		"update": {
+			"_index": store.ProductsIndex,
+			"_id":    productId,
+		},
+	}
+	opData, err := json.Marshal(update)
+	if err != nil {
+		return "", err
+	}
+	docData, err := json.Marshal(map[string]any{"doc": doc})
+	if err != nil {
+		return "", err
+	}
+	return string(opData) + "\n" + string(docData), nil
+}
+
+// newBulkProductVersionedUpdate partially updates the product unless the document already has fields
+// of the same or a newer version stored in versionField. Updates without version are always applied.
+func newBulkProductVersionedUpdate(productId string, versionField string, version *int64, doc map[string]any) (string, error) {
+	if version == nil {
+		return newBulkProductPartialUpdate(productId, doc)
+	}
+
+	update := map[string]map[string]string{
+		"update": {
+			"_index": store.ProductsIndex,
+			"_id":    productId,
+		},
+	}
+	opData, err := json.Marshal(update)
+	if err != nil {
+		return "", err
+	}
+	scriptData, err := json.Marshal(map[string]any{
+		"script": map[string]any{
+			"lang": "painless",
+			"source": `if (ctx._source[params.version_field] != null && ctx._source[params.version_field] >= params.version) {
+  ctx.op = 'noop';
+} else {
+  ctx._source.putAll(params.doc);
+  ctx._source[params.version_field] = params.version;
+}`,
+			"params": map[string]any{
+				"version_field": versionField,
+				"version":       *version,
+				"doc":           doc,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(opData) + "\n" + string(scriptData), nil
+}
+
 func newBulkProductUpsert(p api.ProductChange) (string, error) {
 	update := map[string]map[string]string{
 		"update": {
@@ -184,6 +349,15 @@ func newBulkProductUpsert(p api.ProductChange) (string, error) {
 	doc["description"] = p.Description
 	doc["price"] = p.Price
 	doc["stock"] = p.Stock
+	doc["skus"] = make([]api.ProductChangeSku, 0)
+	if len(p.Skus) > 0 {
+		doc["skus"] = p.Skus
+		doc["price"] = skusFromPrice(p.Skus)
+	}
+	doc["category_paths"] = make([]string, 0)
+	if len(p.CategoryPaths) > 0 {
+		doc["category_paths"] = p.CategoryPaths
+	}
 	if len(p.Pictures) > 0 {
 		doc["picture"] = p.Pictures[0].Url
 	} else {
@@ -195,6 +369,32 @@ func newBulkProductUpsert(p api.ProductChange) (string, error) {
 	}
 	return string(opData) + "\n" + string(docData), nil
 }
+
+// skusFromPrice is the lowest price of skus in stock, products with skus are listed "from" it.
+func skusFromPrice(skus []api.ProductChangeSku) float64 {
+	price := -1.0
+	for _, sku := range skus {
+		if sku.Stock > 0 && (price < 0 || sku.Price < price) {
+			price = sku.Price
+		}
+	}
+	return max(price, 0)
+}
+
+// categoryPathPrefixes indexes the product under its category and every ancestor,
+// so filtering by a category path finds products of its descendants too.
+func categoryPathPrefixes(path *string) []string {
+	if path == nil || *path == "" {
+		return nil
+	}
+	slugs := strings.Split(*path, "/")
+	prefixes := make([]string, 0, len(slugs))
+	for i := range slugs {
+		prefixes = append(prefixes, strings.Join(slugs[:i+1], "/"))
+	}
+	return prefixes
+}
+
 func newBulkProductDelete(p api.ProductChange) (string, error) {
 	update := map[string]map[string]string{
 		"delete": {
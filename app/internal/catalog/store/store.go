@@ -52,10 +52,18 @@ type SearchDTOInput struct {
 	NextPageToken *string
 	// Search term.
 	Term *string
+	// Category path, products of the category and its descendants are found.
+	Category *string
 }
 type SearchDTOOutput struct {
 	NextPageToken *string
 	Products      []SearchDTOOutputProduct
+	// Categories are facets of all found products, not only of the page.
+	Categories []SearchDTOOutputCategory
+}
+type SearchDTOOutputCategory struct {
+	Path  string
+	Count int
 }
 type SearchDTOOutputProduct struct {
 	Id      string
@@ -77,6 +85,14 @@ type ProductsOpenSearchResp struct {
 			} `json:"_source"`
 		} `json:"hits"`
 	} `json:"hits"`
+	Aggregations struct {
+		Categories struct {
+			Buckets []struct {
+				Key      string `json:"key"`
+				DocCount int    `json:"doc_count"`
+			} `json:"buckets"`
+		} `json:"categories"`
+	} `json:"aggregations"`
 }
 
 type NextPage struct {
@@ -86,6 +102,8 @@ type NextPage struct {
 	From int
 	// OpenSearch query.
 	Query *string
+	// Category path filter.
+	Category *string
 }
 
 var (
@@ -111,6 +129,14 @@ func (s *Store) Search(ctx context.Context, in SearchDTOInput) (SearchDTOOutput,
 	if page.Query == nil && in.Term != nil {
 		page.Query = in.Term
 	}
+	if page.Category == nil && in.Category != nil {
+		page.Category = in.Category
+	}
+
+	filter, err := newCategoryFilter(page.Category)
+	if err != nil {
+		return SearchDTOOutput{}, fmt.Errorf("failed to prepare category filter: %v", err)
+	}
 
 	var content io.Reader
 	if page.Query != nil {
@@ -118,11 +144,13 @@ func (s *Store) Search(ctx context.Context, in SearchDTOInput) (SearchDTOOutput,
 			page.Size+1,
 			page.From,
 			*page.Query,
+			filter,
 		))
 	} else {
 		content = strings.NewReader(newCatalogQuery(
 			page.Size+1,
 			page.From,
+			filter,
 		))
 	}
 
@@ -161,8 +189,17 @@ func (s *Store) Search(ctx context.Context, in SearchDTOInput) (SearchDTOOutput,
 		}
 	}
 
+	categories := make([]SearchDTOOutputCategory, 0, len(hits.Aggregations.Categories.Buckets))
+	for _, bucket := range hits.Aggregations.Categories.Buckets {
+		categories = append(categories, SearchDTOOutputCategory{
+			Path:  bucket.Key,
+			Count: bucket.DocCount,
+		})
+	}
+
 	out := SearchDTOOutput{
-		Products: products,
+		Products:   products,
+		Categories: categories,
 	}
 
 	if len(hits.Hits.Hits) > page.Size {
@@ -202,15 +239,40 @@ func min(a, b int) int {
 	return a
 }
 
-func newCatalogQuery(limit, offset int) string {
+// newCategoryFilter returns the bool query filter of products indexed under the category path.
+func newCategoryFilter(category *string) (string, error) {
+	filter := make([]map[string]any, 0, 1)
+	if category != nil {
+		filter = append(filter, map[string]any{
+			"term": map[string]string{"category_paths": *category},
+		})
+	}
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func newCatalogQuery(limit, offset int, filter string) string {
 	// GET /products/_search
 	return fmt.Sprintf(`
 {
   "size": %d,
   "from": %d,
+  "aggs": {
+    "categories": {
+      "terms": { "field": "category_paths", "size": 100 }
+    }
+  },
   "query": {
     "function_score": {
-      "query": { "match_all": {} },
+      "query": {
+        "bool": {
+          "must": { "match_all": {} },
+          "filter": %s
+        }
+      },
       "functions": [
         {
           "field_value_factor": {
@@ -242,23 +304,33 @@ func newCatalogQuery(limit, offset int) string {
     }
   }
 }
-`, limit, offset)
+`, limit, offset, filter)
 
 }
 
 // name^3 - priority of field "name" is increased 3 times to "description field"
-func newCatalogSearchQuery(limit, offset int, query string) string {
+func newCatalogSearchQuery(limit, offset int, query, filter string) string {
 	// GET /products/_search
 	return fmt.Sprintf(`
 {
   "size": %d,
   "from": %d,
+  "aggs": {
+    "categories": {
+      "terms": { "field": "category_paths", "size": 100 }
+    }
+  },
   "query": {
     "function_score": {
     "query": {
-      "multi_match": {
-        "query": "%s",
-        "fields": ["name^3", "description"]
+      "bool": {
+        "must": {
+          "multi_match": {
+            "query": "%s",
+            "fields": ["name^3", "description"]
+          }
+        },
+        "filter": %s
       }
     },
       "functions": [
@@ -292,6 +364,6 @@ func newCatalogSearchQuery(limit, offset int, query string) string {
     }
   }
 }
-`, limit, offset, query)
+`, limit, offset, query, filter)
 
 }
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
@@ -22,6 +23,302 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CreateProductCampaignResStatus.
+const (
+	CreateProductCampaignResStatusActive    CreateProductCampaignResStatus = "active"
+	CreateProductCampaignResStatusCancelled CreateProductCampaignResStatus = "cancelled"
+	CreateProductCampaignResStatusExhausted CreateProductCampaignResStatus = "exhausted"
+	CreateProductCampaignResStatusFinished  CreateProductCampaignResStatus = "finished"
+	CreateProductCampaignResStatusScheduled CreateProductCampaignResStatus = "scheduled"
+)
+
+// Defines values for ListProductCampaignsResCampaignStatus.
+const (
+	ListProductCampaignsResCampaignStatusActive    ListProductCampaignsResCampaignStatus = "active"
+	ListProductCampaignsResCampaignStatusCancelled ListProductCampaignsResCampaignStatus = "cancelled"
+	ListProductCampaignsResCampaignStatusExhausted ListProductCampaignsResCampaignStatus = "exhausted"
+	ListProductCampaignsResCampaignStatusFinished  ListProductCampaignsResCampaignStatus = "finished"
+	ListProductCampaignsResCampaignStatusScheduled ListProductCampaignsResCampaignStatus = "scheduled"
+)
+
+// Defines values for OrderEventMessageType.
+const (
+	OrderCancelled OrderEventMessageType = "order.cancelled"
+	OrderCompleted OrderEventMessageType = "order.completed"
+	OrderCreated   OrderEventMessageType = "order.created"
+	OrderDelivered OrderEventMessageType = "order.delivered"
+	OrderPaid      OrderEventMessageType = "order.paid"
+	OrderShipped   OrderEventMessageType = "order.shipped"
+)
+
+// Defines values for OrdersCreateCouponReqDiscountType.
+const (
+	Fixed   OrdersCreateCouponReqDiscountType = "fixed"
+	Percent OrdersCreateCouponReqDiscountType = "percent"
+)
+
+// AuthenticateReq defines model for AuthenticateReq.
+type AuthenticateReq struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// AuthenticateRes defines model for AuthenticateRes.
+type AuthenticateRes struct {
+	ExpiresAt    string `json:"expires_at"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// CancelProductCampaignRes defines model for CancelProductCampaignRes.
+type CancelProductCampaignRes struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// CartApplyPromoCodeReq defines model for CartApplyPromoCodeReq.
+type CartApplyPromoCodeReq struct {
+	// Code promo code, case insensitive
+	Code string `json:"code"`
+}
+
+// CartClearCartRes defines model for CartClearCartRes.
+type CartClearCartRes = map[string]interface{}
+
+// CartClearCartResPosition defines model for CartClearCartResPosition.
+type CartClearCartResPosition struct {
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// CartDeleteCartPositionRes defines model for CartDeleteCartPositionRes.
+type CartDeleteCartPositionRes struct {
+	DeletedPosition CartDeleteCartPositionResPosition `json:"deleted_position"`
+}
+
+// CartDeleteCartPositionResPosition defines model for CartDeleteCartPositionResPosition.
+type CartDeleteCartPositionResPosition struct {
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// CartGetCartPositionsRes defines model for CartGetCartPositionsRes.
+type CartGetCartPositionsRes struct {
+	Positions []CartGetCartPositionsResPosition `json:"positions"`
+}
+
+// CartGetCartPositionsResPosition defines model for CartGetCartPositionsResPosition.
+type CartGetCartPositionsResPosition struct {
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// CartPromoCodeRes promo code applied to the cart. Codes stay applied until removed, orders are discounted with the coupon
+// of the code if it's applicable to them. Discounts are previewed with the orders coupon preview.
+type CartPromoCodeRes struct {
+	AppliedAt time.Time `json:"applied_at"`
+	Code      string    `json:"code"`
+}
+
+// CartRemovePromoCodeRes defines model for CartRemovePromoCodeRes.
+type CartRemovePromoCodeRes = map[string]interface{}
+
+// CartSetCartPositionRes defines model for CartSetCartPositionRes.
+type CartSetCartPositionRes struct {
+	SetPosition CartSetCartPositionResPosition `json:"set_position"`
+}
+
+// CartSetCartPositionResPosition defines model for CartSetCartPositionResPosition.
+type CartSetCartPositionResPosition struct {
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// CatalogGetRes defines model for CatalogGetRes.
+type CatalogGetRes struct {
+	// Categories category facets, numbers of found products by category paths
+	Categories    []CatalogGetResCategory `json:"categories"`
+	NextPageToken *string                 `json:"next_page_token"`
+	Products      []CatalogGetResProduct  `json:"products"`
+}
+
+// CatalogGetResCategory defines model for CatalogGetResCategory.
+type CatalogGetResCategory struct {
+	Count int    `json:"count"`
+	Path  string `json:"path"`
+}
+
+// CatalogGetResProduct defines model for CatalogGetResProduct.
+type CatalogGetResProduct struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+
+	// Picture url
+	Picture *string `json:"picture"`
+	Price   float64 `json:"price"`
+}
+
+// CreateAccessTokenReq defines model for CreateAccessTokenReq.
+type CreateAccessTokenReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// CreateAccessTokenRes defines model for CreateAccessTokenRes.
+type CreateAccessTokenRes struct {
+	AccessToken string `json:"access_token"`
+	ExpiresAt   string `json:"expires_at"`
+}
+
+// CreateProductCampaignReq defines model for CreateProductCampaignReq.
+type CreateProductCampaignReq struct {
+	// Boost Catalog ranking multiplier applied while the campaign is active
+	Boost float64 `json:"boost"`
+
+	// Budget Budget the campaign spends while active, the campaign is exhausted once it's spent
+	Budget   float64   `json:"budget"`
+	EndsAt   time.Time `json:"ends_at"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// CreateProductCampaignRes defines model for CreateProductCampaignRes.
+type CreateProductCampaignRes struct {
+	Boost     float64 `json:"boost"`
+	Budget    float64 `json:"budget"`
+	CreatedAt string  `json:"created_at"`
+	EndsAt    string  `json:"ends_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+
+	// Spent Budget spent by the campaign so far
+	Spent     float64                        `json:"spent"`
+	StartsAt  string                         `json:"starts_at"`
+	Status    CreateProductCampaignResStatus `json:"status"`
+	UpdatedAt string                         `json:"updated_at"`
+}
+
+// CreateProductCampaignResStatus defines model for CreateProductCampaignRes.Status.
+type CreateProductCampaignResStatus string
+
+// CreateProductCategoryReq defines model for CreateProductCategoryReq.
+type CreateProductCategoryReq struct {
+	Name string `json:"name"`
+
+	// ParentId id of the parent category, the category is created at the root if absent
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Position position of the category among its siblings, the category is put after its siblings if absent
+	Position *int `json:"position,omitempty"`
+
+	// Slug unique lowercase latin letters and digits separated by hyphens, i.e. "garden-roses"
+	Slug string `json:"slug"`
+}
+
+// CreateProductCategoryRes defines model for CreateProductCategoryRes.
+type CreateProductCategoryRes = ProductCategory
+
+// CreateProductReq defines model for CreateProductReq.
+type CreateProductReq struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
+	Description string                 `json:"description"`
+	Metadata    map[string]interface{} `json:"metadata"`
+	Name        string                 `json:"name"`
+
+	// Options option axes of the product variants, skus are added with "POST /api/v1/products/{product_id}/skus"
+	Options *[]GetProductResOption `json:"options,omitempty"`
+	Price   float64                `json:"price"`
+	Stock   int                    `json:"stock"`
+}
+
+// CreateProductRes defines model for CreateProductRes.
+type CreateProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
+	CreatedAt   string                 `json:"created_at"`
+	Description string                 `json:"description"`
+	Id          string                 `json:"id"`
+	Metadata    map[string]interface{} `json:"metadata"`
+	Name        string                 `json:"name"`
+
+	// Options option axes of the product variants, i.e. size or colour
+	Options  []GetProductResOption `json:"options"`
+	Pictures GetProductResPictures `json:"pictures"`
+	Price    float64               `json:"price"`
+	SellerId string                `json:"seller_id"`
+
+	// Skus variants of the product, stock of the product with skus is the sum of their stocks
+	Skus      []GetProductResSku `json:"skus"`
+	Stock     int                `json:"stock"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// CreateProductSkuReq defines model for CreateProductSkuReq.
+type CreateProductSkuReq struct {
+	// Options values of every product option by option names
+	Options map[string]string `json:"options"`
+
+	// PictureIds ids of the product pictures of the sku
+	PictureIds *[]string `json:"picture_ids,omitempty"`
+	Price      float64   `json:"price"`
+	Stock      int       `json:"stock"`
+}
+
+// CreateProductSkuRes defines model for CreateProductSkuRes.
+type CreateProductSkuRes = GetProductResSku
+
+// CreateSellerAccountReq defines model for CreateSellerAccountReq.
+type CreateSellerAccountReq struct {
+	AccessToken string `json:"access_token"`
+	Seller      struct {
+		Email    string `json:"email"`
+		Name     string `json:"name"`
+		Password string `json:"password"`
+	} `json:"seller"`
+}
+
+// CreateSellerAccountRes defines model for CreateSellerAccountRes.
+type CreateSellerAccountRes struct {
+	Email *string `json:"email,omitempty"`
+	Name  string  `json:"name"`
+}
+
+// CreateUserAccountReq defines model for CreateUserAccountReq.
+type CreateUserAccountReq struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// CreateUserAccountRes defines model for CreateUserAccountRes.
+type CreateUserAccountRes struct {
+	Email *string `json:"email,omitempty"`
+	Name  string  `json:"name"`
+}
+
+// DeleteProductCategoryRes defines model for DeleteProductCategoryRes.
+type DeleteProductCategoryRes struct {
+	Id string `json:"id"`
+}
+
+// DeleteProductPictureRes defines model for DeleteProductPictureRes.
+type DeleteProductPictureRes struct {
+	Id string `json:"id"`
+}
+
+// DeleteProductRes defines model for DeleteProductRes.
+type DeleteProductRes struct {
+	Id string `json:"id"`
+}
+
+// DeleteProductSkuRes defines model for DeleteProductSkuRes.
+type DeleteProductSkuRes struct {
+	Id string `json:"id"`
+}
+
 // Err defines model for Err.
 type Err struct {
 	Code    int    `json:"code"`
@@ -52,8 +349,16 @@ type FeedbackDeleteProductReviewRes struct {
 
 // FeedbackGetProductRatingRes defines model for FeedbackGetProductRatingRes.
 type FeedbackGetProductRatingRes struct {
-	ProductId string `json:"product_id"`
-	Rating    int    `json:"rating"`
+	Distribution []FeedbackGetProductRatingResDistributionBucket `json:"distribution"`
+	ProductId    string                                          `json:"product_id"`
+	Rating       float64                                         `json:"rating"`
+	ReviewsCount int                                             `json:"reviews_count"`
+}
+
+// FeedbackGetProductRatingResDistributionBucket defines model for FeedbackGetProductRatingResDistributionBucket.
+type FeedbackGetProductRatingResDistributionBucket struct {
+	Count int `json:"count"`
+	Stars int `json:"stars"`
 }
 
 // FeedbackGetProductReviewRes defines model for FeedbackGetProductReviewRes.
@@ -92,11 +397,691 @@ type FeedbackUpdateProductReviewReq struct {
 
 // FeedbackUpdateProductReviewRes defines model for FeedbackUpdateProductReviewRes.
 type FeedbackUpdateProductReviewRes struct {
-	Id     string   `json:"id"`
-	Rating *float64 `json:"rating,omitempty"`
-	Review *string  `json:"review,omitempty"`
+	Id        string  `json:"id"`
+	Rating    float64 `json:"rating"`
+	Review    string  `json:"review"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// GetProductRes defines model for GetProductRes.
+type GetProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
+	CreatedAt   string                 `json:"created_at"`
+	Description string                 `json:"description"`
+	Id          string                 `json:"id"`
+	Metadata    map[string]interface{} `json:"metadata"`
+	Name        string                 `json:"name"`
+
+	// Options option axes of the product variants, i.e. size or colour
+	Options  []GetProductResOption `json:"options"`
+	Pictures GetProductResPictures `json:"pictures"`
+	Price    float64               `json:"price"`
+	SellerId string                `json:"seller_id"`
+
+	// Skus variants of the product, stock of the product with skus is the sum of their stocks
+	Skus      []GetProductResSku `json:"skus"`
+	Stock     int                `json:"stock"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// GetProductResOption defines model for GetProductResOption.
+type GetProductResOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// GetProductResPicture defines model for GetProductResPicture.
+type GetProductResPicture struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
+// GetProductResPictures defines model for GetProductResPictures.
+type GetProductResPictures = []GetProductResPicture
+
+// GetProductResSku defines model for GetProductResSku.
+type GetProductResSku struct {
+	Id string `json:"id"`
+
+	// Options values of the product options by option names
+	Options map[string]string `json:"options"`
+
+	// PictureIds ids of the product pictures of the sku
+	PictureIds []string `json:"picture_ids"`
+	Price      float64  `json:"price"`
+	Stock      int      `json:"stock"`
+}
+
+// ListProductCampaignsRes defines model for ListProductCampaignsRes.
+type ListProductCampaignsRes struct {
+	Campaigns []ListProductCampaignsResCampaign `json:"campaigns"`
+}
+
+// ListProductCampaignsResCampaign defines model for ListProductCampaignsResCampaign.
+type ListProductCampaignsResCampaign struct {
+	Boost     float64 `json:"boost"`
+	Budget    float64 `json:"budget"`
+	CreatedAt string  `json:"created_at"`
+	EndsAt    string  `json:"ends_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+
+	// Spent Budget spent by the campaign so far
+	Spent     float64                               `json:"spent"`
+	StartsAt  string                                `json:"starts_at"`
+	Status    ListProductCampaignsResCampaignStatus `json:"status"`
+	UpdatedAt string                                `json:"updated_at"`
+}
+
+// ListProductCampaignsResCampaignStatus defines model for ListProductCampaignsResCampaign.Status.
+type ListProductCampaignsResCampaignStatus string
+
+// ListProductCategoriesRes defines model for ListProductCategoriesRes.
+type ListProductCategoriesRes struct {
+	Categories []ProductCategory `json:"categories"`
+}
+
+// ListProductsRes defines model for ListProductsRes.
+type ListProductsRes struct {
+	NextPageToken *string                  `json:"next_page_token"`
+	Products      []ListProductsResProduct `json:"products"`
+}
+
+// ListProductsResProduct defines model for ListProductsResProduct.
+type ListProductsResProduct struct {
+	// CategoryId id of the product category
+	CategoryId *string `json:"category_id,omitempty"`
+	Id         string  `json:"id"`
+	Name       string  `json:"name"`
+	PictureUrl string  `json:"picture_url"`
+	Price      float64 `json:"price"`
+	SellerId   string  `json:"seller_id"`
+}
+
+// OrderEventActor defines model for OrderEventActor.
+type OrderEventActor struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// OrderEventItem defines model for OrderEventItem.
+type OrderEventItem struct {
+	Count     int    `json:"count"`
+	ProductId string `json:"product_id"`
+	SellerId  string `json:"seller_id"`
+}
+
+// OrderEventMessage order lifecycle domain event published to orders/order_events_topic
+type OrderEventMessage struct {
+	Actor OrderEventActor `json:"actor"`
+
+	// Id unique event id, consumers deduplicate redelivered events by it
+	Id             string           `json:"id"`
+	Items          []OrderEventItem `json:"items"`
+	OccurredAt     time.Time        `json:"occurred_at"`
+	OrderId        string           `json:"order_id"`
+	PreviousStatus *string          `json:"previous_status"`
+	Reason         string           `json:"reason"`
+
+	// SchemaVersion incremented on breaking changes of the event schema
+	SchemaVersion int                   `json:"schema_version"`
+	Status        string                `json:"status"`
+	Type          OrderEventMessageType `json:"type"`
+	UserId        string                `json:"user_id"`
+}
+
+// OrderEventMessageType defines model for OrderEventMessage.Type.
+type OrderEventMessageType string
+
+// OrdersAddress defines model for OrdersAddress.
+type OrdersAddress struct {
+	City          string  `json:"city"`
+	Comment       *string `json:"comment,omitempty"`
+	Country       string  `json:"country"`
+	CreatedAt     string  `json:"created_at"`
+	Id            string  `json:"id"`
+	Phone         string  `json:"phone"`
+	PostalCode    string  `json:"postal_code"`
+	RecipientName string  `json:"recipient_name"`
+	Street        string  `json:"street"`
+	UpdatedAt     string  `json:"updated_at"`
+	UserId        string  `json:"user_id"`
+}
+
+// OrdersAddressReq defines model for OrdersAddressReq.
+type OrdersAddressReq struct {
+	City string `json:"city"`
+
+	// Comment note for the courier
+	Comment *string `json:"comment,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 country code
+	Country       string `json:"country"`
+	Phone         string `json:"phone"`
+	PostalCode    string `json:"postal_code"`
+	RecipientName string `json:"recipient_name"`
+
+	// Street street, building and apartment
+	Street string `json:"street"`
+}
+
+// OrdersCost order cost computed at order creation, absent for orders placed before it was introduced.
+// Amounts are in minor units of the currency (e.g. kopecks), prices include VAT.
+type OrdersCost struct {
+	CurrencyIso4217 int   `json:"currency_iso_4217"`
+	Discount        int64 `json:"discount"`
+	ShippingFee     int64 `json:"shipping_fee"`
+
+	// Subtotal sum of the order items prices
+	Subtotal int64 `json:"subtotal"`
+
+	// Total amount to pay, subtotal less discount plus shipping fee
+	Total int64 `json:"total"`
+
+	// Vat VAT included in the total
+	Vat int64 `json:"vat"`
+}
+
+// OrdersCoupon defines model for OrdersCoupon.
+type OrdersCoupon struct {
+	Code         string `json:"code"`
+	CreatedAt    string `json:"created_at"`
+	DiscountType string `json:"discount_type"`
+
+	// DiscountValue percent from 1 to 100 or fixed amount in minor units of the currency
+	DiscountValue int64   `json:"discount_value"`
+	ExpiresAt     *string `json:"expires_at,omitempty"`
+
+	// MaxUses maximum redemptions of the coupon, 0 is unlimited
+	MaxUses int64 `json:"max_uses"`
+
+	// MaxUsesPerUser maximum redemptions of the coupon by a user, 0 is unlimited
+	MaxUsesPerUser int64 `json:"max_uses_per_user"`
+
+	// MinSubtotal minimum sum of the order items prices in minor units of the currency
+	MinSubtotal int64  `json:"min_subtotal"`
+	OwnerId     string `json:"owner_id"`
+
+	// OwnerType subject type of the coupon creator
+	OwnerType  string   `json:"owner_type"`
+	ProductIds []string `json:"product_ids"`
+	SellerIds  []string `json:"seller_ids"`
+	StartsAt   *string  `json:"starts_at,omitempty"`
+
+	// Uses redemptions of the coupon by orders that aren't cancelled
+	Uses int64 `json:"uses"`
+}
+
+// OrdersCouponPreviewRes discount the coupon gives to the items. Coupons that are not applicable to the items don't discount them.
+// Amounts are in minor units of the currency, shipping isn't included.
+type OrdersCouponPreviewRes struct {
+	Applicable      bool   `json:"applicable"`
+	Code            string `json:"code"`
+	CurrencyIso4217 int    `json:"currency_iso_4217"`
+	Discount        int64  `json:"discount"`
+
+	// Reason why the coupon isn't applicable
+	Reason   *string `json:"reason,omitempty"`
+	Subtotal int64   `json:"subtotal"`
+	Total    int64   `json:"total"`
+}
+
+// OrdersCreateCouponReq defines model for OrdersCreateCouponReq.
+type OrdersCreateCouponReq struct {
+	// Code promo code of 3 to 32 latin letters, digits, "-" or "_", case insensitive
+	Code         string                            `json:"code"`
+	DiscountType OrdersCreateCouponReqDiscountType `json:"discount_type"`
+
+	// DiscountValue percent from 1 to 100 or fixed amount in minor units of the currency
+	DiscountValue int64      `json:"discount_value"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+
+	// MaxUses maximum redemptions of the coupon, 0 is unlimited
+	MaxUses *int64 `json:"max_uses,omitempty"`
+
+	// MaxUsesPerUser maximum redemptions of the coupon by a user, 0 is unlimited
+	MaxUsesPerUser *int64 `json:"max_uses_per_user,omitempty"`
+
+	// MinSubtotal minimum sum of the order items prices in minor units of the currency
+	MinSubtotal *int64 `json:"min_subtotal,omitempty"`
+
+	// ProductIds discount items of the products only
+	ProductIds *[]string `json:"product_ids,omitempty"`
+
+	// SellerIds discount items of the sellers only, coupons of sellers discount items of the seller
+	SellerIds *[]string  `json:"seller_ids,omitempty"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+}
+
+// OrdersCreateCouponReqDiscountType defines model for OrdersCreateCouponReq.DiscountType.
+type OrdersCreateCouponReqDiscountType string
+
+// OrdersCreateOrderReq defines model for OrdersCreateOrderReq.
+type OrdersCreateOrderReq struct {
+	// AddressId address book entry to deliver the order to, required unless the order is picked up
+	AddressId *string `json:"address_id,omitempty"`
+
+	// DeliveryMethod "courier", "post" or "pickup"
+	DeliveryMethod string `json:"delivery_method"`
+}
+
+// OrdersCreateOrderRes defines model for OrdersCreateOrderRes.
+type OrdersCreateOrderRes struct {
+	Operation OrdersCreateOrderResOperation `json:"operation"`
+}
+
+// OrdersCreateOrderResOperation defines model for OrdersCreateOrderResOperation.
+type OrdersCreateOrderResOperation struct {
+	CreatedAt string  `json:"created_at"`
+	Id        string  `json:"id"`
+	OrderId   *string `json:"order_id,omitempty"`
+	Status    string  `json:"status"`
+	Type      string  `json:"type"`
+	UpdatedAt string  `json:"updated_at"`
+	UserId    string  `json:"user_id"`
+}
+
+// OrdersCreateReturnReq defines model for OrdersCreateReturnReq.
+type OrdersCreateReturnReq struct {
+	Items  []OrdersCreateReturnReqItem `json:"items"`
+	Reason string                      `json:"reason"`
+}
+
+// OrdersCreateReturnReqItem defines model for OrdersCreateReturnReqItem.
+type OrdersCreateReturnReqItem struct {
+	Count     int    `json:"count"`
+	ProductId string `json:"product_id"`
+
+	// SkuId returned sku, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
+}
+
+// OrdersDeleteAddressRes defines model for OrdersDeleteAddressRes.
+type OrdersDeleteAddressRes struct {
+	Id string `json:"id"`
+}
+
+// OrdersDeleteCouponRes defines model for OrdersDeleteCouponRes.
+type OrdersDeleteCouponRes struct {
+	Code string `json:"code"`
+}
+
+// OrdersDelivery delivery of the order, absent for orders placed before delivery was introduced
+type OrdersDelivery struct {
+	// Address snapshot of the address book entry taken when the order was placed
+	Address *OrdersDeliveryAddress `json:"address,omitempty"`
+	Method  string                 `json:"method"`
+}
+
+// OrdersDeliveryAddress snapshot of the address book entry taken when the order was placed
+type OrdersDeliveryAddress struct {
+	AddressId     string  `json:"address_id"`
+	City          string  `json:"city"`
+	Comment       *string `json:"comment,omitempty"`
+	Country       string  `json:"country"`
+	Phone         string  `json:"phone"`
+	PostalCode    string  `json:"postal_code"`
+	RecipientName string  `json:"recipient_name"`
+	Street        string  `json:"street"`
+}
+
+// OrdersGetOperationRes defines model for OrdersGetOperationRes.
+type OrdersGetOperationRes struct {
+	CreatedAt string `json:"created_at"`
+
+	// Details Why the operation is aborted, or for completed operations why the order is placed without
+	// the discount of the promo code applied to the cart ("promo_code_rejected: <reason>")
+	Details *string `json:"details,omitempty"`
+	Id      string  `json:"id"`
+	OrderId *string `json:"order_id,omitempty"`
+
+	// Payment how to pay for the order, present only while the order awaits payment
+	Payment   *OrdersPayment `json:"payment,omitempty"`
+	Status    string         `json:"status"`
+	Type      string         `json:"type"`
+	UpdatedAt string         `json:"updated_at"`
+	UserId    string         `json:"user_id"`
 }
 
+// OrdersGetOrderRes defines model for OrdersGetOrderRes.
+type OrdersGetOrderRes struct {
+	// AllowedTransitions statuses the requesting subject may set with order update
+	AllowedTransitions []string `json:"allowed_transitions"`
+
+	// Cost order cost computed at order creation, absent for orders placed before it was introduced.
+	// Amounts are in minor units of the currency (e.g. kopecks), prices include VAT.
+	Cost      *OrdersCost `json:"cost,omitempty"`
+	CreatedAt string      `json:"created_at"`
+
+	// Delivery delivery of the order, absent for orders placed before delivery was introduced
+	Delivery *OrdersDelivery         `json:"delivery,omitempty"`
+	Id       string                  `json:"id"`
+	Items    []OrdersGetOrderResItem `json:"items"`
+
+	// Payment how to pay for the order, present only while the order awaits payment
+	Payment *OrdersPayment `json:"payment,omitempty"`
+
+	// PromoCode promo code redeemed by the order
+	PromoCode *string                     `json:"promo_code,omitempty"`
+	Shipments []OrdersGetOrderResShipment `json:"shipments"`
+	Status    string                      `json:"status"`
+
+	// StatusHistory order status transitions, oldest first
+	StatusHistory []OrdersGetOrderResStatusHistoryEntry `json:"status_history"`
+	UpdatedAt     string                                `json:"updated_at"`
+	UserId        string                                `json:"user_id"`
+}
+
+// OrdersGetOrderResItem defines model for OrdersGetOrderResItem.
+type OrdersGetOrderResItem struct {
+	Count      int     `json:"count"`
+	Name       string  `json:"name"`
+	PictureUrl *string `json:"picture_url,omitempty"`
+	Price      float64 `json:"price"`
+	ProductId  string  `json:"product_id"`
+	SellerId   string  `json:"seller_id"`
+	SkuId      *string `json:"sku_id,omitempty"`
+}
+
+// OrdersGetOrderResShipment defines model for OrdersGetOrderResShipment.
+type OrdersGetOrderResShipment struct {
+	Carrier  *string `json:"carrier,omitempty"`
+	SellerId string  `json:"seller_id"`
+	Status   string  `json:"status"`
+
+	// TrackingNumber carrier tracking number, set once the shipment is shipped
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// OrdersGetOrderResStatusHistoryEntry defines model for OrdersGetOrderResStatusHistoryEntry.
+type OrdersGetOrderResStatusHistoryEntry struct {
+	ActorId string `json:"actor_id"`
+
+	// ActorType subject type of the actor, "system" for transitions made by the service itself
+	ActorType string `json:"actor_type"`
+	CreatedAt string `json:"created_at"`
+
+	// FromStatus null for order creation
+	FromStatus *string `json:"from_status"`
+	Reason     string  `json:"reason"`
+	Status     string  `json:"status"`
+}
+
+// OrdersListAddressesRes defines model for OrdersListAddressesRes.
+type OrdersListAddressesRes struct {
+	Addresses []OrdersAddress `json:"addresses"`
+}
+
+// OrdersListCouponsRes defines model for OrdersListCouponsRes.
+type OrdersListCouponsRes struct {
+	Coupons []OrdersCoupon `json:"coupons"`
+}
+
+// OrdersListOrdersRes defines model for OrdersListOrdersRes.
+type OrdersListOrdersRes struct {
+	NextPageToken *string                    `json:"next_page_token"`
+	Orders        []OrdersListOrdersResOrder `json:"orders"`
+}
+
+// OrdersListOrdersResItem defines model for OrdersListOrdersResItem.
+type OrdersListOrdersResItem struct {
+	Count      int     `json:"count"`
+	Name       string  `json:"name"`
+	PictureUrl *string `json:"picture_url,omitempty"`
+	Price      float64 `json:"price"`
+	ProductId  string  `json:"product_id"`
+	SellerId   string  `json:"seller_id"`
+	SkuId      *string `json:"sku_id,omitempty"`
+}
+
+// OrdersListOrdersResOrder defines model for OrdersListOrdersResOrder.
+type OrdersListOrdersResOrder struct {
+	CreatedAt *string                   `json:"created_at,omitempty"`
+	Id        string                    `json:"id"`
+	Items     []OrdersListOrdersResItem `json:"items"`
+	Status    string                    `json:"status"`
+	UpdatedAt *string                   `json:"updated_at,omitempty"`
+	UserId    string                    `json:"user_id"`
+}
+
+// OrdersListReturnsRes defines model for OrdersListReturnsRes.
+type OrdersListReturnsRes struct {
+	Returns []OrdersReturn `json:"returns"`
+}
+
+// OrdersListSellerOrdersRes defines model for OrdersListSellerOrdersRes.
+type OrdersListSellerOrdersRes struct {
+	NextPageToken *string                          `json:"next_page_token"`
+	Orders        []OrdersListSellerOrdersResOrder `json:"orders"`
+}
+
+// OrdersListSellerOrdersResOrder defines model for OrdersListSellerOrdersResOrder.
+type OrdersListSellerOrdersResOrder struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+
+	// Items seller items of the order
+	Items    []OrdersListOrdersResItem `json:"items"`
+	Shipment OrdersGetOrderResShipment `json:"shipment"`
+
+	// Status order status
+	Status string `json:"status"`
+	UserId string `json:"user_id"`
+}
+
+// OrdersPayment how to pay for the order, present only while the order awaits payment
+type OrdersPayment struct {
+	// Amount amount left to pay
+	Amount          float64                 `json:"amount"`
+	Checkouts       []OrdersPaymentCheckout `json:"checkouts"`
+	CurrencyIso4217 int                     `json:"currency_iso_4217"`
+}
+
+// OrdersPaymentCheckout defines model for OrdersPaymentCheckout.
+type OrdersPaymentCheckout struct {
+	// Form form to submit instead of following the checkout link
+	Form     *OrdersPaymentCheckoutForm `json:"form,omitempty"`
+	Provider string                     `json:"provider"`
+
+	// Url link to pay for the order with
+	Url string `json:"url"`
+}
+
+// OrdersPaymentCheckoutForm form to submit instead of following the checkout link
+type OrdersPaymentCheckoutForm struct {
+	Action string            `json:"action"`
+	Method string            `json:"method"`
+	Params map[string]string `json:"params"`
+}
+
+// OrdersPreviewCouponReq defines model for OrdersPreviewCouponReq.
+type OrdersPreviewCouponReq struct {
+	Items []OrdersPreviewCouponReqItem `json:"items"`
+}
+
+// OrdersPreviewCouponReqItem defines model for OrdersPreviewCouponReqItem.
+type OrdersPreviewCouponReqItem struct {
+	Count int `json:"count"`
+
+	// Price price of the product or its sku
+	Price     float64 `json:"price"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// OrdersProcessCarrierEventsReq tracking events webhook, its format is specific to the carrier
+type OrdersProcessCarrierEventsReq = map[string]interface{}
+
+// OrdersProcessCarrierEventsRes defines model for OrdersProcessCarrierEventsRes.
+type OrdersProcessCarrierEventsRes = map[string]interface{}
+
+// OrdersProcessPaymentReq payment notification, its format is specific to the payment provider
+type OrdersProcessPaymentReq = map[string]interface{}
+
+// OrdersProcessPaymentRes defines model for OrdersProcessPaymentRes.
+type OrdersProcessPaymentRes = map[string]interface{}
+
+// OrdersReturn defines model for OrdersReturn.
+type OrdersReturn struct {
+	// AllowedTransitions statuses the requesting subject may set with return update
+	AllowedTransitions []string `json:"allowed_transitions"`
+
+	// Comment seller comment on the return resolution
+	Comment   *string             `json:"comment,omitempty"`
+	CreatedAt string              `json:"created_at"`
+	Id        string              `json:"id"`
+	Items     []OrdersReturnItem  `json:"items"`
+	OrderId   string              `json:"order_id"`
+	Photos    []OrdersReturnPhoto `json:"photos"`
+	Reason    string              `json:"reason"`
+
+	// RefundAmount amount refunded to the buyer once the seller receives returned items
+	RefundAmount float64 `json:"refund_amount"`
+	SellerId     string  `json:"seller_id"`
+	Status       string  `json:"status"`
+	UpdatedAt    string  `json:"updated_at"`
+	UserId       string  `json:"user_id"`
+}
+
+// OrdersReturnItem defines model for OrdersReturnItem.
+type OrdersReturnItem struct {
+	Count     int     `json:"count"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// OrdersReturnPhoto defines model for OrdersReturnPhoto.
+type OrdersReturnPhoto struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
+// OrdersUpdateOrderReq defines model for OrdersUpdateOrderReq.
+type OrdersUpdateOrderReq struct {
+	// Reason recorded in the order status history; required to complete cancellation of an order whose payments are not refunded (the manual refund reason)
+	Reason *string `json:"reason,omitempty"`
+	Status string  `json:"status"`
+}
+
+// OrdersUpdateOrderRes defines model for OrdersUpdateOrderRes.
+type OrdersUpdateOrderRes struct {
+	Status    string `json:"status"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// OrdersUpdateReturnReq defines model for OrdersUpdateReturnReq.
+type OrdersUpdateReturnReq struct {
+	// Comment seller comment, required to reject the return
+	Comment *string `json:"comment,omitempty"`
+	Status  string  `json:"status"`
+}
+
+// OrdersUpdateShipmentReq defines model for OrdersUpdateShipmentReq.
+type OrdersUpdateShipmentReq struct {
+	// Carrier required to ship the shipment unless the order is picked up
+	Carrier *string `json:"carrier,omitempty"`
+	Status  string  `json:"status"`
+
+	// TrackingNumber required to ship the shipment unless the order is picked up
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+}
+
+// OrdersUpdateShipmentRes defines model for OrdersUpdateShipmentRes.
+type OrdersUpdateShipmentRes struct {
+	Carrier *string `json:"carrier,omitempty"`
+	OrderId string  `json:"order_id"`
+
+	// OrderStatus order status derived from its shipments
+	OrderStatus    string  `json:"order_status"`
+	SellerId       string  `json:"seller_id"`
+	Status         string  `json:"status"`
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// OrdersUploadReturnPhotoRes defines model for OrdersUploadReturnPhotoRes.
+type OrdersUploadReturnPhotoRes struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
+// PrivateApplyAdCampaignsReq defines model for PrivateApplyAdCampaignsReq.
+type PrivateApplyAdCampaignsReq = map[string]interface{}
+
+// PrivateApplyAdCampaignsRes defines model for PrivateApplyAdCampaignsRes.
+type PrivateApplyAdCampaignsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductRatingsReq defines model for PrivateCatalogSyncProductRatingsReq.
+type PrivateCatalogSyncProductRatingsReq struct {
+	Messages []PrivateCatalogSyncProductRatingsReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductRatingsReqMessage defines model for PrivateCatalogSyncProductRatingsReqMessage.
+type PrivateCatalogSyncProductRatingsReqMessage struct {
+	ProductId    string  `json:"product_id"`
+	Rating       float64 `json:"rating"`
+	ReviewsCount int     `json:"reviews_count"`
+
+	// Version version of the product rating, ratings older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductRatingsRes defines model for PrivateCatalogSyncProductRatingsRes.
+type PrivateCatalogSyncProductRatingsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsAdBoostReq defines model for PrivateCatalogSyncProductsAdBoostReq.
+type PrivateCatalogSyncProductsAdBoostReq struct {
+	Messages []PrivateCatalogSyncProductsAdBoostReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsAdBoostReqMessage defines model for PrivateCatalogSyncProductsAdBoostReqMessage.
+type PrivateCatalogSyncProductsAdBoostReqMessage struct {
+	AdBoost   float64 `json:"ad_boost"`
+	ProductId string  `json:"product_id"`
+
+	// Version time the ad boost was computed at in unix milliseconds, ad boosts older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductsAdBoostRes defines model for PrivateCatalogSyncProductsAdBoostRes.
+type PrivateCatalogSyncProductsAdBoostRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsPurchasesReq defines model for PrivateCatalogSyncProductsPurchasesReq.
+type PrivateCatalogSyncProductsPurchasesReq struct {
+	Messages []PrivateCatalogSyncProductsPurchasesReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsPurchasesReqMessage defines model for PrivateCatalogSyncProductsPurchasesReqMessage.
+type PrivateCatalogSyncProductsPurchasesReqMessage struct {
+	ProductId        string `json:"product_id"`
+	Purchases30d     int    `json:"purchases_30d"`
+	PurchasesAlltime int    `json:"purchases_alltime"`
+}
+
+// PrivateCatalogSyncProductsPurchasesRes defines model for PrivateCatalogSyncProductsPurchasesRes.
+type PrivateCatalogSyncProductsPurchasesRes = map[string]interface{}
+
+// PrivateClearCartPositionsReq defines model for PrivateClearCartPositionsReq.
+type PrivateClearCartPositionsReq struct {
+	Messages []PrivateClearCartPositionsReqMessage `json:"messages"`
+}
+
+// PrivateClearCartPositionsReqMessage defines model for PrivateClearCartPositionsReqMessage.
+type PrivateClearCartPositionsReqMessage struct {
+	// OrderId the order the cart is cleared for, the cart is cleared once per order; messages without order id are always applied
+	OrderId *string `json:"order_id,omitempty"`
+	UserId  string  `json:"user_id"`
+}
+
+// PrivateClearCartPositionsRes defines model for PrivateClearCartPositionsRes.
+type PrivateClearCartPositionsRes = map[string]interface{}
+
 // PrivateFeedbackProcessCompletedOrderReq defines model for PrivateFeedbackProcessCompletedOrderReq.
 type PrivateFeedbackProcessCompletedOrderReq struct {
 	Messages []PrivateFeedbackProcessCompletedOrderReqMessage `json:"messages"`
@@ -104,18 +1089,334 @@ type PrivateFeedbackProcessCompletedOrderReq struct {
 
 // PrivateFeedbackProcessCompletedOrderReqMessage defines model for PrivateFeedbackProcessCompletedOrderReqMessage.
 type PrivateFeedbackProcessCompletedOrderReqMessage struct {
-	OrderId  string                                          `json:"order_id"`
-	Products PrivateFeedbackProcessCompletedOrderReqProducts `json:"products"`
+	OrderId  string                                           `json:"order_id"`
+	Products []PrivateFeedbackProcessCompletedOrderReqProduct `json:"products"`
+	UserId   string                                           `json:"user_id"`
 }
 
-// PrivateFeedbackProcessCompletedOrderReqProducts defines model for PrivateFeedbackProcessCompletedOrderReqProducts.
-type PrivateFeedbackProcessCompletedOrderReqProducts struct {
+// PrivateFeedbackProcessCompletedOrderReqProduct defines model for PrivateFeedbackProcessCompletedOrderReqProduct.
+type PrivateFeedbackProcessCompletedOrderReqProduct struct {
 	Id string `json:"id"`
 }
 
 // PrivateFeedbackProcessCompletedOrderRes defines model for PrivateFeedbackProcessCompletedOrderRes.
 type PrivateFeedbackProcessCompletedOrderRes = map[string]interface{}
 
+// PrivateOrderBatchCancelUnpaidOrdersReq defines model for PrivateOrderBatchCancelUnpaidOrdersReq.
+type PrivateOrderBatchCancelUnpaidOrdersReq = map[string]interface{}
+
+// PrivateOrderBatchCancelUnpaidOrdersRes defines model for PrivateOrderBatchCancelUnpaidOrdersRes.
+type PrivateOrderBatchCancelUnpaidOrdersRes = map[string]interface{}
+
+// PrivateOrderBatchCompleteDeliveredOrdersReq defines model for PrivateOrderBatchCompleteDeliveredOrdersReq.
+type PrivateOrderBatchCompleteDeliveredOrdersReq = map[string]interface{}
+
+// PrivateOrderBatchCompleteDeliveredOrdersRes defines model for PrivateOrderBatchCompleteDeliveredOrdersRes.
+type PrivateOrderBatchCompleteDeliveredOrdersRes = map[string]interface{}
+
+// PrivateOrderCancelOperationsReq defines model for PrivateOrderCancelOperationsReq.
+type PrivateOrderCancelOperationsReq struct {
+	Messages []PrivateOrderCancelOperationsReqMessage `json:"messages"`
+}
+
+// PrivateOrderCancelOperationsReqMessage defines model for PrivateOrderCancelOperationsReqMessage.
+type PrivateOrderCancelOperationsReqMessage struct {
+	Details     string `json:"details"`
+	OperationId string `json:"operation_id"`
+}
+
+// PrivateOrderCancelOperationsRes defines model for PrivateOrderCancelOperationsRes.
+type PrivateOrderCancelOperationsRes = map[string]interface{}
+
+// PrivateOrderProcessPaymentNotificationsReq defines model for PrivateOrderProcessPaymentNotificationsReq.
+type PrivateOrderProcessPaymentNotificationsReq struct {
+	Messages []PrivateOrderProcessPaymentNotificationsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessPaymentNotificationsReqMessage defines model for PrivateOrderProcessPaymentNotificationsReqMessage.
+type PrivateOrderProcessPaymentNotificationsReqMessage struct {
+	Amount          float64   `json:"amount"`
+	CurrencyIso4217 int       `json:"currency_iso_4217"`
+	Datetime        time.Time `json:"datetime"`
+	OrderId         string    `json:"order_id"`
+
+	// PaymentId payment id derived from the provider operation id, duplicate notifications share it
+	PaymentId    *string                `json:"payment_id,omitempty"`
+	ProviderMeta map[string]interface{} `json:"provider_meta"`
+}
+
+// PrivateOrderProcessPaymentNotificationsRes defines model for PrivateOrderProcessPaymentNotificationsRes.
+type PrivateOrderProcessPaymentNotificationsRes = map[string]interface{}
+
+// PrivateOrderProcessPublishedCartPositionsReq defines model for PrivateOrderProcessPublishedCartPositionsReq.
+type PrivateOrderProcessPublishedCartPositionsReq struct {
+	Messages []PrivateOrderProcessPublishedCartPositionsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsReqCartPosition defines model for PrivateOrderProcessPublishedCartPositionsReqCartPosition.
+type PrivateOrderProcessPublishedCartPositionsReqCartPosition struct {
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsReqMessage defines model for PrivateOrderProcessPublishedCartPositionsReqMessage.
+type PrivateOrderProcessPublishedCartPositionsReqMessage struct {
+	CartPositions []PrivateOrderProcessPublishedCartPositionsReqCartPosition `json:"cart_positions"`
+	OperationId   string                                                     `json:"operation_id"`
+
+	// PromoCode promo code applied to the cart, orders of the operation are discounted with its coupon
+	PromoCode *string `json:"promo_code,omitempty"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsRes defines model for PrivateOrderProcessPublishedCartPositionsRes.
+type PrivateOrderProcessPublishedCartPositionsRes = map[string]interface{}
+
+// PrivateOrderProcessRefundsReq defines model for PrivateOrderProcessRefundsReq.
+type PrivateOrderProcessRefundsReq = map[string]interface{}
+
+// PrivateOrderProcessRefundsRes defines model for PrivateOrderProcessRefundsRes.
+type PrivateOrderProcessRefundsRes = map[string]interface{}
+
+// PrivateOrderProcessReservedProductsReq defines model for PrivateOrderProcessReservedProductsReq.
+type PrivateOrderProcessReservedProductsReq struct {
+	Messages []PrivateOrderProcessReservedProductsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessReservedProductsReqMessage defines model for PrivateOrderProcessReservedProductsReqMessage.
+type PrivateOrderProcessReservedProductsReqMessage struct {
+	OperationId string                                          `json:"operation_id"`
+	Products    []PrivateOrderProcessReservedProductsReqProduct `json:"products"`
+}
+
+// PrivateOrderProcessReservedProductsReqProduct defines model for PrivateOrderProcessReservedProductsReqProduct.
+type PrivateOrderProcessReservedProductsReqProduct struct {
+	Count    int     `json:"count"`
+	Id       string  `json:"id"`
+	Name     string  `json:"name"`
+	Picture  *string `json:"picture,omitempty"`
+	Price    float64 `json:"price"`
+	SellerId string  `json:"seller_id"`
+
+	// SkuId reserved sku, price and picture are the ones of the sku
+	SkuId *string `json:"sku_id,omitempty"`
+}
+
+// PrivateOrderProcessReservedProductsRes defines model for PrivateOrderProcessReservedProductsRes.
+type PrivateOrderProcessReservedProductsRes = map[string]interface{}
+
+// PrivateOrderProcessUnreservedProductsReq defines model for PrivateOrderProcessUnreservedProductsReq.
+type PrivateOrderProcessUnreservedProductsReq struct {
+	Messages []PrivateOrderProcessUnreservedProductsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessUnreservedProductsReqMessage defines model for PrivateOrderProcessUnreservedProductsReqMessage.
+type PrivateOrderProcessUnreservedProductsReqMessage struct {
+	OrderId string `json:"order_id"`
+
+	// ReturnId return whose received items are restocked, absent for cancelled orders
+	ReturnId *string `json:"return_id,omitempty"`
+}
+
+// PrivateOrderProcessUnreservedProductsRes defines model for PrivateOrderProcessUnreservedProductsRes.
+type PrivateOrderProcessUnreservedProductsRes = map[string]interface{}
+
+// PrivateOrderPublishProductsPurchasesStatsReq defines model for PrivateOrderPublishProductsPurchasesStatsReq.
+type PrivateOrderPublishProductsPurchasesStatsReq = map[string]interface{}
+
+// PrivateOrderPublishProductsPurchasesStatsRes defines model for PrivateOrderPublishProductsPurchasesStatsRes.
+type PrivateOrderPublishProductsPurchasesStatsRes = map[string]interface{}
+
+// PrivatePublishCartPositionsReq defines model for PrivatePublishCartPositionsReq.
+type PrivatePublishCartPositionsReq struct {
+	Messages []PrivatePublishCartPositionsReqMessage `json:"messages"`
+}
+
+// PrivatePublishCartPositionsReqMessage defines model for PrivatePublishCartPositionsReqMessage.
+type PrivatePublishCartPositionsReqMessage struct {
+	OperationId string `json:"operation_id"`
+	UserId      string `json:"user_id"`
+}
+
+// PrivatePublishCartPositionsRes defines model for PrivatePublishCartPositionsRes.
+type PrivatePublishCartPositionsRes = map[string]interface{}
+
+// PrivateRelayOutboxReq defines model for PrivateRelayOutboxReq.
+type PrivateRelayOutboxReq = map[string]interface{}
+
+// PrivateRelayOutboxRes defines model for PrivateRelayOutboxRes.
+type PrivateRelayOutboxRes struct {
+	// Relayed Number of messages published to topics
+	Relayed int `json:"relayed"`
+}
+
+// PrivateReleaseExpiredReservationsReq defines model for PrivateReleaseExpiredReservationsReq.
+type PrivateReleaseExpiredReservationsReq = map[string]interface{}
+
+// PrivateReleaseExpiredReservationsRes defines model for PrivateReleaseExpiredReservationsRes.
+type PrivateReleaseExpiredReservationsRes struct {
+	// Released Number of released reservation holds
+	Released int `json:"released"`
+}
+
+// PrivateReserveProductsReq defines model for PrivateReserveProductsReq.
+type PrivateReserveProductsReq struct {
+	Messages []PrivateReserveProductsReqMessage `json:"messages"`
+}
+
+// PrivateReserveProductsReqMessage defines model for PrivateReserveProductsReqMessage.
+type PrivateReserveProductsReqMessage struct {
+	OperationId string `json:"operation_id"`
+
+	// OrderId id of the order to be created of the reserved products, products are held for it until it's paid
+	OrderId  string                             `json:"order_id"`
+	Products []PrivateReserveProductsReqProduct `json:"products"`
+}
+
+// PrivateReserveProductsReqProduct defines model for PrivateReserveProductsReqProduct.
+type PrivateReserveProductsReqProduct struct {
+	Count int    `json:"count"`
+	Id    string `json:"id"`
+
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
+}
+
+// PrivateReserveProductsRes defines model for PrivateReserveProductsRes.
+type PrivateReserveProductsRes = map[string]interface{}
+
+// PrivateSellProductsReq defines model for PrivateSellProductsReq.
+type PrivateSellProductsReq struct {
+	Messages []PrivateSellProductsReqMessage `json:"messages"`
+}
+
+// PrivateSellProductsReqMessage defines model for PrivateSellProductsReqMessage.
+type PrivateSellProductsReqMessage struct {
+	// OrderId paid order whose reserved products are sold
+	OrderId string `json:"order_id"`
+}
+
+// PrivateSellProductsRes defines model for PrivateSellProductsRes.
+type PrivateSellProductsRes = map[string]interface{}
+
+// PrivateUnreserveProductsReq defines model for PrivateUnreserveProductsReq.
+type PrivateUnreserveProductsReq struct {
+	Messages []PrivateUnreserveProductsReqMessage `json:"messages"`
+}
+
+// PrivateUnreserveProductsReqMessage defines model for PrivateUnreserveProductsReqMessage.
+type PrivateUnreserveProductsReqMessage struct {
+	OrderId  string                               `json:"order_id"`
+	Products []PrivateUnreserveProductsReqProduct `json:"products"`
+
+	// ReturnId return whose received items are restocked, absent for cancelled orders
+	ReturnId *string `json:"return_id,omitempty"`
+}
+
+// PrivateUnreserveProductsReqProduct defines model for PrivateUnreserveProductsReqProduct.
+type PrivateUnreserveProductsReqProduct struct {
+	Count int    `json:"count"`
+	Id    string `json:"id"`
+
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
+}
+
+// PrivateUnreserveProductsRes defines model for PrivateUnreserveProductsRes.
+type PrivateUnreserveProductsRes = map[string]interface{}
+
+// ProductCategory defines model for ProductCategory.
+type ProductCategory struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+
+	// ParentId id of the parent category, absent for root categories
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Path slugs of the category ancestors and the category joined with "/", i.e. "flowers/roses"
+	Path string `json:"path"`
+
+	// Position position of the category among its siblings
+	Position  int    `json:"position"`
+	Slug      string `json:"slug"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ReplaceRefreshTokenReq defines model for ReplaceRefreshTokenReq.
+type ReplaceRefreshTokenReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ReplaceRefreshTokenRes defines model for ReplaceRefreshTokenRes.
+type ReplaceRefreshTokenRes struct {
+	ExpiresAt    string `json:"expires_at"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// UpdateProductCategoryReq defines model for UpdateProductCategoryReq.
+type UpdateProductCategoryReq struct {
+	Name *string `json:"name,omitempty"`
+
+	// ParentId id of the new parent category, empty string moves the category to the root
+	ParentId *string `json:"parent_id,omitempty"`
+	Position *int    `json:"position,omitempty"`
+	Slug     *string `json:"slug,omitempty"`
+}
+
+// UpdateProductCategoryRes defines model for UpdateProductCategoryRes.
+type UpdateProductCategoryRes = ProductCategory
+
+// UpdateProductReq defines model for UpdateProductReq.
+type UpdateProductReq struct {
+	// CategoryId id of the product category
+	CategoryId  *string                 `json:"category_id,omitempty"`
+	Description *string                 `json:"description,omitempty"`
+	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
+	Name        *string                 `json:"name,omitempty"`
+
+	// Options option axes of the product variants, values of existing skus must stay valid
+	Options *[]GetProductResOption `json:"options,omitempty"`
+	Price   *float64               `json:"price,omitempty"`
+
+	// StockDelta The amount of "in stock" product count change, either of:
+	// - positive: stock amount is increased (seller releases more products)
+	// - negative: stock amount is decreased (item purchased)
+	//
+	// Stock of the product with skus is changed with sku updates.
+	StockDelta *int `json:"stock_delta,omitempty"`
+}
+
+// UpdateProductRes defines model for UpdateProductRes.
+type UpdateProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                 `json:"category_id,omitempty"`
+	Description *string                 `json:"description,omitempty"`
+	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
+	Name        *string                 `json:"name,omitempty"`
+	Options     *[]GetProductResOption  `json:"options,omitempty"`
+	Price       *float64                `json:"price,omitempty"`
+	Stock       *int                    `json:"stock,omitempty"`
+}
+
+// UpdateProductSkuReq defines model for UpdateProductSkuReq.
+type UpdateProductSkuReq struct {
+	PictureIds *[]string `json:"picture_ids,omitempty"`
+	Price      *float64  `json:"price,omitempty"`
+
+	// StockDelta The amount of sku stock change, negative values withdraw products from stock
+	StockDelta *int `json:"stock_delta,omitempty"`
+}
+
+// UpdateProductSkuRes defines model for UpdateProductSkuRes.
+type UpdateProductSkuRes = GetProductResSku
+
+// UploadProductPictureRes defines model for UploadProductPictureRes.
+type UploadProductPictureRes struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Errors []Err `json:"errors"`
@@ -129,6 +1430,9 @@ type FeedbackListProductReviewsParams struct {
 // FeedbackProcessCompletedOrderJSONRequestBody defines body for FeedbackProcessCompletedOrder for application/json ContentType.
 type FeedbackProcessCompletedOrderJSONRequestBody = PrivateFeedbackProcessCompletedOrderReq
 
+// PrivateFeedbackRelayOutboxJSONRequestBody defines body for PrivateFeedbackRelayOutbox for application/json ContentType.
+type PrivateFeedbackRelayOutboxJSONRequestBody = PrivateRelayOutboxReq
+
 // FeedbackAddProductReviewJSONRequestBody defines body for FeedbackAddProductReview for application/json ContentType.
 type FeedbackAddProductReviewJSONRequestBody = FeedbackCreateProductReviewReq
 
@@ -140,6 +1444,10 @@ type FeedbackUpdateProductReviewJSONRequestBody = FeedbackUpdateProductReviewReq
 const FeedbackProcessCompletedOrderMethod = "POST"
 const FeedbackProcessCompletedOrderPath = "/api/private/v1/feedback/orders:process_completed_order"
 
+// Relay outbox
+const PrivateFeedbackRelayOutboxMethod = "POST"
+const PrivateFeedbackRelayOutboxPath = "/api/private/v1/feedback/relay-outbox"
+
 // Get product rating
 const FeedbackGetProductRatingMethod = "GET"
 const FeedbackGetProductRatingPath = "/api/v1/feedback/products/:product_id/rating"
@@ -169,6 +1477,9 @@ type ServerInterface interface {
 	// Process completed order contents
 	// (POST /api/private/v1/feedback/orders:process_completed_order)
 	FeedbackProcessCompletedOrder(c *gin.Context)
+	// Relay outbox
+	// (POST /api/private/v1/feedback/relay-outbox)
+	PrivateFeedbackRelayOutbox(c *gin.Context)
 	// Get product rating
 	// (GET /api/v1/feedback/products/{product_id}/rating)
 	FeedbackGetProductRating(c *gin.Context, productId string)
@@ -211,6 +1522,19 @@ func (siw *ServerInterfaceWrapper) FeedbackProcessCompletedOrder(c *gin.Context)
 	siw.Handler.FeedbackProcessCompletedOrder(c)
 }
 
+// PrivateFeedbackRelayOutbox operation middleware
+func (siw *ServerInterfaceWrapper) PrivateFeedbackRelayOutbox(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PrivateFeedbackRelayOutbox(c)
+}
+
 // FeedbackGetProductRating operation middleware
 func (siw *ServerInterfaceWrapper) FeedbackGetProductRating(c *gin.Context) {
 
@@ -427,6 +1751,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	}
 
 	router.POST(options.BaseURL+"/api/private/v1/feedback/orders:process_completed_order", wrapper.FeedbackProcessCompletedOrder)
+	router.POST(options.BaseURL+"/api/private/v1/feedback/relay-outbox", wrapper.PrivateFeedbackRelayOutbox)
 	router.GET(options.BaseURL+"/api/v1/feedback/products/:product_id/rating", wrapper.FeedbackGetProductRating)
 	router.GET(options.BaseURL+"/api/v1/feedback/products/:product_id/reviews", wrapper.FeedbackListProductReviews)
 	router.POST(options.BaseURL+"/api/v1/feedback/products/:product_id/reviews", wrapper.FeedbackAddProductReview)
@@ -438,33 +1763,138 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xab2/bNhP/KgKf58XzAHLkttg66F3WZUWxFTXSDhhQBMaZPNtsJVEhKTee4e8+8I8k",
-	"25JsObGTIuirKNLd8Xj3u3+kV4SKNBcZZlqReEUkqlxkCu0/V1IKaR6oyDRm2jxCniecguYii74okZl3",
-	"is4xBfuVMW4+QTKSIkepuZE0hURhSPKNVyuCRrh94hpT+/BfiVMSk/9EtU6Rk62iKynJOiR6mSOJCUgJ",
-	"S7Jeh0TibcElMhJ/LkXeVGRi8gWpJmtDyFBRyXOjHYkdqRXgF/D7PXITVDA0f/16PNM4Q6toikrBbPOj",
-	"0pJns4bSVkRN31Q+JL8jsgnQr28kgsaRFKyg+hoXHL9d4+2RKkvQRo14RaZCpqBJTJgoJolRwq+cFenE",
-	"7ULaRQ5vwgutGI7ehTrW8FYIG4NuUS4knLW+zt2i447PpzFNSIqc7VOuUCjbVdixKmdkS+eaNWxYPNw0",
-	"yZYK+3zxGyb4YF/03Mo+Pd6iLpWw+zpeiQe4NoU7nhYpiX8KScoz9zwMG0G9s6Utx3j5Pff4A/RPCfo/",
-	"udr2hDreFRne6XEOMxxr8RVtFcyKJAFjt1jLAsMWS7vFepe8vfq6p4MlsVwzbGh8tIWuK9//gOxjQ/Yv",
-	"S/a9VP7j1DxJOQnP07d0lKWR5AvQWG5rJAVFpd6INDflkn2QDOXx9vctXv8E0FON9751PJQLqvUbWw7J",
-	"3UDDTLmyZhcdQ87JTX9bvK/73SNMIgz3eH8WOJWZRqW4jkKutuK20uwcxhpt7OwRGq2Ha6w2Fj4o1AxV",
-	"SAvJ9fKjcZHjniBIlJeFntutmCFsjsBQmtoEqZH898B8FpL/Y+fLOrAh53/g0s1xPJsKqw3XptaSKyrS",
-	"4HL0joRkgVK58W548eJiaDAkcsyMVjF5dTG8GJo8DXpuFYog55HXPFq8iChIHdMEQQ78vGvJ7gaeZmDl",
-	"mNq+DtuZ82KScDW/B/vUmz+ysFNx7twwpqUfxvaDbXSFsuVqe571fgsqhsAyBKUqwf+UCPQcdGAgHsxB",
-	"BRDkKFOujMkCLYIEYYFBqUkwFdKQOKT+n1hTSuuXd4zEZC9giMMkKv2rYMujThBOEOgGJzYqNo4yXg6H",
-	"j6yGajt2KAM/YKCB2M9TKBLdtWS1h+iqPrAo0hTksofTTQD5CC3damO+HyatrHgCms4HFDKKyaDIcuBs",
-	"4EDaH91OkpdxP2YfEAMfYsgGJuIGuVA2ed5bnkSFcoFssFls+gkqOWIv4x6cRdaP1yeYaOXr0zra3HgP",
-	"qmhVt6frXRYNiZhtv6zyUanqloCo7sVm2JKM3qIuE0dQ9b/t6WN38LcJWkKK2oLk867oUqyt0baImHxe",
-	"l5Dtobyqi24iq8N7t4benDFd7Dvj6E4R3nBBDstEAHtwrmj1STM7mOSwpAaBM9D4DZYDe/zhPFeaBHjm",
-	"uzZCTK2XC05xDJSKInNDG4Ev+Br56/zrz0n+cji9/eX1q2ldzy3mZeIqnJenyLq5+AISzkC7U2j/D15v",
-	"lhYbJusjoFsP4a3YNaNvbahqem5Hb3NOfiL8hr6jui1QLmtpuzP/U4dA+8nLviBwVCeLgg7vPps4CDva",
-	"w0vGdrbdielLxrZc9JQZ+fQt5IGrlDN3jgeuQM4fCH4ss27cHMg+3xiD13HSipdnXS28gVuLRbRyD755",
-	"IszemjSjzN2m9A20lruXp6se2+s4zTuXqazx3fVYHfdZLYHlKBsoP3NcdSHkGRWgwxPB/rDYvSb7EROn",
-	"mzv6V5rzzB3PEO45aDpvAt7dhPTFfMu9yfOH/fmau47bskdq7jouwQ6HXOEx8zgtXhdCn2OXZ8/8oir2",
-	"VLSqnpsHYp64PKbs+BKtyhuiHfaNg8SWt3sO4trPK3JOdSFRHUMbrRrCC2V0jqHQc8y0ATi2ElCRTblM",
-	"x5gCT9yp5Lb1qf/RXop6LpgyKP7w8ZO9MOMzkyDKxLgr2I48l5SiUp/8Tyb2UBnU7KH4iEmCch+dxDwB",
-	"itc4lajm1YrrCuCNEbk2DRdZ4BFcZzpjOtLMj9WJfoOhAkKT6Y07c23ylIexbSxSt9FL3UJsryBaVPKg",
-	"7txFNWY2OKtssL5Z/xsAAP//srlMmTQqAAA=",
+	"H4sIAAAAAAAC/+x9/W/kNrLgv0LoDtgEULs9M/uSO7+fnMm8XHC7O8bMZO+AOGjQUnU3Y0lUSMp2r+H/",
+	"/YFfEiVRny23J7Pzk+XmV7FYVSwWq4qPQUTTnGaQCR5cPAYMeE4zDuqfd4xRJj8imgnIhPzEeZ6QCAtC",
+	"s/XvnGbyNx7tIcWqNI6JLMLJFaM5MEFkT1uccAiD3PnpMQDZufoiAlL18T8ZbIOL4H+sK5jWum++fsdY",
+	"8BQG4pBDcBFgxvAheHoKAwZ/FIRBHFz8arv8raxGb36HSARPsmIMPGIkl9AFF7qq6sAMIMe/LMQeMiGn",
+	"Bx/gj6kTSjFJ5IcZnAtGsp0EOsec31MWewqbM1B9OC3acwkbYPKpYD7khAHfYOGFlcGWAd9vBL2FbBjg",
+	"evXQ7d0H+lucRSBhjItIvMVpjskumz4HEnth5wKLgg8DTeKgrOyHkonLPE8OV4ym9C2NZ1BDRGOQf+tk",
+	"l8sOkSwLUYQ5IJJxyDgR5A6CMEjxw98g24l9cPHmdRikJLP/vgoH5qTG65rM2wQwkx9jUD3YwxXlRM9n",
+	"IkaKzKU5kgnYgeLqXBPEpmtdbwt/UQMHTjehGa4LIz9CAgLkl53NdCqMVR/xJnfw0SfCOse1n60JtUaY",
+	"NJ0vYZ1+AuHOik9fJYu78VtNx7jVKg1sQ9WIE2b1JSyWIy6HV6lLMCKlYUCMBEViDyjCTJwh2StHXOBD",
+	"WV5kgiSIQUrvIA4RZTEwjjADFBOuAIUY3ROx193QIqfZdUa35t8YENkiIv7CkdFpbhIwg6Zn6EfTh+4x",
+	"Z3BH4N7t0Iyn+7UVzq6zoLlEBl6z4W4pS+VXEGMBK0FSCML24tjdY4TMD90Bulbmg8LSpPXx9vOxTrjT",
+	"uZGDmCQv2wN2Csta17+NnsCfn/EETujuJxDTVyPCAnaUlfuZy5Gm7IC2OALBQ5QV6Y2keLpFW1pkMTIA",
+	"cnRzQGXtHIs9D8KxktaB/a3poi1fwyCDB7HJ8Q4qvTQrkkSybHAhWAEeHrLgTZD7DjRGRx0W9naU0MVm",
+	"G+LBpSunvxwdYrF3SrrITNYaTWAWLYvo6xlOwVuQk0gUzKM/FywJwjFLTyKoS1tayAZlXU3M/pOBAst2",
+	"4sUIAyzgMoqA809ydacfD446Zo2Eaao0wKpxJ0hh/9GxAXGts+FzoYK+dS6citUbSrloU42hYMRwdkuy",
+	"HUqLRBC5Z7JSmbjfkwS0ZmBGR4QjHJmDWZuOUvxA0iINLl6dq4Oa+adFYGFwU8Q78ED1g/q9PibPIYu5",
+	"gUaPHraggoc9LrjUbmgWgVZhZEOh8BwlBSd38HcLkmYRzwRshXMPzBKKSQoLF5iJKU0a5KJXrkSW22EF",
+	"zQTK4XMpZ1BiuAs6onKk4Iu7zC0OoltlHVJzSL2AJAHWWZpD1k2LqlRu53WipGiLmZcLWtOt0UGPgQYy",
+	"SXq/KutbXCQQB2FQcRvJCN+r3yJlMNLlJd07hFD1XeRxN6J9Yr6mX1VYCz20aJjLgO8nztpS18AZQbZ6",
+	"658u8Lp3UMwgszRSX2sSI3MI0pVK3c1KGv2flDRmRghrMcUoFfLQhG+4Rkh7WEeZro9qS+zY5TA4pdkO",
+	"EcERJzcJyXa8DUdeCIS3AlitXg0UjzhzVCGeFDuPNpGRPwpACb0HpqxxCRYkQwkIoY6SWYxislNDQo6Z",
+	"QsXNAe0P+R4yHiJyBmfoOthhFkO2YpQDvw4GRZ2CxWgZE0hjsm7fL3gmq2SzCEovD9pSpomnpiJ7xhD7",
+	"dvcSX7xNNlkEXFCzTLWi3ynJ7En9OlhfB+VKbdVS83XnUi1EwUEfDR4vuVwKciAOS11+riCaYeY2SBgi",
+	"DN1/iTMf5mttPWhKQeAYC+wUVtPopFual8a/OnC6AOEH4E0o7zAjOJNnXn5baAMQjuOKpK7ef/yE1jgn",
+	"67tXa9OIrx+rDeVpLRsqAht18vwJRLkC/H3utzJOOdaEARc0uvWdCxsEZYjIxY2DatvP8Gmogv/lKGhA",
+	"5A0RWIdIfAm6U/KKk38BogxFNKEFW5qW9BF7WndXttFUYuzXSW8LD54sMhpICpGiyCbqFGcqbiVcFfAi",
+	"NXUI0034LAx+vC186Otkrzni3NE9vexYrlWNMy2FGQQ2WfWIbeDjbTF9J3AI3t9qiCODO5wUmingDqQp",
+	"0ayt4Zibg/2SSOKBZxYGURsSc58saTGcRaz9nd8WLpG04F1EIPdpqg3yqJbYrqnuZdwKLnSrvuTCusg3",
+	"/X4x6zqwloq9uxa0PsXu5f2oRMVlpKy103l00MKnRZEsGuvb0nNgGOv0YmTeCN+XRkMDbVif12js8cUc",
+	"fDqQ0Glc7gbxF37E8j7rKtnlsaeOPg8lz1w+L2Rrf4mjz9hjrvFIPAyB0ateDoCXG3m5nWrc4NKPcJ4n",
+	"V1vTS4FzvBt/UW/r++D6L4D4Bke3jdOUdCqYcbuEhQTj4rHv4uI/Bu4ttEeD0lgqt7S/nv/v74YMXGb0",
+	"sofJ0z2NqWvAjt6Hwx5cTbPuhEHBuw5GgzZr2zRsYXya7m/XoiER5q3FEaxp4XDOX2pe04GIiRz5prBH",
+	"/VHHvp7hf3T6+6GIbkH4tcbZBOVlyvNOQuObzmv/PieSBpnYXsI6viYujQc3i3kwcIG1i/iA0OqavW7f",
+	"59zgmdhXIfSSQuhvhNdXYobL5xxvIcMSk6WFF179Neg7ZMcc5y00ZsSvJPsCJPuLqvanU9mmzWchY9JJ",
+	"qMNHAe2lHljdmhX4653G1zuNr3caf7I7DR/VzHOv6Q3ECo19u6Y6DLRISfazrvpqQEcwyDNDDE7zqvKc",
+	"PV5YFywZudyy5ljYxutY3pl5CLdF3F/vPb6Yew9H27XenXzOhmyajqa9jnHt96BuX404YVb2+6vz6lfn",
+	"1c/YebVGvdah79joo1FcWR/1MIILyyEGJnIiM8PkoKQGiDPCksbYFjpGebFTz8yAoY1fY1lOse8JF3KZ",
+	"0G5yLlg+tL9nMbB3d5CJy0hQtozeon8YAl2Vhl5TfBg8rATecU1H5A4L2OCcBL/VIJb66+liJsevSadk",
+	"7DACj5vt36urvglRzSpGGCVkC9EhSgDFNMUkk+5NmUB5cZOonUIGHauafK3+bFS5dGfISdQOJ7aU0ic1",
+	"moRVspTXAV7DQ+IQRTTjRQqMoxjiQmd6AcQghoTcAYNY11XKK/FGAJRCbZR0a5CTRyWlUVQwNjF8WqOx",
+	"UwWBO0ILvqk29BHWYcw7LCx6Kps7YNzrNk6yiEEKmY7XQjcMsApCi/Y421Wqul4D3ZnffbwruUnF8VYv",
+	"UdM/Mzt6YNBxlmNS/eOqJvoXvid57vxfLnnVhqa5ykfhV2HGGmQbCAu1FDXyqFw510xbqi7NpQsNN5QL",
+	"ZOmvTjfTeZ5fxjEDPlmjIeLgXaGIpilkoqOsyATraDfPQr+nWcc+SbnAyaYjvYBEY0RyApnYdG61XDAA",
+	"8fwm+2r5G0DZ+VWYCzXiS9jq85ym29aWf0Y8hKEAx/7++vw8HLIHOfRRlx4ZFaDCZ0wOC0aA1RMFvTo/",
+	"Pw97qare488f36M3r777bvUK4STf49VrZOoi66biwF6D/HXYQ2tTche1CNGdz3eDjdtUOhHdFQ3XcaN/",
+	"D9FNQZJYCmkZW4RzzERqosyqcf5jcJzWbd9RZNxNrG/N6X+yYhJRLpCU6oUJ8jM/S3YhNKuFb6kijvIE",
+	"RzIKDraUASIC3WOOSCaU0gXx2XV2mVZpU0iGUpJRhoqMVFZvJZiz6IC+gbPdGbqlOUS3/NsQKbVZdhcl",
+	"RQzon5effPlUbPMN4XTz19evvvdrlDYJTE1rIJn47q/+7VVufyTbbbYAY5sUN4IKnHjoqDTUG5SqXcnM",
+	"LwjHdN7RM1bYlRpjjg8hsiCgBDgv896gPCk4sjNCckajxrzDHp745+UnuyKxXFA5KQ3ciD6bx/HW0jlY",
+	"dJassRwaMouTPkaQuXiOc+8bf/NmgN10nLecGsoO3MZsDixS7MVoil7JNX11fi4vx7bkQfKjXup+Hhq3",
+	"sAM5/1L8sCm4LwmMuXlWJ4DU2K0tBArZITqXt1NFlpCUaG1zBDx2wE0OTH6wGSPLIwhGsvFMGEi26eZg",
+	"c82Oejl5kbWh91n3WUUXWgprChlF/kiWNlCjSFfpxT0G2PopbfBuoDxKT23Xa1T1k13vopudSOyxkJtM",
+	"9heB3NPMZJmklR4H086aNNm8xdQNOnJ4yUfldfTXcGpQURM7Q5Luio12zqjjt9woHMzuyB1wm3RNLa/M",
+	"uiaLKlyjjIp2tjTDEzGVS+F2nU5SB8JqxyJcdmV3nc6kapE+s5dkdUNpAjjrSaAWPpP2UNkH6oi+3x9c",
+	"HOt5ObD7dFNHJk3RE2bSfQ2Y8fvz4FasqFjTz/LJUyXpvJHU9+Z1PWdDaBI2hOg6WF0HcjO9DjYyA8Ck",
+	"bKtvwhH7vbW2mG1cXf08dBhHPldVYJw57Zn1g/7UHafXFQbgeRG9oR+mxqbeIew1CPVbGI5olhwmOQnU",
+	"FYExY+kWeqjQLIkqtAV9zYJwrrIxIw2VEYoDm/6Q2FPfM6IztdHJe31mytANpbcIlLVGUGRstA6ZCRoi",
+	"OyFJ5bJNVUq4dBG5lUW5PwWH6u+wSUHsqQeM68DYoKRIvVb2CStlZcdFPiL/TXOQkdjkk+PRgeExaUZ9",
+	"Y70vG7dDsm3JWLjfu4A8u3d279XHiDuE5zXqGn4qzfeVkXe6jVZj+QOIgs1QMWbcUzVHtFdWPc58rmbY",
+	"stlOMx7W7jdGY+WYW9q+QJsJWW6bRzsJGcTSN80RVdLEWG5JpeProCwZmSNXo0bHt5Wm/RNGtrnjW52Y",
+	"L2OnGvsMQAmCkr1Tz4umWU2lGTYPl83qRuKgY+Mbx4R2CmYdjTe72a36kTO44TT7noYlnuGc76mwWPLt",
+	"2fgWMnS/h8zZle+xRVzQrxK0D7NL3ze+zM1hY5mcSYfPd2vyE4hyZ1482i8GgUniUZD/nzEIlGqESjN7",
+	"Q5nQOewVM5UX7VU1mRP20NDkNK9JYUkLcZ3JwlKRrrT8nrT66JtrfahWuNowkBiC+AJdF+fnbyK956hv",
+	"uA6+neCn1e9/gQ+WNoe5/cpU/tKUF0l98/RanMgkhvFGMJw5L1s0LzIljKB1fzkR4EIa1qzNOMUHxMGE",
+	"mGiK0mBPOm1FlI9cRnVFOSaaqdqexu8EPYQ4R8tzlqbLKWkuCVfM1mvdYhADpDrZaMnyXlvhnuSpfbpr",
+	"3hQ/mi46TtNdHKeLNnvCBfV5GWia0rWQQ6ohokkMXKAtYVyMjV5qQ606/j969HdqH/DA/0z+KKUAsL5G",
+	"1TK0EBN6+fVIibGs6+VJnGqP8/uf82KGJwLNGq+7c1l2s8dEdGPGiDZVTp1rzy7HcCRdBzcGrZ4nO9So",
+	"yFY0T3aEStSrXPHKrGamJHWIyunvqJAEF9fV9jiRqj08PTW7maCdeNWF429SVX1p4+IHLiC9DrQXVsXE",
+	"KMUxWAnNgd0RlYufQ7KdEccrDf+OQ2odPumeWp2ySv+cMS9h9DmujnwtzwXNWV4HoWGF+rCyVIy6yJSB",
+	"B+a0NSeEBNumEzdA5/DYG0ZR9d8/BXNROudUrxpONUSpVoPQ2877Yddfpwl/0WaCibOtAak+BmduxhkX",
+	"/OIZ5esO+7w7rGdJT2Ecn3McaNPFNE35NEpoP6q1OXgGj2tr7VSU6eFGpL/RnffDrpN2/imEVAPUZxVV",
+	"3rFOykMNHUrBU7/AtefWxbnNUcpnH3a7lC335BqEz8CwJfTVAXKUqnRVGR0mGKX39N54C5dhBMZ6nzNQ",
+	"5nt5K++8f6Xnj+8xERxZQ0dL70rtbud1T05ga32Ux0VeR3uIbmkx2ZBhcPLWNPcaqcY4fDXVvtTsY+3G",
+	"LqyDa1XCNY0xJcJmzf+/ZEOtC9yRuOP8aZSN+sIlJLv10okyEY65h9MDdqcC6QZ4GkFL5EhIeXGTEoFI",
+	"xgXgWL9KKU0t8twrobfLhOTUfFGUPe+M+G+T1CM4OJ2WEaQ/g7UBoxy0HKIHg9rpc65r3RwVqDmkXy43",
+	"xd+AXuLt9Jluqo0W3TS4yhN7MzmLeWJKJU6Z+Gjey2rgZYakgVvwK0Yj4PytthWp2Fsb51bHT2lFMiG/",
+	"93Czp/Q2VAjSqFEmpBwisiWRc7FkgtQmAcC9qchqLYzs8AJrNirpoCyBMfFL/ZDaNo7wGglAH7BG+X3p",
+	"Sx2tXM+71emIRTQqnimXwcwaEDUQA06TwkizheJY58gqjf7OaPLei8k9FXTeeFeyqW/AHvsbg22RxZsB",
+	"VUrXqu5tb4oDMMeYq9eEQQTKf790r7Gq5fGZ7E50tPVHfvsMy6WFsY7BSp02C7nc3YtDVCcwCi1o9Jmz",
+	"qUw05bgM8JlkndOA6TSmM31xuwI6GESUOZGQtXtOc+n3n5Vbm6ClM4cNUsL2DUGcWQ17T3m5G/Eyzqbk",
+	"/G/kQCnOCpyYH5EG79uOl3hHmfVNvZH4m/zO/0yp4QdyJJtqiOd6hI7c98La6mqfGWcjHBWcv/AaWbPG",
+	"jDlX95RNOq/mKA0W9ZvDIcfyOgZGhOPPv/M8IaDHLg5f8BK5V5HRhWMMXCgGRu4g1oFH6uTj+DI869X1",
+	"UXLBURa8KkINA6OlR0Jx7GxniyW9Pn5Hu9JJai7zPDlcxk7KyD/aJ5G+5Dad/fBZ/ZiH9T8esqj2LMSM",
+	"xCnmbZwpqQAHQbBJu4ZMFeXYkxIFTQBgGiqWT7Df815IGHRmrjIFTROJHj80f7ly6JIGQ6w1IutnSjNQ",
+	"qgy/VS4m/4kslq3HKrL9y1o4uccHbhvPCOMe89DJwsu7FM/wy/gHSrl4SaZxYHghrvFAMNUXZDMlEe4A",
+	"l3VyhSApGPd+pMZT/vtuFh2SybjOB5SSJCEcIprFPCyrf5YMU+Ju6bVcjEeuChbtsXISekEucaF4KT7x",
+	"wbDoBpPbATZvzuOOdJ1lFZwkkiOmPkXV7qA57vLYmkmLCWD2FjNxZd7ZPyUF+sY+Dd31jTxt8u5RpSFK",
+	"q7BlG5FCOIrk0DokMPQWKONnDsYd0iMndZ8k9gvK+XZKW3EphM4jSPtMj71HscFCMy1dc2lzCIyTkOlY",
+	"IOZT7PEZvEeC2pnZewKBem3oJcDPgeB5mcLnhtIeD/A8llONf8Ai2r9VRtxfshyT2Nq//3iGPo+G08z7",
+	"R5vFdzFguzo+AmKNgDIa84QbbNfwJxFeQ4NPQ4ETcup5ysZ0P06KuLWrWNYFp3gErdSv4v/hXPefmmz6",
+	"ITkdBY2DY+JZOm0lP+tx3huZUg0LsEeFJdK46/l6FUtTJlXAmoHbmLGUt4cbgh2HqMp277qQSHu4ylwn",
+	"OnIpqq42KXif0uvenXsdDOvdOoh7HhJZgBntSwYvdEwaBcvpGbILEvf/072gMccVYL7ZdNKSTL4WE5uc",
+	"Om5Si9OK+7/Xjah/Px0d6O3JyRDaVCrWh76UUphV+R1M3gd1Y6ejvIZTm9XR1pjEc63x8cLlg/J4OE5z",
+	"bXa1BFQc2B3E1aNJLyHsPFCcXMz1wDAzYdvSJ+8BaGc8qbUo6/SAtNjmMPM9rWd9S6svR5jGiM4RpsZT",
+	"LzEYqJQkVLIxaz51Oe9l2gFXt6PX83hx80vGPguB44Xj5CKnF4oFTX3ao6snjZ1x3TPet8bpVpEnA/XA",
+	"KcS1LGllnnJURv2NNOg9B8qOIUu93bcuWWQOhyO36v6e58FsOn2h40nH6Cdhm4GxF96hxxuq6yamYy5W",
+	"/DOcRycfIMGH94W4oQ9zibjWhb1trTkWJ/gAHonyD7Vpyu2svM+qvRCoXgPkw/f6doA5uPwACWAO71SO",
+	"8FhvZDXb2qI9+rEjq/eix9ZBrOoN7WkSj0OO7n8eduR4cPoduD3wSWRH97ALi43uG+Lq1Vib3hrdADIB",
+	"HLaoVBethh6WX2ov3kOiE8wS6RAsSIKI+IuMbSZxEC53zGija+zJoiENHTvhUXd33QA997miS7Hnt0XD",
+	"m/D4FMBHGqpaSJq3c8h0DKcXDI1RTyIVOsZcyidEcmUtLqbF3YqnOU3i59We6/OcRxWl1n160vANfRL6",
+	"6Bv4M3DD8IHX43rxGR0Bj9wN+ib+dT/oQ9Nk3lcN39rn7U+RlKfbkIdZ1yWp8yK/qlQ+yF+jV0ZpWSJB",
+	"9ClMWOw9a5sUu+ppG9M1ktTPBWVcGfRqRb9Tktl7jetgLV8YIWdwhq6DrYziZXzNKAfue2FE5fwu79Ma",
+	"G4opaYOS0mynY57ITUKyHfe/8pkUu6PjlbTlUfZUGh1LiA0Cp8UlfwCV/vsDbBnw/SeZMGpOkKtqXaXG",
+	"6p9EvfpoqKYGUQ08VnkU0LX3r3wz0DF7DRYeg9mUZO6vr5qzOo5DM7hvcymkuTgg3RNK6Z3JDFESuLlV",
+	"lBw8xDL9z0t1sMDTePzxr1LwqxRcXgrWqG0JLrVoGSIVPWKJRf9bWk5bf5opHGOv31APJdO8IzmMLkD4",
+	"AZpPvKE7zAjOpFlEPV+myuGBmOQxtwVHacEF4gIfZA21SqNU6p9AlLjn7/Mub4lJF4ZSZd7EkAjcnuMn",
+	"GS2V2vctrgOSIVX/OqhWRJVGe5ztIERAxF4ZDS+usxXStHYHF7qV7YqoF8eZtil+UyZUUYZCjlLKSkzy",
+	"b2U3Geywv5sYym4k/pANaIm/vc6us4+qdmNtSr1Wttdgx+WPJnsPP/MbNofYgf9bscOLUmxH+FP/Cn28",
+	"LZaQWTaJ8OSXmZ+JLyXhas6wbGg5xsofSd8xw/fV8U55Z6pGQTgTkQvF6jsUNTrHXyNsuhSyLm+YftUD",
+	"1uoTSaLmvpxjjQVtcl2ra1O/4QrxjHQwIthPG7Jz62umh7DN61P0b+wyF4NZ3itd+bNJxyDRAFHBiDh8",
+	"lHJFD3YDmAG7LLQWSFTuVcA6rZyWX8H/X8liysi/cD1RGs7J/wVph5O6bbZViZQEEYksexfRFF1e/Rw4",
+	"McnB+dmrs3NNrpDhnAQXwZuz87Nzo0YpgNY4J2tjnFjfvVpHmIm1iqFbRTQT9tmYh5Wps1L9CFbAU+hv",
+	"bO4j5zZXN5Mrqu5GpzRVMZ1rfsiilSH5lck9cFwvfIXjVRkyfkw/ZeTq+I62Jk5prc2AF7n209iUb39t",
+	"qE3pnBsI64LAOHa4j4Xp1yHM4qBvONUPtxccGNpjjjDKgaWEqyByQVEC+A6QhUQddLCVKt+6bm4/x8FF",
+	"0BtZFWjuAS5+oPFBWwkVHM5j7bKn9e8mrZbekReKnZOco/iX5zQzy/D6/PzEYHDNwK1l0lucUm5U8RYX",
+	"SWfu6nIO63eMUS1YeZGmmB1GLHoQBtYsaZdV2SQn0mSTUzsoUIuDyl+BbiVgKRESMC6wAKMCcJ0R2uZM",
+	"U/0a8wRhlWdDnd4aSHccK56X2BpOIKchrdqgXkJSNSzuLDhHE5Tb63HEowhxfSNjBFf6kmNVqJDGVZVP",
+	"f0ZPhtBXsY03nNldSVt8raGb2oER0CsTW7SqhQnN7sx6+KzkDrmqRTXM6U+nBzyiub5rXbm3a3M6KrLj",
+	"uzLKRnuTXUnRMrm/edqHHX0tOfwgFYbIJs2a0cmRMBijxErbsOOV4wA1DxrZHGa0lEaSGc1Kqhhoe/dq",
+	"jQuxX0c02xKWvksxMcMdIll7hwXc48Mqoszckcvk6VzuGe8/flIeNGRHMtOp06tSQx+Nt+HT2mW3EbXW",
+	"j1Vw1FN/ExniszLhP7VqSnts/WjNyh2/rx9bA5ZbdYlcF7h1lSNsB56d+ycQjXRendqeYzGxFVWKehBK",
+	"CP/qiW5S3WorovpF23XNGaiep6s8cOmnWKots3k4++0Zt+CuqfZrdAZxMm+rPKsevRN716S9H4ctLlBH",
+	"cL1yFiWYZMZzIgjCwDxOt8GRso7q3/Hv8D2Q7/Pb75L89fn2j//1/Rv33TrJpSzRBxLTn3otrTm4shdj",
+	"QdVBxfwDH1zlTPPgBNLVKds6aVe+EFMhylTuol5Z2a5pWfUl6Dc0JoE/CmCHqrfmmz8vzQJtfA0xga61",
+	"GBd0rO4Xwwdhx1nqMo4b0+6k6cs4ri3RS0rk5Q9hdpZv1TVgbaInOI31jn4KRjB2RbWMrkXx19+efnP5",
+	"xEsvX/RuYRDs3SzWj/rDKGZBDAkIT+j1j+r3sYyma38OvBY2x9GQdw5TYuOz07E8OO1gLF2zReXPzFdd",
+	"FPIFbUDDJ4J+tnDvUL/yxLLnjvE7zfOcO75Acs+lHbFN8PoCeSzNNzwr/j3I/vmUOw86T6jceUcfw3KF",
+	"oZnTqHhdFPolannaRGsevFjpB+fWj+b/hunL1C0fTu8qWj9GNIan4Rrr3ODWU9O5Nnh0w/S83ZY3Ex0l",
+	"60cbzPE0qtK6en55fOX1o/6YPIrbcF2+hTaifflkyPqxTK7hHbpxhbJ+tJnOvLV1X7VOezBccFW3NMFW",
+	"L/dPqbx+NJ/tKTj3GJ5fe2zDfjOXe40wvrLHEuxvYX2CptQd3bmKwxlZz9Opxr608EMmpCgHX7n2A76M",
+	"JMV8Mr7+3ZVMSFJHBf1Ydk811g5ckNWeSmnbstdU0EtnCiNOq21Xzi5ob9alN0CrQUle7UYmq3m7jb1O",
+	"8DVhwlefCU9lncG1Xd0wW+csSptHq2W5NT399vTfAwBXKszGYvYAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
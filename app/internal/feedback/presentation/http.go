@@ -1,3 +1,259 @@
 package presentation
 
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/feedback/presentation/generated"
+	"github.com/bratushkadan/floral/internal/feedback/service"
+	"github.com/bratushkadan/floral/pkg/xhttp"
+	"github.com/bratushkadan/floral/pkg/xhttp/gin/middleware/auth"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
 //go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=oapi/config.yaml oapi/api.yaml
+
+var _ oapi_codegen.ServerInterface = (*ApiImpl)(nil)
+
+type ApiImpl struct {
+	Logger  *zap.Logger
+	Service *service.Feedback
+}
+
+func (api *ApiImpl) FeedbackProcessCompletedOrder(c *gin.Context) {
+	var reqBody oapi_codegen.FeedbackProcessCompletedOrderJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
+		return
+	}
+
+	if err := api.Service.ProcessCompletedOrders(c.Request.Context(), reqBody.Messages); err != nil {
+		api.Logger.Error("process completed orders", zap.Any("messages", reqBody.Messages), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: "failed to process completed orders"}))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "ok"})
+}
+
+func (api *ApiImpl) PrivateFeedbackRelayOutbox(c *gin.Context) {
+	var reqBody oapi_codegen.PrivateFeedbackRelayOutboxJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: err.Error()}))
+		return
+	}
+
+	relayed, err := api.Service.RelayOutbox(c.Request.Context())
+	if err != nil {
+		api.Logger.Error("relay outbox", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: 1, Message: "failed to relay outbox"}))
+		return
+	}
+
+	c.JSON(http.StatusOK, oapi_codegen.PrivateRelayOutboxRes{Relayed: relayed})
+}
+
+func (api *ApiImpl) FeedbackGetProductRating(c *gin.Context, productId string) {
+	res, err := api.Service.GetProductRating(c.Request.Context(), productId)
+	if err != nil {
+		api.Logger.Error("get product rating", zap.String("product_id", productId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to get product rating"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) FeedbackListProductReviews(c *gin.Context, productId string, params oapi_codegen.FeedbackListProductReviewsParams) {
+	res, err := api.Service.ListProductReviews(c.Request.Context(), productId, params.NextPageToken)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidListReviewsNextPageToken) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 125, Message: err.Error()}},
+			})
+			return
+		}
+
+		api.Logger.Error("list product reviews", zap.String("product_id", productId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to list product reviews"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) FeedbackAddProductReview(c *gin.Context, productId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	var reqBody oapi_codegen.FeedbackAddProductReviewJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	subject := service.Subject{Id: accessToken.SubjectId, Type: accessToken.SubjectType}
+	res, err := api.Service.AddProductReview(c.Request.Context(), subject, productId, reqBody)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRating) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrNoVerifiedPurchase) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: "only customers who purchased the product may review it"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrReviewExists) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 127, Message: "product is already reviewed, update the existing review instead"}},
+			})
+			return
+		}
+
+		api.Logger.Error("add product review", zap.String("product_id", productId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to add product review"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) FeedbackGetProductReview(c *gin.Context, productId string, reviewId string) {
+	res, err := api.Service.GetProductReview(c.Request.Context(), productId, reviewId)
+	if err != nil {
+		api.Logger.Error("get product review", zap.String("product_id", productId), zap.String("id", reviewId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to get product review"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "review not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) FeedbackUpdateProductReview(c *gin.Context, productId string, reviewId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	var reqBody oapi_codegen.FeedbackUpdateProductReviewJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	subject := service.Subject{Id: accessToken.SubjectId, Type: accessToken.SubjectType}
+	res, err := api.Service.UpdateProductReview(c.Request.Context(), subject, productId, reviewId, reqBody)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRating) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("update product review", zap.String("product_id", productId), zap.String("id", reviewId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to update product review"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "review not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) FeedbackDeleteProductReview(c *gin.Context, productId string, reviewId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	subject := service.Subject{Id: accessToken.SubjectId, Type: accessToken.SubjectType}
+	res, err := api.Service.DeleteProductReview(c.Request.Context(), subject, productId, reviewId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("delete product review", zap.String("product_id", productId), zap.String("id", reviewId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to delete product review"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "review not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) ErrorHandlerValidation(c *gin.Context, message string, code int) {
+	api.Logger.Info("validation handled", zap.String("validation_message", message))
+	c.JSON(code, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: code, Message: message}))
+}
+func (api *ApiImpl) ErrorHandler(c *gin.Context, err error, code int) {
+	api.Logger.Error("error handler", zap.Error(err))
+	c.JSON(code, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: code, Message: err.Error()}))
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/feedback/presentation/generated"
+	"github.com/bratushkadan/floral/internal/feedback/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPermissionDenied                = errors.New("permission denied")
+	ErrNoVerifiedPurchase              = errors.New("product was not purchased by user")
+	ErrReviewExists                    = errors.New("user has already reviewed the product")
+	ErrInvalidListReviewsNextPageToken = errors.New("invalid list reviews next page token")
+	ErrInvalidRating                   = fmt.Errorf("rating must be between %d and %d", store.RatingMinStars, store.RatingMaxStars)
+)
+
+type Feedback struct {
+	l     *zap.Logger
+	store *store.Feedback
+}
+
+type FeedbackBuilder struct {
+	svc Feedback
+}
+
+func NewBuilder() *FeedbackBuilder {
+	return &FeedbackBuilder{}
+}
+
+func (b *FeedbackBuilder) Logger(l *zap.Logger) *FeedbackBuilder {
+	b.svc.l = l
+	return b
+}
+func (b *FeedbackBuilder) Store(store *store.Feedback) *FeedbackBuilder {
+	b.svc.store = store
+	return b
+}
+
+func (b *FeedbackBuilder) Build() (*Feedback, error) {
+	if b.svc.store == nil {
+		return nil, errors.New("store is nil")
+	}
+
+	if b.svc.l == nil {
+		b.svc.l = zap.NewNop()
+	}
+
+	return &b.svc, nil
+}
+
+type Subject struct {
+	Id   string
+	Type string
+}
+
+func (s *Feedback) AddProductReview(ctx context.Context, subject Subject, productId string, req oapi_codegen.FeedbackCreateProductReviewReq) (oapi_codegen.FeedbackCreateProductReviewRes, error) {
+	if subject.Type != shared_api.SubjectTypeUser {
+		return oapi_codegen.FeedbackCreateProductReviewRes{}, ErrPermissionDenied
+	}
+	if !validRating(req.Rating) {
+		return oapi_codegen.FeedbackCreateProductReviewRes{}, ErrInvalidRating
+	}
+
+	purchased, err := s.store.HasPurchase(ctx, subject.Id, productId)
+	if err != nil {
+		return oapi_codegen.FeedbackCreateProductReviewRes{}, fmt.Errorf("check purchase: %w", err)
+	}
+	if !purchased {
+		return oapi_codegen.FeedbackCreateProductReviewRes{}, ErrNoVerifiedPurchase
+	}
+
+	review, _, err := s.store.CreateReview(ctx, store.CreateReviewDTOInput{
+		Id:        uuid.NewString(),
+		ProductId: productId,
+		UserId:    subject.Id,
+		Rating:    req.Rating,
+		Review:    req.Review,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrReviewExists) {
+			return oapi_codegen.FeedbackCreateProductReviewRes{}, ErrReviewExists
+		}
+		return oapi_codegen.FeedbackCreateProductReviewRes{}, fmt.Errorf("create review: %w", err)
+	}
+
+	return oapi_codegen.FeedbackCreateProductReviewRes{
+		Id:        review.Id,
+		ProductId: review.ProductId,
+		UserId:    review.UserId,
+		Rating:    review.Rating,
+		Review:    review.Review,
+		CreatedAt: review.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: review.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *Feedback) GetProductReview(ctx context.Context, productId, reviewId string) (*oapi_codegen.FeedbackGetProductReviewRes, error) {
+	review, err := s.store.GetReview(ctx, productId, reviewId)
+	if err != nil {
+		return nil, fmt.Errorf("get review: %w", err)
+	}
+	if review == nil {
+		return nil, nil
+	}
+
+	return &oapi_codegen.FeedbackGetProductReviewRes{
+		Id:        review.Id,
+		ProductId: review.ProductId,
+		UserId:    review.UserId,
+		Rating:    review.Rating,
+		Review:    review.Review,
+		CreatedAt: review.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: review.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// validRating checks the rating is within the stars range, ratings are aggregated by stars.
+func validRating(rating float64) bool {
+	return rating >= store.RatingMinStars && rating <= store.RatingMaxStars
+}
+
+// checkReviewAccess returns nil review if it does not exist.
+func (s *Feedback) checkReviewAccess(ctx context.Context, subject Subject, productId, reviewId string) (*store.Review, error) {
+	review, err := s.store.GetReview(ctx, productId, reviewId)
+	if err != nil {
+		return nil, fmt.Errorf("get review: %w", err)
+	}
+	if review == nil {
+		return nil, nil
+	}
+
+	if subject.Type != shared_api.SubjectTypeAdmin && !(subject.Type == shared_api.SubjectTypeUser && subject.Id == review.UserId) {
+		return nil, ErrPermissionDenied
+	}
+	return review, nil
+}
+
+func (s *Feedback) UpdateProductReview(ctx context.Context, subject Subject, productId, reviewId string, req oapi_codegen.FeedbackUpdateProductReviewReq) (*oapi_codegen.FeedbackUpdateProductReviewRes, error) {
+	if req.Rating != nil && !validRating(*req.Rating) {
+		return nil, ErrInvalidRating
+	}
+
+	review, err := s.checkReviewAccess(ctx, subject, productId, reviewId)
+	if err != nil || review == nil {
+		return nil, err
+	}
+
+	updated, _, err := s.store.UpdateReview(ctx, store.UpdateReviewDTOInput{
+		Id:        reviewId,
+		Rating:    req.Rating,
+		Review:    req.Review,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update review: %w", err)
+	}
+	if updated == nil {
+		return nil, nil
+	}
+
+	return &oapi_codegen.FeedbackUpdateProductReviewRes{
+		Id:        updated.Id,
+		Rating:    updated.Rating,
+		Review:    updated.Review,
+		UpdatedAt: updated.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *Feedback) DeleteProductReview(ctx context.Context, subject Subject, productId, reviewId string) (*oapi_codegen.FeedbackDeleteProductReviewRes, error) {
+	review, err := s.checkReviewAccess(ctx, subject, productId, reviewId)
+	if err != nil || review == nil {
+		return nil, err
+	}
+
+	deleted, _, err := s.store.DeleteReview(ctx, reviewId)
+	if err != nil {
+		return nil, fmt.Errorf("delete review: %w", err)
+	}
+	if deleted == nil {
+		return nil, nil
+	}
+
+	return &oapi_codegen.FeedbackDeleteProductReviewRes{Id: deleted.Id}, nil
+}
+
+func (s *Feedback) ListProductReviews(ctx context.Context, productId string, nextPageToken *string) (oapi_codegen.FeedbackListProductReviewsRes, error) {
+	res, err := s.store.ListReviews(ctx, productId, nextPageToken)
+	if err != nil {
+		if errors.Is(err, store.ErrInvalidListReviewsNextPageToken) {
+			return oapi_codegen.FeedbackListProductReviewsRes{}, ErrInvalidListReviewsNextPageToken
+		}
+		return oapi_codegen.FeedbackListProductReviewsRes{}, fmt.Errorf("list reviews: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Feedback) GetProductRating(ctx context.Context, productId string) (oapi_codegen.FeedbackGetProductRatingRes, error) {
+	rating, err := s.store.GetProductRating(ctx, productId)
+	if err != nil {
+		return oapi_codegen.FeedbackGetProductRatingRes{}, fmt.Errorf("get product rating: %w", err)
+	}
+
+	distribution := make([]oapi_codegen.FeedbackGetProductRatingResDistributionBucket, 0, len(rating.Distribution))
+	for i, count := range rating.Distribution {
+		distribution = append(distribution, oapi_codegen.FeedbackGetProductRatingResDistributionBucket{
+			Stars: i + store.RatingMinStars,
+			Count: int(count),
+		})
+	}
+
+	return oapi_codegen.FeedbackGetProductRatingRes{
+		ProductId:    productId,
+		Rating:       rating.Average(),
+		ReviewsCount: int(rating.ReviewsCount),
+		Distribution: distribution,
+	}, nil
+}
+
+func (s *Feedback) ProcessCompletedOrders(ctx context.Context, messages []oapi_codegen.PrivateFeedbackProcessCompletedOrderReqMessage) error {
+	now := time.Now()
+
+	var purchases []store.AddPurchasesDTOInputPurchase
+	for _, msg := range messages {
+		for _, product := range msg.Products {
+			purchases = append(purchases, store.AddPurchasesDTOInputPurchase{
+				UserId:    msg.UserId,
+				ProductId: product.Id,
+				OrderId:   msg.OrderId,
+				CreatedAt: now,
+			})
+		}
+	}
+
+	if err := s.store.AddPurchases(ctx, purchases); err != nil {
+		return fmt.Errorf("add purchases: %w", err)
+	}
+
+	s.l.Info("processed completed orders", zap.Int("orders", len(messages)), zap.Int("purchases", len(purchases)))
+	return nil
+}
+
+// RelayOutbox publishes messages of committed state changes left in the outbox and returns their number.
+func (s *Feedback) RelayOutbox(ctx context.Context) (int, error) {
+	relayed, err := s.store.RelayOutbox(ctx)
+	if err != nil {
+		return relayed, fmt.Errorf("relay outbox: %w", err)
+	}
+	return relayed, nil
+}
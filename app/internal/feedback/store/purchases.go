@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var queryAddPurchases = template.ReplaceAllPairs(`
+DECLARE $purchases AS List<Struct<
+  user_id:String,
+  product_id:String,
+  order_id:String,
+  created_at:Datetime,
+>>;
+
+UPSERT INTO {{table.purchases}}
+SELECT * FROM AS_TABLE($purchases);
+`,
+	"{{table.purchases}}",
+	tablePurchases,
+)
+
+type AddPurchasesDTOInputPurchase struct {
+	UserId    string
+	ProductId string
+	OrderId   string
+	CreatedAt time.Time
+}
+
+// AddPurchases is idempotent: repeated delivery of the same completed order does not duplicate rows.
+func (s *Feedback) AddPurchases(ctx context.Context, purchases []AddPurchasesDTOInputPurchase) error {
+	if len(purchases) == 0 {
+		return nil
+	}
+
+	rows := make([]types.Value, 0, len(purchases))
+	for _, p := range purchases {
+		rows = append(rows, types.StructValue(
+			types.StructFieldValue("user_id", types.BytesValueFromString(p.UserId)),
+			types.StructFieldValue("product_id", types.BytesValueFromString(p.ProductId)),
+			types.StructFieldValue("order_id", types.BytesValueFromString(p.OrderId)),
+			types.StructFieldValue("created_at", types.DatetimeValueFromTime(p.CreatedAt)),
+		))
+	}
+
+	return s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, queryAddPurchases, table.NewQueryParameters(
+			table.ValueParam("$purchases", types.ListValue(rows...)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		return res.Err()
+	})
+}
+
+var queryHasPurchase = template.ReplaceAllPairs(`
+DECLARE $user_id AS String;
+DECLARE $product_id AS String;
+
+SELECT COUNT(*) AS purchases_count
+FROM {{table.purchases}}
+WHERE user_id = $user_id AND product_id = $product_id;
+`,
+	"{{table.purchases}}",
+	tablePurchases,
+)
+
+func (s *Feedback) HasPurchase(ctx context.Context, userId, productId string) (bool, error) {
+	var count uint64
+
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		_, res, err := ss.Execute(ctx, readTx, queryHasPurchase, table.NewQueryParameters(
+			table.ValueParam("$user_id", types.BytesValueFromString(userId)),
+			table.ValueParam("$product_id", types.BytesValueFromString(productId)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				if err := res.ScanNamed(
+					named.Required("purchases_count", &count),
+				); err != nil {
+					return err
+				}
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
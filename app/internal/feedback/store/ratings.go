@@ -0,0 +1,216 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/feedback/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	RatingMinStars = 1
+	RatingMaxStars = 5
+)
+
+type ProductRating struct {
+	ProductId    string
+	ReviewsCount int64
+	RatingSum    float64
+	// Distribution[i] is the count of reviews with rating rounded to i+1 stars.
+	Distribution [RatingMaxStars]int64
+	// Version is incremented on every change of the rating.
+	Version uint64
+}
+
+func (r ProductRating) Average() float64 {
+	if r.ReviewsCount <= 0 {
+		return 0
+	}
+	return r.RatingSum / float64(r.ReviewsCount)
+}
+
+// ratingDelta describes how a single review mutation changes a product rating aggregate.
+type ratingDelta struct {
+	count        int64
+	sum          float64
+	distribution [RatingMaxStars]int64
+}
+
+func (d *ratingDelta) add(rating float64) {
+	d.count++
+	d.sum += rating
+	d.distribution[ratingStars(rating)-1]++
+}
+func (d *ratingDelta) remove(rating float64) {
+	d.count--
+	d.sum -= rating
+	d.distribution[ratingStars(rating)-1]--
+}
+
+func ratingStars(rating float64) int {
+	stars := int(math.Round(rating))
+	return min(max(stars, RatingMinStars), RatingMaxStars)
+}
+
+var queryGetProductRating = template.ReplaceAllPairs(`
+DECLARE $product_id AS String;
+
+SELECT
+  product_id,
+  reviews_count,
+  rating_sum,
+  rating_1_count,
+  rating_2_count,
+  rating_3_count,
+  rating_4_count,
+  rating_5_count,
+  version
+FROM {{table.product_ratings}}
+WHERE product_id = $product_id;
+`,
+	"{{table.product_ratings}}",
+	tableProductRatings,
+)
+
+var queryUpsertProductRating = template.ReplaceAllPairs(`
+DECLARE $product_id AS String;
+DECLARE $reviews_count AS Int64;
+DECLARE $rating_sum AS Double;
+DECLARE $rating_1_count AS Int64;
+DECLARE $rating_2_count AS Int64;
+DECLARE $rating_3_count AS Int64;
+DECLARE $rating_4_count AS Int64;
+DECLARE $rating_5_count AS Int64;
+DECLARE $version AS Uint64;
+DECLARE $updated_at AS Datetime;
+
+UPSERT INTO {{table.product_ratings}} (product_id, reviews_count, rating_sum, rating_1_count, rating_2_count, rating_3_count, rating_4_count, rating_5_count, version, updated_at)
+VALUES ($product_id, $reviews_count, $rating_sum, $rating_1_count, $rating_2_count, $rating_3_count, $rating_4_count, $rating_5_count, $version, $updated_at);
+`,
+	"{{table.product_ratings}}",
+	tableProductRatings,
+)
+
+func scanProductRating(res namedScanner, out *ProductRating) error {
+	return res.ScanNamed(
+		named.Required("product_id", &out.ProductId),
+		named.Required("reviews_count", &out.ReviewsCount),
+		named.Required("rating_sum", &out.RatingSum),
+		named.Required("rating_1_count", &out.Distribution[0]),
+		named.Required("rating_2_count", &out.Distribution[1]),
+		named.Required("rating_3_count", &out.Distribution[2]),
+		named.Required("rating_4_count", &out.Distribution[3]),
+		named.Required("rating_5_count", &out.Distribution[4]),
+		named.OptionalWithDefault("version", &out.Version),
+	)
+}
+
+// applyRatingDelta must be called inside of the transaction that mutates the review
+// so that the aggregate never diverges from the reviews table.
+// The new rating is written to the outbox in the same transaction to be applied to the catalog
+// along with its version, so the catalog skips ratings delivered after a newer one.
+func (s *Feedback) applyRatingDelta(ctx context.Context, tx table.TransactionActor, productId string, delta ratingDelta) (ProductRating, error) {
+	rating := ProductRating{ProductId: productId}
+
+	res, err := tx.Execute(ctx, queryGetProductRating, table.NewQueryParameters(
+		table.ValueParam("$product_id", types.BytesValueFromString(productId)),
+	))
+	if err != nil {
+		return ProductRating{}, err
+	}
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			if err := scanProductRating(res, &rating); err != nil {
+				_ = res.Close()
+				return ProductRating{}, err
+			}
+		}
+	}
+	if err := res.Err(); err != nil {
+		_ = res.Close()
+		return ProductRating{}, err
+	}
+	_ = res.Close()
+
+	rating.ReviewsCount = max(rating.ReviewsCount+delta.count, 0)
+	rating.RatingSum += delta.sum
+	for i := range rating.Distribution {
+		rating.Distribution[i] = max(rating.Distribution[i]+delta.distribution[i], 0)
+	}
+	if rating.ReviewsCount == 0 {
+		rating.RatingSum = 0
+	}
+	rating.Version++
+
+	updatedAt := time.Now()
+	res, err = tx.Execute(ctx, queryUpsertProductRating, table.NewQueryParameters(
+		table.ValueParam("$product_id", types.BytesValueFromString(productId)),
+		table.ValueParam("$reviews_count", types.Int64Value(rating.ReviewsCount)),
+		table.ValueParam("$rating_sum", types.DoubleValue(rating.RatingSum)),
+		table.ValueParam("$rating_1_count", types.Int64Value(rating.Distribution[0])),
+		table.ValueParam("$rating_2_count", types.Int64Value(rating.Distribution[1])),
+		table.ValueParam("$rating_3_count", types.Int64Value(rating.Distribution[2])),
+		table.ValueParam("$rating_4_count", types.Int64Value(rating.Distribution[3])),
+		table.ValueParam("$rating_5_count", types.Int64Value(rating.Distribution[4])),
+		table.ValueParam("$version", types.Uint64Value(rating.Version)),
+		table.ValueParam("$updated_at", types.DatetimeValueFromTime(updatedAt)),
+	))
+	if err != nil {
+		return ProductRating{}, err
+	}
+	_ = res.Close()
+
+	version := int64(rating.Version)
+	msg, err := outbox.NewMessage(topicProductRatings, fmt.Sprintf("product_rating:%s:%d", productId, rating.Version), oapi_codegen.PrivateCatalogSyncProductRatingsReqMessage{
+		ProductId:    rating.ProductId,
+		Rating:       rating.Average(),
+		ReviewsCount: int(rating.ReviewsCount),
+		Version:      &version,
+	})
+	if err != nil {
+		return ProductRating{}, err
+	}
+	if err := s.outbox.EnqueueTableTx(ctx, tx, msg); err != nil {
+		return ProductRating{}, err
+	}
+
+	return rating, nil
+}
+
+// GetProductRating returns zero rating for products without reviews.
+func (s *Feedback) GetProductRating(ctx context.Context, productId string) (ProductRating, error) {
+	out := ProductRating{ProductId: productId}
+
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		_, res, err := ss.Execute(ctx, readTx, queryGetProductRating, table.NewQueryParameters(
+			table.ValueParam("$product_id", types.BytesValueFromString(productId)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				if err := scanProductRating(res, &out); err != nil {
+					return err
+				}
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return ProductRating{}, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,472 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/feedback/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/bratushkadan/floral/pkg/token"
+	ydbpkg "github.com/bratushkadan/floral/pkg/ydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"go.uber.org/zap"
+)
+
+const (
+	ListReviewsPageSize uint32 = 10
+)
+
+var (
+	ErrInvalidListReviewsNextPageToken = errors.New("invalid list reviews next page token")
+	ErrReviewExists                    = errors.New("user has already reviewed the product")
+)
+
+const nextPageTokenEncryptKey = "puqsyuv4jxjd74rs43yj3lyegcji2qpe"
+
+type Review struct {
+	Id        string
+	ProductId string
+	UserId    string
+	Rating    float64
+	Review    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+var queryCreateReview = template.ReplaceAllPairs(`
+DECLARE $id AS String;
+DECLARE $product_id AS String;
+DECLARE $user_id AS String;
+DECLARE $rating AS Double;
+DECLARE $review AS Utf8;
+DECLARE $created_at AS Datetime;
+
+INSERT INTO {{table.reviews}} (id, product_id, user_id, rating, review, created_at, updated_at)
+VALUES ($id, $product_id, $user_id, $rating, $review, $created_at, $created_at)
+RETURNING id, product_id, user_id, rating, review, created_at, updated_at;
+`,
+	"{{table.reviews}}",
+	tableReviews,
+)
+
+type CreateReviewDTOInput struct {
+	Id        string
+	ProductId string
+	UserId    string
+	Rating    float64
+	Review    string
+	CreatedAt time.Time
+}
+
+func (s *Feedback) CreateReview(ctx context.Context, in CreateReviewDTOInput) (Review, ProductRating, error) {
+	var out Review
+	var rating ProductRating
+
+	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, queryCreateReview, table.NewQueryParameters(
+			table.ValueParam("$id", types.BytesValueFromString(in.Id)),
+			table.ValueParam("$product_id", types.BytesValueFromString(in.ProductId)),
+			table.ValueParam("$user_id", types.BytesValueFromString(in.UserId)),
+			table.ValueParam("$rating", types.DoubleValue(in.Rating)),
+			table.ValueParam("$review", types.UTF8Value(in.Review)),
+			table.ValueParam("$created_at", types.DatetimeValueFromTime(in.CreatedAt)),
+		))
+		if err != nil {
+			if ydbpkg.IsUniqueConstraintViolation(err) {
+				return ErrReviewExists
+			}
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				if err := scanReview(res, &out); err != nil {
+					return err
+				}
+			}
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
+
+		var delta ratingDelta
+		delta.add(out.Rating)
+		rating, err = s.applyRatingDelta(ctx, tx, out.ProductId, delta)
+		return err
+	}); err != nil {
+		return Review{}, ProductRating{}, err
+	}
+
+	return out, rating, nil
+}
+
+var queryGetReview = template.ReplaceAllPairs(`
+DECLARE $id AS String;
+DECLARE $product_id AS String;
+
+SELECT
+  id,
+  product_id,
+  user_id,
+  rating,
+  review,
+  created_at,
+  updated_at
+FROM {{table.reviews}} VIEW idx_id
+WHERE id = $id AND product_id = $product_id;
+`,
+	"{{table.reviews}}",
+	tableReviews,
+)
+
+func (s *Feedback) GetReview(ctx context.Context, productId, reviewId string) (*Review, error) {
+	var out *Review
+
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		_, res, err := ss.Execute(ctx, readTx, queryGetReview, table.NewQueryParameters(
+			table.ValueParam("$id", types.BytesValueFromString(reviewId)),
+			table.ValueParam("$product_id", types.BytesValueFromString(productId)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				out = &Review{}
+				if err := scanReview(res, out); err != nil {
+					return err
+				}
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+var queryGetReviewById = template.ReplaceAllPairs(`
+DECLARE $id AS String;
+
+SELECT
+  id,
+  product_id,
+  user_id,
+  rating,
+  review,
+  created_at,
+  updated_at
+FROM {{table.reviews}} VIEW idx_id
+WHERE id = $id;
+`,
+	"{{table.reviews}}",
+	tableReviews,
+)
+
+// getReviewByIdTx returns nil review if it does not exist.
+func getReviewByIdTx(ctx context.Context, tx table.TransactionActor, id string) (*Review, error) {
+	res, err := tx.Execute(ctx, queryGetReviewById, table.NewQueryParameters(
+		table.ValueParam("$id", types.BytesValueFromString(id)),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close() }()
+
+	var out *Review
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			out = &Review{}
+			if err := scanReview(res, out); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+var queryUpdateReview = template.ReplaceAllPairs(`
+DECLARE $product_id AS String;
+DECLARE $user_id AS String;
+DECLARE $rating AS Optional<Double>;
+DECLARE $review AS Optional<Utf8>;
+DECLARE $updated_at AS Datetime;
+
+UPDATE {{table.reviews}}
+SET
+  rating = COALESCE($rating, rating),
+  review = COALESCE($review, review),
+  updated_at = $updated_at
+WHERE product_id = $product_id AND user_id = $user_id
+RETURNING id, product_id, user_id, rating, review, created_at, updated_at;
+`,
+	"{{table.reviews}}",
+	tableReviews,
+)
+
+type UpdateReviewDTOInput struct {
+	Id        string
+	Rating    *float64
+	Review    *string
+	UpdatedAt time.Time
+}
+
+// UpdateReview returns nil review if it does not exist.
+func (s *Feedback) UpdateReview(ctx context.Context, in UpdateReviewDTOInput) (*Review, ProductRating, error) {
+	var out *Review
+	var rating ProductRating
+
+	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		out = nil
+
+		before, err := getReviewByIdTx(ctx, tx, in.Id)
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return nil
+		}
+
+		res, err := tx.Execute(ctx, queryUpdateReview, table.NewQueryParameters(
+			table.ValueParam("$product_id", types.BytesValueFromString(before.ProductId)),
+			table.ValueParam("$user_id", types.BytesValueFromString(before.UserId)),
+			table.ValueParam("$rating", types.NullableDoubleValue(in.Rating)),
+			table.ValueParam("$review", types.NullableUTF8Value(in.Review)),
+			table.ValueParam("$updated_at", types.DatetimeValueFromTime(in.UpdatedAt)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				out = &Review{}
+				if err := scanReview(res, out); err != nil {
+					return err
+				}
+			}
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
+		if out == nil {
+			return nil
+		}
+
+		var delta ratingDelta
+		delta.remove(before.Rating)
+		delta.add(out.Rating)
+		rating, err = s.applyRatingDelta(ctx, tx, out.ProductId, delta)
+		return err
+	}); err != nil {
+		return nil, ProductRating{}, err
+	}
+
+	return out, rating, nil
+}
+
+var queryDeleteReview = template.ReplaceAllPairs(`
+DECLARE $product_id AS String;
+DECLARE $user_id AS String;
+
+DELETE FROM {{table.reviews}}
+WHERE product_id = $product_id AND user_id = $user_id;
+`,
+	"{{table.reviews}}",
+	tableReviews,
+)
+
+// DeleteReview returns nil review if it does not exist.
+func (s *Feedback) DeleteReview(ctx context.Context, reviewId string) (*Review, ProductRating, error) {
+	var out *Review
+	var rating ProductRating
+
+	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		var err error
+		out, err = getReviewByIdTx(ctx, tx, reviewId)
+		if err != nil {
+			return err
+		}
+		if out == nil {
+			return nil
+		}
+
+		if _, err := tx.Execute(ctx, queryDeleteReview, table.NewQueryParameters(
+			table.ValueParam("$product_id", types.BytesValueFromString(out.ProductId)),
+			table.ValueParam("$user_id", types.BytesValueFromString(out.UserId)),
+		)); err != nil {
+			return err
+		}
+
+		var delta ratingDelta
+		delta.remove(out.Rating)
+		rating, err = s.applyRatingDelta(ctx, tx, out.ProductId, delta)
+		return err
+	}); err != nil {
+		return nil, ProductRating{}, err
+	}
+
+	return out, rating, nil
+}
+
+var queryListReviews = template.ReplaceAllPairs(`
+DECLARE $product_id AS String;
+DECLARE $last_paginated_review_id AS Optional<String>;
+DECLARE $last_paginated_created_at AS Optional<Datetime>;
+DECLARE $page_size AS Uint32;
+
+SELECT
+  id,
+  product_id,
+  user_id,
+  rating,
+  review,
+  created_at,
+  updated_at
+FROM {{table.reviews}}
+VIEW idx_product_created_at
+WHERE
+  product_id = $product_id
+    AND
+  (COALESCE($last_paginated_created_at, created_at), COALESCE($last_paginated_review_id, id)) >= (created_at, id)
+ORDER BY created_at DESC, id DESC
+LIMIT $page_size + 1;
+`,
+	"{{table.reviews}}",
+	tableReviews,
+)
+
+type ListReviewsNextPageDto struct {
+	ReviewId  string `json:"review_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+func (s *Feedback) ListReviews(ctx context.Context, productId string, nextPageToken *string) (oapi_codegen.FeedbackListProductReviewsRes, error) {
+	var lastReviewId *string
+	var lastCreatedAt *time.Time
+	if nextPageToken != nil {
+		decrypted, err := token.DecryptToken(*nextPageToken, nextPageTokenEncryptKey)
+		if err != nil {
+			s.logger.Info("decode next page token", zap.Error(err))
+			return oapi_codegen.FeedbackListProductReviewsRes{}, fmt.Errorf("%w: %w", ErrInvalidListReviewsNextPageToken, err)
+		}
+
+		var nextPage ListReviewsNextPageDto
+		if err := json.Unmarshal([]byte(decrypted), &nextPage); err != nil {
+			return oapi_codegen.FeedbackListProductReviewsRes{}, fmt.Errorf("%w: %w", ErrInvalidListReviewsNextPageToken, err)
+		}
+		createdAt, err := time.Parse(time.RFC3339, nextPage.CreatedAt)
+		if err != nil {
+			return oapi_codegen.FeedbackListProductReviewsRes{}, fmt.Errorf("%w: parse created_at as RFC3339: %w", ErrInvalidListReviewsNextPageToken, err)
+		}
+		lastReviewId = &nextPage.ReviewId
+		lastCreatedAt = &createdAt
+	}
+
+	var lastReviewIdValue types.Value = types.NullValue(types.TypeString)
+	if lastReviewId != nil {
+		lastReviewIdValue = types.OptionalValue(types.BytesValueFromString(*lastReviewId))
+	}
+	var lastCreatedAtValue types.Value = types.NullValue(types.TypeDatetime)
+	if lastCreatedAt != nil {
+		lastCreatedAtValue = types.OptionalValue(types.DatetimeValueFromTime(*lastCreatedAt))
+	}
+
+	readTx := table.TxControl(table.BeginTx(table.WithStaleReadOnly()), table.CommitTx())
+
+	reviews := make([]Review, 0, ListReviewsPageSize+1)
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		_, res, err := ss.Execute(ctx, readTx, queryListReviews, table.NewQueryParameters(
+			table.ValueParam("$product_id", types.BytesValueFromString(productId)),
+			table.ValueParam("$last_paginated_review_id", lastReviewIdValue),
+			table.ValueParam("$last_paginated_created_at", lastCreatedAtValue),
+			table.ValueParam("$page_size", types.Uint32Value(ListReviewsPageSize)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var review Review
+				if err := scanReview(res, &review); err != nil {
+					return err
+				}
+				reviews = append(reviews, review)
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return oapi_codegen.FeedbackListProductReviewsRes{}, err
+	}
+
+	var out oapi_codegen.FeedbackListProductReviewsRes
+
+	if len(reviews) > int(ListReviewsPageSize) {
+		last := reviews[len(reviews)-1]
+		reviews = reviews[:len(reviews)-1]
+
+		tokenBytes, err := json.Marshal(&ListReviewsNextPageDto{
+			ReviewId:  last.Id,
+			CreatedAt: last.CreatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return oapi_codegen.FeedbackListProductReviewsRes{}, fmt.Errorf("serialize next page: %v", err)
+		}
+
+		token, err := token.EncryptToken(string(tokenBytes), nextPageTokenEncryptKey)
+		if err != nil {
+			return oapi_codegen.FeedbackListProductReviewsRes{}, fmt.Errorf("encrypt next page token: %w", err)
+		}
+		out.NextPageToken = &token
+	}
+
+	out.Reviews = make([]oapi_codegen.FeedbackListProductReviewsResReview, 0, len(reviews))
+	for _, review := range reviews {
+		out.Reviews = append(out.Reviews, oapi_codegen.FeedbackListProductReviewsResReview{
+			Id:        review.Id,
+			ProductId: review.ProductId,
+			UserId:    review.UserId,
+			Rating:    review.Rating,
+			Review:    review.Review,
+			CreatedAt: review.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: review.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return out, nil
+}
+
+type namedScanner interface {
+	ScanNamed(namedValues ...named.Value) error
+}
+
+func scanReview(res namedScanner, out *Review) error {
+	return res.ScanNamed(
+		named.Required("id", &out.Id),
+		named.Required("product_id", &out.ProductId),
+		named.Required("user_id", &out.UserId),
+		named.Required("rating", &out.Rating),
+		named.Required("review", &out.Review),
+		named.Required("created_at", &out.CreatedAt),
+		named.Required("updated_at", &out.UpdatedAt),
+	)
+}
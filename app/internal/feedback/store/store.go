@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	tableReviews        = "`feedback/reviews`"
+	tablePurchases      = "`feedback/purchases`"
+	tableProductRatings = "`feedback/product_ratings`"
+	// Path of the outbox table, the outbox quotes it in queries.
+	tableOutbox = "feedback/outbox"
+
+	topicProductRatings = "feedback/product_ratings_topic"
+)
+
+type FeedbackBuilder struct {
+	store Feedback
+}
+
+func NewBuilder() *FeedbackBuilder {
+	return &FeedbackBuilder{}
+}
+
+func (b *FeedbackBuilder) Ydb(db *ydb.Driver) *FeedbackBuilder {
+	b.store.db = db
+	return b
+}
+func (b *FeedbackBuilder) Logger(l *zap.Logger) *FeedbackBuilder {
+	b.store.logger = l
+	return b
+}
+
+func (b *FeedbackBuilder) Build() (*Feedback, error) {
+	if b.store.db == nil {
+		return nil, errors.New("ydb driver is nil")
+	}
+
+	if b.store.logger == nil {
+		b.store.logger = zap.NewNop()
+	}
+
+	outbox, err := outbox.NewBuilder().Ydb(b.store.db).Table(tableOutbox).Logger(b.store.logger).Build()
+	if err != nil {
+		return nil, fmt.Errorf("setup outbox: %w", err)
+	}
+	b.store.outbox = outbox
+
+	return &b.store, nil
+}
+
+type Feedback struct {
+	db     *ydb.Driver
+	logger *zap.Logger
+
+	// outbox publishes messages of state changes
+	outbox *outbox.Outbox
+}
+
+// RelayOutbox publishes messages of committed state changes to their topics.
+func (s *Feedback) RelayOutbox(ctx context.Context) (int, error) {
+	return s.outbox.Relay(ctx)
+}
@@ -0,0 +1,71 @@
+// Package carrier contains integrations with delivery carriers.
+package carrier
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidWebhook = errors.New("invalid carrier webhook")
+)
+
+type EventType string
+
+const (
+	EventTypeInTransit EventType = "in_transit"
+	EventTypeDelivered EventType = "delivered"
+)
+
+// WebhookReq is a raw webhook request received from a carrier.
+type WebhookReq struct {
+	Header http.Header
+	Body   []byte
+}
+
+// Event is a verified tracking event of a parcel.
+type Event struct {
+	Id             string
+	TrackingNumber string
+	Type           EventType
+	OccurredAt     time.Time
+}
+
+type Carrier interface {
+	// Name is the carrier name sellers ship with.
+	Name() string
+	// VerifyWebhook checks the signature of the webhook and parses its tracking events.
+	VerifyWebhook(ctx context.Context, req WebhookReq) ([]Event, error)
+}
+
+// Registry keeps carriers by their names.
+type Registry struct {
+	carriers map[string]Carrier
+}
+
+func NewRegistry() *Registry {
+	return &Registry{carriers: make(map[string]Carrier)}
+}
+
+func (r *Registry) Register(c Carrier) *Registry {
+	r.carriers[c.Name()] = c
+	return r
+}
+
+func (r *Registry) Get(name string) (Carrier, bool) {
+	c, ok := r.carriers[name]
+	return c, ok
+}
+
+// Names returns sorted names of registered carriers.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.carriers))
+	for name := range r.carriers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,97 @@
+package carrier
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	CarrierFake = "fake"
+
+	// FakeSignatureHeader carries "sha256=<hex HMAC-SHA256 of the body>".
+	FakeSignatureHeader = "X-Fake-Carrier-Signature"
+)
+
+// Fake accepts tracking events sent by hand.
+// It's meant for local development and QA, webhooks are signed with a shared secret.
+type Fake struct {
+	secret []byte
+}
+
+func NewFake(secret string) (*Fake, error) {
+	if secret == "" {
+		return nil, errors.New("fake carrier secret is empty")
+	}
+	return &Fake{secret: []byte(secret)}, nil
+}
+
+func (*Fake) Name() string {
+	return CarrierFake
+}
+
+// Sign returns the signature header value of the webhook body.
+func (c *Fake) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type fakeWebhook struct {
+	Events []fakeEvent `json:"events"`
+}
+type fakeEvent struct {
+	Id             string    `json:"id"`
+	TrackingNumber string    `json:"tracking_number"`
+	Type           string    `json:"type"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+func (c *Fake) VerifyWebhook(_ context.Context, req WebhookReq) ([]Event, error) {
+	signature, ok := strings.CutPrefix(req.Header.Get(FakeSignatureHeader), "sha256=")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing signature", ErrInvalidWebhook)
+	}
+	signatureBytes, err := hex.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode signature: %v", ErrInvalidWebhook, err)
+	}
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(req.Body)
+	if !hmac.Equal(signatureBytes, mac.Sum(nil)) {
+		return nil, fmt.Errorf("%w: invalid signature", ErrInvalidWebhook)
+	}
+
+	var w fakeWebhook
+	if err := json.Unmarshal(req.Body, &w); err != nil {
+		return nil, fmt.Errorf("%w: decode body: %v", ErrInvalidWebhook, err)
+	}
+
+	events := make([]Event, 0, len(w.Events))
+	for _, e := range w.Events {
+		if e.Id == "" || e.TrackingNumber == "" {
+			return nil, fmt.Errorf(`%w: "id" and "tracking_number" event fields must not be empty`, ErrInvalidWebhook)
+		}
+		switch EventType(e.Type) {
+		case EventTypeInTransit, EventTypeDelivered:
+		default:
+			return nil, fmt.Errorf(`%w: unknown event type "%s"`, ErrInvalidWebhook, e.Type)
+		}
+		if e.OccurredAt.IsZero() {
+			e.OccurredAt = time.Now()
+		}
+		events = append(events, Event{
+			Id:             e.Id,
+			TrackingNumber: e.TrackingNumber,
+			Type:           EventType(e.Type),
+			OccurredAt:     e.OccurredAt,
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,87 @@
+// Package payment contains integrations with payment providers.
+package payment
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidNotification = errors.New("invalid payment notification")
+	// ErrCheckoutNotSupported means the provider is not configured to accept payments.
+	ErrCheckoutNotSupported = errors.New("checkout is not supported by payment provider")
+)
+
+// NotificationReq is a raw payment notification received from a provider.
+type NotificationReq struct {
+	ContentType string
+	Body        []byte
+}
+
+// Notification is a verified payment notification.
+type Notification struct {
+	OrderId         string
+	OperationId     string
+	Amount          float64
+	CurrencyIso4217 int
+	Datetime        time.Time
+	// Meta is provider specific notification data stored with the payment.
+	Meta map[string]any
+}
+
+type CheckoutReq struct {
+	OrderId         string
+	Amount          float64
+	CurrencyIso4217 int
+}
+
+// Checkout describes how to pay for the order: follow the link or submit the form.
+type Checkout struct {
+	Url  string
+	Form *CheckoutForm
+}
+type CheckoutForm struct {
+	Action string
+	Method string
+	Params map[string]string
+}
+
+type PaymentProvider interface {
+	Name() string
+	// VerifyNotification checks integrity of the notification and parses it.
+	VerifyNotification(ctx context.Context, req NotificationReq) (Notification, error)
+	// Checkout builds a link or a form to pay for the order with.
+	Checkout(ctx context.Context, req CheckoutReq) (Checkout, error)
+	Refund(ctx context.Context, req RefundReq) (RefundRes, error)
+}
+
+// Registry keeps payment providers by their names.
+type Registry struct {
+	providers map[string]PaymentProvider
+}
+
+func NewRegistry() *Registry {
+	return &Registry{providers: make(map[string]PaymentProvider)}
+}
+
+func (r *Registry) Register(p PaymentProvider) *Registry {
+	r.providers[p.Name()] = p
+	return r
+}
+
+func (r *Registry) Get(name string) (PaymentProvider, bool) {
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// Names returns sorted names of registered providers.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,40 @@
+package payment
+
+import (
+	"errors"
+)
+
+var (
+	// ErrRefundNotSupported means the payment can't be refunded automatically and requires manual handling.
+	ErrRefundNotSupported = errors.New("refund is not supported for payment")
+	// ErrRefundUncertain means the refund might have been made, it's retried with the same idempotency key
+	// once the outcome could be known.
+	ErrRefundUncertain = errors.New("refund outcome is unknown")
+)
+
+type RefundReq struct {
+	// IdempotencyKey identifies the refund: refunds with the same key are made once.
+	IdempotencyKey  string
+	PaymentId       string
+	OrderId         string
+	Amount          float64
+	CurrencyIso4217 uint32
+	// PaymentMeta is the provider data of the refunded payment.
+	PaymentMeta map[string]any
+}
+type RefundRes struct {
+	ProviderRefundId string
+}
+
+// ProviderMeta extracts provider name and provider data from payment provider metadata
+// stored as {"<provider name>": {...}}.
+func ProviderMeta(meta map[string]any) (string, map[string]any, bool) {
+	if len(meta) != 1 {
+		return "", nil, false
+	}
+	for name, data := range meta {
+		providerData, ok := data.(map[string]any)
+		return name, providerData, ok
+	}
+	return "", nil, false
+}
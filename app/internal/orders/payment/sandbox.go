@@ -0,0 +1,93 @@
+package payment
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ProviderSandbox = "sandbox"
+)
+
+// Sandbox accepts payments sent by hand and refunds any payment instantly.
+// It's meant for local development and QA, notifications are authenticated with a shared secret.
+type Sandbox struct {
+	secret string
+}
+
+func NewSandbox(secret string) (*Sandbox, error) {
+	if secret == "" {
+		return nil, errors.New("sandbox payment provider secret is empty")
+	}
+	return &Sandbox{secret: secret}, nil
+}
+
+func (*Sandbox) Name() string {
+	return ProviderSandbox
+}
+
+type sandboxNotification struct {
+	OrderId         string   `json:"order_id"`
+	Amount          *float64 `json:"amount"`
+	CurrencyIso4217 *int     `json:"currency_iso_4217"`
+	OperationId     string   `json:"operation_id"`
+	Secret          string   `json:"secret"`
+}
+
+func (p *Sandbox) VerifyNotification(_ context.Context, req NotificationReq) (Notification, error) {
+	var n sandboxNotification
+	if err := json.Unmarshal(req.Body, &n); err != nil {
+		return Notification{}, fmt.Errorf("%w: decode body: %v", ErrInvalidNotification, err)
+	}
+	if subtle.ConstantTimeCompare([]byte(n.Secret), []byte(p.secret)) != 1 {
+		return Notification{}, fmt.Errorf("%w: invalid secret", ErrInvalidNotification)
+	}
+	if n.OrderId == "" {
+		return Notification{}, fmt.Errorf(`%w: invalid empty "order_id" field`, ErrInvalidNotification)
+	}
+	if n.Amount == nil || *n.Amount <= 0 {
+		return Notification{}, fmt.Errorf(`%w: invalid "amount" field value`, ErrInvalidNotification)
+	}
+
+	currency := 643
+	if n.CurrencyIso4217 != nil {
+		currency = *n.CurrencyIso4217
+	}
+	if n.OperationId == "" {
+		n.OperationId = uuid.NewString()
+	}
+
+	return Notification{
+		OrderId:         n.OrderId,
+		OperationId:     n.OperationId,
+		Amount:          *n.Amount,
+		CurrencyIso4217: currency,
+		Datetime:        time.Now(),
+		Meta: map[string]any{
+			"operation_id": n.OperationId,
+		},
+	}, nil
+}
+
+// Checkout returns a pseudo link with the parameters of the notification to send.
+func (*Sandbox) Checkout(_ context.Context, req CheckoutReq) (Checkout, error) {
+	return Checkout{
+		Url: "sandbox://checkout?" + url.Values{
+			"order_id":          {req.OrderId},
+			"amount":            {strconv.FormatFloat(req.Amount, 'f', 2, 64)},
+			"currency_iso_4217": {strconv.Itoa(req.CurrencyIso4217)},
+		}.Encode(),
+	}, nil
+}
+
+func (*Sandbox) Refund(_ context.Context, req RefundReq) (RefundRes, error) {
+	return RefundRes{ProviderRefundId: "sandbox-" + req.IdempotencyKey}, nil
+}
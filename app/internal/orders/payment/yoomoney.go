@@ -0,0 +1,302 @@
+package payment
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ProviderYoomoney = "yoomoney"
+
+	yoomoneyApiUrl      = "https://yoomoney.ru/api"
+	yoomoneyQuickpayUrl = "https://yoomoney.ru/quickpay/confirm"
+
+	yoomoneyCurrencyIso4217 = 643
+
+	yoomoneyStatusSuccess    = "success"
+	yoomoneyStatusInProgress = "in_progress"
+
+	yoomoneyProcessPaymentAttempts = 3
+)
+
+// Yoomoney accepts payments with YooMoney quickpay forms (https://yoomoney.ru/docs/payment-buttons)
+// and refunds them with a p2p transfer from the shop wallet back to the sender wallet
+// (https://yoomoney.ru/docs/wallet/process-payments/request-payment).
+// Transfers are labeled with the refund idempotency key and looked up in the wallet operation history
+// before the transfer, so a retried refund is not transferred twice.
+type Yoomoney struct {
+	notificationSecret string
+	// OAuth token must be issued with "payment-p2p" and "operation-history" scopes, payments are not refunded automatically without it.
+	oauthToken string
+	// wallet receives payments, checkout links are not available without it.
+	wallet string
+	client *http.Client
+}
+
+type YoomoneyBuilder struct {
+	p Yoomoney
+}
+
+func NewYoomoneyBuilder() *YoomoneyBuilder {
+	return &YoomoneyBuilder{p: Yoomoney{client: &http.Client{Timeout: 5 * time.Second}}}
+}
+
+func (b *YoomoneyBuilder) NotificationSecret(secret string) *YoomoneyBuilder {
+	b.p.notificationSecret = secret
+	return b
+}
+func (b *YoomoneyBuilder) OAuthToken(token string) *YoomoneyBuilder {
+	b.p.oauthToken = token
+	return b
+}
+func (b *YoomoneyBuilder) Wallet(wallet string) *YoomoneyBuilder {
+	b.p.wallet = wallet
+	return b
+}
+
+func (b *YoomoneyBuilder) Build() (*Yoomoney, error) {
+	if b.p.notificationSecret == "" {
+		return nil, errors.New("payment notification secret for Yoomoney is empty")
+	}
+	return &b.p, nil
+}
+
+func (*Yoomoney) Name() string {
+	return ProviderYoomoney
+}
+
+var yoomoneyNotificationRequiredFields = []string{
+	"notification_type",
+	"operation_id",
+	"amount",
+	"currency",
+	"datetime",
+	"sha1_hash",
+}
+
+// VerifyNotification checks notification integrity with the notification secret
+// (https://yoomoney.ru/docs/payment-buttons/using-api/notifications).
+func (p *Yoomoney) VerifyNotification(_ context.Context, req NotificationReq) (Notification, error) {
+	form, err := url.ParseQuery(string(req.Body))
+	if err != nil {
+		return Notification{}, fmt.Errorf("%w: parse form: %v", ErrInvalidNotification, err)
+	}
+	for _, field := range yoomoneyNotificationRequiredFields {
+		if form.Get(field) == "" {
+			return Notification{}, fmt.Errorf(`%w: missing "%s" field`, ErrInvalidNotification, field)
+		}
+	}
+
+	amount, err := strconv.ParseFloat(form.Get("amount"), 64)
+	if err != nil {
+		return Notification{}, fmt.Errorf(`%w: invalid "amount" field value`, ErrInvalidNotification)
+	}
+	paymentTime, err := time.Parse(time.RFC3339, form.Get("datetime"))
+	if err != nil {
+		return Notification{}, fmt.Errorf(`%w: invalid "datetime" field value`, ErrInvalidNotification)
+	}
+	currency, err := strconv.Atoi(form.Get("currency"))
+	if err != nil {
+		return Notification{}, fmt.Errorf(`%w: invalid "currency" field value`, ErrInvalidNotification)
+	}
+
+	integrityCheckString := strings.Join([]string{
+		form.Get("notification_type"),
+		form.Get("operation_id"),
+		form.Get("amount"),
+		form.Get("currency"),
+		form.Get("datetime"),
+		form.Get("sender"),
+		form.Get("codepro"),
+		p.notificationSecret,
+		form.Get("label"),
+	}, "&")
+	hash := sha1.Sum([]byte(integrityCheckString))
+	if subtle.ConstantTimeCompare([]byte(hex.EncodeToString(hash[:])), []byte(form.Get("sha1_hash"))) != 1 {
+		return Notification{}, fmt.Errorf("%w: integrity check failed", ErrInvalidNotification)
+	}
+
+	orderId := strings.Split(form.Get("label"), ":")[0]
+	if orderId == "" {
+		return Notification{}, fmt.Errorf(`%w: invalid empty "label" field`, ErrInvalidNotification)
+	}
+
+	return Notification{
+		OrderId:         orderId,
+		OperationId:     form.Get("operation_id"),
+		Amount:          amount,
+		CurrencyIso4217: currency,
+		Datetime:        paymentTime,
+		Meta: map[string]any{
+			"notification_type": form.Get("notification_type"),
+			"operation_id":      form.Get("operation_id"),
+			"amount":            form.Get("amount"),
+			"currency":          form.Get("currency"),
+			"datetime":          form.Get("datetime"),
+			"sender":            form.Get("sender"),
+			"codepro":           form.Get("codepro"),
+			"label":             form.Get("label"),
+		},
+	}, nil
+}
+
+// Checkout builds a quickpay form, order id is passed as the payment label.
+func (p *Yoomoney) Checkout(_ context.Context, req CheckoutReq) (Checkout, error) {
+	if p.wallet == "" {
+		return Checkout{}, fmt.Errorf("%w: yoomoney wallet is not configured", ErrCheckoutNotSupported)
+	}
+	if req.CurrencyIso4217 != yoomoneyCurrencyIso4217 {
+		return Checkout{}, fmt.Errorf("%w: currency %d", ErrCheckoutNotSupported, req.CurrencyIso4217)
+	}
+
+	params := map[string]string{
+		"receiver":      p.wallet,
+		"quickpay-form": "button",
+		"paymentType":   "AC",
+		"sum":           strconv.FormatFloat(req.Amount, 'f', 2, 64),
+		"label":         req.OrderId,
+	}
+	query := url.Values{}
+	for k, v := range params {
+		query.Set(k, v)
+	}
+
+	return Checkout{
+		Url: yoomoneyQuickpayUrl + "?" + query.Encode(),
+		Form: &CheckoutForm{
+			Action: yoomoneyQuickpayUrl,
+			Method: http.MethodPost,
+			Params: params,
+		},
+	}, nil
+}
+
+type yoomoneyRequestPaymentRes struct {
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	RequestId string `json:"request_id"`
+}
+
+type yoomoneyOperationHistoryRes struct {
+	Error      string `json:"error"`
+	Operations []struct {
+		OperationId string `json:"operation_id"`
+		Status      string `json:"status"`
+	} `json:"operations"`
+}
+
+type yoomoneyProcessPaymentRes struct {
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	PaymentId string `json:"payment_id"`
+	NextRetry int    `json:"next_retry"`
+}
+
+func (p *Yoomoney) Refund(ctx context.Context, req RefundReq) (RefundRes, error) {
+	if p.oauthToken == "" {
+		return RefundRes{}, fmt.Errorf("%w: yoomoney oauth token is not configured", ErrRefundNotSupported)
+	}
+	// Card payments have no sender wallet to return funds to.
+	sender, _ := req.PaymentMeta["sender"].(string)
+	if sender == "" {
+		return RefundRes{}, fmt.Errorf("%w: payment has no sender wallet", ErrRefundNotSupported)
+	}
+	if req.CurrencyIso4217 != yoomoneyCurrencyIso4217 {
+		return RefundRes{}, fmt.Errorf("%w: currency %d", ErrRefundNotSupported, req.CurrencyIso4217)
+	}
+
+	var historyRes yoomoneyOperationHistoryRes
+	if err := p.call(ctx, "operation-history", url.Values{
+		"type":  {"payment"},
+		"label": {req.IdempotencyKey},
+	}, &historyRes); err != nil {
+		return RefundRes{}, fmt.Errorf("operation history: %w", err)
+	}
+	if historyRes.Error != "" {
+		return RefundRes{}, fmt.Errorf("operation history: %s", historyRes.Error)
+	}
+	// Refused transfers are made again.
+	for _, operation := range historyRes.Operations {
+		switch operation.Status {
+		case yoomoneyStatusSuccess:
+			return RefundRes{ProviderRefundId: operation.OperationId}, nil
+		case yoomoneyStatusInProgress:
+			return RefundRes{}, fmt.Errorf("%w: transfer %s is in progress", ErrRefundUncertain, operation.OperationId)
+		}
+	}
+
+	message := fmt.Sprintf("Refund for order %s", req.OrderId)
+
+	var requestPaymentRes yoomoneyRequestPaymentRes
+	if err := p.call(ctx, "request-payment", url.Values{
+		"pattern_id": {"p2p"},
+		"to":         {sender},
+		"amount_due": {strconv.FormatFloat(req.Amount, 'f', 2, 64)},
+		"comment":    {message},
+		"message":    {message},
+		"label":      {req.IdempotencyKey},
+	}, &requestPaymentRes); err != nil {
+		return RefundRes{}, fmt.Errorf("request payment: %w", err)
+	}
+	if requestPaymentRes.Status != yoomoneyStatusSuccess {
+		return RefundRes{}, fmt.Errorf("request payment: status %s: %s", requestPaymentRes.Status, requestPaymentRes.Error)
+	}
+
+	for attempt := 1; ; attempt++ {
+		var processPaymentRes yoomoneyProcessPaymentRes
+		if err := p.call(ctx, "process-payment", url.Values{
+			"request_id": {requestPaymentRes.RequestId},
+		}, &processPaymentRes); err != nil {
+			return RefundRes{}, fmt.Errorf("%w: process payment: %v", ErrRefundUncertain, err)
+		}
+
+		switch processPaymentRes.Status {
+		case yoomoneyStatusSuccess:
+			return RefundRes{ProviderRefundId: processPaymentRes.PaymentId}, nil
+		case yoomoneyStatusInProgress:
+			if attempt == yoomoneyProcessPaymentAttempts {
+				return RefundRes{}, fmt.Errorf("%w: process payment: still in progress", ErrRefundUncertain)
+			}
+			select {
+			case <-ctx.Done():
+				return RefundRes{}, fmt.Errorf("%w: %v", ErrRefundUncertain, ctx.Err())
+			case <-time.After(time.Duration(processPaymentRes.NextRetry) * time.Millisecond):
+			}
+		default:
+			return RefundRes{}, fmt.Errorf("process payment: status %s: %s", processPaymentRes.Status, processPaymentRes.Error)
+		}
+	}
+}
+
+func (p *Yoomoney) call(ctx context.Context, method string, form url.Values, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, yoomoneyApiUrl+"/"+method, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+p.oauthToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
@@ -17,21 +17,45 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for OrdersProcessYoomoneyPaymentReqCurrency.
+// Defines values for CreateProductCampaignResStatus.
 const (
-	N643 OrdersProcessYoomoneyPaymentReqCurrency = 643
+	CreateProductCampaignResStatusActive    CreateProductCampaignResStatus = "active"
+	CreateProductCampaignResStatusCancelled CreateProductCampaignResStatus = "cancelled"
+	CreateProductCampaignResStatusExhausted CreateProductCampaignResStatus = "exhausted"
+	CreateProductCampaignResStatusFinished  CreateProductCampaignResStatus = "finished"
+	CreateProductCampaignResStatusScheduled CreateProductCampaignResStatus = "scheduled"
 )
 
-// Defines values for OrdersProcessYoomoneyPaymentReqNotificationType.
+// Defines values for ListProductCampaignsResCampaignStatus.
 const (
-	CardIncoming OrdersProcessYoomoneyPaymentReqNotificationType = "card-incoming"
-	P2pIncoming  OrdersProcessYoomoneyPaymentReqNotificationType = "p2p-incoming"
+	ListProductCampaignsResCampaignStatusActive    ListProductCampaignsResCampaignStatus = "active"
+	ListProductCampaignsResCampaignStatusCancelled ListProductCampaignsResCampaignStatus = "cancelled"
+	ListProductCampaignsResCampaignStatusExhausted ListProductCampaignsResCampaignStatus = "exhausted"
+	ListProductCampaignsResCampaignStatusFinished  ListProductCampaignsResCampaignStatus = "finished"
+	ListProductCampaignsResCampaignStatusScheduled ListProductCampaignsResCampaignStatus = "scheduled"
+)
+
+// Defines values for OrderEventMessageType.
+const (
+	OrderCancelled OrderEventMessageType = "order.cancelled"
+	OrderCompleted OrderEventMessageType = "order.completed"
+	OrderCreated   OrderEventMessageType = "order.created"
+	OrderDelivered OrderEventMessageType = "order.delivered"
+	OrderPaid      OrderEventMessageType = "order.paid"
+	OrderShipped   OrderEventMessageType = "order.shipped"
+)
+
+// Defines values for OrdersCreateCouponReqDiscountType.
+const (
+	Fixed   OrdersCreateCouponReqDiscountType = "fixed"
+	Percent OrdersCreateCouponReqDiscountType = "percent"
 )
 
 // AuthenticateReq defines model for AuthenticateReq.
@@ -46,13 +70,26 @@ type AuthenticateRes struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// CancelProductCampaignRes defines model for CancelProductCampaignRes.
+type CancelProductCampaignRes struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// CartApplyPromoCodeReq defines model for CartApplyPromoCodeReq.
+type CartApplyPromoCodeReq struct {
+	// Code promo code, case insensitive
+	Code string `json:"code"`
+}
+
 // CartClearCartRes defines model for CartClearCartRes.
 type CartClearCartRes = map[string]interface{}
 
 // CartClearCartResPosition defines model for CartClearCartResPosition.
 type CartClearCartResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CartDeleteCartPositionRes defines model for CartDeleteCartPositionRes.
@@ -62,8 +99,9 @@ type CartDeleteCartPositionRes struct {
 
 // CartDeleteCartPositionResPosition defines model for CartDeleteCartPositionResPosition.
 type CartDeleteCartPositionResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CartGetCartPositionsRes defines model for CartGetCartPositionsRes.
@@ -73,10 +111,21 @@ type CartGetCartPositionsRes struct {
 
 // CartGetCartPositionsResPosition defines model for CartGetCartPositionsResPosition.
 type CartGetCartPositionsResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// CartPromoCodeRes promo code applied to the cart. Codes stay applied until removed, orders are discounted with the coupon
+// of the code if it's applicable to them. Discounts are previewed with the orders coupon preview.
+type CartPromoCodeRes struct {
+	AppliedAt time.Time `json:"applied_at"`
+	Code      string    `json:"code"`
 }
 
+// CartRemovePromoCodeRes defines model for CartRemovePromoCodeRes.
+type CartRemovePromoCodeRes = map[string]interface{}
+
 // CartSetCartPositionRes defines model for CartSetCartPositionRes.
 type CartSetCartPositionRes struct {
 	SetPosition CartSetCartPositionResPosition `json:"set_position"`
@@ -84,14 +133,23 @@ type CartSetCartPositionRes struct {
 
 // CartSetCartPositionResPosition defines model for CartSetCartPositionResPosition.
 type CartSetCartPositionResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CatalogGetRes defines model for CatalogGetRes.
 type CatalogGetRes struct {
-	NextPageToken *string                `json:"next_page_token"`
-	Products      []CatalogGetResProduct `json:"products"`
+	// Categories category facets, numbers of found products by category paths
+	Categories    []CatalogGetResCategory `json:"categories"`
+	NextPageToken *string                 `json:"next_page_token"`
+	Products      []CatalogGetResProduct  `json:"products"`
+}
+
+// CatalogGetResCategory defines model for CatalogGetResCategory.
+type CatalogGetResCategory struct {
+	Count int    `json:"count"`
+	Path  string `json:"path"`
 }
 
 // CatalogGetResProduct defines model for CatalogGetResProduct.
@@ -115,29 +173,104 @@ type CreateAccessTokenRes struct {
 	ExpiresAt   string `json:"expires_at"`
 }
 
+// CreateProductCampaignReq defines model for CreateProductCampaignReq.
+type CreateProductCampaignReq struct {
+	// Boost Catalog ranking multiplier applied while the campaign is active
+	Boost float64 `json:"boost"`
+
+	// Budget Budget the campaign spends while active, the campaign is exhausted once it's spent
+	Budget   float64   `json:"budget"`
+	EndsAt   time.Time `json:"ends_at"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// CreateProductCampaignRes defines model for CreateProductCampaignRes.
+type CreateProductCampaignRes struct {
+	Boost     float64 `json:"boost"`
+	Budget    float64 `json:"budget"`
+	CreatedAt string  `json:"created_at"`
+	EndsAt    string  `json:"ends_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+
+	// Spent Budget spent by the campaign so far
+	Spent     float64                        `json:"spent"`
+	StartsAt  string                         `json:"starts_at"`
+	Status    CreateProductCampaignResStatus `json:"status"`
+	UpdatedAt string                         `json:"updated_at"`
+}
+
+// CreateProductCampaignResStatus defines model for CreateProductCampaignRes.Status.
+type CreateProductCampaignResStatus string
+
+// CreateProductCategoryReq defines model for CreateProductCategoryReq.
+type CreateProductCategoryReq struct {
+	Name string `json:"name"`
+
+	// ParentId id of the parent category, the category is created at the root if absent
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Position position of the category among its siblings, the category is put after its siblings if absent
+	Position *int `json:"position,omitempty"`
+
+	// Slug unique lowercase latin letters and digits separated by hyphens, i.e. "garden-roses"
+	Slug string `json:"slug"`
+}
+
+// CreateProductCategoryRes defines model for CreateProductCategoryRes.
+type CreateProductCategoryRes = ProductCategory
+
 // CreateProductReq defines model for CreateProductReq.
 type CreateProductReq struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	Description string                 `json:"description"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Price       float64                `json:"price"`
-	Stock       int                    `json:"stock"`
+
+	// Options option axes of the product variants, skus are added with "POST /api/v1/products/{product_id}/skus"
+	Options *[]GetProductResOption `json:"options,omitempty"`
+	Price   float64                `json:"price"`
+	Stock   int                    `json:"stock"`
 }
 
 // CreateProductRes defines model for CreateProductRes.
 type CreateProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	CreatedAt   string                 `json:"created_at"`
 	Description string                 `json:"description"`
 	Id          string                 `json:"id"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Pictures    GetProductResPictures  `json:"pictures"`
-	Price       float64                `json:"price"`
-	SellerId    string                 `json:"seller_id"`
-	Stock       int                    `json:"stock"`
-	UpdatedAt   string                 `json:"updated_at"`
+
+	// Options option axes of the product variants, i.e. size or colour
+	Options  []GetProductResOption `json:"options"`
+	Pictures GetProductResPictures `json:"pictures"`
+	Price    float64               `json:"price"`
+	SellerId string                `json:"seller_id"`
+
+	// Skus variants of the product, stock of the product with skus is the sum of their stocks
+	Skus      []GetProductResSku `json:"skus"`
+	Stock     int                `json:"stock"`
+	UpdatedAt string             `json:"updated_at"`
 }
 
+// CreateProductSkuReq defines model for CreateProductSkuReq.
+type CreateProductSkuReq struct {
+	// Options values of every product option by option names
+	Options map[string]string `json:"options"`
+
+	// PictureIds ids of the product pictures of the sku
+	PictureIds *[]string `json:"picture_ids,omitempty"`
+	Price      float64   `json:"price"`
+	Stock      int       `json:"stock"`
+}
+
+// CreateProductSkuRes defines model for CreateProductSkuRes.
+type CreateProductSkuRes = GetProductResSku
+
 // CreateSellerAccountReq defines model for CreateSellerAccountReq.
 type CreateSellerAccountReq struct {
 	AccessToken string `json:"access_token"`
@@ -167,6 +300,11 @@ type CreateUserAccountRes struct {
 	Name  string  `json:"name"`
 }
 
+// DeleteProductCategoryRes defines model for DeleteProductCategoryRes.
+type DeleteProductCategoryRes struct {
+	Id string `json:"id"`
+}
+
 // DeleteProductPictureRes defines model for DeleteProductPictureRes.
 type DeleteProductPictureRes struct {
 	Id string `json:"id"`
@@ -177,24 +315,121 @@ type DeleteProductRes struct {
 	Id string `json:"id"`
 }
 
+// DeleteProductSkuRes defines model for DeleteProductSkuRes.
+type DeleteProductSkuRes struct {
+	Id string `json:"id"`
+}
+
 // Err defines model for Err.
 type Err struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 }
 
+// FeedbackCreateProductReviewReq defines model for FeedbackCreateProductReviewReq.
+type FeedbackCreateProductReviewReq struct {
+	Rating float64 `json:"rating"`
+	Review string  `json:"review"`
+}
+
+// FeedbackCreateProductReviewRes defines model for FeedbackCreateProductReviewRes.
+type FeedbackCreateProductReviewRes struct {
+	CreatedAt string  `json:"created_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	Rating    float64 `json:"rating"`
+	Review    string  `json:"review"`
+	UpdatedAt string  `json:"updated_at"`
+	UserId    string  `json:"user_id"`
+}
+
+// FeedbackDeleteProductReviewRes defines model for FeedbackDeleteProductReviewRes.
+type FeedbackDeleteProductReviewRes struct {
+	Id string `json:"id"`
+}
+
+// FeedbackGetProductRatingRes defines model for FeedbackGetProductRatingRes.
+type FeedbackGetProductRatingRes struct {
+	Distribution []FeedbackGetProductRatingResDistributionBucket `json:"distribution"`
+	ProductId    string                                          `json:"product_id"`
+	Rating       float64                                         `json:"rating"`
+	ReviewsCount int                                             `json:"reviews_count"`
+}
+
+// FeedbackGetProductRatingResDistributionBucket defines model for FeedbackGetProductRatingResDistributionBucket.
+type FeedbackGetProductRatingResDistributionBucket struct {
+	Count int `json:"count"`
+	Stars int `json:"stars"`
+}
+
+// FeedbackGetProductReviewRes defines model for FeedbackGetProductReviewRes.
+type FeedbackGetProductReviewRes struct {
+	CreatedAt string  `json:"created_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	Rating    float64 `json:"rating"`
+	Review    string  `json:"review"`
+	UpdatedAt string  `json:"updated_at"`
+	UserId    string  `json:"user_id"`
+}
+
+// FeedbackListProductReviewsRes defines model for FeedbackListProductReviewsRes.
+type FeedbackListProductReviewsRes struct {
+	NextPageToken *string                               `json:"next_page_token"`
+	Reviews       []FeedbackListProductReviewsResReview `json:"reviews"`
+}
+
+// FeedbackListProductReviewsResReview defines model for FeedbackListProductReviewsResReview.
+type FeedbackListProductReviewsResReview struct {
+	CreatedAt string  `json:"created_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	Rating    float64 `json:"rating"`
+	Review    string  `json:"review"`
+	UpdatedAt string  `json:"updated_at"`
+	UserId    string  `json:"user_id"`
+}
+
+// FeedbackUpdateProductReviewReq defines model for FeedbackUpdateProductReviewReq.
+type FeedbackUpdateProductReviewReq struct {
+	Rating *float64 `json:"rating,omitempty"`
+	Review *string  `json:"review,omitempty"`
+}
+
+// FeedbackUpdateProductReviewRes defines model for FeedbackUpdateProductReviewRes.
+type FeedbackUpdateProductReviewRes struct {
+	Id        string  `json:"id"`
+	Rating    float64 `json:"rating"`
+	Review    string  `json:"review"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
 // GetProductRes defines model for GetProductRes.
 type GetProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	CreatedAt   string                 `json:"created_at"`
 	Description string                 `json:"description"`
 	Id          string                 `json:"id"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Pictures    GetProductResPictures  `json:"pictures"`
-	Price       float64                `json:"price"`
-	SellerId    string                 `json:"seller_id"`
-	Stock       int                    `json:"stock"`
-	UpdatedAt   string                 `json:"updated_at"`
+
+	// Options option axes of the product variants, i.e. size or colour
+	Options  []GetProductResOption `json:"options"`
+	Pictures GetProductResPictures `json:"pictures"`
+	Price    float64               `json:"price"`
+	SellerId string                `json:"seller_id"`
+
+	// Skus variants of the product, stock of the product with skus is the sum of their stocks
+	Skus      []GetProductResSku `json:"skus"`
+	Stock     int                `json:"stock"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// GetProductResOption defines model for GetProductResOption.
+type GetProductResOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
 }
 
 // GetProductResPicture defines model for GetProductResPicture.
@@ -206,6 +441,49 @@ type GetProductResPicture struct {
 // GetProductResPictures defines model for GetProductResPictures.
 type GetProductResPictures = []GetProductResPicture
 
+// GetProductResSku defines model for GetProductResSku.
+type GetProductResSku struct {
+	Id string `json:"id"`
+
+	// Options values of the product options by option names
+	Options map[string]string `json:"options"`
+
+	// PictureIds ids of the product pictures of the sku
+	PictureIds []string `json:"picture_ids"`
+	Price      float64  `json:"price"`
+	Stock      int      `json:"stock"`
+}
+
+// ListProductCampaignsRes defines model for ListProductCampaignsRes.
+type ListProductCampaignsRes struct {
+	Campaigns []ListProductCampaignsResCampaign `json:"campaigns"`
+}
+
+// ListProductCampaignsResCampaign defines model for ListProductCampaignsResCampaign.
+type ListProductCampaignsResCampaign struct {
+	Boost     float64 `json:"boost"`
+	Budget    float64 `json:"budget"`
+	CreatedAt string  `json:"created_at"`
+	EndsAt    string  `json:"ends_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+
+	// Spent Budget spent by the campaign so far
+	Spent     float64                               `json:"spent"`
+	StartsAt  string                                `json:"starts_at"`
+	Status    ListProductCampaignsResCampaignStatus `json:"status"`
+	UpdatedAt string                                `json:"updated_at"`
+}
+
+// ListProductCampaignsResCampaignStatus defines model for ListProductCampaignsResCampaign.Status.
+type ListProductCampaignsResCampaignStatus string
+
+// ListProductCategoriesRes defines model for ListProductCategoriesRes.
+type ListProductCategoriesRes struct {
+	Categories []ProductCategory `json:"categories"`
+}
+
 // ListProductsRes defines model for ListProductsRes.
 type ListProductsRes struct {
 	NextPageToken *string                  `json:"next_page_token"`
@@ -214,6 +492,8 @@ type ListProductsRes struct {
 
 // ListProductsResProduct defines model for ListProductsResProduct.
 type ListProductsResProduct struct {
+	// CategoryId id of the product category
+	CategoryId *string `json:"category_id,omitempty"`
 	Id         string  `json:"id"`
 	Name       string  `json:"name"`
 	PictureUrl string  `json:"picture_url"`
@@ -221,6 +501,173 @@ type ListProductsResProduct struct {
 	SellerId   string  `json:"seller_id"`
 }
 
+// OrderEventActor defines model for OrderEventActor.
+type OrderEventActor struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// OrderEventItem defines model for OrderEventItem.
+type OrderEventItem struct {
+	Count     int    `json:"count"`
+	ProductId string `json:"product_id"`
+	SellerId  string `json:"seller_id"`
+}
+
+// OrderEventMessage order lifecycle domain event published to orders/order_events_topic
+type OrderEventMessage struct {
+	Actor OrderEventActor `json:"actor"`
+
+	// Id unique event id, consumers deduplicate redelivered events by it
+	Id             string           `json:"id"`
+	Items          []OrderEventItem `json:"items"`
+	OccurredAt     time.Time        `json:"occurred_at"`
+	OrderId        string           `json:"order_id"`
+	PreviousStatus *string          `json:"previous_status"`
+	Reason         string           `json:"reason"`
+
+	// SchemaVersion incremented on breaking changes of the event schema
+	SchemaVersion int                   `json:"schema_version"`
+	Status        string                `json:"status"`
+	Type          OrderEventMessageType `json:"type"`
+	UserId        string                `json:"user_id"`
+}
+
+// OrderEventMessageType defines model for OrderEventMessage.Type.
+type OrderEventMessageType string
+
+// OrdersAddress defines model for OrdersAddress.
+type OrdersAddress struct {
+	City          string  `json:"city"`
+	Comment       *string `json:"comment,omitempty"`
+	Country       string  `json:"country"`
+	CreatedAt     string  `json:"created_at"`
+	Id            string  `json:"id"`
+	Phone         string  `json:"phone"`
+	PostalCode    string  `json:"postal_code"`
+	RecipientName string  `json:"recipient_name"`
+	Street        string  `json:"street"`
+	UpdatedAt     string  `json:"updated_at"`
+	UserId        string  `json:"user_id"`
+}
+
+// OrdersAddressReq defines model for OrdersAddressReq.
+type OrdersAddressReq struct {
+	City string `json:"city"`
+
+	// Comment note for the courier
+	Comment *string `json:"comment,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 country code
+	Country       string `json:"country"`
+	Phone         string `json:"phone"`
+	PostalCode    string `json:"postal_code"`
+	RecipientName string `json:"recipient_name"`
+
+	// Street street, building and apartment
+	Street string `json:"street"`
+}
+
+// OrdersCost order cost computed at order creation, absent for orders placed before it was introduced.
+// Amounts are in minor units of the currency (e.g. kopecks), prices include VAT.
+type OrdersCost struct {
+	CurrencyIso4217 int   `json:"currency_iso_4217"`
+	Discount        int64 `json:"discount"`
+	ShippingFee     int64 `json:"shipping_fee"`
+
+	// Subtotal sum of the order items prices
+	Subtotal int64 `json:"subtotal"`
+
+	// Total amount to pay, subtotal less discount plus shipping fee
+	Total int64 `json:"total"`
+
+	// Vat VAT included in the total
+	Vat int64 `json:"vat"`
+}
+
+// OrdersCoupon defines model for OrdersCoupon.
+type OrdersCoupon struct {
+	Code         string `json:"code"`
+	CreatedAt    string `json:"created_at"`
+	DiscountType string `json:"discount_type"`
+
+	// DiscountValue percent from 1 to 100 or fixed amount in minor units of the currency
+	DiscountValue int64   `json:"discount_value"`
+	ExpiresAt     *string `json:"expires_at,omitempty"`
+
+	// MaxUses maximum redemptions of the coupon, 0 is unlimited
+	MaxUses int64 `json:"max_uses"`
+
+	// MaxUsesPerUser maximum redemptions of the coupon by a user, 0 is unlimited
+	MaxUsesPerUser int64 `json:"max_uses_per_user"`
+
+	// MinSubtotal minimum sum of the order items prices in minor units of the currency
+	MinSubtotal int64  `json:"min_subtotal"`
+	OwnerId     string `json:"owner_id"`
+
+	// OwnerType subject type of the coupon creator
+	OwnerType  string   `json:"owner_type"`
+	ProductIds []string `json:"product_ids"`
+	SellerIds  []string `json:"seller_ids"`
+	StartsAt   *string  `json:"starts_at,omitempty"`
+
+	// Uses redemptions of the coupon by orders that aren't cancelled
+	Uses int64 `json:"uses"`
+}
+
+// OrdersCouponPreviewRes discount the coupon gives to the items. Coupons that are not applicable to the items don't discount them.
+// Amounts are in minor units of the currency, shipping isn't included.
+type OrdersCouponPreviewRes struct {
+	Applicable      bool   `json:"applicable"`
+	Code            string `json:"code"`
+	CurrencyIso4217 int    `json:"currency_iso_4217"`
+	Discount        int64  `json:"discount"`
+
+	// Reason why the coupon isn't applicable
+	Reason   *string `json:"reason,omitempty"`
+	Subtotal int64   `json:"subtotal"`
+	Total    int64   `json:"total"`
+}
+
+// OrdersCreateCouponReq defines model for OrdersCreateCouponReq.
+type OrdersCreateCouponReq struct {
+	// Code promo code of 3 to 32 latin letters, digits, "-" or "_", case insensitive
+	Code         string                            `json:"code"`
+	DiscountType OrdersCreateCouponReqDiscountType `json:"discount_type"`
+
+	// DiscountValue percent from 1 to 100 or fixed amount in minor units of the currency
+	DiscountValue int64      `json:"discount_value"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+
+	// MaxUses maximum redemptions of the coupon, 0 is unlimited
+	MaxUses *int64 `json:"max_uses,omitempty"`
+
+	// MaxUsesPerUser maximum redemptions of the coupon by a user, 0 is unlimited
+	MaxUsesPerUser *int64 `json:"max_uses_per_user,omitempty"`
+
+	// MinSubtotal minimum sum of the order items prices in minor units of the currency
+	MinSubtotal *int64 `json:"min_subtotal,omitempty"`
+
+	// ProductIds discount items of the products only
+	ProductIds *[]string `json:"product_ids,omitempty"`
+
+	// SellerIds discount items of the sellers only, coupons of sellers discount items of the seller
+	SellerIds *[]string  `json:"seller_ids,omitempty"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+}
+
+// OrdersCreateCouponReqDiscountType defines model for OrdersCreateCouponReq.DiscountType.
+type OrdersCreateCouponReqDiscountType string
+
+// OrdersCreateOrderReq defines model for OrdersCreateOrderReq.
+type OrdersCreateOrderReq struct {
+	// AddressId address book entry to deliver the order to, required unless the order is picked up
+	AddressId *string `json:"address_id,omitempty"`
+
+	// DeliveryMethod "courier", "post" or "pickup"
+	DeliveryMethod string `json:"delivery_method"`
+}
+
 // OrdersCreateOrderRes defines model for OrdersCreateOrderRes.
 type OrdersCreateOrderRes struct {
 	Operation OrdersCreateOrderResOperation `json:"operation"`
@@ -237,26 +684,95 @@ type OrdersCreateOrderResOperation struct {
 	UserId    string  `json:"user_id"`
 }
 
+// OrdersCreateReturnReq defines model for OrdersCreateReturnReq.
+type OrdersCreateReturnReq struct {
+	Items  []OrdersCreateReturnReqItem `json:"items"`
+	Reason string                      `json:"reason"`
+}
+
+// OrdersCreateReturnReqItem defines model for OrdersCreateReturnReqItem.
+type OrdersCreateReturnReqItem struct {
+	Count     int    `json:"count"`
+	ProductId string `json:"product_id"`
+
+	// SkuId returned sku, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
+}
+
+// OrdersDeleteAddressRes defines model for OrdersDeleteAddressRes.
+type OrdersDeleteAddressRes struct {
+	Id string `json:"id"`
+}
+
+// OrdersDeleteCouponRes defines model for OrdersDeleteCouponRes.
+type OrdersDeleteCouponRes struct {
+	Code string `json:"code"`
+}
+
+// OrdersDelivery delivery of the order, absent for orders placed before delivery was introduced
+type OrdersDelivery struct {
+	// Address snapshot of the address book entry taken when the order was placed
+	Address *OrdersDeliveryAddress `json:"address,omitempty"`
+	Method  string                 `json:"method"`
+}
+
+// OrdersDeliveryAddress snapshot of the address book entry taken when the order was placed
+type OrdersDeliveryAddress struct {
+	AddressId     string  `json:"address_id"`
+	City          string  `json:"city"`
+	Comment       *string `json:"comment,omitempty"`
+	Country       string  `json:"country"`
+	Phone         string  `json:"phone"`
+	PostalCode    string  `json:"postal_code"`
+	RecipientName string  `json:"recipient_name"`
+	Street        string  `json:"street"`
+}
+
 // OrdersGetOperationRes defines model for OrdersGetOperationRes.
 type OrdersGetOperationRes struct {
-	CreatedAt string  `json:"created_at"`
-	Details   *string `json:"details,omitempty"`
-	Id        string  `json:"id"`
-	OrderId   *string `json:"order_id,omitempty"`
-	Status    string  `json:"status"`
-	Type      string  `json:"type"`
-	UpdatedAt string  `json:"updated_at"`
-	UserId    string  `json:"user_id"`
+	CreatedAt string `json:"created_at"`
+
+	// Details Why the operation is aborted, or for completed operations why the order is placed without
+	// the discount of the promo code applied to the cart ("promo_code_rejected: <reason>")
+	Details *string `json:"details,omitempty"`
+	Id      string  `json:"id"`
+	OrderId *string `json:"order_id,omitempty"`
+
+	// Payment how to pay for the order, present only while the order awaits payment
+	Payment   *OrdersPayment `json:"payment,omitempty"`
+	Status    string         `json:"status"`
+	Type      string         `json:"type"`
+	UpdatedAt string         `json:"updated_at"`
+	UserId    string         `json:"user_id"`
 }
 
 // OrdersGetOrderRes defines model for OrdersGetOrderRes.
 type OrdersGetOrderRes struct {
-	CreatedAt string                  `json:"created_at"`
-	Id        string                  `json:"id"`
-	Items     []OrdersGetOrderResItem `json:"items"`
-	Status    string                  `json:"status"`
-	UpdatedAt string                  `json:"updated_at"`
-	UserId    string                  `json:"user_id"`
+	// AllowedTransitions statuses the requesting subject may set with order update
+	AllowedTransitions []string `json:"allowed_transitions"`
+
+	// Cost order cost computed at order creation, absent for orders placed before it was introduced.
+	// Amounts are in minor units of the currency (e.g. kopecks), prices include VAT.
+	Cost      *OrdersCost `json:"cost,omitempty"`
+	CreatedAt string      `json:"created_at"`
+
+	// Delivery delivery of the order, absent for orders placed before delivery was introduced
+	Delivery *OrdersDelivery         `json:"delivery,omitempty"`
+	Id       string                  `json:"id"`
+	Items    []OrdersGetOrderResItem `json:"items"`
+
+	// Payment how to pay for the order, present only while the order awaits payment
+	Payment *OrdersPayment `json:"payment,omitempty"`
+
+	// PromoCode promo code redeemed by the order
+	PromoCode *string                     `json:"promo_code,omitempty"`
+	Shipments []OrdersGetOrderResShipment `json:"shipments"`
+	Status    string                      `json:"status"`
+
+	// StatusHistory order status transitions, oldest first
+	StatusHistory []OrdersGetOrderResStatusHistoryEntry `json:"status_history"`
+	UpdatedAt     string                                `json:"updated_at"`
+	UserId        string                                `json:"user_id"`
 }
 
 // OrdersGetOrderResItem defines model for OrdersGetOrderResItem.
@@ -267,6 +783,42 @@ type OrdersGetOrderResItem struct {
 	Price      float64 `json:"price"`
 	ProductId  string  `json:"product_id"`
 	SellerId   string  `json:"seller_id"`
+	SkuId      *string `json:"sku_id,omitempty"`
+}
+
+// OrdersGetOrderResShipment defines model for OrdersGetOrderResShipment.
+type OrdersGetOrderResShipment struct {
+	Carrier  *string `json:"carrier,omitempty"`
+	SellerId string  `json:"seller_id"`
+	Status   string  `json:"status"`
+
+	// TrackingNumber carrier tracking number, set once the shipment is shipped
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// OrdersGetOrderResStatusHistoryEntry defines model for OrdersGetOrderResStatusHistoryEntry.
+type OrdersGetOrderResStatusHistoryEntry struct {
+	ActorId string `json:"actor_id"`
+
+	// ActorType subject type of the actor, "system" for transitions made by the service itself
+	ActorType string `json:"actor_type"`
+	CreatedAt string `json:"created_at"`
+
+	// FromStatus null for order creation
+	FromStatus *string `json:"from_status"`
+	Reason     string  `json:"reason"`
+	Status     string  `json:"status"`
+}
+
+// OrdersListAddressesRes defines model for OrdersListAddressesRes.
+type OrdersListAddressesRes struct {
+	Addresses []OrdersAddress `json:"addresses"`
+}
+
+// OrdersListCouponsRes defines model for OrdersListCouponsRes.
+type OrdersListCouponsRes struct {
+	Coupons []OrdersCoupon `json:"coupons"`
 }
 
 // OrdersListOrdersRes defines model for OrdersListOrdersRes.
@@ -283,6 +835,7 @@ type OrdersListOrdersResItem struct {
 	Price      float64 `json:"price"`
 	ProductId  string  `json:"product_id"`
 	SellerId   string  `json:"seller_id"`
+	SkuId      *string `json:"sku_id,omitempty"`
 }
 
 // OrdersListOrdersResOrder defines model for OrdersListOrdersResOrder.
@@ -295,109 +848,337 @@ type OrdersListOrdersResOrder struct {
 	UserId    string                    `json:"user_id"`
 }
 
-// OrdersProcessYoomoneyPaymentReq defines model for OrdersProcessYoomoneyPaymentReq.
-type OrdersProcessYoomoneyPaymentReq struct {
-	Amount           float64                                         `json:"amount"`
-	Currency         OrdersProcessYoomoneyPaymentReqCurrency         `json:"currency"`
-	Datetime         time.Time                                       `json:"datetime"`
-	Label            *string                                         `json:"label"`
-	NotificationType OrdersProcessYoomoneyPaymentReqNotificationType `json:"notification_type"`
-	OperationId      string                                          `json:"operation_id"`
-
-	// Sha1Hash sha1 hash that is used to check the integrity of payment processing request
-	Sha1Hash []string `json:"sha1_hash"`
+// OrdersListReturnsRes defines model for OrdersListReturnsRes.
+type OrdersListReturnsRes struct {
+	Returns []OrdersReturn `json:"returns"`
 }
 
-// OrdersProcessYoomoneyPaymentReqCurrency defines model for OrdersProcessYoomoneyPaymentReq.Currency.
-type OrdersProcessYoomoneyPaymentReqCurrency float32
+// OrdersListSellerOrdersRes defines model for OrdersListSellerOrdersRes.
+type OrdersListSellerOrdersRes struct {
+	NextPageToken *string                          `json:"next_page_token"`
+	Orders        []OrdersListSellerOrdersResOrder `json:"orders"`
+}
 
-// OrdersProcessYoomoneyPaymentReqNotificationType defines model for OrdersProcessYoomoneyPaymentReq.NotificationType.
-type OrdersProcessYoomoneyPaymentReqNotificationType string
+// OrdersListSellerOrdersResOrder defines model for OrdersListSellerOrdersResOrder.
+type OrdersListSellerOrdersResOrder struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
 
-// OrdersProcessYoomoneyPaymentRes defines model for OrdersProcessYoomoneyPaymentRes.
-type OrdersProcessYoomoneyPaymentRes = map[string]interface{}
+	// Items seller items of the order
+	Items    []OrdersListOrdersResItem `json:"items"`
+	Shipment OrdersGetOrderResShipment `json:"shipment"`
 
-// OrdersUpdateOrderReq defines model for OrdersUpdateOrderReq.
-type OrdersUpdateOrderReq struct {
+	// Status order status
 	Status string `json:"status"`
+	UserId string `json:"user_id"`
 }
 
-// OrdersUpdateOrderRes defines model for OrdersUpdateOrderRes.
-type OrdersUpdateOrderRes struct {
-	Status    string `json:"status"`
-	UpdatedAt string `json:"updated_at"`
+// OrdersPayment how to pay for the order, present only while the order awaits payment
+type OrdersPayment struct {
+	// Amount amount left to pay
+	Amount          float64                 `json:"amount"`
+	Checkouts       []OrdersPaymentCheckout `json:"checkouts"`
+	CurrencyIso4217 int                     `json:"currency_iso_4217"`
 }
 
-// PrivateClearCartPositionsReq defines model for PrivateClearCartPositionsReq.
-type PrivateClearCartPositionsReq struct {
-	Messages []PrivateClearCartPositionsReqMessage `json:"messages"`
-}
+// OrdersPaymentCheckout defines model for OrdersPaymentCheckout.
+type OrdersPaymentCheckout struct {
+	// Form form to submit instead of following the checkout link
+	Form     *OrdersPaymentCheckoutForm `json:"form,omitempty"`
+	Provider string                     `json:"provider"`
 
-// PrivateClearCartPositionsReqMessage defines model for PrivateClearCartPositionsReqMessage.
-type PrivateClearCartPositionsReqMessage struct {
-	UserId string `json:"user_id"`
+	// Url link to pay for the order with
+	Url string `json:"url"`
 }
 
-// PrivateClearCartPositionsRes defines model for PrivateClearCartPositionsRes.
-type PrivateClearCartPositionsRes = map[string]interface{}
+// OrdersPaymentCheckoutForm form to submit instead of following the checkout link
+type OrdersPaymentCheckoutForm struct {
+	Action string            `json:"action"`
+	Method string            `json:"method"`
+	Params map[string]string `json:"params"`
+}
 
-// PrivateOrderBatchCancelUnpaidOrdersReq defines model for PrivateOrderBatchCancelUnpaidOrdersReq.
-type PrivateOrderBatchCancelUnpaidOrdersReq = map[string]interface{}
+// OrdersPreviewCouponReq defines model for OrdersPreviewCouponReq.
+type OrdersPreviewCouponReq struct {
+	Items []OrdersPreviewCouponReqItem `json:"items"`
+}
 
-// PrivateOrderBatchCancelUnpaidOrdersRes defines model for PrivateOrderBatchCancelUnpaidOrdersRes.
-type PrivateOrderBatchCancelUnpaidOrdersRes = map[string]interface{}
+// OrdersPreviewCouponReqItem defines model for OrdersPreviewCouponReqItem.
+type OrdersPreviewCouponReqItem struct {
+	Count int `json:"count"`
 
-// PrivateOrderCancelOperationsReq defines model for PrivateOrderCancelOperationsReq.
-type PrivateOrderCancelOperationsReq struct {
-	Messages []PrivateOrderCancelOperationsReqMessage `json:"messages"`
+	// Price price of the product or its sku
+	Price     float64 `json:"price"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
-// PrivateOrderCancelOperationsReqMessage defines model for PrivateOrderCancelOperationsReqMessage.
-type PrivateOrderCancelOperationsReqMessage struct {
-	Details     string `json:"details"`
-	OperationId string `json:"operation_id"`
-}
+// OrdersProcessCarrierEventsReq tracking events webhook, its format is specific to the carrier
+type OrdersProcessCarrierEventsReq = map[string]interface{}
 
-// PrivateOrderCancelOperationsRes defines model for PrivateOrderCancelOperationsRes.
-type PrivateOrderCancelOperationsRes = map[string]interface{}
+// OrdersProcessCarrierEventsRes defines model for OrdersProcessCarrierEventsRes.
+type OrdersProcessCarrierEventsRes = map[string]interface{}
 
-// PrivateOrderProcessPaymentNotificationsReq defines model for PrivateOrderProcessPaymentNotificationsReq.
-type PrivateOrderProcessPaymentNotificationsReq struct {
-	Messages []PrivateOrderProcessPaymentNotificationsReqMessage `json:"messages"`
-}
+// OrdersProcessPaymentReq payment notification, its format is specific to the payment provider
+type OrdersProcessPaymentReq = map[string]interface{}
 
-// PrivateOrderProcessPaymentNotificationsReqMessage defines model for PrivateOrderProcessPaymentNotificationsReqMessage.
-type PrivateOrderProcessPaymentNotificationsReqMessage struct {
-	Amount          float64                `json:"amount"`
-	CurrencyIso4217 int                    `json:"currency_iso_4217"`
-	Datetime        time.Time              `json:"datetime"`
-	OrderId         string                 `json:"order_id"`
-	ProviderMeta    map[string]interface{} `json:"provider_meta"`
-}
+// OrdersProcessPaymentRes defines model for OrdersProcessPaymentRes.
+type OrdersProcessPaymentRes = map[string]interface{}
 
-// PrivateOrderProcessPaymentNotificationsRes defines model for PrivateOrderProcessPaymentNotificationsRes.
-type PrivateOrderProcessPaymentNotificationsRes = map[string]interface{}
+// OrdersReturn defines model for OrdersReturn.
+type OrdersReturn struct {
+	// AllowedTransitions statuses the requesting subject may set with return update
+	AllowedTransitions []string `json:"allowed_transitions"`
 
-// PrivateOrderProcessPublishedCartPositionsReq defines model for PrivateOrderProcessPublishedCartPositionsReq.
-type PrivateOrderProcessPublishedCartPositionsReq struct {
-	Messages []PrivateOrderProcessPublishedCartPositionsReqMessage `json:"messages"`
+	// Comment seller comment on the return resolution
+	Comment   *string             `json:"comment,omitempty"`
+	CreatedAt string              `json:"created_at"`
+	Id        string              `json:"id"`
+	Items     []OrdersReturnItem  `json:"items"`
+	OrderId   string              `json:"order_id"`
+	Photos    []OrdersReturnPhoto `json:"photos"`
+	Reason    string              `json:"reason"`
+
+	// RefundAmount amount refunded to the buyer once the seller receives returned items
+	RefundAmount float64 `json:"refund_amount"`
+	SellerId     string  `json:"seller_id"`
+	Status       string  `json:"status"`
+	UpdatedAt    string  `json:"updated_at"`
+	UserId       string  `json:"user_id"`
 }
 
-// PrivateOrderProcessPublishedCartPositionsReqCartPosition defines model for PrivateOrderProcessPublishedCartPositionsReqCartPosition.
-type PrivateOrderProcessPublishedCartPositionsReqCartPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+// OrdersReturnItem defines model for OrdersReturnItem.
+type OrdersReturnItem struct {
+	Count     int     `json:"count"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
-// PrivateOrderProcessPublishedCartPositionsReqMessage defines model for PrivateOrderProcessPublishedCartPositionsReqMessage.
-type PrivateOrderProcessPublishedCartPositionsReqMessage struct {
-	CartPositions []PrivateOrderProcessPublishedCartPositionsReqCartPosition `json:"cart_positions"`
-	OperationId   string                                                     `json:"operation_id"`
+// OrdersReturnPhoto defines model for OrdersReturnPhoto.
+type OrdersReturnPhoto struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
 }
 
-// PrivateOrderProcessPublishedCartPositionsRes defines model for PrivateOrderProcessPublishedCartPositionsRes.
+// OrdersUpdateOrderReq defines model for OrdersUpdateOrderReq.
+type OrdersUpdateOrderReq struct {
+	// Reason recorded in the order status history; required to complete cancellation of an order whose payments are not refunded (the manual refund reason)
+	Reason *string `json:"reason,omitempty"`
+	Status string  `json:"status"`
+}
+
+// OrdersUpdateOrderRes defines model for OrdersUpdateOrderRes.
+type OrdersUpdateOrderRes struct {
+	Status    string `json:"status"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// OrdersUpdateReturnReq defines model for OrdersUpdateReturnReq.
+type OrdersUpdateReturnReq struct {
+	// Comment seller comment, required to reject the return
+	Comment *string `json:"comment,omitempty"`
+	Status  string  `json:"status"`
+}
+
+// OrdersUpdateShipmentReq defines model for OrdersUpdateShipmentReq.
+type OrdersUpdateShipmentReq struct {
+	// Carrier required to ship the shipment unless the order is picked up
+	Carrier *string `json:"carrier,omitempty"`
+	Status  string  `json:"status"`
+
+	// TrackingNumber required to ship the shipment unless the order is picked up
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+}
+
+// OrdersUpdateShipmentRes defines model for OrdersUpdateShipmentRes.
+type OrdersUpdateShipmentRes struct {
+	Carrier *string `json:"carrier,omitempty"`
+	OrderId string  `json:"order_id"`
+
+	// OrderStatus order status derived from its shipments
+	OrderStatus    string  `json:"order_status"`
+	SellerId       string  `json:"seller_id"`
+	Status         string  `json:"status"`
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// OrdersUploadReturnPhotoRes defines model for OrdersUploadReturnPhotoRes.
+type OrdersUploadReturnPhotoRes struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
+// PrivateApplyAdCampaignsReq defines model for PrivateApplyAdCampaignsReq.
+type PrivateApplyAdCampaignsReq = map[string]interface{}
+
+// PrivateApplyAdCampaignsRes defines model for PrivateApplyAdCampaignsRes.
+type PrivateApplyAdCampaignsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductRatingsReq defines model for PrivateCatalogSyncProductRatingsReq.
+type PrivateCatalogSyncProductRatingsReq struct {
+	Messages []PrivateCatalogSyncProductRatingsReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductRatingsReqMessage defines model for PrivateCatalogSyncProductRatingsReqMessage.
+type PrivateCatalogSyncProductRatingsReqMessage struct {
+	ProductId    string  `json:"product_id"`
+	Rating       float64 `json:"rating"`
+	ReviewsCount int     `json:"reviews_count"`
+
+	// Version version of the product rating, ratings older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductRatingsRes defines model for PrivateCatalogSyncProductRatingsRes.
+type PrivateCatalogSyncProductRatingsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsAdBoostReq defines model for PrivateCatalogSyncProductsAdBoostReq.
+type PrivateCatalogSyncProductsAdBoostReq struct {
+	Messages []PrivateCatalogSyncProductsAdBoostReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsAdBoostReqMessage defines model for PrivateCatalogSyncProductsAdBoostReqMessage.
+type PrivateCatalogSyncProductsAdBoostReqMessage struct {
+	AdBoost   float64 `json:"ad_boost"`
+	ProductId string  `json:"product_id"`
+
+	// Version time the ad boost was computed at in unix milliseconds, ad boosts older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductsAdBoostRes defines model for PrivateCatalogSyncProductsAdBoostRes.
+type PrivateCatalogSyncProductsAdBoostRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsPurchasesReq defines model for PrivateCatalogSyncProductsPurchasesReq.
+type PrivateCatalogSyncProductsPurchasesReq struct {
+	Messages []PrivateCatalogSyncProductsPurchasesReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsPurchasesReqMessage defines model for PrivateCatalogSyncProductsPurchasesReqMessage.
+type PrivateCatalogSyncProductsPurchasesReqMessage struct {
+	ProductId        string `json:"product_id"`
+	Purchases30d     int    `json:"purchases_30d"`
+	PurchasesAlltime int    `json:"purchases_alltime"`
+}
+
+// PrivateCatalogSyncProductsPurchasesRes defines model for PrivateCatalogSyncProductsPurchasesRes.
+type PrivateCatalogSyncProductsPurchasesRes = map[string]interface{}
+
+// PrivateClearCartPositionsReq defines model for PrivateClearCartPositionsReq.
+type PrivateClearCartPositionsReq struct {
+	Messages []PrivateClearCartPositionsReqMessage `json:"messages"`
+}
+
+// PrivateClearCartPositionsReqMessage defines model for PrivateClearCartPositionsReqMessage.
+type PrivateClearCartPositionsReqMessage struct {
+	// OrderId the order the cart is cleared for, the cart is cleared once per order; messages without order id are always applied
+	OrderId *string `json:"order_id,omitempty"`
+	UserId  string  `json:"user_id"`
+}
+
+// PrivateClearCartPositionsRes defines model for PrivateClearCartPositionsRes.
+type PrivateClearCartPositionsRes = map[string]interface{}
+
+// PrivateFeedbackProcessCompletedOrderReq defines model for PrivateFeedbackProcessCompletedOrderReq.
+type PrivateFeedbackProcessCompletedOrderReq struct {
+	Messages []PrivateFeedbackProcessCompletedOrderReqMessage `json:"messages"`
+}
+
+// PrivateFeedbackProcessCompletedOrderReqMessage defines model for PrivateFeedbackProcessCompletedOrderReqMessage.
+type PrivateFeedbackProcessCompletedOrderReqMessage struct {
+	OrderId  string                                           `json:"order_id"`
+	Products []PrivateFeedbackProcessCompletedOrderReqProduct `json:"products"`
+	UserId   string                                           `json:"user_id"`
+}
+
+// PrivateFeedbackProcessCompletedOrderReqProduct defines model for PrivateFeedbackProcessCompletedOrderReqProduct.
+type PrivateFeedbackProcessCompletedOrderReqProduct struct {
+	Id string `json:"id"`
+}
+
+// PrivateFeedbackProcessCompletedOrderRes defines model for PrivateFeedbackProcessCompletedOrderRes.
+type PrivateFeedbackProcessCompletedOrderRes = map[string]interface{}
+
+// PrivateOrderBatchCancelUnpaidOrdersReq defines model for PrivateOrderBatchCancelUnpaidOrdersReq.
+type PrivateOrderBatchCancelUnpaidOrdersReq = map[string]interface{}
+
+// PrivateOrderBatchCancelUnpaidOrdersRes defines model for PrivateOrderBatchCancelUnpaidOrdersRes.
+type PrivateOrderBatchCancelUnpaidOrdersRes = map[string]interface{}
+
+// PrivateOrderBatchCompleteDeliveredOrdersReq defines model for PrivateOrderBatchCompleteDeliveredOrdersReq.
+type PrivateOrderBatchCompleteDeliveredOrdersReq = map[string]interface{}
+
+// PrivateOrderBatchCompleteDeliveredOrdersRes defines model for PrivateOrderBatchCompleteDeliveredOrdersRes.
+type PrivateOrderBatchCompleteDeliveredOrdersRes = map[string]interface{}
+
+// PrivateOrderCancelOperationsReq defines model for PrivateOrderCancelOperationsReq.
+type PrivateOrderCancelOperationsReq struct {
+	Messages []PrivateOrderCancelOperationsReqMessage `json:"messages"`
+}
+
+// PrivateOrderCancelOperationsReqMessage defines model for PrivateOrderCancelOperationsReqMessage.
+type PrivateOrderCancelOperationsReqMessage struct {
+	Details     string `json:"details"`
+	OperationId string `json:"operation_id"`
+}
+
+// PrivateOrderCancelOperationsRes defines model for PrivateOrderCancelOperationsRes.
+type PrivateOrderCancelOperationsRes = map[string]interface{}
+
+// PrivateOrderProcessPaymentNotificationsReq defines model for PrivateOrderProcessPaymentNotificationsReq.
+type PrivateOrderProcessPaymentNotificationsReq struct {
+	Messages []PrivateOrderProcessPaymentNotificationsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessPaymentNotificationsReqMessage defines model for PrivateOrderProcessPaymentNotificationsReqMessage.
+type PrivateOrderProcessPaymentNotificationsReqMessage struct {
+	Amount          float64   `json:"amount"`
+	CurrencyIso4217 int       `json:"currency_iso_4217"`
+	Datetime        time.Time `json:"datetime"`
+	OrderId         string    `json:"order_id"`
+
+	// PaymentId payment id derived from the provider operation id, duplicate notifications share it
+	PaymentId    *string                `json:"payment_id,omitempty"`
+	ProviderMeta map[string]interface{} `json:"provider_meta"`
+}
+
+// PrivateOrderProcessPaymentNotificationsRes defines model for PrivateOrderProcessPaymentNotificationsRes.
+type PrivateOrderProcessPaymentNotificationsRes = map[string]interface{}
+
+// PrivateOrderProcessPublishedCartPositionsReq defines model for PrivateOrderProcessPublishedCartPositionsReq.
+type PrivateOrderProcessPublishedCartPositionsReq struct {
+	Messages []PrivateOrderProcessPublishedCartPositionsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsReqCartPosition defines model for PrivateOrderProcessPublishedCartPositionsReqCartPosition.
+type PrivateOrderProcessPublishedCartPositionsReqCartPosition struct {
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsReqMessage defines model for PrivateOrderProcessPublishedCartPositionsReqMessage.
+type PrivateOrderProcessPublishedCartPositionsReqMessage struct {
+	CartPositions []PrivateOrderProcessPublishedCartPositionsReqCartPosition `json:"cart_positions"`
+	OperationId   string                                                     `json:"operation_id"`
+
+	// PromoCode promo code applied to the cart, orders of the operation are discounted with its coupon
+	PromoCode *string `json:"promo_code,omitempty"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsRes defines model for PrivateOrderProcessPublishedCartPositionsRes.
 type PrivateOrderProcessPublishedCartPositionsRes = map[string]interface{}
 
+// PrivateOrderProcessRefundsReq defines model for PrivateOrderProcessRefundsReq.
+type PrivateOrderProcessRefundsReq = map[string]interface{}
+
+// PrivateOrderProcessRefundsRes defines model for PrivateOrderProcessRefundsRes.
+type PrivateOrderProcessRefundsRes = map[string]interface{}
+
 // PrivateOrderProcessReservedProductsReq defines model for PrivateOrderProcessReservedProductsReq.
 type PrivateOrderProcessReservedProductsReq struct {
 	Messages []PrivateOrderProcessReservedProductsReqMessage `json:"messages"`
@@ -417,6 +1198,9 @@ type PrivateOrderProcessReservedProductsReqProduct struct {
 	Picture  *string `json:"picture,omitempty"`
 	Price    float64 `json:"price"`
 	SellerId string  `json:"seller_id"`
+
+	// SkuId reserved sku, price and picture are the ones of the sku
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateOrderProcessReservedProductsRes defines model for PrivateOrderProcessReservedProductsRes.
@@ -430,11 +1214,20 @@ type PrivateOrderProcessUnreservedProductsReq struct {
 // PrivateOrderProcessUnreservedProductsReqMessage defines model for PrivateOrderProcessUnreservedProductsReqMessage.
 type PrivateOrderProcessUnreservedProductsReqMessage struct {
 	OrderId string `json:"order_id"`
+
+	// ReturnId return whose received items are restocked, absent for cancelled orders
+	ReturnId *string `json:"return_id,omitempty"`
 }
 
 // PrivateOrderProcessUnreservedProductsRes defines model for PrivateOrderProcessUnreservedProductsRes.
 type PrivateOrderProcessUnreservedProductsRes = map[string]interface{}
 
+// PrivateOrderPublishProductsPurchasesStatsReq defines model for PrivateOrderPublishProductsPurchasesStatsReq.
+type PrivateOrderPublishProductsPurchasesStatsReq = map[string]interface{}
+
+// PrivateOrderPublishProductsPurchasesStatsRes defines model for PrivateOrderPublishProductsPurchasesStatsRes.
+type PrivateOrderPublishProductsPurchasesStatsRes = map[string]interface{}
+
 // PrivatePublishCartPositionsReq defines model for PrivatePublishCartPositionsReq.
 type PrivatePublishCartPositionsReq struct {
 	Messages []PrivatePublishCartPositionsReqMessage `json:"messages"`
@@ -449,6 +1242,24 @@ type PrivatePublishCartPositionsReqMessage struct {
 // PrivatePublishCartPositionsRes defines model for PrivatePublishCartPositionsRes.
 type PrivatePublishCartPositionsRes = map[string]interface{}
 
+// PrivateRelayOutboxReq defines model for PrivateRelayOutboxReq.
+type PrivateRelayOutboxReq = map[string]interface{}
+
+// PrivateRelayOutboxRes defines model for PrivateRelayOutboxRes.
+type PrivateRelayOutboxRes struct {
+	// Relayed Number of messages published to topics
+	Relayed int `json:"relayed"`
+}
+
+// PrivateReleaseExpiredReservationsReq defines model for PrivateReleaseExpiredReservationsReq.
+type PrivateReleaseExpiredReservationsReq = map[string]interface{}
+
+// PrivateReleaseExpiredReservationsRes defines model for PrivateReleaseExpiredReservationsRes.
+type PrivateReleaseExpiredReservationsRes struct {
+	// Released Number of released reservation holds
+	Released int `json:"released"`
+}
+
 // PrivateReserveProductsReq defines model for PrivateReserveProductsReq.
 type PrivateReserveProductsReq struct {
 	Messages []PrivateReserveProductsReqMessage `json:"messages"`
@@ -456,19 +1267,39 @@ type PrivateReserveProductsReq struct {
 
 // PrivateReserveProductsReqMessage defines model for PrivateReserveProductsReqMessage.
 type PrivateReserveProductsReqMessage struct {
-	OperationId string                             `json:"operation_id"`
-	Products    []PrivateReserveProductsReqProduct `json:"products"`
+	OperationId string `json:"operation_id"`
+
+	// OrderId id of the order to be created of the reserved products, products are held for it until it's paid
+	OrderId  string                             `json:"order_id"`
+	Products []PrivateReserveProductsReqProduct `json:"products"`
 }
 
 // PrivateReserveProductsReqProduct defines model for PrivateReserveProductsReqProduct.
 type PrivateReserveProductsReqProduct struct {
 	Count int    `json:"count"`
 	Id    string `json:"id"`
+
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateReserveProductsRes defines model for PrivateReserveProductsRes.
 type PrivateReserveProductsRes = map[string]interface{}
 
+// PrivateSellProductsReq defines model for PrivateSellProductsReq.
+type PrivateSellProductsReq struct {
+	Messages []PrivateSellProductsReqMessage `json:"messages"`
+}
+
+// PrivateSellProductsReqMessage defines model for PrivateSellProductsReqMessage.
+type PrivateSellProductsReqMessage struct {
+	// OrderId paid order whose reserved products are sold
+	OrderId string `json:"order_id"`
+}
+
+// PrivateSellProductsRes defines model for PrivateSellProductsRes.
+type PrivateSellProductsRes = map[string]interface{}
+
 // PrivateUnreserveProductsReq defines model for PrivateUnreserveProductsReq.
 type PrivateUnreserveProductsReq struct {
 	Messages []PrivateUnreserveProductsReqMessage `json:"messages"`
@@ -478,17 +1309,41 @@ type PrivateUnreserveProductsReq struct {
 type PrivateUnreserveProductsReqMessage struct {
 	OrderId  string                               `json:"order_id"`
 	Products []PrivateUnreserveProductsReqProduct `json:"products"`
+
+	// ReturnId return whose received items are restocked, absent for cancelled orders
+	ReturnId *string `json:"return_id,omitempty"`
 }
 
 // PrivateUnreserveProductsReqProduct defines model for PrivateUnreserveProductsReqProduct.
 type PrivateUnreserveProductsReqProduct struct {
 	Count int    `json:"count"`
 	Id    string `json:"id"`
+
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateUnreserveProductsRes defines model for PrivateUnreserveProductsRes.
 type PrivateUnreserveProductsRes = map[string]interface{}
 
+// ProductCategory defines model for ProductCategory.
+type ProductCategory struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+
+	// ParentId id of the parent category, absent for root categories
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Path slugs of the category ancestors and the category joined with "/", i.e. "flowers/roses"
+	Path string `json:"path"`
+
+	// Position position of the category among its siblings
+	Position  int    `json:"position"`
+	Slug      string `json:"slug"`
+	UpdatedAt string `json:"updated_at"`
+}
+
 // ReplaceRefreshTokenReq defines model for ReplaceRefreshTokenReq.
 type ReplaceRefreshTokenReq struct {
 	RefreshToken string `json:"refresh_token"`
@@ -500,28 +1355,63 @@ type ReplaceRefreshTokenRes struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// UpdateProductCategoryReq defines model for UpdateProductCategoryReq.
+type UpdateProductCategoryReq struct {
+	Name *string `json:"name,omitempty"`
+
+	// ParentId id of the new parent category, empty string moves the category to the root
+	ParentId *string `json:"parent_id,omitempty"`
+	Position *int    `json:"position,omitempty"`
+	Slug     *string `json:"slug,omitempty"`
+}
+
+// UpdateProductCategoryRes defines model for UpdateProductCategoryRes.
+type UpdateProductCategoryRes = ProductCategory
+
 // UpdateProductReq defines model for UpdateProductReq.
 type UpdateProductReq struct {
+	// CategoryId id of the product category
+	CategoryId  *string                 `json:"category_id,omitempty"`
 	Description *string                 `json:"description,omitempty"`
 	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
 	Name        *string                 `json:"name,omitempty"`
-	Price       *float64                `json:"price,omitempty"`
+
+	// Options option axes of the product variants, values of existing skus must stay valid
+	Options *[]GetProductResOption `json:"options,omitempty"`
+	Price   *float64               `json:"price,omitempty"`
 
 	// StockDelta The amount of "in stock" product count change, either of:
 	// - positive: stock amount is increased (seller releases more products)
 	// - negative: stock amount is decreased (item purchased)
+	//
+	// Stock of the product with skus is changed with sku updates.
 	StockDelta *int `json:"stock_delta,omitempty"`
 }
 
 // UpdateProductRes defines model for UpdateProductRes.
 type UpdateProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                 `json:"category_id,omitempty"`
 	Description *string                 `json:"description,omitempty"`
 	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
 	Name        *string                 `json:"name,omitempty"`
+	Options     *[]GetProductResOption  `json:"options,omitempty"`
 	Price       *float64                `json:"price,omitempty"`
 	Stock       *int                    `json:"stock,omitempty"`
 }
 
+// UpdateProductSkuReq defines model for UpdateProductSkuReq.
+type UpdateProductSkuReq struct {
+	PictureIds *[]string `json:"picture_ids,omitempty"`
+	Price      *float64  `json:"price,omitempty"`
+
+	// StockDelta The amount of sku stock change, negative values withdraw products from stock
+	StockDelta *int `json:"stock_delta,omitempty"`
+}
+
+// UpdateProductSkuRes defines model for UpdateProductSkuRes.
+type UpdateProductSkuRes = GetProductResSku
+
 // UploadProductPictureRes defines model for UploadProductPictureRes.
 type UploadProductPictureRes struct {
 	Id  string `json:"id"`
@@ -539,9 +1429,24 @@ type OrdersListOrdersParams struct {
 	NextPageToken *string `form:"next_page_token,omitempty" json:"next_page_token,omitempty"`
 }
 
+// OrdersUploadReturnPhotoMultipartBody defines parameters for OrdersUploadReturnPhoto.
+type OrdersUploadReturnPhotoMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// OrdersListSellerOrdersParams defines parameters for OrdersListSellerOrders.
+type OrdersListSellerOrdersParams struct {
+	// Status shipment statuses to filter by, all statuses if omitted
+	Status        *[]string `form:"status,omitempty" json:"status,omitempty"`
+	NextPageToken *string   `form:"next_page_token,omitempty" json:"next_page_token,omitempty"`
+}
+
 // PrivateOrdersBatchCancelUnpaidOrdersJSONRequestBody defines body for PrivateOrdersBatchCancelUnpaidOrders for application/json ContentType.
 type PrivateOrdersBatchCancelUnpaidOrdersJSONRequestBody = PrivateOrderBatchCancelUnpaidOrdersReq
 
+// PrivateOrdersBatchCompleteDeliveredOrdersJSONRequestBody defines body for PrivateOrdersBatchCompleteDeliveredOrders for application/json ContentType.
+type PrivateOrdersBatchCompleteDeliveredOrdersJSONRequestBody = PrivateOrderBatchCompleteDeliveredOrdersReq
+
 // PrivateOrdersCancelOperationsJSONRequestBody defines body for PrivateOrdersCancelOperations for application/json ContentType.
 type PrivateOrdersCancelOperationsJSONRequestBody = PrivateOrderCancelOperationsReq
 
@@ -551,23 +1456,69 @@ type PrivateOrdersProcessPaymentNotificationsJSONRequestBody = PrivateOrderProce
 // PrivateOrdersProcessPublishedCartPositionsJSONRequestBody defines body for PrivateOrdersProcessPublishedCartPositions for application/json ContentType.
 type PrivateOrdersProcessPublishedCartPositionsJSONRequestBody = PrivateOrderProcessPublishedCartPositionsReq
 
+// PrivateOrdersProcessRefundsJSONRequestBody defines body for PrivateOrdersProcessRefunds for application/json ContentType.
+type PrivateOrdersProcessRefundsJSONRequestBody = PrivateOrderProcessRefundsReq
+
 // PrivateOrdersProcessReservedProductsJSONRequestBody defines body for PrivateOrdersProcessReservedProducts for application/json ContentType.
 type PrivateOrdersProcessReservedProductsJSONRequestBody = PrivateOrderProcessReservedProductsReq
 
 // PrivateOrdersProcessUnreservedProductsJSONRequestBody defines body for PrivateOrdersProcessUnreservedProducts for application/json ContentType.
 type PrivateOrdersProcessUnreservedProductsJSONRequestBody = PrivateOrderProcessUnreservedProductsReq
 
+// PrivateOrdersPublishProductsPurchasesStatsJSONRequestBody defines body for PrivateOrdersPublishProductsPurchasesStats for application/json ContentType.
+type PrivateOrdersPublishProductsPurchasesStatsJSONRequestBody = PrivateOrderPublishProductsPurchasesStatsReq
+
+// PrivateOrdersRelayOutboxJSONRequestBody defines body for PrivateOrdersRelayOutbox for application/json ContentType.
+type PrivateOrdersRelayOutboxJSONRequestBody = PrivateRelayOutboxReq
+
+// OrdersProcessCarrierEventsJSONRequestBody defines body for OrdersProcessCarrierEvents for application/json ContentType.
+type OrdersProcessCarrierEventsJSONRequestBody = OrdersProcessCarrierEventsReq
+
+// OrdersCreateCouponJSONRequestBody defines body for OrdersCreateCoupon for application/json ContentType.
+type OrdersCreateCouponJSONRequestBody = OrdersCreateCouponReq
+
+// OrdersPreviewCouponJSONRequestBody defines body for OrdersPreviewCoupon for application/json ContentType.
+type OrdersPreviewCouponJSONRequestBody = OrdersPreviewCouponReq
+
+// OrdersCreateOrderJSONRequestBody defines body for OrdersCreateOrder for application/json ContentType.
+type OrdersCreateOrderJSONRequestBody = OrdersCreateOrderReq
+
 // OrdersUpdateOrderJSONRequestBody defines body for OrdersUpdateOrder for application/json ContentType.
 type OrdersUpdateOrderJSONRequestBody = OrdersUpdateOrderReq
 
-// OrdersProcessPaymentYoomoneyFormdataRequestBody defines body for OrdersProcessPaymentYoomoney for application/x-www-form-urlencoded ContentType.
-type OrdersProcessPaymentYoomoneyFormdataRequestBody = OrdersProcessYoomoneyPaymentReq
+// OrdersCreateReturnJSONRequestBody defines body for OrdersCreateReturn for application/json ContentType.
+type OrdersCreateReturnJSONRequestBody = OrdersCreateReturnReq
+
+// OrdersUpdateReturnJSONRequestBody defines body for OrdersUpdateReturn for application/json ContentType.
+type OrdersUpdateReturnJSONRequestBody = OrdersUpdateReturnReq
+
+// OrdersUploadReturnPhotoMultipartRequestBody defines body for OrdersUploadReturnPhoto for multipart/form-data ContentType.
+type OrdersUploadReturnPhotoMultipartRequestBody OrdersUploadReturnPhotoMultipartBody
+
+// OrdersUpdateShipmentJSONRequestBody defines body for OrdersUpdateShipment for application/json ContentType.
+type OrdersUpdateShipmentJSONRequestBody = OrdersUpdateShipmentReq
+
+// OrdersProcessPaymentJSONRequestBody defines body for OrdersProcessPayment for application/json ContentType.
+type OrdersProcessPaymentJSONRequestBody = OrdersProcessPaymentReq
+
+// OrdersProcessPaymentFormdataRequestBody defines body for OrdersProcessPayment for application/x-www-form-urlencoded ContentType.
+type OrdersProcessPaymentFormdataRequestBody = OrdersProcessPaymentReq
+
+// OrdersCreateAddressJSONRequestBody defines body for OrdersCreateAddress for application/json ContentType.
+type OrdersCreateAddressJSONRequestBody = OrdersAddressReq
+
+// OrdersUpdateAddressJSONRequestBody defines body for OrdersUpdateAddress for application/json ContentType.
+type OrdersUpdateAddressJSONRequestBody = OrdersAddressReq
 
 // Method & Path constants for routes.
 // Batch cancel unpaid orders
 const PrivateOrdersBatchCancelUnpaidOrdersMethod = "POST"
 const PrivateOrdersBatchCancelUnpaidOrdersPath = "/api/private/v1/order/batch-cancel-unpaid-orders"
 
+// Batch complete delivered orders
+const PrivateOrdersBatchCompleteDeliveredOrdersMethod = "POST"
+const PrivateOrdersBatchCompleteDeliveredOrdersPath = "/api/private/v1/order/batch-complete-delivered-orders"
+
 // Cancel order operations
 const PrivateOrdersCancelOperationsMethod = "POST"
 const PrivateOrdersCancelOperationsPath = "/api/private/v1/order/operations/cancel"
@@ -580,6 +1531,10 @@ const PrivateOrdersProcessPaymentNotificationsPath = "/api/private/v1/order/proc
 const PrivateOrdersProcessPublishedCartPositionsMethod = "POST"
 const PrivateOrdersProcessPublishedCartPositionsPath = "/api/private/v1/order/process-published-cart-positions"
 
+// Process refunds
+const PrivateOrdersProcessRefundsMethod = "POST"
+const PrivateOrdersProcessRefundsPath = "/api/private/v1/order/process-refunds"
+
 // Process reserved products
 const PrivateOrdersProcessReservedProductsMethod = "POST"
 const PrivateOrdersProcessReservedProductsPath = "/api/private/v1/order/process-reserved-products"
@@ -588,6 +1543,38 @@ const PrivateOrdersProcessReservedProductsPath = "/api/private/v1/order/process-
 const PrivateOrdersProcessUnreservedProductsMethod = "POST"
 const PrivateOrdersProcessUnreservedProductsPath = "/api/private/v1/order/process-unreserved-products"
 
+// Publish products purchases stats
+const PrivateOrdersPublishProductsPurchasesStatsMethod = "POST"
+const PrivateOrdersPublishProductsPurchasesStatsPath = "/api/private/v1/order/publish-products-purchases-stats"
+
+// Relay outbox
+const PrivateOrdersRelayOutboxMethod = "POST"
+const PrivateOrdersRelayOutboxPath = "/api/private/v1/order/relay-outbox"
+
+// Process carrier tracking events
+const OrdersProcessCarrierEventsMethod = "POST"
+const OrdersProcessCarrierEventsPath = "/api/v1/order/carrier-events/:carrier"
+
+// List coupons
+const OrdersListCouponsMethod = "GET"
+const OrdersListCouponsPath = "/api/v1/order/coupons"
+
+// Create coupon
+const OrdersCreateCouponMethod = "POST"
+const OrdersCreateCouponPath = "/api/v1/order/coupons"
+
+// Delete coupon
+const OrdersDeleteCouponMethod = "DELETE"
+const OrdersDeleteCouponPath = "/api/v1/order/coupons/:code"
+
+// Get coupon
+const OrdersGetCouponMethod = "GET"
+const OrdersGetCouponPath = "/api/v1/order/coupons/:code"
+
+// Preview coupon
+const OrdersPreviewCouponMethod = "POST"
+const OrdersPreviewCouponPath = "/api/v1/order/coupons/:code/preview"
+
 // Get orders operation
 const OrdersGetOperationMethod = "GET"
 const OrdersGetOperationPath = "/api/v1/order/operations/:operation_id"
@@ -608,15 +1595,66 @@ const OrdersGetOrderPath = "/api/v1/order/orders/:order_id"
 const OrdersUpdateOrderMethod = "PATCH"
 const OrdersUpdateOrderPath = "/api/v1/order/orders/:order_id"
 
-// Process YooMoney Payment Notification
-const OrdersProcessPaymentYoomoneyMethod = "POST"
-const OrdersProcessPaymentYoomoneyPath = "/api/v1/order/process-payment/yoomoney"
+// List order returns
+const OrdersListReturnsMethod = "GET"
+const OrdersListReturnsPath = "/api/v1/order/orders/:order_id/returns"
+
+// Create return
+const OrdersCreateReturnMethod = "POST"
+const OrdersCreateReturnPath = "/api/v1/order/orders/:order_id/returns"
+
+// Get return
+const OrdersGetReturnMethod = "GET"
+const OrdersGetReturnPath = "/api/v1/order/orders/:order_id/returns/:return_id"
+
+// Update return
+const OrdersUpdateReturnMethod = "PATCH"
+const OrdersUpdateReturnPath = "/api/v1/order/orders/:order_id/returns/:return_id"
+
+// Upload a return photo
+const OrdersUploadReturnPhotoMethod = "POST"
+const OrdersUploadReturnPhotoPath = "/api/v1/order/orders/:order_id/returns/:return_id/photos"
+
+// Update shipment
+const OrdersUpdateShipmentMethod = "PATCH"
+const OrdersUpdateShipmentPath = "/api/v1/order/orders/:order_id/shipments/:seller_id"
+
+// Process payment provider notification
+const OrdersProcessPaymentMethod = "POST"
+const OrdersProcessPaymentPath = "/api/v1/order/process-payment/:provider"
+
+// List seller orders
+const OrdersListSellerOrdersMethod = "GET"
+const OrdersListSellerOrdersPath = "/api/v1/order/sellers/:seller_id/orders"
+
+// List addresses
+const OrdersListAddressesMethod = "GET"
+const OrdersListAddressesPath = "/api/v1/order/users/:user_id/addresses"
+
+// Create address
+const OrdersCreateAddressMethod = "POST"
+const OrdersCreateAddressPath = "/api/v1/order/users/:user_id/addresses"
+
+// Delete address
+const OrdersDeleteAddressMethod = "DELETE"
+const OrdersDeleteAddressPath = "/api/v1/order/users/:user_id/addresses/:address_id"
+
+// Get address
+const OrdersGetAddressMethod = "GET"
+const OrdersGetAddressPath = "/api/v1/order/users/:user_id/addresses/:address_id"
+
+// Update address
+const OrdersUpdateAddressMethod = "PUT"
+const OrdersUpdateAddressPath = "/api/v1/order/users/:user_id/addresses/:address_id"
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Batch cancel unpaid orders
 	// (POST /api/private/v1/order/batch-cancel-unpaid-orders)
 	PrivateOrdersBatchCancelUnpaidOrders(c *gin.Context)
+	// Batch complete delivered orders
+	// (POST /api/private/v1/order/batch-complete-delivered-orders)
+	PrivateOrdersBatchCompleteDeliveredOrders(c *gin.Context)
 	// Cancel order operations
 	// (POST /api/private/v1/order/operations/cancel)
 	PrivateOrdersCancelOperations(c *gin.Context)
@@ -626,12 +1664,39 @@ type ServerInterface interface {
 	// Process published cart positions
 	// (POST /api/private/v1/order/process-published-cart-positions)
 	PrivateOrdersProcessPublishedCartPositions(c *gin.Context)
+	// Process refunds
+	// (POST /api/private/v1/order/process-refunds)
+	PrivateOrdersProcessRefunds(c *gin.Context)
 	// Process reserved products
 	// (POST /api/private/v1/order/process-reserved-products)
 	PrivateOrdersProcessReservedProducts(c *gin.Context)
 	// Process unreserved products
 	// (POST /api/private/v1/order/process-unreserved-products)
 	PrivateOrdersProcessUnreservedProducts(c *gin.Context)
+	// Publish products purchases stats
+	// (POST /api/private/v1/order/publish-products-purchases-stats)
+	PrivateOrdersPublishProductsPurchasesStats(c *gin.Context)
+	// Relay outbox
+	// (POST /api/private/v1/order/relay-outbox)
+	PrivateOrdersRelayOutbox(c *gin.Context)
+	// Process carrier tracking events
+	// (POST /api/v1/order/carrier-events/{carrier})
+	OrdersProcessCarrierEvents(c *gin.Context, carrier string)
+	// List coupons
+	// (GET /api/v1/order/coupons)
+	OrdersListCoupons(c *gin.Context)
+	// Create coupon
+	// (POST /api/v1/order/coupons)
+	OrdersCreateCoupon(c *gin.Context)
+	// Delete coupon
+	// (DELETE /api/v1/order/coupons/{code})
+	OrdersDeleteCoupon(c *gin.Context, code string)
+	// Get coupon
+	// (GET /api/v1/order/coupons/{code})
+	OrdersGetCoupon(c *gin.Context, code string)
+	// Preview coupon
+	// (POST /api/v1/order/coupons/{code}/preview)
+	OrdersPreviewCoupon(c *gin.Context, code string)
 	// Get orders operation
 	// (GET /api/v1/order/operations/{operation_id})
 	OrdersGetOperation(c *gin.Context, operationId string)
@@ -647,9 +1712,45 @@ type ServerInterface interface {
 	// Update order
 	// (PATCH /api/v1/order/orders/{order_id})
 	OrdersUpdateOrder(c *gin.Context, orderId string)
-	// Process YooMoney Payment Notification
-	// (POST /api/v1/order/process-payment/yoomoney)
-	OrdersProcessPaymentYoomoney(c *gin.Context)
+	// List order returns
+	// (GET /api/v1/order/orders/{order_id}/returns)
+	OrdersListReturns(c *gin.Context, orderId string)
+	// Create return
+	// (POST /api/v1/order/orders/{order_id}/returns)
+	OrdersCreateReturn(c *gin.Context, orderId string)
+	// Get return
+	// (GET /api/v1/order/orders/{order_id}/returns/{return_id})
+	OrdersGetReturn(c *gin.Context, orderId string, returnId string)
+	// Update return
+	// (PATCH /api/v1/order/orders/{order_id}/returns/{return_id})
+	OrdersUpdateReturn(c *gin.Context, orderId string, returnId string)
+	// Upload a return photo
+	// (POST /api/v1/order/orders/{order_id}/returns/{return_id}/photos)
+	OrdersUploadReturnPhoto(c *gin.Context, orderId string, returnId string)
+	// Update shipment
+	// (PATCH /api/v1/order/orders/{order_id}/shipments/{seller_id})
+	OrdersUpdateShipment(c *gin.Context, orderId string, sellerId string)
+	// Process payment provider notification
+	// (POST /api/v1/order/process-payment/{provider})
+	OrdersProcessPayment(c *gin.Context, provider string)
+	// List seller orders
+	// (GET /api/v1/order/sellers/{seller_id}/orders)
+	OrdersListSellerOrders(c *gin.Context, sellerId string, params OrdersListSellerOrdersParams)
+	// List addresses
+	// (GET /api/v1/order/users/{user_id}/addresses)
+	OrdersListAddresses(c *gin.Context, userId string)
+	// Create address
+	// (POST /api/v1/order/users/{user_id}/addresses)
+	OrdersCreateAddress(c *gin.Context, userId string)
+	// Delete address
+	// (DELETE /api/v1/order/users/{user_id}/addresses/{address_id})
+	OrdersDeleteAddress(c *gin.Context, userId string, addressId string)
+	// Get address
+	// (GET /api/v1/order/users/{user_id}/addresses/{address_id})
+	OrdersGetAddress(c *gin.Context, userId string, addressId string)
+	// Update address
+	// (PUT /api/v1/order/users/{user_id}/addresses/{address_id})
+	OrdersUpdateAddress(c *gin.Context, userId string, addressId string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -674,6 +1775,19 @@ func (siw *ServerInterfaceWrapper) PrivateOrdersBatchCancelUnpaidOrders(c *gin.C
 	siw.Handler.PrivateOrdersBatchCancelUnpaidOrders(c)
 }
 
+// PrivateOrdersBatchCompleteDeliveredOrders operation middleware
+func (siw *ServerInterfaceWrapper) PrivateOrdersBatchCompleteDeliveredOrders(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PrivateOrdersBatchCompleteDeliveredOrders(c)
+}
+
 // PrivateOrdersCancelOperations operation middleware
 func (siw *ServerInterfaceWrapper) PrivateOrdersCancelOperations(c *gin.Context) {
 
@@ -713,6 +1827,19 @@ func (siw *ServerInterfaceWrapper) PrivateOrdersProcessPublishedCartPositions(c
 	siw.Handler.PrivateOrdersProcessPublishedCartPositions(c)
 }
 
+// PrivateOrdersProcessRefunds operation middleware
+func (siw *ServerInterfaceWrapper) PrivateOrdersProcessRefunds(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PrivateOrdersProcessRefunds(c)
+}
+
 // PrivateOrdersProcessReservedProducts operation middleware
 func (siw *ServerInterfaceWrapper) PrivateOrdersProcessReservedProducts(c *gin.Context) {
 
@@ -739,21 +1866,21 @@ func (siw *ServerInterfaceWrapper) PrivateOrdersProcessUnreservedProducts(c *gin
 	siw.Handler.PrivateOrdersProcessUnreservedProducts(c)
 }
 
-// OrdersGetOperation operation middleware
-func (siw *ServerInterfaceWrapper) OrdersGetOperation(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "operation_id" -------------
-	var operationId string
+// PrivateOrdersPublishProductsPurchasesStats operation middleware
+func (siw *ServerInterfaceWrapper) PrivateOrdersPublishProductsPurchasesStats(c *gin.Context) {
 
-	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", c.Param("operation_id"), &operationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operation_id: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	siw.Handler.PrivateOrdersPublishProductsPurchasesStats(c)
+}
+
+// PrivateOrdersRelayOutbox operation middleware
+func (siw *ServerInterfaceWrapper) PrivateOrdersRelayOutbox(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -762,32 +1889,190 @@ func (siw *ServerInterfaceWrapper) OrdersGetOperation(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.OrdersGetOperation(c, operationId)
+	siw.Handler.PrivateOrdersRelayOutbox(c)
 }
 
-// OrdersListOrders operation middleware
-func (siw *ServerInterfaceWrapper) OrdersListOrders(c *gin.Context) {
+// OrdersProcessCarrierEvents operation middleware
+func (siw *ServerInterfaceWrapper) OrdersProcessCarrierEvents(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params OrdersListOrdersParams
-
-	// ------------- Required query parameter "user_id" -------------
-
-	if paramValue := c.Query("user_id"); paramValue != "" {
+	// ------------- Path parameter "carrier" -------------
+	var carrier string
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+	err = runtime.BindStyledParameterWithOptions("simple", "carrier", c.Param("carrier"), &carrier, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter carrier: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersProcessCarrierEvents(c, carrier)
+}
+
+// OrdersListCoupons operation middleware
+func (siw *ServerInterfaceWrapper) OrdersListCoupons(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersListCoupons(c)
+}
+
+// OrdersCreateCoupon operation middleware
+func (siw *ServerInterfaceWrapper) OrdersCreateCoupon(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersCreateCoupon(c)
+}
+
+// OrdersDeleteCoupon operation middleware
+func (siw *ServerInterfaceWrapper) OrdersDeleteCoupon(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersDeleteCoupon(c, code)
+}
+
+// OrdersGetCoupon operation middleware
+func (siw *ServerInterfaceWrapper) OrdersGetCoupon(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersGetCoupon(c, code)
+}
+
+// OrdersPreviewCoupon operation middleware
+func (siw *ServerInterfaceWrapper) OrdersPreviewCoupon(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersPreviewCoupon(c, code)
+}
+
+// OrdersGetOperation operation middleware
+func (siw *ServerInterfaceWrapper) OrdersGetOperation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "operation_id" -------------
+	var operationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "operation_id", c.Param("operation_id"), &operationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operation_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersGetOperation(c, operationId)
+}
+
+// OrdersListOrders operation middleware
+func (siw *ServerInterfaceWrapper) OrdersListOrders(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OrdersListOrdersParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
 	// ------------- Optional query parameter "next_page_token" -------------
@@ -875,8 +2160,413 @@ func (siw *ServerInterfaceWrapper) OrdersUpdateOrder(c *gin.Context) {
 	siw.Handler.OrdersUpdateOrder(c, orderId)
 }
 
-// OrdersProcessPaymentYoomoney operation middleware
-func (siw *ServerInterfaceWrapper) OrdersProcessPaymentYoomoney(c *gin.Context) {
+// OrdersListReturns operation middleware
+func (siw *ServerInterfaceWrapper) OrdersListReturns(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "order_id", c.Param("order_id"), &orderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersListReturns(c, orderId)
+}
+
+// OrdersCreateReturn operation middleware
+func (siw *ServerInterfaceWrapper) OrdersCreateReturn(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "order_id", c.Param("order_id"), &orderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersCreateReturn(c, orderId)
+}
+
+// OrdersGetReturn operation middleware
+func (siw *ServerInterfaceWrapper) OrdersGetReturn(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "order_id", c.Param("order_id"), &orderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "return_id" -------------
+	var returnId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "return_id", c.Param("return_id"), &returnId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter return_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersGetReturn(c, orderId, returnId)
+}
+
+// OrdersUpdateReturn operation middleware
+func (siw *ServerInterfaceWrapper) OrdersUpdateReturn(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "order_id", c.Param("order_id"), &orderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "return_id" -------------
+	var returnId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "return_id", c.Param("return_id"), &returnId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter return_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersUpdateReturn(c, orderId, returnId)
+}
+
+// OrdersUploadReturnPhoto operation middleware
+func (siw *ServerInterfaceWrapper) OrdersUploadReturnPhoto(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "order_id", c.Param("order_id"), &orderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "return_id" -------------
+	var returnId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "return_id", c.Param("return_id"), &returnId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter return_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersUploadReturnPhoto(c, orderId, returnId)
+}
+
+// OrdersUpdateShipment operation middleware
+func (siw *ServerInterfaceWrapper) OrdersUpdateShipment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "order_id", c.Param("order_id"), &orderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "seller_id" -------------
+	var sellerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "seller_id", c.Param("seller_id"), &sellerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter seller_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersUpdateShipment(c, orderId, sellerId)
+}
+
+// OrdersProcessPayment operation middleware
+func (siw *ServerInterfaceWrapper) OrdersProcessPayment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersProcessPayment(c, provider)
+}
+
+// OrdersListSellerOrders operation middleware
+func (siw *ServerInterfaceWrapper) OrdersListSellerOrders(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "seller_id" -------------
+	var sellerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "seller_id", c.Param("seller_id"), &sellerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter seller_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OrdersListSellerOrdersParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "next_page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "next_page_token", c.Request.URL.Query(), &params.NextPageToken)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter next_page_token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersListSellerOrders(c, sellerId, params)
+}
+
+// OrdersListAddresses operation middleware
+func (siw *ServerInterfaceWrapper) OrdersListAddresses(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersListAddresses(c, userId)
+}
+
+// OrdersCreateAddress operation middleware
+func (siw *ServerInterfaceWrapper) OrdersCreateAddress(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersCreateAddress(c, userId)
+}
+
+// OrdersDeleteAddress operation middleware
+func (siw *ServerInterfaceWrapper) OrdersDeleteAddress(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "address_id" -------------
+	var addressId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address_id", c.Param("address_id"), &addressId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter address_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersDeleteAddress(c, userId, addressId)
+}
+
+// OrdersGetAddress operation middleware
+func (siw *ServerInterfaceWrapper) OrdersGetAddress(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "address_id" -------------
+	var addressId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address_id", c.Param("address_id"), &addressId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter address_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OrdersGetAddress(c, userId, addressId)
+}
+
+// OrdersUpdateAddress operation middleware
+func (siw *ServerInterfaceWrapper) OrdersUpdateAddress(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "address_id" -------------
+	var addressId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address_id", c.Param("address_id"), &addressId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter address_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -885,7 +2575,7 @@ func (siw *ServerInterfaceWrapper) OrdersProcessPaymentYoomoney(c *gin.Context)
 		}
 	}
 
-	siw.Handler.OrdersProcessPaymentYoomoney(c)
+	siw.Handler.OrdersUpdateAddress(c, userId, addressId)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -916,73 +2606,204 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	}
 
 	router.POST(options.BaseURL+"/api/private/v1/order/batch-cancel-unpaid-orders", wrapper.PrivateOrdersBatchCancelUnpaidOrders)
+	router.POST(options.BaseURL+"/api/private/v1/order/batch-complete-delivered-orders", wrapper.PrivateOrdersBatchCompleteDeliveredOrders)
 	router.POST(options.BaseURL+"/api/private/v1/order/operations/cancel", wrapper.PrivateOrdersCancelOperations)
 	router.POST(options.BaseURL+"/api/private/v1/order/process-payment-notifications", wrapper.PrivateOrdersProcessPaymentNotifications)
 	router.POST(options.BaseURL+"/api/private/v1/order/process-published-cart-positions", wrapper.PrivateOrdersProcessPublishedCartPositions)
+	router.POST(options.BaseURL+"/api/private/v1/order/process-refunds", wrapper.PrivateOrdersProcessRefunds)
 	router.POST(options.BaseURL+"/api/private/v1/order/process-reserved-products", wrapper.PrivateOrdersProcessReservedProducts)
 	router.POST(options.BaseURL+"/api/private/v1/order/process-unreserved-products", wrapper.PrivateOrdersProcessUnreservedProducts)
+	router.POST(options.BaseURL+"/api/private/v1/order/publish-products-purchases-stats", wrapper.PrivateOrdersPublishProductsPurchasesStats)
+	router.POST(options.BaseURL+"/api/private/v1/order/relay-outbox", wrapper.PrivateOrdersRelayOutbox)
+	router.POST(options.BaseURL+"/api/v1/order/carrier-events/:carrier", wrapper.OrdersProcessCarrierEvents)
+	router.GET(options.BaseURL+"/api/v1/order/coupons", wrapper.OrdersListCoupons)
+	router.POST(options.BaseURL+"/api/v1/order/coupons", wrapper.OrdersCreateCoupon)
+	router.DELETE(options.BaseURL+"/api/v1/order/coupons/:code", wrapper.OrdersDeleteCoupon)
+	router.GET(options.BaseURL+"/api/v1/order/coupons/:code", wrapper.OrdersGetCoupon)
+	router.POST(options.BaseURL+"/api/v1/order/coupons/:code/preview", wrapper.OrdersPreviewCoupon)
 	router.GET(options.BaseURL+"/api/v1/order/operations/:operation_id", wrapper.OrdersGetOperation)
 	router.GET(options.BaseURL+"/api/v1/order/orders", wrapper.OrdersListOrders)
 	router.POST(options.BaseURL+"/api/v1/order/orders", wrapper.OrdersCreateOrder)
 	router.GET(options.BaseURL+"/api/v1/order/orders/:order_id", wrapper.OrdersGetOrder)
 	router.PATCH(options.BaseURL+"/api/v1/order/orders/:order_id", wrapper.OrdersUpdateOrder)
-	router.POST(options.BaseURL+"/api/v1/order/process-payment/yoomoney", wrapper.OrdersProcessPaymentYoomoney)
+	router.GET(options.BaseURL+"/api/v1/order/orders/:order_id/returns", wrapper.OrdersListReturns)
+	router.POST(options.BaseURL+"/api/v1/order/orders/:order_id/returns", wrapper.OrdersCreateReturn)
+	router.GET(options.BaseURL+"/api/v1/order/orders/:order_id/returns/:return_id", wrapper.OrdersGetReturn)
+	router.PATCH(options.BaseURL+"/api/v1/order/orders/:order_id/returns/:return_id", wrapper.OrdersUpdateReturn)
+	router.POST(options.BaseURL+"/api/v1/order/orders/:order_id/returns/:return_id/photos", wrapper.OrdersUploadReturnPhoto)
+	router.PATCH(options.BaseURL+"/api/v1/order/orders/:order_id/shipments/:seller_id", wrapper.OrdersUpdateShipment)
+	router.POST(options.BaseURL+"/api/v1/order/process-payment/:provider", wrapper.OrdersProcessPayment)
+	router.GET(options.BaseURL+"/api/v1/order/sellers/:seller_id/orders", wrapper.OrdersListSellerOrders)
+	router.GET(options.BaseURL+"/api/v1/order/users/:user_id/addresses", wrapper.OrdersListAddresses)
+	router.POST(options.BaseURL+"/api/v1/order/users/:user_id/addresses", wrapper.OrdersCreateAddress)
+	router.DELETE(options.BaseURL+"/api/v1/order/users/:user_id/addresses/:address_id", wrapper.OrdersDeleteAddress)
+	router.GET(options.BaseURL+"/api/v1/order/users/:user_id/addresses/:address_id", wrapper.OrdersGetAddress)
+	router.PUT(options.BaseURL+"/api/v1/order/users/:user_id/addresses/:address_id", wrapper.OrdersUpdateAddress)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdWW/cOBL+K4J2H3YAKW0ns5OF3zJZIwh2sjGcBNhBYjRoqbqbsSQqJOW41+j/PuCh",
-	"m9TRrZad401u8ajjqyqyipTv3YDEKUkg4cw9u3cpsJQkDOQf55QSKh4CknBIuHhEaRrhAHFMksVnRhLx",
-	"Gws2ECP5NgyxeIWiC0pSoByLkVYoYuC5aeWnexfE4PIJc4jlw98prNwz92+LkqaFGpstzil1d57Ltym4",
-	"Zy6iFG3d3c5zKXzJMIXQPfuYD3lVNCPXnyHg7k40DIEFFKeCOvdMNZUD6AnE/C8yvoGEC/bgEr6MZShG",
-	"OBIPenLGKU7WgugUMfaV0NDwssmBHKPSo82L1yCTjSXzLsUU2BJxI60UVhTYZsnJDST9BNebe9XRTaS/",
-	"RJS/jABR8TCE9t4RLgjDSqejpBCQLKkKACcc1iAhllISZgFf4gH6qrT19Jg2tv8NEXAQTznJ43UXyjHC",
-	"ZVphustorPMWUmsy1JphFDvfjDJeAa+SzsarIhfQcA9mmbdURY93K2ccwdU3o5F3ddrHK4QBH2UX7Qmt",
-	"RlEbejgD34jsOYrI+hXw8SJP4I4vU7SGMlokWRSh6wjcM06z0n9XYqEia4zZVAi8UL37bSWfxWsR2SuE",
-	"fI5xsjCqxHMTFIN5TYADnlFQXr26MsmoWAAMkCMOZO8VobGI5W5IMtGhaJtk8TXQlmgkHiRZ+SBGiVBA",
-	"HF4EATD2Xsht/HrooJXEQJrGIhbJzlaSvO7VUYPi2mD9Sx9JvQbXeGnWQGIgPAaOQsRR5WU5tx2Fg1Hk",
-	"uYyT4Mbklxpi0diqElwhLx+nH3yFqMYqOZDdQ9sKt0+SFkveU8DKzHu93CvgJb8XeaexGoIoArq0MGDV",
-	"n+dmaWiXmMl/lDN5Zn0XfHeo3qtqqkaEHRTv5MQvAhnQxltRr/0rxuQCb+Dmzq76wbs+LcEBm7/WwkRS",
-	"69X5Giw9NtkO1yIEa+ixk/iBHaDeo2opV08eO7u26AZeHpew1fZNuxztb8bTOGQdisN+Amad+ZzS0Svy",
-	"EMyOMwbG0HqANuQQZXsTXbUQ8DPk/Qx5rklC02xLxCZjGNui5VDahm/qjJy1NnWe+wdmeUP2SPenDRKP",
-	"tEO1zDLLHnVpRst0htqxQ61aXW5CVbJMsnpLQ6BMhWD5PB454hkNySSZ5npbdG5yVg47lO63VUKmCwgW",
-	"JBAxq92ZIp4x4yv1g8nPdHlZz83YGEjIJgUdZe9xTlVJ+RXwQrRHCLcc4Yj9lLxN8vsZ5X6YLpz4IG/e",
-	"ovE1h9gUmTp0MqnoS1EX0leMHCh7yddkielZQkhn9ntUhKnlxg0LO8VpV4pKyVNEZfU0z+JEeomxYK4R",
-	"KR96Fyd6nmFLE8MsP8E1NbiU3h6rv2wr/zF6TLuoLygJgLE/CYlJAtsLtI2hyD81MohxDtQBoAoySiEJ",
-	"tjKfk2Sxe/bxt1+fXRlaCv45jhtoRRx8+avBF0ToGqJBXiMhHK/0mZ1lvmDQ5Ljp09THSUBi0dhzA0TD",
-	"8u8rkw/Kl01WU9mg0+UGsU27tiVeOeKVwzeIO5g5GYPQ4cQJNhDcOHwDjjR8ivnWISsnVZpwUqUgnKwd",
-	"oW9g3K0gtZ24QHev1ctTz41xUv7R7faqrHm5qk0CrCisouUq7+PBxoxpFtXngzQQHbvHZkWthtfMJqt2",
-	"dsprVLCpqBiXiynXoN0LnguKbxGH4qhQ5VDEWPnppN1wp9g19xudAewLwcWkLeY8987naM1UrJEzLVGK",
-	"3asert+UucoRzA/2vXnDqeg1mMOAkSQ4f0c82LxESQDRhyRFOMxj05cjjHkAnWq4Yh86IzRt08+Czr7J",
-	"xxbG7ZvtnljV7f7zgSdk8QCs6Kiho8V/KxFpbth0UzIfgobRMbI+vM/qbokZWf769PS5efsyfmnXmQxK",
-	"KbnF4n0MxsqMaSvXWM+0CW8OW6H6OPqZwBKy6wizDYQPFN0H0TK/Ndgoqf79eA8kTsXtftYfIFoe9DwO",
-	"IGpqMGySx0WsBsGN7seS7uG2ewkM6C2EZUXrIazWQMXs9tpBw54Vq464Ma6wOYzaPeqdk4K0g6TJvNwB",
-	"Z3tnrZmOTzYeLOzDfcGHhD4Kb2CkY3Z/0EnFSI9gX0Xa1ojHoH4/hOjY80BrO8vss6ChZ+6Jo8Lg9E5j",
-	"c3xItsfM4X440R5pftfRnngWdNinfSTLhTaBQ1cIDYQVBEwjpnkWBaaovP9Wp8XFfjZSeOX5rcQ09Sx2",
-	"0jXxZGF0fysxkTfYTsqMzkE20kXDt2QlBj5G2sklpBEK4FLdQHss192MVH1Tt/1VvXDElbcYJ9VfTx/p",
-	"JbhlCJGapF7efr8BRyVZHbJyPrk4cWT7T66jLdWRMHeCDUrW4DmA+QaoQ1ZnnxLfUYmcWzhTvfKhMHNw",
-	"ElBADELnH2qP51CIxA/MiQmFfHT2ixgmgTUyDxNCMYxwVk6a0WAj/v6lZLV6q69Poex7UajlVqOB/4ig",
-	"8Ci3Zg4/ky8TBkFGMd++E9FGTXYNiAJ9kXF5HAMLmG4AhfLWmBKg+z9fvCYU/x/pqwx5/Enxf2CrPp+C",
-	"kxWR5GEeiXfnAYmdFxevXc+9BcqUAZw8OX1yotOYifCuZ+6zJydPTuR1Kb6RBC1QihfaAy9uTxcBonwR",
-	"RICorz8zI5vd+bqNL8fhNIOdZ+6cqv3EHt1lKF1cIx5s/EBW6/xMFnf98qRdShhvm7osBzuqj6P6OMWp",
-	"uWIl+zp0z2r7ZGYpI7tKy8D47yTcjvrkztA0R0dRfLdTMKt8+ufpycm8VDDTV3rsUnYKGTuMI8qdnHp1",
-	"/nqFsojb6CoYXZyXXwHK4hjRbZ9m8xWE/kEsHsYgrSCaLdQEdoApGamZS2Z70NWsOM8AK9MZhhnxZKqx",
-	"G4Bkkaajb5wdBBi7pg5Eiz705utDcH71GFqHa9K5uOLsXL1bJ346SrYzQKnniMOMqOopXRsA1iXzSVDW",
-	"p9WpsJbX/HwRWv1aMbQHbnlPR/R0jFVJO+KMpcYZMWc9TPAAqLOWXQ24u7BIvX5idqLQOEDVE8EwLyP4",
-	"1QRLN/7yLo6p0mgFXrOiNR/kTDXw+cFmqugZYHbZFO5R8WVS5UTAypI9oFV2Kvn39RKRqcA/CGzt8th8",
-	"cDOXWecHnLlEaPJsHcKfHHGGSQ7BXD7GQo86fEda9Cwo6ul7e7pAGd8sApKsMI3P86+G3PnbQLReIw5f",
-	"0dYP9MdWY+AbEjLB+9t37wVsKV7jRA9aGVVure91nW+3qK4EBrRa3JfHvXbNLvJja/UfW/uj+2o5SIzg",
-	"roHXKlfSzNq3eWWugaIYuNzAf2watdjOreRNE1LpIhMjKeKbMi3SqEeVWRh1zaa0gGbG5uqIFmW+vWww",
-	"n7fNbXKKthFB4b4mozNLUqDVnNLHK8FvaVGvgLe26AZT8loAVfd+irvuQloIJ7r04l5fI8whur6ON//M",
-	"bm5ukgSfyhMv9BYHsETqKzuqLfoMzwE/T29+i9KnJ6sv/3r+bFU5ZiuMikbA2LKYQy7PmwTdogiHiKsv",
-	"IOs/4LLqrZXJtFFc5I40ZOua+QMzbssVNa/TtcEsgfolA7otkVreeBsOUs88VPPC58PivH6/1obyuaBd",
-	"V9x3jGjPlpOSF0otC57W1yvco8Oj8YmPh8ZHQzw/nstb3Oe16SEBW4upM1irpJp0baYIXVbCH1t0fiyY",
-	"LMLxd++xEA8MN45VxVInZ31deHUYRxwsHqxyyfWh0Dn9htB4i/jImz/jneGHtocqHn4wH90oJyy2+gJ6",
-	"f/7jT0LeiJaOTog71Yy4xYzqKfT8svvgfMed//XrV39FaOxnNIIkICGEY7Fn/6zDLNC3X/Q3WAG5qWQ1",
-	"jDmKPi38GFiuZO0Mv3akHYxNFpWPVQ5uu7hvDS52YEwmYvJ/zGJ6HzQ/YN7ZSB+qszSofcrX1Iy2j4+J",
-	"ZrsCKE1rr/xbGVlWV3ov45ngzhX7xpaTUEm5VodCVe1O+oP77T55csjUhXJTe8oNjXX2o9Vc28buavdX",
-	"AAAA//8YjDL5+WgAAA==",
+	"H4sIAAAAAAAC/+x9e3PjOJLnV0HwLmK6IijL1TXTc+f9y13d0zdxu1uOqpq9ixh3KCASktAmCTYA2tY4",
+	"/N038CRIgk9Rkrvsv0pl4pFI/JBIJDITT0FE0pxkKOMsuHoKKGI5yRiS//mZUkLFj4hkHGVc/IR5nuAI",
+	"ckyy5W+MZOJvLNqhFMqvcYzFJ5jcUJIjyrFoaQMThsIgd/70FCDRuPyFOUrlj/9J0Sa4Cv7HsqRpqdpm",
+	"y58pDZ7DgO9zFFwFkFK4D56fw4Ci3wtMURxc/dM0+astRta/oYgHz6JgjFhEcS6oC65UUdmA7kD0f13w",
+	"Hcq4GB76jH4fO6AU4kT80J0zTnG2FUTnkLEHQmPPx/oIZBtOjeZYwhqZbCyZjzmmiK0g99JK0YYitltx",
+	"coeyfoKrxUO3dR/pH2EWIUFjXET8I0xziLfZ+DHg2Es745AXrJ9oHAe2sJ9Kyq/zPNnfUJKSjySegIaI",
+	"xEj8W4VdLhoE4lsIIsgQwBlDGcMc36MgDFL4+O8o2/JdcPXh+zBIcWb++z7sGZPsr20wHxMEqfgxhNW9",
+	"LdwQhtV4RnKkyFzM4YyjLZKrOleAWLXN613h/1TjgdNMqLtr48hPKEEciV9mNONRGMs24lXu8KNLhLX2",
+	"a342BtToYdRwvoV5+gVxd1Rs/CwZ3g3falr6LWepZxsqexwxqm9hshxx2T9LbYIRSA0DxYATwHcIRJDy",
+	"CyBaZYBxuLffi4zjBFCUknsUh4DQGFEGIEUgxkwSimLwgPlONUOKnGS3Gdno/8YI4A3A/E8MaJ1mnSDd",
+	"aXoBftJtqBZziu4xenAb1P2pdk2Bi9ssqE+RpldvuBtCU/EriCFHC45TFITNyTG7xwCZH7odtM3MZ8ml",
+	"UfPjbedLFbjjVyNDfJS8bHbYKiwrTf86eAB//IXHYUK2vyA+fjYiyNGWULufuStSf9uDDYwQZyHIinQt",
+	"EE82YEOKLAaaQAbWe2BL55DvWBAOlbQO7R91E035GgYZeuSrHG5RqZdmRZKIJRtccVogzxoy5I2Q+w41",
+	"WkftF/aml9DlZpPi3qmzw58Ph5DvnC9tMBOlBgPMsGUWfT2DKfJ+yHHEC+rRnwuaBOGQqccRqkpbUogK",
+	"tqwCs/9kIMkyjXg5QhHk6DqKEGNfxeyOPx4cdMwaSNNYaQBl5VaSwu6jY43iSmP950JJfeNcOJara0IY",
+	"b6JGIxhQmN3hbAvSIuFY7JnUKhMPO5wgpRno3gFmAEb6YNbEUQofcVqkwdX7S3lQ0/9pACwM1kW8RR6q",
+	"fpR/r/bJcpTFTFOjeg8bVKHHHSyY0G5IFiGlwoiKXPI5SgqG79F/GJLUEvEMwBS49NAsqBilsDAOKR9T",
+	"pQYXNXOWWW6DJTUjkMOmIqdXYrgTOqBwJOmL28wtDqMb31qkZp96gZIE0davOcrasSi/iu28CkoCNpB6",
+	"V0FjuBUcdBhoUCag909pfYuLBMVBGJSrDWeY7eTfImkwUt8t7h0glG0XedzOaJ+Yr+hXJddCDxb14tLk",
+	"+8FZmeoKOQNgq7b+8QKvfQeFFGUGI9W5xjHQhyBVyOpuRtKo/wlJo0cEoBJTlBAuDk1wzRRDmt06ynS1",
+	"V/PF9G27gSnJtgBzBhheJzjbsiYdecEB3HBEK+UqpHjEmaMKsaTYerSJDP9eIJCQB0SlNS6BHGcgQZzL",
+	"o2QWgxhvZZcoh1SyYr0Hu32+QxkLAb5AF+A22EIao2xBCUPsNugVdZIWrWWMgMZo3b5b8IxWySYBSk0P",
+	"2BCqwFNRkT198F2zecEv1oRNFiHGiZ6myqffCM7MSf02WN4GdqY2cqrZsnWqZkJw0IXBwyWXiyCH4tDq",
+	"8lMF0QQzt2ZCHzBU+5ZnPs5X6nrYlCIOY8ih87EcRituSW6Nf1Xi1AcAHxGrU3kPKYaZOPOyu0IZgGAc",
+	"l5C6+fTlK1jCHC/v3y91JbZ8KjeU56WoKAE26OT5C+J2Btin3G9lHHOsCQPGSXTnOxfWAKVB5PLGYbVp",
+	"p/80VNJ/PgT1iLw+gLWIxHPgTsorhv+FAKEgIgkp6NxYUkfscc3dmEpjwditk94VHj4ZZtSYFAKJyDrr",
+	"5MqUqxUz+YEVqS6DqarCJnHwy13hY1/r8poizh3d07sc7VxVVqZBmGZgfakesA18uSvG7wQO4P21+lZk",
+	"cA+TQi0KdI+EKVHPrV4x6735JZjEAs8oNKNWOGY+WdJYcIax5u/srnBB0qB3FoHcpanW4FFOsZlT1cqw",
+	"GZzpVn3OiXWZr9v9Zua1Zy7l8m6b0OoQ26f3ixQV15G01o5fo70WPiWKxKehvi0dB4ahTi9a5g3wfalV",
+	"1NSG1XEN5h6bzcGnhQmtxuV2Ev/BDpjeo86SmR5z6ujyUPKM5WUxW/lLHHzGHnKNh+N+CrRedT4Cztfz",
+	"fDvVsM6FH+E0T66mppcixuB2+EW9Ke+j628IxWsY3dVOU8KpYMLtEuSCjKunrouLv/TcWyiPBqmxlG5p",
+	"f7783z/0Gbh077aF0cM9jamrx47excMOXo2z7oRBwdoORr02a1M1bHB8nO5v5qImEabNxQFL09DhnL/k",
+	"uMYTEWPR87owR/1Bx76O7n9y2vuxiO4Q92uNkwHlXZSXrUBjq9Zr/y4nkhpMTCthlV8jp8bDm9k8GBiH",
+	"ykW8R2i1jV7V73Ju8AzsTQidUwj9O2bVmZjg8jnFW0gvidHSwkuv+tXrO2T6HOYtNKTHN8ieAbL/kMX+",
+	"cCrbuPHMZEw6CTp8CGhOdc/sVqzAb3cab3cab3caf7A7DR9qprnXdAZihdq+XVEdemqkOPu7Kvq+R0fQ",
+	"zNNd9A7zpvScPVxYFzQZON2i5FDahutY3pF5gNsA99u9xzdz7+Fou8a7k03ZkHXVwdhr6df87tXtyx5H",
+	"jMr8fnNefXNefcHOqxX0Goe+Q6OPBq3Kaq/7AavQdtEzkBOZGUYHJdVInBCWNMS20NLL2U49EwOGVn6N",
+	"ZT7FviNcyF2EZpNzyfKx/RONEf35HmX8OuKEzqO3qD/0kS6/hl5TfBg8LjjcMoUjfA85WsEcB79WKBb6",
+	"6+liJofPSatkbDECDxvtf5RXfSOimmWMMEjwBkX7KEEgJinEmXBvyjjIi3UidwoRdCxLsqX8ZyW/C3eG",
+	"HEfNcGKDlC6pUQeWXVJeB3hFD45DEJGMFSmiDMQoLlSmFwQoilGC7xFFsSorlVfsjQCwQm2QdKvByaOS",
+	"kigqKB0ZPq3Y2KqCoHtMCrYqN/QB1mHIWiwsaiire0SZ120cZxFFKcpUvBZYUwRlEFq0g9m2VNXVHKjG",
+	"/O7jbclNyhVv9BI5/Au9oweaHRc5xOV/XNVE/YXtcJ47/7dTXtYhaS7zUfhVmKEG2RrDQiVFtTyyM+ea",
+	"aa3qUp+6UK8GO0EGf1XcjF/z7DqOKWKjNRrM994Zikiaooy3fCsyTlvqTbPQ70jWsk8SxmGyakkvINgY",
+	"4RyjjK9at1rGKUL8+Cb7cvprRJnxlZwLFeMtbdVxjtNtK9M/IR5CI8Cxv39/eRn22YMcfFSlR0Y4kuEz",
+	"OocFxYhWEwW9v7y8DDtRVW3x718+gQ/vf/hh8R7AJN/BxfdAlwXGTcWhvUL592EH1sbkLmoA0R3PD72V",
+	"mygdye4Sw1XeqL+HYF3gJBZCWsQWwRxSnuoos7Kfv/T207jtOwjG7WD9qE//oxWTiDAOhFQvdJCf/rNY",
+	"LphklfAt+YmBPIGRiIJDG0IRwBw8QAZwxqXSheKL2+w6LdOm4AykOCMUFBkurd5SMGfRHnyHLrYX4I7k",
+	"KLpj70Ig1WbRXJQUMQL/df3Vl0/FVF9hRlZ//v79X/0apUkCU9EacMZ/+LN/exXbH862qw1CQ6sUa044",
+	"TDw4soZ6zVK5K+nxBeGQxltahpK7QmPM4T4EhgSQIMZs3huQJwUDZkRAjGhQn/fQsyb+6/qrmZFYTKgY",
+	"lCJuQJv143hj6hwuOlNWmw5FmeFJ10IQuXgOc+8bfvOmiV21nLecEtIO3ORsjmgklxclKXgv5vT95aW4",
+	"HNvgR7Ee1VR3r6FhE9uT8y+Fj6uC+ZLA6JtneQJItd3aUCCZHYJLcTtVZAlOsdI2B9BjOlzliIofdELP",
+	"4ggCgag8kQacrdpXsL5mB50reZa5IQ9Z+1lFfTQIqwsZCX8gvtZYI6Er9eIOA2z1lNZ7N2CP0mPrdRpV",
+	"/bDrnHS9E/Ed5GKTyf7EgXuaGS2TlNLjcNqZk/oybyzqGo6cteRDeZX9FZ5qVlTETp+ku6GDnTOq/LUb",
+	"hcPZLb5HzCRdk9Mrsq6JTyWvQUZ4M1uaXhMxEVPhNp2OUgfCcsfCTDRldp3WpGqROrNbWK0JSRDMOhKo",
+	"hUfSHkr7QJXRD7u9y2M1Lod2n27qyKQxesJE3FeIGb4/927FEsUKP/MnTxXQ+SDQ9+H7as6GUCdsCMFt",
+	"sLgNxGZ6G6xEBoBR2VY/hAP2e2Nt0du4vPp5bDGOvFRVYJg57cj6QXfqjtPrCj30nEVv6Kaptqm3CHtF",
+	"QvUWhgGSJftRTgJVRWBIX6qG6irUUyI/mg9d1YJwqrIxIQ2VFoo9m36f2JO/J0RnKqOT9/pMfwNrQu4A",
+	"ktYaToC20Tow4yQEZkAC5aJO+RUz4SJyJz7l/hQcsr39KkV8Rzxk3AbaBiVE6q20TxgpKxou8gH5b+qd",
+	"DOQmGx2PjigckmbU19cnW7kZkm2+DKX7k0vI0b2zO68+BtwhHNeoq9eTNd+XRt7xNlrF5c+IF3SCijHh",
+	"nqreo7my6nDmczXDhs12nPGwcr8xmCuH3NJ2BdqMyHJbP9oJylAsfNMcUSVMjHZLso6vvbJkYI5cxRoV",
+	"32ZN+yeMbHP7Nzoxm8dONfQZAEuClL1jz4u6WkWl6TcP22pVI3HQsvENW4RmCHoetTe73q26mdO74dTb",
+	"HscllsGc7Qg3XPLt2fAOZeBhhzJnV36AhnFBt0rQPMzOfd94npvD2jQ5gw6Pd2vyC+J2Z5492i9GHOLE",
+	"oyD/P20QsGqETDO7JpSrHPZyMdmL9rKYyAm7r2lyaq0JYUkKfpuJj1aRLrX8jrT64LtbdaiWvFpRJDiE",
+	"4itwW1xefojUniN/o9vg3Qg/rW7/C7g32Oxf7Te68LemvAj0TdNrYSKSGMYrTmHmvGxRv8gUNCKl+4uB",
+	"IMaFYc3YjFO4BwzpEBOFKEX2qNNWRNjAaZRXlEOimcrtafhO0AHEKVqeMzVtTklTIVwutk7rFkUxQqlK",
+	"NmqXvNdWuMN5ap7umjbEL7qJltN024pTn1Y7zDjxeRkoTKlSwIFqCEgSI8bBBlPGh0YvNamWDf8f1fvP",
+	"ch/w0H8kfxQrAIyvUTkNDcaE3vV6oMSY1/XyJE61h/n9T3kxwxOBZozX7bks25fHSHZDSrEyVY4da8cu",
+	"R2EkXAdXmq2eJztkr8AU1E92hFLUy1zx0qymhyR0iNLp76CQBJfX5fY4EtWeNT02uxknrXxVH4ffpMry",
+	"wsbF9oyj9DZQXljlIgYpjJGR0AzReyxz8TOUbCbE8QrDv+OQWqVPuKeWpyzrnzPkJYwux9WBr+W5pDnT",
+	"6zA0LFkflpaKQReZIvBAn7amhJBAU3XkBugcHjvDKMr2u4egL0qnnOplxbGGKFmrl3rTeDft6tdpwl+U",
+	"mWDkaCtEyh+9I9f9DAt+8fTytsMed4f1TOkpjONTjgNNXIzTlE+jhHazWpmDJ6xxZa0dyzLV3YD0N6rx",
+	"btpV0s4/hJCqkXpUUeXt66RrqKZDSXqqF7jm3Dr7anOU8smH3TZlyz25BuERFqylvjxADlKVbkqjwwij",
+	"9I48aG9hG0agrfc5RdJ8L27lnfev1PjhA8ScAWPoaOhdqdntvO7JCdoYH+VhkdfRDkV3pBhtyNA8+air",
+	"e41UQxy+6mpfqvexZmWX1t65snSNW5iCYZPG/zdRUekC9zhuOX9qZaM6cQnO7rw4kSbCIfdwqsP2VCDt",
+	"BI8DtGCOoJQV6xRzgDPGEYzVq5TC1CLOvYJ6M01ADM0XRdnxzoj/Nkk+ggPTcRlBujNYazJsp7aLDg4q",
+	"p8+prnVTVKB6l365XBd/PXqJt9Ej3VRrLbpucBUn9npyFv3ElEycMvLRvPNq4DZDUs8t+A0lEWLso7IV",
+	"ydhbE+dW5Y+1IumQ3we03hFyF0oGKdZIE1KOIrzBkXOxpIPURhHAvKnIKjW07PASqzcq4aAsiNHxS92U",
+	"mjqO8BpIQBexWvk996WOUq6n3eq0xCJqFU9/F8HMihDZEUWMJIWWZjPFsU6RVYr9rdHknReTO8LJtP5u",
+	"RFVfhx32N4o2RRavelQpVaq8t10Xe0QdY66aE4oiJP33rXuNUS0Pz2R3oqOtP/LbZ1i2FsYqB0t1Wk/k",
+	"fHcvDqhOYBSa0egzZVMZacpxF8ALyTqnCFNpTCf64rYFdFAUEepEQlbuOfWl37+Vbm2cWGcOE6QEzRuC",
+	"MDMa9o4wuxsxG2djV/53oqMUZgVM9B+BIu9dy0u8g8z6utxA/o1+53+i1PATOXCZKoqneoQO3PfCyuwq",
+	"nxlnIxwUnD/zHBmzxoQxl/eUdZyXYxQGi+rNYZ9jeZUDA8Lxp995npDQQyeHzXiJ3KnIqI9DDFwgRhTf",
+	"o1gFHsmTj+PLcNSr64PkgqMseFWECgcGS4+EwNjZzmZLen34jnajktRc53myv46dlJG/N08iXcltWtth",
+	"k9rRD+t/2WdR5VmICYlT9Ns4Y1IB9pJgknb1mSps36MSBY0gYBwr5k+w3/FeSBi0Zq7SH+omEtV/qP9l",
+	"0qFLGAyh0oiMnynJkFRl2J10Mfk3YLhsPFaBaV+UgskD3DNTeUIY95CHTmae3rnWDLuOfySE8XMuGoeG",
+	"M60aDwVjfUFWYxLh9qyy1lXBcYq0ez+Q/Un/fTeLDs5EXOcjSHGSYIYiksUstMVf5IKxvJt7LmdbIzcF",
+	"jXZQOgmdcZW4VJxrnfhomHWDyU0Hqw+XcUu6TlsEJolYEWOfomo2UO93fm5NxGKCIP0IKb/R7+yfEoG+",
+	"vk+Du66exw3eParURGkZtmwiUjADkehahQSG3g/S+Jkj7Q7pkZOqTRz7BeV0O6UpOBdDpwHSPNNj7lFM",
+	"sNBES9dUbPaRcRKYDiViOmIPz+A9kNTWzN4jAOq1oVuCj8HgaZnCp4bSHk7wtCUnK/8IebT7KI24/8hy",
+	"iGNj//79CG0eTKce908mi+9sxLY1fADFigE2GvOEG2xb9ycRXn2dj2OBE3LqecpGNz9Mirily1jWGYd4",
+	"AFaqV/H/6Vz3nxo23ZScDkHD6Bh5lk4byc86nPcGplSDHJmjwhxp3NV4vYql/iZUwIqBW5uxpLeHG4Id",
+	"h6DMdu+6kAh7uMxcx1tyKcqmVinyPqXXvjt3OhhWm3UYdxyIzLAYzUsGZzomDaLl9AuyjRL3/6d7QWOK",
+	"K8B0s+moKRl9LcZXOXHcpGbHivt/rxtR9346ONDbk5MhNKlUjA+9lVKQlvkddN4HeWOnorz6U5tV2VYb",
+	"xLHm+HDh8ll6PBymudabmoMqhug9istHk84h7DxUnFzMddAwMWHb3CfvHmonPKk169LpIGm2zWHie1pH",
+	"fUurK0eY4ojKESb7ky8xaKqkJJSyMas/dTntZdoeV7eD5/NwcfOPjL4IgeOl4+Qip5OKGU19yqOrI42d",
+	"dt3T3rfa6VbCkyL5wCmKK1nSbJ5yYKP+Bhr0jsGyQ2CptvvGJYvI4XDgVt3d8jSadaNnOp609H6SZdPT",
+	"98w79HBDddXEdMjFin+E03DyGSVw/6nga/I4FcSVJsxta8WxOIF75JEo/yk3TbGd2fusyguB8jVA1n+v",
+	"bzqYwsvPKEGQoZ9ljvBYbWQV29qsLfq5I4p3sseUAbRsDexIEg9jjmp/GndEf+j0O3Cz45PIjvZuZxYb",
+	"7TfE5auxJr01WCOgAzjMJ6suGg09tL/kXrxDiUowi4VDMMcJwPxPIrYZx0E43zGjya6hJ4uaNHTshAfd",
+	"3bUTdOxzRZtiz+6Kmjfh4SmADzRUNZg0becQ6RhOLxhqvZ5EKrT0OZdPiFiVlbiYxuqWa5qRJD6u9lwd",
+	"5zRUWK379NDwdX0SfHR1/ALcMHzkdbhevKAj4IG7QdfA3/aDLjaNXvuy4kfzvP0pkvK0G/IgbbskdV7k",
+	"l4Xsg/wVvFJC7BdBok9hgnznmduk2JZP2+imgUA/44QyadCrfPqN4Mzca9wGS/HCCL5AF+A22IgoXsqW",
+	"lDDEfC+MyJzf9j6ttqHoL01SUpJtVcwTXic42zL/K59JsT04XklZHkVL1uhoKdYMHBeX/BnJ9N+f0YYi",
+	"tvsqEkZNCXKVtcvUWN2DqBYfTNXYIKqexyoPIrry/pVvBCpmr7aEh3A2xZn71/f1UR22QjP00FylKM35",
+	"HqiWQErudWYIC3B9qyhWcN+S6X5eqmUJPA/nH3uTgm9ScH4pWEHbHKvUsKUPKqpHy0X/W1pOXX+aKRhD",
+	"r99QB5JJ3pIcRn0A8BHVn3gD95BimAmziHy+TH5Hj1gnj7krGEgLxgHjcC9KyFkapFL/grjlPfuUt3lL",
+	"jLowFCrzKkYJh80xfhXRUql53+I2wBmQ5W+Dckbk12gHsy0KAcJ8J42GV7fZAiis3aMrVcs0heWL41TZ",
+	"FL+zCVWkoZCBlFDLSfZONJOhLfQ3EyPbjOAfMAEt8bvb7Db7IkvX5sbqtaK+Iju2f9TZe9iF37DZtxzY",
+	"q1oOZ0VsS/hT9wx9uSvmkFkmifDol5mPtC4FcNXKMMvQrBgjfwS+YwofyuOd9M6UlYJwIiNnitV3EDU4",
+	"x18tbNoKWXdt6HblA9byJxCgZr6cY7UJra+6RtO6fM0V4og4GBDspwzZufE1U12Y6tUh+jd2kYtBT++N",
+	"Kvxi0jEINqCooJjvvwi5ojpbI0gRvS6UFohl7lUEVVo5Jb+C/78QnwnF/4LVRGkwx/8XCTuc0G2zjUyk",
+	"xDFPxLefI5KC65u/B05McnB58f7iUsEVZTDHwVXw4eLy4lKrUZKgJczxUhsnlvfvlxGkfClj6BYRybh5",
+	"NuZxocssZDucFug59FfW95FTq8ubyQWRd6NjqsqYziXbZ9FCQ36hcw8c1gpbwHhhQ8YPacdGrg5vaKPj",
+	"lJbKDHiVKz+NlX37a0VMSueRDU5js+xtuRYhPgtlo1wUMiJpUabDzjWrqhJJhgVpuyZQdUrbpr3a+nsc",
+	"XFUcPVhL7FOg1iFi/EcS75W9UcLNefZdNLj8TSfoUnv7GGemjkiu52clCFhOMj2f319enpYKpgTBUC47",
+	"LsLy+WNgqFdb0wYWSWumbDvQ5c+UEiXGWZGmkO77ZtaYP/UfhOVzAtI02hexCSfrhZsJQCszFQFbGXy3",
+	"3psEpEzkctUPTL8DCcm2btIF/ZKKakuwLharBsCtsJlADnbwHoGMCN5mOrMXs67ZkCLnjT4o2lY6O98h",
+	"TEECGbfUDVkD/pC6ky2E9lDBk6+G9uDC9iVh8FCiQE/T3OugraPDF4NFCFuq1dYBf7UaFX7Let0wq0fg",
+	"nQBavpjOE8Kp2b0XQi3cFNA5GDXtM3UgWrSqsNBRbotKwFo7crQnKPClSu7BT0f82Amg1BPyeUJU9cTR",
+	"eQDWxfNZUNY3q3NhzbgiLoQqv6iEX/XAzdRUeTy8EUjtiPOGFZ0Qc62RjWdAXWuIlQd3Ny1cB3pChel3",
+	"tv1xwFTPBEOV/rYDdSrEq0ymSzYNjwtwj2Ej97u6idngDCb4X81cvZsiSfZlRt4hx5tqzNnpIOvEy50e",
+	"o7ZzPyg1TvQszg9Aapk9G96U89nCdTfqlncNf7WBMKlGZ5wSL83AonMApxmd4kHQ54Yz4DHlmW8qZwJW",
+	"kU2AVpE1KAILLauYfW2rH2zNYKDTwc0fyXZ6wPkDojqElo/5syOuyI6BOW22bZorF4xD3mlrKWQSbeke",
+	"QJJYZl3TzYDvYJIAmQ1Tbp360Shp/PhwCWK4Z+/kF929+JpqF0hpQwUUZncqTWsXZLviwU4B275It1NC",
+	"ty82zg9fLShNaZn62yprM2JYN5i39Hg4kOuW7RZpqemwYVRCByRpijlHsSQF6ZtJph6qM085yHa11xSm",
+	"ZcBVBzqdaK/jYrEWmXYa0FU6bdmPhdVUc242JLmtHoIag8Sl4MRe3PZEJuP58IsR28i0exW3OoIMLZQD",
+	"YrxwotemUSOqowk1hU18QjW7NfXUvX+/hAXfLSOSbTBNf04h1t3tI1F6Czl6gPtFRKgOcBAv3zGxsj59",
+	"+SqWG8VbnOlGnVblHeKTDhV9XromiAGllk9lZpvn7iqUpGShc7dUisltq/FH4xPY8vflU6NDe1VnmesS",
+	"t7QJ3kdUUXnOW+ror94qyyf1o8kWJXP1bcpCPQK3fNL/f+7XVhneZigGLc/IGWcFbUy31zbgO3SxvQAb",
+	"eIfeNWRv+wNy5uVExBEVwqJOlLiBL30fzQt1WEUcSb9DfUdffiy9AdQ7waXorHsO/Hoc0d/9Xt/z83Od",
+	"xmNuCV3EeLcGcje/emwwUsOUZ48IG/JGeqooLBmmQJzpAKNgvYaYo2S9Tnd/Ke7u7rIMvw/CQAg8HKEV",
+	"jKRjoSoLf0N/Rfiv+d0PSf795eb3//XXDxvH5U3ISJqou3zdhzTz1gmSrpaQE3nHr/+DPrs4UhKwuST1",
+	"s/5XT8EWeRageENZZ4UqfYLkRajIQp/ijIGkVkSo8uQh8xm1yneZP+p+j44yp7O2extNeg73wlFoKsC0",
+	"F4+UF67/zj9/ff7VxZ/L0W8bbGHbSZAioTdDzYUL8LEEj75kt8nJqo+Aq6/ylekWbKm2P5o0ZscTpW5H",
+	"ZxOhepytoD4VpvWMltnjXp8EXT4JLe9ZoT1B3JOs7yf5d6U2aOSraQR3CKnHxizs+Q7twQOiCMgoqNg8",
+	"ne3DvGrXYr5Td1Edy4yBLTqL+jJSYTkqyt3xtchwVSQG7vCOjHk9m68B86HRDXzo+wXxbxd6L0XA/oL4",
+	"m3RF4jwtD5hdB0ZZoCFMjWTYypeNdTSj0i2MtblgiFqJ7EuQWjZzm5Vu8x35V8unlSv5JoxZPqxSKZ7c",
+	"jvFmg6ibYFoO5+I2az2/Oi/fn2kFHu+0Wn3V/5w6lqal8whRTqWB6ZGlggH7q5QMjp/jk5v06Nk5y7bs",
+	"V9aVr2/BOCmjnCqehVPLuvSStjB3vC3w/VT3/T7hrlb3O39tKLYe6u3mlxYPodK+YX28a2CWQP29QHRf",
+	"IrV8y2c4SEN/Uxl65KscbpFNiHBOnJeM6EL5Sa08rS7dr8fIoz2WN6VOpKec6fx7pQN8qWPtCEMZUJc5",
+	"nXaeT9pN5dhmHvsc2nk0EJeG80PbndlXKa2XTyaP1hBdQ7OpU88wjwy2KBdl1q6Xpli8FExaTeKbF7aQ",
+	"R57UMCqEXUvbhXZJUf4pF+BvRbLBSSJ9lJl8xh7pA677npGqawPhQsCQbpCtVO6IVWscmnn0PnZk8lkA",
+	"f6xtwBnaCVxmPH2ef4m5EHvtYn+pozi79XZdqJJ1OLQ3XGJ5VUtgKu5NzY1X+x2XaPyzJuBb3Fic8XUC",
+	"33Dv5Cq96fl1ava6qGaCwG4tiFUh+AJcJ0n1+lbXkBmi1ghs1L6EYpHIRHxnMDV3vJ1qvwLHt7bHuGM7",
+	"2SajWel1x5STddpDBTUz+7a90Ez60+mUwQNOGmdbFWFLNuO2TuygXti+81LWgjjMvIaF0Hea0TCqH2e+",
+	"KCcgmIt4S8RCQJHIpaSiLnXubObsNyFYF3tEbWwT7j6/vIZldNxj0ivdwiqofdvCmlvYMt8RTjpCxFSm",
+	"NgCBLKje5xGVUQy2hMQMPOxwglxVEjOg0SwS9qTwEbyXcWX6jyEQf/ogo3IIh8m71pUvOlZwuRF9v87l",
+	"nxYJxzmkfCmy9y1MCk2URSRWUQTBBifIqfVVNY9TuEXL33K0DYH6nasxOZRU0+mZdmyawDXOoC/bZzNh",
+	"4zlM8A2AtPmhQQ4FbgtZHsUKyceXPHrdaMjkGsKvWwJZU+byyb7TqUJNOrUOVdYaQqtvRtV1EWWIUEZV",
+	"lbXXsajKSFdDRKfO8aU0rJ5d7JQ+D5oT+pguQiTkX0taPTS4L6K+KKXEsPjE5tuyW6+8MJ9PrKM4c/ia",
+	"ZEQt2dXyyWRseZ6W6arM9l9NAKPjzvaEpCRD+xAwmMVr8tgThKZTQY0JP6v37F+UzteXFYimR6zWZFhp",
+	"9XHx8PCwkHpIQROpgqinHA/t5nyRbpaMk8W4NYDpoveVLX597+IqA33eV9rSoHfdTMSlL9T/zCNSVV2B",
+	"heJxFcQ42GDKeMf1jWq5029rjn21sblbrcbeA3MCNjjhiIK1eKYkScpPeAOIynSg3rpJZBiz6tPnD6Yq",
+	"VtzAhicOZ3yfiD+IFR/8cTzO3Ils2+MdFJ32soq5Pb+y5V4wudhtJD6MY4oYQ+2rXbLMhpDb8marFS2Z",
+	"v4I1IXcdy/vadtazl8tG2/T5Ka6ap0G9HV8L5O33k8IdOlx/hfey17EwAdQBbOJRGvDV5rLLkm3vOi9d",
+	"9ZyeB9HH0kP1oM6mGRqmtq+hE1/AQjvLb5uF4OST/mlsRwNie3WNZnCvXZGjY3vPtfYa+qMZQlsnJbde",
+	"ZAyxXe6dQcTOIE8TQ/wqFl1fEPEbxr+dzUR4MLwSUOeF10VOSvb5twNlv30VS+VN5TvRZcBrU/mcHMme",
+	"v3bk9vMWWbppIIcX9mTy89cwD/KNKTu4cfkI/sBynkaV2gwLvkMZF2sC+b6rR3ivowgx9lU/tN1eSCCk",
+	"vYCypXUUo81Xw0WxZwvuxqm5pF6+eqWw6ogwsXSaks+mwm1UsPBqVvqokxU36ph0kL4qlPvKU+4prHeY",
+	"RnG9nltHAXT+xmZNk/YxeP71+b8HAP5abcHfQQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
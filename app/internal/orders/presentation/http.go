@@ -4,15 +4,22 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
 	"slices"
+	"strings"
 
+	"github.com/bratushkadan/floral/internal/orders/carrier"
+	"github.com/bratushkadan/floral/internal/orders/payment"
 	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
 	"github.com/bratushkadan/floral/internal/orders/service"
+	products_store "github.com/bratushkadan/floral/internal/products/store"
 	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
 	"github.com/bratushkadan/floral/pkg/xhttp"
 	"github.com/bratushkadan/floral/pkg/xhttp/gin/middleware/auth"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -21,6 +28,8 @@ var _ oapi_codegen.ServerInterface = (*ApiImpl)(nil)
 type ApiImpl struct {
 	Logger  *zap.Logger
 	Service *service.Orders
+	// ReturnPhotoStore keeps photos of returned goods in the products pictures bucket.
+	ReturnPhotoStore *products_store.Pictures
 }
 
 //go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=oapi/config.yaml oapi/api.yaml
@@ -46,6 +55,91 @@ func (api *ApiImpl) PrivateOrdersBatchCancelUnpaidOrders(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "ok"})
 }
 
+func (api *ApiImpl) PrivateOrdersBatchCompleteDeliveredOrders(c *gin.Context) {
+	var reqBody oapi_codegen.PrivateOrdersBatchCompleteDeliveredOrdersJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: fmt.Sprintf("invalid request body: %v", err),
+		}))
+		return
+	}
+	if err := api.Service.BatchCompleteDeliveredOrders(c.Request.Context(), reqBody); err != nil {
+		api.Logger.Error("batch complete delivered orders", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: "failed to batch complete delivered orders",
+		}))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "ok"})
+}
+
+func (api *ApiImpl) PrivateOrdersPublishProductsPurchasesStats(c *gin.Context) {
+	var reqBody oapi_codegen.PrivateOrdersPublishProductsPurchasesStatsJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: fmt.Sprintf("invalid request body: %v", err),
+		}))
+		return
+	}
+	if err := api.Service.PublishProductsPurchasesStats(c.Request.Context(), reqBody); err != nil {
+		api.Logger.Error("publish products purchases stats", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: "failed to publish products purchases stats",
+		}))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "ok"})
+}
+
+func (api *ApiImpl) PrivateOrdersProcessRefunds(c *gin.Context) {
+	var reqBody oapi_codegen.PrivateOrdersProcessRefundsJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: fmt.Sprintf("invalid request body: %v", err),
+		}))
+		return
+	}
+	if err := api.Service.ProcessRefunds(c.Request.Context(), reqBody); err != nil {
+		api.Logger.Error("process refunds", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: "failed to process refunds",
+		}))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "ok"})
+}
+
+func (api *ApiImpl) PrivateOrdersRelayOutbox(c *gin.Context) {
+	var reqBody oapi_codegen.PrivateOrdersRelayOutboxJSONRequestBody
+	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: fmt.Sprintf("invalid request body: %v", err),
+		}))
+		return
+	}
+	res, err := api.Service.RelayOutbox(c.Request.Context(), reqBody)
+	if err != nil {
+		api.Logger.Error("relay outbox", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: "failed to relay outbox",
+		}))
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 func (api *ApiImpl) PrivateOrdersCancelOperations(c *gin.Context) {
 	var reqBody oapi_codegen.PrivateOrdersCancelOperationsJSONRequestBody
 	if err := json.NewDecoder(c.Request.Body).Decode(&reqBody); err != nil {
@@ -191,7 +285,8 @@ func (api *ApiImpl) OrdersListOrders(c *gin.Context, params oapi_codegen.OrdersL
 		return
 	}
 
-	if accessToken.SubjectType == shared_api.SubjectTypeUser && params.UserId != accessToken.SubjectId {
+	// Sellers see shipments of their own only, not whole orders of a user.
+	if accessToken.SubjectType == shared_api.SubjectTypeSeller || (accessToken.SubjectType == shared_api.SubjectTypeUser && params.UserId != accessToken.SubjectId) {
 		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
 			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
 		})
@@ -210,6 +305,40 @@ func (api *ApiImpl) OrdersListOrders(c *gin.Context, params oapi_codegen.OrdersL
 	c.JSON(http.StatusOK, res)
 }
 
+func (api *ApiImpl) OrdersListSellerOrders(c *gin.Context, sellerId string, params oapi_codegen.OrdersListSellerOrdersParams) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.ListSellerOrders(c.Request.Context(), sellerId, params, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrShipmentInvalidStatus) || errors.Is(err, service.ErrInvalidNextPageToken) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+
+		api.Logger.Error("list seller orders", zap.String("seller_id", sellerId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to list seller orders"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 func (api *ApiImpl) OrdersCreateOrder(c *gin.Context) {
 	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
 	if !ok {
@@ -228,8 +357,22 @@ func (api *ApiImpl) OrdersCreateOrder(c *gin.Context) {
 
 	userId := accessToken.SubjectId
 
-	createOrderRes, err := api.Service.CreateOrder(c.Request.Context(), userId)
+	var requestBody oapi_codegen.OrdersCreateOrderReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	createOrderRes, err := api.Service.CreateOrder(c.Request.Context(), requestBody, userId)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidDelivery) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
 		api.Logger.Error("create order operation and publish request cart contents", zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
 			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to create order operation and place order"}},
@@ -249,7 +392,7 @@ func (api *ApiImpl) OrdersGetOrder(c *gin.Context, orderId string) {
 		return
 	}
 
-	res, err := api.Service.GetOrder(c.Request.Context(), orderId)
+	res, err := api.Service.GetOrder(c.Request.Context(), orderId, accessToken.SubjectType, accessToken.SubjectId)
 	if err != nil {
 		api.Logger.Error("get order", zap.String("id", orderId), zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
@@ -270,6 +413,15 @@ func (api *ApiImpl) OrdersGetOrder(c *gin.Context, orderId string) {
 		})
 		return
 	}
+	if accessToken.SubjectType == shared_api.SubjectTypeSeller {
+		res = service.SellerOrderView(res, accessToken.SubjectId)
+		if res == nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+	}
 
 	c.JSON(http.StatusOK, res)
 }
@@ -312,7 +464,7 @@ func (api *ApiImpl) OrdersUpdateOrder(c *gin.Context, orderId string) {
 		return
 	}
 
-	res, err := api.Service.UpdateOrder(c.Request.Context(), requestBody, orderId, accessToken.SubjectType)
+	res, err := api.Service.UpdateOrder(c.Request.Context(), requestBody, orderId, accessToken.SubjectType, accessToken.SubjectId)
 	if err != nil {
 		if errors.Is(err, service.ErrPermissionDenied) {
 			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
@@ -332,6 +484,24 @@ func (api *ApiImpl) OrdersUpdateOrder(c *gin.Context, orderId string) {
 			})
 			return
 		}
+		if errors.Is(err, service.ErrOrderStatusDerived) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrOrderConflict) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrOrderNotRefunded) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 127, Message: err.Error()}},
+			})
+			return
+		}
 
 		api.Logger.Error("update order", zap.String("id", orderId), zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
@@ -350,60 +520,807 @@ func (api *ApiImpl) OrdersUpdateOrder(c *gin.Context, orderId string) {
 	c.JSON(http.StatusOK, res)
 }
 
-func (api *ApiImpl) OrdersProcessPaymentYoomoney(c *gin.Context) {
-	api.Logger.Info("Content-Type value", zap.String("val", c.GetHeader("Content-Type")))
+func (api *ApiImpl) OrdersUpdateShipment(c *gin.Context, orderId string, sellerId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
 
-	// should already be validated by the Oapi validator
-	req := service.ProcessYoomoneyPaymentNotificationReq{
-		NotificationType: c.PostForm("notification_type"),
-		OperationId:      c.PostForm("operation_id"),
-		Amount:           c.PostForm("amount"),
-		Currency:         c.PostForm("currency"),
-		Datetime:         c.PostForm("datetime"),
-		Sender:           c.PostForm("sender"),
-		Codepro:          c.PostForm("codepro"),
-		Label:            c.PostForm("label"),
-
-		Sha1Hash: c.PostForm("sha1_hash"),
-	}
-
-	// formData := c.Request.PostForm
-
-	api.Logger.Info(
-		"Yoomoney process payment notification request",
-		zap.Any("yoomoney_meta", map[string]any{
-			"sender":            req.Sender,
-			"currency":          req.Currency,
-			"operation_id":      req.OperationId,
-			"notification_type": req.NotificationType,
-			"sha1_hash":         req.Sha1Hash,
-			"datetime":          req.Datetime,
-		}),
-		zap.String("amount", req.Amount),
-		// zap.Any("form_data", formData),
-	)
-
-	if err := api.Service.ProcessYoomoneyPaymentNotification(c.Request.Context(), req); err != nil {
-		if errors.Is(err, service.ErrYoomoneyPaymentNotificationValidation) || errors.Is(err, service.ErrYoomoneyPaymentNotificationIntegrityCheckFailed) {
-			api.Logger.Info("Yoomoney payment notification bad input", zap.Error(err))
-			c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
-				Code:    1,
-				Message: err.Error(),
-			}))
+	var requestBody oapi_codegen.OrdersUpdateShipmentReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	res, err := api.Service.UpdateShipment(c.Request.Context(), requestBody, orderId, sellerId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrShipmentInvalidStatus) || errors.Is(err, service.ErrShipmentIncorrectStatusTransition) || errors.Is(err, service.ErrOrderNotInFulfillment) || errors.Is(err, service.ErrInvalidShipmentTracking) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrShipmentConflict) || errors.Is(err, service.ErrShipmentHasOpenReturns) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
 			return
 		}
 
-		api.Logger.Error("process Yoomoney payment", zap.Error(err))
-		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
-			Code:    1,
-			Message: "failed to process Yoomoney payment",
-		}))
+		api.Logger.Error("update shipment", zap.String("order_id", orderId), zap.String("seller_id", sellerId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to update shipment"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "shipment not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersListReturns(c *gin.Context, orderId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.ListReturns(c.Request.Context(), orderId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("list returns", zap.String("order_id", orderId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to list returns"}},
+		})
 		return
 	}
 
-	api.Logger.Info("yoomoney payment request processed", zap.Any("yoomoney_meta", map[string]any{
-		"operation_id": req.OperationId,
-	}))
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "order not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersCreateReturn(c *gin.Context, orderId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	var requestBody oapi_codegen.OrdersCreateReturnReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	res, err := api.Service.CreateReturn(c.Request.Context(), requestBody, orderId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidReturn) || errors.Is(err, service.ErrReturnNotAllowed) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+
+		api.Logger.Error("create return", zap.String("order_id", orderId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to create return"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "order not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersGetReturn(c *gin.Context, orderId string, returnId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.GetReturn(c.Request.Context(), orderId, returnId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("get return", zap.String("order_id", orderId), zap.String("id", returnId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "failed to get return"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "return not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersUpdateReturn(c *gin.Context, orderId string, returnId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	var requestBody oapi_codegen.OrdersUpdateReturnReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	res, err := api.Service.UpdateReturn(c.Request.Context(), requestBody, orderId, returnId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrReturnInvalidStatus) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 125, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrReturnIncorrectStatusTransition) || errors.Is(err, service.ErrReturnCommentRequired) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrReturnConflict) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+
+		api.Logger.Error("update return", zap.String("order_id", orderId), zap.String("id", returnId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to update return"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "return not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+var allowedReturnPhotoExtensions = map[string]struct{}{".jpg": {}, ".jpeg": {}, ".png": {}, ".webp": {}}
+
+const MiB = 1 << 20
+const MaxReturnPhotoSizeMiB = 2
+const MaxReturnPhotoSize = MaxReturnPhotoSizeMiB * MiB
+
+func (api *ApiImpl) OrdersUploadReturnPhoto(c *gin.Context, orderId string, returnId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	ret, err := api.Service.CheckReturnPhotoUpload(c.Request.Context(), orderId, returnId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrReturnPhotosNotAllowed) {
+			c.AbortWithStatusJSON(http.StatusPreconditionFailed, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+
+		api.Logger.Error("check return photo upload", zap.String("order_id", orderId), zap.String("id", returnId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "failed to get return"}},
+		})
+		return
+	}
+	if ret == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "return not found"}},
+		})
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: `bad photo provided in the "file" form field`}},
+		})
+		return
+	}
+
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if _, ok := allowedReturnPhotoExtensions[ext]; !ok {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`bad photo extension "%s"`, ext)}},
+		})
+		return
+	}
+
+	if file.Size > MaxReturnPhotoSize {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf("photo size (%.2f MiB) exceeds max size of %d MiB", float64(file.Size)/MiB, MaxReturnPhotoSizeMiB)}},
+		})
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "failed to open photo file"}},
+		})
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	photoId := uuid.NewString()
+	uploadRes, err := api.ReturnPhotoStore.Upload(c.Request.Context(), returnId+"/"+photoId+ext, f)
+	if err != nil {
+		api.Logger.Error("failed to upload file to s3", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "failed to upload file"}},
+		})
+		return
+	}
+
+	photo := oapi_codegen.OrdersReturnPhoto{Id: photoId, Url: uploadRes.PictureUrl}
+	if err := api.Service.AddReturnPhoto(c.Request.Context(), orderId, returnId, photo); err != nil {
+		if errors.Is(err, service.ErrReturnPhotosNotAllowed) || errors.Is(err, service.ErrReturnConflict) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+
+		api.Logger.Error("add return photo", zap.String("order_id", orderId), zap.String("id", returnId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "failed to save photo information to return"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, oapi_codegen.OrdersUploadReturnPhotoRes{
+		Id:  photo.Id,
+		Url: photo.Url,
+	})
+}
+
+func (api *ApiImpl) OrdersListAddresses(c *gin.Context, userId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.ListAddresses(c.Request.Context(), userId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("list addresses", zap.String("user_id", userId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to list addresses"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersCreateAddress(c *gin.Context, userId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	var requestBody oapi_codegen.OrdersAddressReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	res, err := api.Service.CreateAddress(c.Request.Context(), requestBody, userId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrAddressesLimitReached) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+
+		api.Logger.Error("create address", zap.String("user_id", userId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to create address"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "address not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersGetAddress(c *gin.Context, userId string, addressId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.GetAddress(c.Request.Context(), userId, addressId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("get address", zap.String("user_id", userId), zap.String("id", addressId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to get address"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "address not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersUpdateAddress(c *gin.Context, userId string, addressId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	var requestBody oapi_codegen.OrdersAddressReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	res, err := api.Service.UpdateAddress(c.Request.Context(), requestBody, userId, addressId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("update address", zap.String("user_id", userId), zap.String("id", addressId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to update address"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "address not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersDeleteAddress(c *gin.Context, userId string, addressId string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.DeleteAddress(c.Request.Context(), userId, addressId, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("delete address", zap.String("user_id", userId), zap.String("id", addressId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to delete address"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersListCoupons(c *gin.Context) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.ListCoupons(c.Request.Context(), accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("list coupons", zap.String("subject_id", accessToken.SubjectId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to list coupons"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersCreateCoupon(c *gin.Context) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	var requestBody oapi_codegen.OrdersCreateCouponReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	res, err := api.Service.CreateCoupon(c.Request.Context(), requestBody, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidCoupon) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrCouponExists) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 126, Message: err.Error()}},
+			})
+			return
+		}
+
+		api.Logger.Error("create coupon", zap.String("subject_id", accessToken.SubjectId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to create coupon"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "coupon not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersGetCoupon(c *gin.Context, code string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.GetCoupon(c.Request.Context(), code, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("get coupon", zap.String("code", code), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to get coupon"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "coupon not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersDeleteCoupon(c *gin.Context, code string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	res, err := api.Service.DeleteCoupon(c.Request.Context(), code, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("delete coupon", zap.String("code", code), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to delete coupon"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "coupon not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersPreviewCoupon(c *gin.Context, code string) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems"}},
+		})
+		return
+	}
+
+	var req oapi_codegen.OrdersPreviewCouponReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&req); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "invalid request body"}},
+		})
+		return
+	}
+
+	res, err := api.Service.PreviewCoupon(c.Request.Context(), code, req, accessToken.SubjectType, accessToken.SubjectId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+			})
+			return
+		}
+
+		api.Logger.Error("preview coupon", zap.String("code", code), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "failed to preview coupon"}},
+		})
+		return
+	}
+
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 125, Message: "coupon not found"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) OrdersProcessPayment(c *gin.Context, provider string) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: fmt.Sprintf("invalid request body: %v", err),
+		}))
+		return
+	}
+
+	api.Logger.Info("process payment notification request", zap.String("provider", provider), zap.String("content_type", c.ContentType()))
+
+	if err := api.Service.ProcessPaymentNotification(c.Request.Context(), provider, payment.NotificationReq{
+		ContentType: c.ContentType(),
+		Body:        body,
+	}); err != nil {
+		if errors.Is(err, service.ErrUnknownPaymentProvider) {
+			c.AbortWithStatusJSON(http.StatusNotFound, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+				Code:    1,
+				Message: err.Error(),
+			}))
+			return
+		}
+		if errors.Is(err, service.ErrInvalidPaymentNotification) {
+			api.Logger.Info("payment notification bad input", zap.String("provider", provider), zap.Error(err))
+			c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+				Code:    1,
+				Message: err.Error(),
+			}))
+			return
+		}
+
+		api.Logger.Error("process payment notification", zap.String("provider", provider), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: "failed to process payment notification",
+		}))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "ok"})
+}
+
+func (api *ApiImpl) OrdersProcessCarrierEvents(c *gin.Context, carrierName string) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: fmt.Sprintf("invalid request body: %v", err),
+		}))
+		return
+	}
+
+	if err := api.Service.ProcessCarrierWebhook(c.Request.Context(), carrierName, carrier.WebhookReq{
+		Header: c.Request.Header,
+		Body:   body,
+	}); err != nil {
+		if errors.Is(err, service.ErrUnknownCarrier) {
+			c.AbortWithStatusJSON(http.StatusNotFound, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+				Code:    1,
+				Message: err.Error(),
+			}))
+			return
+		}
+		if errors.Is(err, service.ErrInvalidCarrierWebhook) {
+			api.Logger.Info("carrier webhook bad input", zap.String("carrier", carrierName), zap.Error(err))
+			c.AbortWithStatusJSON(http.StatusBadRequest, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+				Code:    1,
+				Message: err.Error(),
+			}))
+			return
+		}
+
+		api.Logger.Error("process carrier webhook", zap.String("carrier", carrierName), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{
+			Code:    1,
+			Message: "failed to process carrier webhook",
+		}))
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "ok"})
 }
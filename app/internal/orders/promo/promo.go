@@ -0,0 +1,140 @@
+// Package promo contains the coupon engine: coupon validation and discount computation.
+// It's used by the orders service previewing and redeeming coupons.
+package promo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidCoupon = errors.New("invalid coupon")
+	// ErrNotApplicable is returned by coupons that can't be applied to the basket, the error explains why.
+	ErrNotApplicable = errors.New("coupon is not applicable")
+)
+
+type DiscountType string
+
+const (
+	// DiscountTypePercent discounts a percent of the eligible items prices.
+	DiscountTypePercent DiscountType = "percent"
+	// DiscountTypeFixed discounts a fixed amount, up to the eligible items prices.
+	DiscountTypeFixed DiscountType = "fixed"
+)
+
+var codeRegexp = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// NormalizeCode makes codes case insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Coupon amounts are in minor units of the order currency. Zero limits are unlimited.
+type Coupon struct {
+	Code string
+	// OwnerType is the subject type of the coupon creator, admin or seller.
+	OwnerType     string
+	OwnerId       string
+	DiscountType  DiscountType
+	DiscountValue int64
+	// MinSubtotal is the minimum basket: the sum of all items prices.
+	MinSubtotal    int64
+	MaxUses        uint64
+	MaxUsesPerUser uint64
+	StartsAt       *time.Time
+	ExpiresAt      *time.Time
+	// ProductIds and SellerIds scope the discount to the items of the products or sellers.
+	// Coupons without scope discount all items.
+	ProductIds []string
+	SellerIds  []string
+}
+
+// Validate checks coupon settings.
+func (c Coupon) Validate() error {
+	if !codeRegexp.MatchString(c.Code) {
+		return fmt.Errorf(`%w: code must be 3 to 32 latin letters, digits, "-" or "_"`, ErrInvalidCoupon)
+	}
+	switch c.DiscountType {
+	case DiscountTypePercent:
+		if c.DiscountValue < 1 || c.DiscountValue > 100 {
+			return fmt.Errorf("%w: percent discount must be from 1 to 100", ErrInvalidCoupon)
+		}
+	case DiscountTypeFixed:
+		if c.DiscountValue < 1 {
+			return fmt.Errorf("%w: fixed discount must be positive", ErrInvalidCoupon)
+		}
+	default:
+		return fmt.Errorf(`%w: unknown discount type "%s"`, ErrInvalidCoupon, c.DiscountType)
+	}
+	if c.MinSubtotal < 0 {
+		return fmt.Errorf("%w: minimum basket must not be negative", ErrInvalidCoupon)
+	}
+	if c.StartsAt != nil && c.ExpiresAt != nil && !c.StartsAt.Before(*c.ExpiresAt) {
+		return fmt.Errorf("%w: coupon must start before it expires", ErrInvalidCoupon)
+	}
+	return nil
+}
+
+// Item is a basket item, its price is the unit price in minor units.
+type Item struct {
+	ProductId string
+	SellerId  string
+	Price     int64
+	Count     int
+}
+
+// Usage is the count of unreleased redemptions of the coupon.
+type Usage struct {
+	Uses     uint64
+	UserUses uint64
+}
+
+// Discount computes the discount of the basket in minor units.
+func (c Coupon) Discount(items []Item, usage Usage, now time.Time) (int64, error) {
+	if c.StartsAt != nil && now.Before(*c.StartsAt) {
+		return 0, fmt.Errorf("%w: coupon is not active yet", ErrNotApplicable)
+	}
+	if c.ExpiresAt != nil && !now.Before(*c.ExpiresAt) {
+		return 0, fmt.Errorf("%w: coupon has expired", ErrNotApplicable)
+	}
+	if c.MaxUses != 0 && usage.Uses >= c.MaxUses {
+		return 0, fmt.Errorf("%w: coupon usage limit is reached", ErrNotApplicable)
+	}
+	if c.MaxUsesPerUser != 0 && usage.UserUses >= c.MaxUsesPerUser {
+		return 0, fmt.Errorf("%w: coupon usage limit per user is reached", ErrNotApplicable)
+	}
+
+	var subtotal, eligible int64
+	for _, item := range items {
+		amount := item.Price * int64(item.Count)
+		subtotal += amount
+		if c.covers(item) {
+			eligible += amount
+		}
+	}
+	if subtotal < c.MinSubtotal {
+		return 0, fmt.Errorf("%w: minimum basket is %d", ErrNotApplicable, c.MinSubtotal)
+	}
+	if eligible == 0 {
+		return 0, fmt.Errorf("%w: no items are eligible for the coupon", ErrNotApplicable)
+	}
+
+	if c.DiscountType == DiscountTypePercent {
+		return eligible * c.DiscountValue / 100, nil
+	}
+	return min(c.DiscountValue, eligible), nil
+}
+
+func (c Coupon) covers(item Item) bool {
+	if len(c.ProductIds) != 0 && !slices.Contains(c.ProductIds, item.ProductId) {
+		return false
+	}
+	if len(c.SellerIds) != 0 && !slices.Contains(c.SellerIds, item.SellerId) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,208 @@
+package promo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bratushkadan/floral/internal/orders/promo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	now    = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	past   = now.Add(-time.Hour)
+	future = now.Add(time.Hour)
+)
+
+func TestCouponValidate(t *testing.T) {
+	valid := promo.Coupon{Code: "SPRING-10", DiscountType: promo.DiscountTypePercent, DiscountValue: 10}
+
+	tests := []struct {
+		name    string
+		modify  func(c *promo.Coupon)
+		invalid bool
+	}{
+		{name: "valid", modify: func(c *promo.Coupon) {}},
+		{name: "code with underscore", modify: func(c *promo.Coupon) { c.Code = "SPRING_10" }},
+		{name: "short code", modify: func(c *promo.Coupon) { c.Code = "AB" }, invalid: true},
+		{name: "long code", modify: func(c *promo.Coupon) { c.Code = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456" }, invalid: true},
+		{name: "lowercase code", modify: func(c *promo.Coupon) { c.Code = "spring" }, invalid: true},
+		{name: "code with space", modify: func(c *promo.Coupon) { c.Code = "SPRING 10" }, invalid: true},
+		{name: "100 percent", modify: func(c *promo.Coupon) { c.DiscountValue = 100 }},
+		{name: "zero percent", modify: func(c *promo.Coupon) { c.DiscountValue = 0 }, invalid: true},
+		{name: "over 100 percent", modify: func(c *promo.Coupon) { c.DiscountValue = 101 }, invalid: true},
+		{name: "fixed", modify: func(c *promo.Coupon) { c.DiscountType, c.DiscountValue = promo.DiscountTypeFixed, 500_00 }},
+		{name: "zero fixed", modify: func(c *promo.Coupon) { c.DiscountType, c.DiscountValue = promo.DiscountTypeFixed, 0 }, invalid: true},
+		{name: "unknown discount type", modify: func(c *promo.Coupon) { c.DiscountType = "gift" }, invalid: true},
+		{name: "minimum basket", modify: func(c *promo.Coupon) { c.MinSubtotal = 1000_00 }},
+		{name: "negative minimum basket", modify: func(c *promo.Coupon) { c.MinSubtotal = -1 }, invalid: true},
+		{name: "window", modify: func(c *promo.Coupon) { c.StartsAt, c.ExpiresAt = &past, &future }},
+		{name: "open window", modify: func(c *promo.Coupon) { c.ExpiresAt = &past }},
+		{name: "empty window", modify: func(c *promo.Coupon) { c.StartsAt, c.ExpiresAt = &now, &now }, invalid: true},
+		{name: "reversed window", modify: func(c *promo.Coupon) { c.StartsAt, c.ExpiresAt = &future, &past }, invalid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid
+			tt.modify(&c)
+
+			err := c.Validate()
+			if tt.invalid {
+				assert.ErrorIs(t, err, promo.ErrInvalidCoupon)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCouponDiscount(t *testing.T) {
+	items := []promo.Item{
+		{ProductId: "product-1", SellerId: "seller-1", Price: 100_00, Count: 2},
+		{ProductId: "product-2", SellerId: "seller-1", Price: 33_33, Count: 1},
+		{ProductId: "product-3", SellerId: "seller-2", Price: 50_00, Count: 3},
+	}
+
+	tests := []struct {
+		name     string
+		coupon   promo.Coupon
+		items    []promo.Item
+		usage    promo.Usage
+		expected int64
+		// notApplicable coupons return promo.ErrNotApplicable
+		notApplicable bool
+	}{
+		{
+			name:     "percent of all items",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypePercent, DiscountValue: 10},
+			expected: 38_33,
+		},
+		{
+			name:     "percent is rounded down",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypePercent, DiscountValue: 15},
+			items:    []promo.Item{{ProductId: "product-2", SellerId: "seller-1", Price: 33_33, Count: 1}},
+			expected: 4_99,
+		},
+		{
+			name:     "100 percent",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypePercent, DiscountValue: 100},
+			expected: 383_33,
+		},
+		{
+			name:     "fixed",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 50_00},
+			expected: 50_00,
+		},
+		{
+			name:     "fixed is capped by eligible items",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 1000_00, SellerIds: []string{"seller-2"}},
+			expected: 150_00,
+		},
+		{
+			name:     "percent of product items",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypePercent, DiscountValue: 50, ProductIds: []string{"product-1"}},
+			expected: 100_00,
+		},
+		{
+			name:     "percent of seller items",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypePercent, DiscountValue: 10, SellerIds: []string{"seller-1"}},
+			expected: 23_33,
+		},
+		{
+			name:     "product and seller scopes both apply",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypePercent, DiscountValue: 10, ProductIds: []string{"product-1", "product-3"}, SellerIds: []string{"seller-1"}},
+			expected: 20_00,
+		},
+		{
+			name:          "no eligible items",
+			coupon:        promo.Coupon{DiscountType: promo.DiscountTypePercent, DiscountValue: 10, ProductIds: []string{"product-4"}},
+			notApplicable: true,
+		},
+		{
+			name:          "empty basket",
+			coupon:        promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00},
+			items:         []promo.Item{},
+			notApplicable: true,
+		},
+		{
+			name:     "minimum basket counts all items",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, MinSubtotal: 383_33, SellerIds: []string{"seller-2"}},
+			expected: 10_00,
+		},
+		{
+			name:          "minimum basket is not reached",
+			coupon:        promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, MinSubtotal: 383_34},
+			notApplicable: true,
+		},
+		{
+			name:     "within window",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, StartsAt: &past, ExpiresAt: &future},
+			expected: 10_00,
+		},
+		{
+			name:     "window starts now",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, StartsAt: &now},
+			expected: 10_00,
+		},
+		{
+			name:          "not active yet",
+			coupon:        promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, StartsAt: &future},
+			notApplicable: true,
+		},
+		{
+			name:          "expires now",
+			coupon:        promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, ExpiresAt: &now},
+			notApplicable: true,
+		},
+		{
+			name:          "expired",
+			coupon:        promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, ExpiresAt: &past},
+			notApplicable: true,
+		},
+		{
+			name:     "below usage limits",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, MaxUses: 10, MaxUsesPerUser: 2},
+			usage:    promo.Usage{Uses: 9, UserUses: 1},
+			expected: 10_00,
+		},
+		{
+			name:     "zero limits are unlimited",
+			coupon:   promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00},
+			usage:    promo.Usage{Uses: 1000, UserUses: 1000},
+			expected: 10_00,
+		},
+		{
+			name:          "usage limit is reached",
+			coupon:        promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, MaxUses: 10},
+			usage:         promo.Usage{Uses: 10},
+			notApplicable: true,
+		},
+		{
+			name:          "usage limit per user is reached",
+			coupon:        promo.Coupon{DiscountType: promo.DiscountTypeFixed, DiscountValue: 10_00, MaxUses: 10, MaxUsesPerUser: 1},
+			usage:         promo.Usage{Uses: 1, UserUses: 1},
+			notApplicable: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			basket := items
+			if tt.items != nil {
+				basket = tt.items
+			}
+
+			discount, err := tt.coupon.Discount(basket, tt.usage, now)
+			if tt.notApplicable {
+				assert.ErrorIs(t, err, promo.ErrNotApplicable)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, discount)
+		})
+	}
+}
+
+func TestNormalizeCode(t *testing.T) {
+	assert.Equal(t, "SPRING-10", promo.NormalizeCode(" spring-10\n"))
+}
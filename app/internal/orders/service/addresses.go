@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/google/uuid"
+)
+
+// AddressesLimitCount is the max count of addresses in the user address book.
+const AddressesLimitCount = 10
+
+var (
+	ErrAddressesLimitReached = errors.New("addresses limit is reached")
+)
+
+// Address book is managed by its user, admins may manage address books of any user.
+func authorizeAddressBook(userId, subjectType, subjectId string) error {
+	switch subjectType {
+	case shared_api.SubjectTypeAdmin:
+		return nil
+	case shared_api.SubjectTypeUser:
+		if userId == subjectId {
+			return nil
+		}
+	}
+	return ErrPermissionDenied
+}
+
+func (s *Orders) ListAddresses(ctx context.Context, userId, subjectType, subjectId string) (*oapi_codegen.OrdersListAddressesRes, error) {
+	if err := authorizeAddressBook(userId, subjectType, subjectId); err != nil {
+		return nil, err
+	}
+
+	addresses, err := s.store.ListAddresses(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("list addresses: %v", err)
+	}
+	return &oapi_codegen.OrdersListAddressesRes{Addresses: addresses}, nil
+}
+
+func (s *Orders) CreateAddress(ctx context.Context, req oapi_codegen.OrdersAddressReq, userId, subjectType, subjectId string) (*oapi_codegen.OrdersAddress, error) {
+	if err := authorizeAddressBook(userId, subjectType, subjectId); err != nil {
+		return nil, err
+	}
+
+	addressId := uuid.NewString()
+	if err := s.store.CreateAddress(ctx, store.CreateAddressDTOInput{
+		Id:        addressId,
+		UserId:    userId,
+		Address:   req,
+		Limit:     AddressesLimitCount,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		if errors.Is(err, store.ErrAddressesLimitReached) {
+			return nil, fmt.Errorf("%w: max amount of addresses of %d is reached", ErrAddressesLimitReached, AddressesLimitCount)
+		}
+		return nil, fmt.Errorf("create address: %v", err)
+	}
+
+	return s.getAddress(ctx, userId, addressId)
+}
+
+// GetAddress returns nil response if the user has no such address.
+func (s *Orders) GetAddress(ctx context.Context, userId, addressId, subjectType, subjectId string) (*oapi_codegen.OrdersAddress, error) {
+	if err := authorizeAddressBook(userId, subjectType, subjectId); err != nil {
+		return nil, err
+	}
+	return s.getAddress(ctx, userId, addressId)
+}
+
+func (s *Orders) getAddress(ctx context.Context, userId, addressId string) (*oapi_codegen.OrdersAddress, error) {
+	address, err := s.store.GetAddress(ctx, userId, addressId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve address: %v", err)
+	}
+	return address, nil
+}
+
+// UpdateAddress replaces the address, orders placed with it keep the previous one.
+// It returns nil response if the user has no such address.
+func (s *Orders) UpdateAddress(ctx context.Context, req oapi_codegen.OrdersAddressReq, userId, addressId, subjectType, subjectId string) (*oapi_codegen.OrdersAddress, error) {
+	if err := authorizeAddressBook(userId, subjectType, subjectId); err != nil {
+		return nil, err
+	}
+
+	found, err := s.store.UpdateAddress(ctx, store.UpdateAddressDTOInput{
+		Id:        addressId,
+		UserId:    userId,
+		Address:   req,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update address: %v", err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return s.getAddress(ctx, userId, addressId)
+}
+
+func (s *Orders) DeleteAddress(ctx context.Context, userId, addressId, subjectType, subjectId string) (*oapi_codegen.OrdersDeleteAddressRes, error) {
+	if err := authorizeAddressBook(userId, subjectType, subjectId); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteAddress(ctx, userId, addressId); err != nil {
+		return nil, fmt.Errorf("delete address: %v", err)
+	}
+	return &oapi_codegen.OrdersDeleteAddressRes{Id: addressId}, nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/bratushkadan/floral/internal/orders/carrier"
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrUnknownCarrier        = errors.New("unknown carrier")
+	ErrInvalidCarrierWebhook = errors.New("invalid carrier webhook")
+)
+
+// ProcessCarrierWebhook moves shipped shipments to "delivered" once their carrier reports the delivery.
+// Events of unknown parcels and repeated events are skipped, so that carriers don't retry them.
+func (s *Orders) ProcessCarrierWebhook(ctx context.Context, carrierName string, req carrier.WebhookReq) error {
+	c, ok := s.carriers.Get(carrierName)
+	if !ok {
+		return fmt.Errorf(`%w: "%s"`, ErrUnknownCarrier, carrierName)
+	}
+
+	events, err := c.VerifyWebhook(ctx, req)
+	if err != nil {
+		if errors.Is(err, carrier.ErrInvalidWebhook) {
+			return fmt.Errorf("%w: %v", ErrInvalidCarrierWebhook, err)
+		}
+		return fmt.Errorf("verify carrier webhook: %v", err)
+	}
+
+	actor := store.Actor{Type: SubjectTypeSystem, Id: "carrier:" + c.Name()}
+	var processed bool
+	for _, event := range events {
+		if event.Type != carrier.EventTypeDelivered {
+			continue
+		}
+
+		shipments, err := s.store.ListShipmentsByTracking(ctx, c.Name(), event.TrackingNumber)
+		if err != nil {
+			return fmt.Errorf("list shipments by tracking: %v", err)
+		}
+		if len(shipments) == 0 {
+			s.l.Info("carrier event of unknown parcel", zap.String("carrier", c.Name()), zap.String("event_id", event.Id), zap.String("tracking_number", event.TrackingNumber))
+			continue
+		}
+
+		for _, key := range shipments {
+			order, err := s.store.GetOrder(ctx, key.OrderId)
+			if err != nil {
+				return fmt.Errorf("retrieve order: %v", err)
+			}
+			if order == nil || !slices.ContainsFunc(order.Shipments, func(shipment oapi_codegen.OrdersGetOrderResShipment) bool {
+				return shipment.SellerId == key.SellerId && ShipmentStatus(shipment.Status) == ShipmentStatusShipped
+			}) {
+				continue
+			}
+
+			occurredAt := event.OccurredAt
+			if _, err := s.updateShipment(ctx, order, shipmentUpdate{
+				SellerId:           key.SellerId,
+				Status:             string(ShipmentStatusDelivered),
+				CarrierDeliveredAt: &occurredAt,
+				Actor:              actor,
+			}); err != nil {
+				return fmt.Errorf(`deliver shipment of order "%s" and seller "%s": %w`, key.OrderId, key.SellerId, err)
+			}
+			processed = true
+		}
+	}
+
+	if processed {
+		s.relayOutbox(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/promo"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+)
+
+var (
+	ErrInvalidCoupon = promo.ErrInvalidCoupon
+	ErrCouponExists  = errors.New("coupon already exists")
+)
+
+// Coupons are managed by sellers and admins.
+func authorizeCoupons(subjectType string) error {
+	if subjectType == shared_api.SubjectTypeAdmin || subjectType == shared_api.SubjectTypeSeller {
+		return nil
+	}
+	return ErrPermissionDenied
+}
+
+// Coupon is managed by its owner, admins may manage coupons of any owner.
+func authorizeCoupon(coupon *store.Coupon, subjectType, subjectId string) error {
+	if subjectType == shared_api.SubjectTypeAdmin || (coupon.OwnerType == subjectType && coupon.OwnerId == subjectId) {
+		return nil
+	}
+	return ErrPermissionDenied
+}
+
+// CreateCoupon creates the coupon owned by the subject. Coupons of sellers only discount items of the seller.
+func (s *Orders) CreateCoupon(ctx context.Context, req oapi_codegen.OrdersCreateCouponReq, subjectType, subjectId string) (*oapi_codegen.OrdersCoupon, error) {
+	if err := authorizeCoupons(subjectType); err != nil {
+		return nil, err
+	}
+
+	coupon, err := newCoupon(req, subjectType, subjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.CreateCoupon(ctx, coupon, time.Now()); err != nil {
+		if errors.Is(err, store.ErrCouponExists) {
+			return nil, fmt.Errorf(`%w: "%s"`, ErrCouponExists, coupon.Code)
+		}
+		return nil, fmt.Errorf("create coupon: %v", err)
+	}
+
+	return s.getCoupon(ctx, coupon.Code)
+}
+
+func newCoupon(req oapi_codegen.OrdersCreateCouponReq, subjectType, subjectId string) (promo.Coupon, error) {
+	coupon := promo.Coupon{
+		Code:          promo.NormalizeCode(req.Code),
+		OwnerType:     subjectType,
+		OwnerId:       subjectId,
+		DiscountType:  promo.DiscountType(req.DiscountType),
+		DiscountValue: req.DiscountValue,
+		MinSubtotal:   deref(req.MinSubtotal),
+		StartsAt:      req.StartsAt,
+		ExpiresAt:     req.ExpiresAt,
+		ProductIds:    deref(req.ProductIds),
+		SellerIds:     deref(req.SellerIds),
+	}
+
+	if deref(req.MaxUses) < 0 || deref(req.MaxUsesPerUser) < 0 {
+		return promo.Coupon{}, fmt.Errorf("%w: usage limits must not be negative", ErrInvalidCoupon)
+	}
+	coupon.MaxUses = uint64(deref(req.MaxUses))
+	coupon.MaxUsesPerUser = uint64(deref(req.MaxUsesPerUser))
+
+	if subjectType == shared_api.SubjectTypeSeller {
+		for _, sellerId := range coupon.SellerIds {
+			if sellerId != subjectId {
+				return promo.Coupon{}, fmt.Errorf("%w: sellers may only discount their own items", ErrInvalidCoupon)
+			}
+		}
+		coupon.SellerIds = []string{subjectId}
+	}
+
+	if err := coupon.Validate(); err != nil {
+		return promo.Coupon{}, err
+	}
+	return coupon, nil
+}
+
+// ListCoupons lists coupons of the seller, admins list coupons of all owners.
+func (s *Orders) ListCoupons(ctx context.Context, subjectType, subjectId string) (*oapi_codegen.OrdersListCouponsRes, error) {
+	if err := authorizeCoupons(subjectType); err != nil {
+		return nil, err
+	}
+
+	var in store.ListCouponsDTOInput
+	if subjectType != shared_api.SubjectTypeAdmin {
+		in.OwnerType, in.OwnerId = &subjectType, &subjectId
+	}
+	coupons, err := s.store.ListCoupons(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("list coupons: %v", err)
+	}
+
+	out := &oapi_codegen.OrdersListCouponsRes{Coupons: make([]oapi_codegen.OrdersCoupon, 0, len(coupons))}
+	for _, coupon := range coupons {
+		out.Coupons = append(out.Coupons, newCouponRes(coupon))
+	}
+	return out, nil
+}
+
+// GetCoupon returns nil response if there's no such coupon.
+func (s *Orders) GetCoupon(ctx context.Context, code, subjectType, subjectId string) (*oapi_codegen.OrdersCoupon, error) {
+	if err := authorizeCoupons(subjectType); err != nil {
+		return nil, err
+	}
+
+	coupon, err := s.store.GetCoupon(ctx, promo.NormalizeCode(code))
+	if err != nil {
+		return nil, fmt.Errorf("retrieve coupon: %v", err)
+	}
+	if coupon == nil {
+		return nil, nil
+	}
+	if err := authorizeCoupon(coupon, subjectType, subjectId); err != nil {
+		return nil, err
+	}
+
+	res := newCouponRes(*coupon)
+	return &res, nil
+}
+
+func (s *Orders) getCoupon(ctx context.Context, code string) (*oapi_codegen.OrdersCoupon, error) {
+	coupon, err := s.store.GetCoupon(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve coupon: %v", err)
+	}
+	if coupon == nil {
+		return nil, nil
+	}
+	res := newCouponRes(*coupon)
+	return &res, nil
+}
+
+// DeleteCoupon deletes the coupon, orders placed with it keep their discount.
+// It returns nil response if there's no such coupon.
+func (s *Orders) DeleteCoupon(ctx context.Context, code, subjectType, subjectId string) (*oapi_codegen.OrdersDeleteCouponRes, error) {
+	if err := authorizeCoupons(subjectType); err != nil {
+		return nil, err
+	}
+
+	code = promo.NormalizeCode(code)
+	coupon, err := s.store.GetCoupon(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve coupon: %v", err)
+	}
+	if coupon == nil {
+		return nil, nil
+	}
+	if err := authorizeCoupon(coupon, subjectType, subjectId); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.DeleteCoupon(ctx, code); err != nil {
+		return nil, fmt.Errorf("delete coupon: %v", err)
+	}
+	return &oapi_codegen.OrdersDeleteCouponRes{Code: code}, nil
+}
+
+// PreviewCoupon previews the discount the coupon gives to the items of the user, e.g. the cart contents.
+// Orders are discounted at the prices the products are reserved at, so the discount may differ from the preview.
+// It returns nil response if there's no such coupon.
+func (s *Orders) PreviewCoupon(ctx context.Context, code string, req oapi_codegen.OrdersPreviewCouponReq, subjectType, subjectId string) (*oapi_codegen.OrdersCouponPreviewRes, error) {
+	if subjectType != shared_api.SubjectTypeUser {
+		return nil, ErrPermissionDenied
+	}
+
+	code = promo.NormalizeCode(code)
+	coupon, userUses, err := s.store.GetCouponUsage(ctx, code, subjectId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve coupon usage: %w", err)
+	}
+	if coupon == nil {
+		return nil, nil
+	}
+
+	out := &oapi_codegen.OrdersCouponPreviewRes{
+		Code:            code,
+		CurrencyIso4217: OrderCurrencyIso4217,
+	}
+	items := make([]promo.Item, 0, len(req.Items))
+	for _, item := range req.Items {
+		price := store.ToMinorUnits(item.Price)
+		items = append(items, promo.Item{
+			ProductId: item.ProductId,
+			SellerId:  item.SellerId,
+			Price:     price,
+			Count:     item.Count,
+		})
+		out.Subtotal += price * int64(item.Count)
+	}
+
+	discount, err := coupon.Discount(items, promo.Usage{Uses: coupon.Uses, UserUses: userUses}, time.Now())
+	if err != nil && !errors.Is(err, promo.ErrNotApplicable) {
+		return nil, fmt.Errorf("compute discount: %w", err)
+	}
+	if err != nil {
+		out.Reason = ptr(err.Error())
+	} else {
+		out.Applicable = true
+		out.Discount = discount
+	}
+	out.Total = out.Subtotal - out.Discount
+	return out, nil
+}
+
+func newCouponRes(coupon store.Coupon) oapi_codegen.OrdersCoupon {
+	res := oapi_codegen.OrdersCoupon{
+		Code:           coupon.Code,
+		OwnerType:      coupon.OwnerType,
+		OwnerId:        coupon.OwnerId,
+		DiscountType:   string(coupon.DiscountType),
+		DiscountValue:  coupon.DiscountValue,
+		MinSubtotal:    coupon.MinSubtotal,
+		MaxUses:        int64(coupon.MaxUses),
+		MaxUsesPerUser: int64(coupon.MaxUsesPerUser),
+		ProductIds:     coupon.ProductIds,
+		SellerIds:      coupon.SellerIds,
+		Uses:           int64(coupon.Uses),
+		CreatedAt:      coupon.CreatedAt.Format(time.RFC3339),
+	}
+	if res.ProductIds == nil {
+		res.ProductIds = make([]string, 0)
+	}
+	if res.SellerIds == nil {
+		res.SellerIds = make([]string, 0)
+	}
+	if coupon.StartsAt != nil {
+		res.StartsAt = ptr(coupon.StartsAt.Format(time.RFC3339))
+	}
+	if coupon.ExpiresAt != nil {
+		res.ExpiresAt = ptr(coupon.ExpiresAt.Format(time.RFC3339))
+	}
+	return res
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+)
+
+const (
+	DeliveryMethodCourier = "courier"
+	DeliveryMethodPost    = "post"
+	// DeliveryMethodPickup orders are picked up by the buyer, they have neither address nor carrier.
+	DeliveryMethodPickup = "pickup"
+)
+
+var (
+	ErrInvalidDelivery         = errors.New("invalid delivery")
+	ErrInvalidShipmentTracking = errors.New("invalid shipment tracking")
+)
+
+// newOrderDelivery validates the delivery of the order being created and snapshots the address book entry into it.
+func (s *Orders) newOrderDelivery(ctx context.Context, req oapi_codegen.OrdersCreateOrderReq, userId string) (*oapi_codegen.OrdersDelivery, error) {
+	switch req.DeliveryMethod {
+	case DeliveryMethodPickup:
+		if req.AddressId != nil {
+			return nil, fmt.Errorf(`%w: "%s" delivery takes no address`, ErrInvalidDelivery, req.DeliveryMethod)
+		}
+		return &oapi_codegen.OrdersDelivery{Method: req.DeliveryMethod}, nil
+	case DeliveryMethodCourier, DeliveryMethodPost:
+	default:
+		return nil, fmt.Errorf(`%w: unknown delivery method "%s"`, ErrInvalidDelivery, req.DeliveryMethod)
+	}
+
+	if req.AddressId == nil {
+		return nil, fmt.Errorf(`%w: "%s" delivery requires an address`, ErrInvalidDelivery, req.DeliveryMethod)
+	}
+	address, err := s.store.GetAddress(ctx, userId, *req.AddressId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve address: %v", err)
+	}
+	if address == nil {
+		return nil, fmt.Errorf(`%w: address "%s" not found`, ErrInvalidDelivery, *req.AddressId)
+	}
+
+	return &oapi_codegen.OrdersDelivery{
+		Method: req.DeliveryMethod,
+		Address: &oapi_codegen.OrdersDeliveryAddress{
+			AddressId:     address.Id,
+			RecipientName: address.RecipientName,
+			Phone:         address.Phone,
+			Country:       address.Country,
+			City:          address.City,
+			Street:        address.Street,
+			PostalCode:    address.PostalCode,
+			Comment:       address.Comment,
+		},
+	}, nil
+}
+
+// validateShipmentTracking requires tracking of shipped shipments of orders delivered by a carrier
+// and rejects it with any other status.
+func validateShipmentTracking(update shipmentUpdate, order *oapi_codegen.OrdersGetOrderRes) error {
+	if ShipmentStatus(update.Status) != ShipmentStatusShipped {
+		if update.TrackingNumber != nil || update.Carrier != nil {
+			return fmt.Errorf(`%w: tracking is set once the shipment is "%s"`, ErrInvalidShipmentTracking, ShipmentStatusShipped)
+		}
+		return nil
+	}
+
+	if order.Delivery != nil && order.Delivery.Method == DeliveryMethodPickup {
+		if update.TrackingNumber != nil || update.Carrier != nil {
+			return fmt.Errorf(`%w: "%s" order has no carrier`, ErrInvalidShipmentTracking, DeliveryMethodPickup)
+		}
+		return nil
+	}
+	if update.TrackingNumber == nil || update.Carrier == nil {
+		return fmt.Errorf("%w: tracking number and carrier are required to ship the shipment", ErrInvalidShipmentTracking)
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package service
+
+// Unexported parts of the service exposed to its tests.
+var (
+	TransitionReturn         = transitionReturn
+	AllowedReturnTransitions = allowedReturnTransitions
+
+	PriceOrder  = priceOrder
+	IncludedVat = includedVat
+)
@@ -16,21 +16,41 @@ const (
 	OperationTypeCreateOrderStatusAborted    = "aborted"
 	OperationTypeCreateOrderStatusTerminated = "terminated"
 	OperationTypeCreateOrderStatusCompleted  = "completed"
+
+	// OperationDetailsPromoCodeRejected prefixes details of completed create order operations
+	// whose orders are placed without the discount of the promo code applied to the cart.
+	OperationDetailsPromoCodeRejected = "promo_code_rejected"
 )
 
 func (s *Orders) GetOperation(ctx context.Context, operationId string) (*oapi_codegen.OrdersGetOperationRes, error) {
-	return s.store.GetOperation(ctx, operationId)
+	op, err := s.store.GetOperation(ctx, operationId)
+	if err != nil || op == nil {
+		return op, err
+	}
+
+	if op.Type == OperationTypeCreateOrder && op.Status == OperationTypeCreateOrderStatusCompleted && op.OrderId != nil {
+		op.Payment, err = s.orderPayment(ctx, *op.OrderId)
+		if err != nil {
+			return nil, fmt.Errorf("order payment: %v", err)
+		}
+	}
+
+	return op, nil
 }
 
+// CancelOperations aborts started create order operations that can't be completed.
+// Operations that are already completed or aborted are left as is, so redelivered and stale messages
+// (e.g. of a cart published again after it was cleared by the created order) don't abort them.
 func (s *Orders) CancelOperations(ctx context.Context, req oapi_codegen.PrivateOrderCancelOperationsReq) error {
 	ops := make([]store.UpdateOperationManyDTOInputOperation, 0, len(req.Messages))
 
 	for _, message := range req.Messages {
 		ops = append(ops, store.UpdateOperationManyDTOInputOperation{
-			Id:        message.OperationId,
-			Status:    OperationTypeCreateOrderStatusAborted,
-			Details:   &message.Details,
-			UpdatedAt: time.Now(),
+			Id:         message.OperationId,
+			FromStatus: OperationTypeCreateOrderStatusStarted,
+			Status:     OperationTypeCreateOrderStatusAborted,
+			Details:    &message.Details,
+			UpdatedAt:  time.Now(),
 		})
 	}
 
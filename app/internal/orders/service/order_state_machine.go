@@ -0,0 +1,254 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+)
+
+var (
+	ErrOrderStateMachineInvalidStatus             = errors.New("invalid order status")
+	ErrOrderStateMachineIncorrentStatusTransition = errors.New("incorrect order status transition")
+)
+
+// OrderTransitionContext describes an attempt to transition the order.
+type OrderTransitionContext struct {
+	Order *oapi_codegen.OrdersGetOrderRes
+	From  OrderStatus
+	To    OrderStatus
+	Actor store.Actor
+	// Derived transitions follow shipment updates.
+	Derived bool
+	// Refunded tells all payments of the order are refunded.
+	Refunded bool
+	// Reason is the reason stated by the subject.
+	Reason string
+}
+
+// OrderTransitionGuard rejects the transition with an error.
+type OrderTransitionGuard func(tc OrderTransitionContext) error
+
+// OrderTransitionHook builds messages published in the same transaction as the new order status.
+type OrderTransitionHook func(tc OrderTransitionContext) ([]outbox.Message, error)
+
+type OrderTransition struct {
+	To     OrderStatus
+	Guards []OrderTransitionGuard
+	Hooks  []OrderTransitionHook
+}
+
+// orderTransitions is the order lifecycle: transitions available from every order status.
+// Final statuses have no transitions.
+//
+// Sellers and admins used to perform any declared transition. Since orders are split into shipments:
+//   - fulfillment statuses ("processed", "shipped", "delivered", "completed") are only derived from shipments,
+//     sellers advance their shipments instead;
+//   - sellers only cancel orders containing their shipment;
+//   - marking orders "paid" and completing cancellation are left to admins (e.g. payments made out of the providers
+//     or refunded manually) and the service.
+var orderTransitions = map[OrderStatus][]OrderTransition{
+	OrderStatusCreated: {
+		{To: OrderStatusPaid, Guards: []OrderTransitionGuard{performedBy(shared_api.SubjectTypeAdmin, SubjectTypeSystem)}, Hooks: []OrderTransitionHook{sellProducts}},
+		{To: OrderStatusCancelling, Guards: []OrderTransitionGuard{performedBy(shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin, SubjectTypeSystem)}, Hooks: []OrderTransitionHook{unreserveProducts}},
+	},
+	OrderStatusPaid: {
+		{To: OrderStatusProcessed, Guards: []OrderTransitionGuard{derivedFromShipments}},
+		{To: OrderStatusCancelling, Guards: []OrderTransitionGuard{performedBy(shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin, SubjectTypeSystem)}, Hooks: []OrderTransitionHook{unreserveProducts}},
+	},
+	OrderStatusProcessed: {
+		{To: OrderStatusShipped, Guards: []OrderTransitionGuard{derivedFromShipments}},
+		{To: OrderStatusCancelling, Guards: []OrderTransitionGuard{performedBy(shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin, SubjectTypeSystem)}, Hooks: []OrderTransitionHook{unreserveProducts}},
+	},
+	OrderStatusShipped: {
+		{To: OrderStatusDelivered, Guards: []OrderTransitionGuard{derivedFromShipments}},
+		{To: OrderStatusCompleted, Guards: []OrderTransitionGuard{derivedFromShipments}, Hooks: []OrderTransitionHook{publishCompletedOrder}},
+	},
+	OrderStatusDelivered: {
+		{To: OrderStatusCompleted, Guards: []OrderTransitionGuard{derivedFromShipments}, Hooks: []OrderTransitionHook{publishCompletedOrder}},
+	},
+	OrderStatusCancelling: {
+		{To: OrderStatusCancelled, Guards: []OrderTransitionGuard{performedBy(shared_api.SubjectTypeAdmin, SubjectTypeSystem), paymentsRefunded}},
+	},
+	OrderStatusCancelled: nil,
+	OrderStatusCompleted: nil,
+}
+
+// performedBy admits the subject types. Sellers only act on orders containing their shipment.
+func performedBy(subjectTypes ...string) OrderTransitionGuard {
+	return func(tc OrderTransitionContext) error {
+		if !slices.Contains(subjectTypes, tc.Actor.Type) {
+			return fmt.Errorf(`%w: "%s" -> "%s" can't be performed by %s`, ErrPermissionDenied, tc.From, tc.To, tc.Actor.Type)
+		}
+		if tc.Actor.Type == shared_api.SubjectTypeSeller && (tc.Order == nil || !orderParticipant(tc.Order, tc.Actor)) {
+			return fmt.Errorf(`%w: order has no shipment of the seller`, ErrPermissionDenied)
+		}
+		return nil
+	}
+}
+
+// Fulfillment statuses of an order follow its least advanced shipment.
+func derivedFromShipments(tc OrderTransitionContext) error {
+	if !tc.Derived {
+		return fmt.Errorf(`%w: "%s"`, ErrOrderStatusDerived, tc.To)
+	}
+	return nil
+}
+
+// Cancellation is completed once all payments of the order are refunded.
+// Admins complete it for payments refunded out of the providers stating the manual refund reason.
+func paymentsRefunded(tc OrderTransitionContext) error {
+	if tc.Refunded {
+		return nil
+	}
+	if tc.Actor.Type == shared_api.SubjectTypeAdmin && tc.Reason != "" {
+		return nil
+	}
+	return ErrOrderNotRefunded
+}
+
+func unreserveProducts(tc OrderTransitionContext) ([]outbox.Message, error) {
+	msgs, err := store.NewProductsUnreservationMessages(newUnreserveProductsMessage(tc.Order))
+	if err != nil {
+		return nil, fmt.Errorf("products unreservation message: %v", err)
+	}
+	return msgs, nil
+}
+
+func sellProducts(tc OrderTransitionContext) ([]outbox.Message, error) {
+	msgs, err := store.NewProductsSaleMessages(newSellProductsMessage(tc.Order))
+	if err != nil {
+		return nil, fmt.Errorf("products sale message: %v", err)
+	}
+	return msgs, nil
+}
+
+func publishCompletedOrder(tc OrderTransitionContext) ([]outbox.Message, error) {
+	msgs, err := store.NewCompletedOrderMessages(newCompletedOrderMessage(tc.Order))
+	if err != nil {
+		return nil, fmt.Errorf("completed order message: %v", err)
+	}
+	return msgs, nil
+}
+
+type OrderStateMachine struct {
+	status OrderStatus
+}
+
+func NewOrderStateMachine(status OrderStatus) (*OrderStateMachine, error) {
+	if _, ok := orderTransitions[status]; !ok {
+		return nil, fmt.Errorf(`invalid initial order status for state machine: "%s"`, status)
+	}
+	return &OrderStateMachine{status: status}, nil
+}
+
+func NewErrUnavailableTransition(fromStatus, toStatus OrderStatus, availableTransitions ...OrderStatus) error {
+	strAvailableTransitions := make([]string, 0, len(availableTransitions))
+	for _, t := range availableTransitions {
+		strAvailableTransitions = append(strAvailableTransitions, fmt.Sprintf(`"%s"`, string(t)))
+	}
+
+	return fmt.Errorf(
+		`%w: no status transition "%s" -> "%s", available transitions are: "%s" -> %s`,
+		ErrOrderStateMachineIncorrentStatusTransition,
+		fromStatus,
+		toStatus,
+		fromStatus,
+		strings.Join(strAvailableTransitions, ", "),
+	)
+}
+
+func NewErrNoTransitionAvailable(fromStatus OrderStatus) error {
+	return fmt.Errorf(
+		`%w: no available transition for status "%s"`,
+		ErrOrderStateMachineIncorrentStatusTransition,
+		fromStatus,
+	)
+}
+
+func (o *OrderStateMachine) TransitionString(newStatus string, tc OrderTransitionContext) (OrderTransition, error) {
+	if _, ok := orderTransitions[OrderStatus(newStatus)]; !ok {
+		return OrderTransition{}, fmt.Errorf(`%w: "%s"`, ErrOrderStateMachineInvalidStatus, newStatus)
+	}
+	return o.Transition(OrderStatus(newStatus), tc)
+}
+
+// Transition moves the machine to the new status if the transition is declared and all of its guards pass.
+// Hooks of the returned transition should be run once the new status is persisted.
+func (o *OrderStateMachine) Transition(newStatus OrderStatus, tc OrderTransitionContext) (OrderTransition, error) {
+	transition, ok := lookupOrderTransition(o.status, newStatus)
+	if !ok {
+		available := o.AvailableTransitions()
+		if len(available) == 0 {
+			return OrderTransition{}, NewErrNoTransitionAvailable(o.status)
+		}
+		return OrderTransition{}, NewErrUnavailableTransition(o.status, newStatus, available...)
+	}
+
+	tc.From, tc.To = o.status, newStatus
+	for _, guard := range transition.Guards {
+		if err := guard(tc); err != nil {
+			return OrderTransition{}, err
+		}
+	}
+
+	o.status = newStatus
+	return transition, nil
+}
+
+// AvailableTransitions lists statuses declared reachable from the current status.
+func (o *OrderStateMachine) AvailableTransitions() []OrderStatus {
+	available := make([]OrderStatus, 0, len(orderTransitions[o.status]))
+	for _, t := range orderTransitions[o.status] {
+		available = append(available, t.To)
+	}
+	return available
+}
+
+// AllowedTransitions lists statuses reachable from the current status whose guards pass for the transition context.
+func (o *OrderStateMachine) AllowedTransitions(tc OrderTransitionContext) []OrderStatus {
+	allowed := make([]OrderStatus, 0)
+	tc.From = o.status
+outer:
+	for _, t := range orderTransitions[o.status] {
+		tc.To = t.To
+		for _, guard := range t.Guards {
+			if guard(tc) != nil {
+				continue outer
+			}
+		}
+		allowed = append(allowed, t.To)
+	}
+	return allowed
+}
+
+func (o *OrderStateMachine) Status() OrderStatus {
+	return o.status
+}
+
+// orderTransitionMessages runs hooks of the transition made by tc and collects their messages.
+func orderTransitionMessages(transition OrderTransition, tc OrderTransitionContext) ([]outbox.Message, error) {
+	var msgs []outbox.Message
+	for _, hook := range transition.Hooks {
+		hookMsgs, err := hook(tc)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, hookMsgs...)
+	}
+	return msgs, nil
+}
+
+// lookupOrderTransition finds a declared transition regardless of its guards.
+func lookupOrderTransition(from, to OrderStatus) (OrderTransition, bool) {
+	idx := slices.IndexFunc(orderTransitions[from], func(t OrderTransition) bool { return t.To == to })
+	if idx == -1 {
+		return OrderTransition{}, false
+	}
+	return orderTransitions[from][idx], true
+}
@@ -0,0 +1,201 @@
+package service_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/service"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var orderStatuses = []service.OrderStatus{
+	service.OrderStatusCreated,
+	service.OrderStatusPaid,
+	service.OrderStatusProcessed,
+	service.OrderStatusShipped,
+	service.OrderStatusDelivered,
+	service.OrderStatusCompleted,
+	service.OrderStatusCancelling,
+	service.OrderStatusCancelled,
+}
+
+type orderActor struct {
+	name  string
+	actor store.Actor
+	// derived transitions follow shipment updates made by the actor
+	derived bool
+}
+
+var orderActors = []orderActor{
+	{name: "user", actor: store.Actor{Type: shared_api.SubjectTypeUser, Id: "user-1"}},
+	{name: "order seller", actor: store.Actor{Type: shared_api.SubjectTypeSeller, Id: "seller-1"}},
+	{name: "other seller", actor: store.Actor{Type: shared_api.SubjectTypeSeller, Id: "seller-2"}},
+	{name: "admin", actor: store.Actor{Type: shared_api.SubjectTypeAdmin, Id: "admin-1"}},
+	{name: "system", actor: store.Actor{Type: service.SubjectTypeSystem}},
+	{name: "shipments", actor: store.Actor{Type: shared_api.SubjectTypeSeller, Id: "seller-1"}, derived: true},
+}
+
+type orderTransitionKey struct {
+	from, to service.OrderStatus
+}
+
+// Actors allowed to make every declared transition, the rest of (from, to) pairs are not declared.
+// Shipments are updated by the order seller, who may cancel the order as well.
+var allowedOrderTransitions = map[orderTransitionKey][]string{
+	{service.OrderStatusCreated, service.OrderStatusPaid}:         {"admin", "system"},
+	{service.OrderStatusCreated, service.OrderStatusCancelling}:   {"order seller", "admin", "system", "shipments"},
+	{service.OrderStatusPaid, service.OrderStatusProcessed}:       {"shipments"},
+	{service.OrderStatusPaid, service.OrderStatusCancelling}:      {"order seller", "admin", "system", "shipments"},
+	{service.OrderStatusProcessed, service.OrderStatusShipped}:    {"shipments"},
+	{service.OrderStatusProcessed, service.OrderStatusCancelling}: {"order seller", "admin", "system", "shipments"},
+	{service.OrderStatusShipped, service.OrderStatusDelivered}:    {"shipments"},
+	{service.OrderStatusShipped, service.OrderStatusCompleted}:    {"shipments"},
+	{service.OrderStatusDelivered, service.OrderStatusCompleted}:  {"shipments"},
+	{service.OrderStatusCancelling, service.OrderStatusCancelled}: {"admin", "system"},
+}
+
+func newTestOrder(status service.OrderStatus) *oapi_codegen.OrdersGetOrderRes {
+	return &oapi_codegen.OrdersGetOrderRes{
+		Id:     "order-1",
+		UserId: "user-1",
+		Status: string(status),
+		Items: []oapi_codegen.OrdersGetOrderResItem{
+			{ProductId: "product-1", SellerId: "seller-1", Count: 1},
+		},
+		Shipments: []oapi_codegen.OrdersGetOrderResShipment{
+			{SellerId: "seller-1", Status: "created"},
+		},
+	}
+}
+
+func TestOrderStateMachineTransition(t *testing.T) {
+	for _, from := range orderStatuses {
+		for _, to := range orderStatuses {
+			for _, a := range orderActors {
+				t.Run(fmt.Sprintf("%s to %s by %s", from, to, a.name), func(t *testing.T) {
+					sm, err := service.NewOrderStateMachine(from)
+					require.NoError(t, err)
+
+					_, err = sm.Transition(to, service.OrderTransitionContext{
+						Order:    newTestOrder(from),
+						Actor:    a.actor,
+						Derived:  a.derived,
+						Refunded: true,
+					})
+
+					actors, declared := allowedOrderTransitions[orderTransitionKey{from, to}]
+					switch {
+					case !declared:
+						assert.ErrorIs(t, err, service.ErrOrderStateMachineIncorrentStatusTransition)
+						assert.Equal(t, from, sm.Status())
+					case slices.Contains(actors, a.name):
+						assert.NoError(t, err)
+						assert.Equal(t, to, sm.Status())
+					default:
+						assert.Error(t, err)
+						assert.NotErrorIs(t, err, service.ErrOrderStateMachineIncorrentStatusTransition)
+						assert.Equal(t, from, sm.Status())
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestOrderStateMachineTransitionHooks(t *testing.T) {
+	tc := service.OrderTransitionContext{Order: newTestOrder(service.OrderStatusCreated), Actor: store.Actor{Type: service.SubjectTypeSystem}, Refunded: true}
+
+	sm, err := service.NewOrderStateMachine(service.OrderStatusCreated)
+	require.NoError(t, err)
+	transition, err := sm.Transition(service.OrderStatusPaid, tc)
+	require.NoError(t, err)
+	assert.Len(t, transition.Hooks, 1)
+
+	sm, err = service.NewOrderStateMachine(service.OrderStatusCancelling)
+	require.NoError(t, err)
+	transition, err = sm.Transition(service.OrderStatusCancelled, tc)
+	require.NoError(t, err)
+	assert.Empty(t, transition.Hooks)
+}
+
+func TestOrderStateMachineCancellationRequiresRefunds(t *testing.T) {
+	admin := store.Actor{Type: shared_api.SubjectTypeAdmin, Id: "admin-1"}
+	system := store.Actor{Type: service.SubjectTypeSystem}
+
+	tests := []struct {
+		name string
+		tc   service.OrderTransitionContext
+		err  error
+	}{
+		{name: "refunded by system", tc: service.OrderTransitionContext{Actor: system, Refunded: true}},
+		{name: "refunded by admin", tc: service.OrderTransitionContext{Actor: admin, Refunded: true}},
+		{name: "not refunded by system", tc: service.OrderTransitionContext{Actor: system, Reason: "refunded"}, err: service.ErrOrderNotRefunded},
+		{name: "not refunded by admin without reason", tc: service.OrderTransitionContext{Actor: admin}, err: service.ErrOrderNotRefunded},
+		{name: "refunded manually by admin", tc: service.OrderTransitionContext{Actor: admin, Reason: "refunded by bank transfer"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.tc.Order = newTestOrder(service.OrderStatusCancelling)
+			sm, err := service.NewOrderStateMachine(service.OrderStatusCancelling)
+			require.NoError(t, err)
+
+			_, err = sm.Transition(service.OrderStatusCancelled, tt.tc)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Equal(t, service.OrderStatusCancelling, sm.Status())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, service.OrderStatusCancelled, sm.Status())
+		})
+	}
+}
+
+func TestOrderStateMachineTransitionString(t *testing.T) {
+	sm, err := service.NewOrderStateMachine(service.OrderStatusCreated)
+	require.NoError(t, err)
+
+	_, err = sm.TransitionString("lost", service.OrderTransitionContext{Actor: store.Actor{Type: service.SubjectTypeSystem}})
+	assert.ErrorIs(t, err, service.ErrOrderStateMachineInvalidStatus)
+
+	_, err = service.NewOrderStateMachine("lost")
+	assert.Error(t, err)
+}
+
+func TestOrderStateMachineAllowedTransitions(t *testing.T) {
+	for _, from := range orderStatuses {
+		for _, a := range orderActors {
+			t.Run(fmt.Sprintf("%s by %s", from, a.name), func(t *testing.T) {
+				sm, err := service.NewOrderStateMachine(from)
+				require.NoError(t, err)
+
+				var expected, available []service.OrderStatus
+				for _, to := range orderStatuses {
+					actors, declared := allowedOrderTransitions[orderTransitionKey{from, to}]
+					if !declared {
+						continue
+					}
+					available = append(available, to)
+					if slices.Contains(actors, a.name) {
+						expected = append(expected, to)
+					}
+				}
+
+				allowed := sm.AllowedTransitions(service.OrderTransitionContext{
+					Order:    newTestOrder(from),
+					Actor:    a.actor,
+					Derived:  a.derived,
+					Refunded: true,
+				})
+				assert.ElementsMatch(t, expected, allowed)
+				assert.ElementsMatch(t, available, sm.AvailableTransitions())
+				assert.Equal(t, from, sm.Status())
+			})
+		}
+	}
+}
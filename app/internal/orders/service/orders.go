@@ -5,13 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"strings"
-	"sync"
 	"time"
 
 	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/promo"
 	"github.com/bratushkadan/floral/internal/orders/store"
 	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -29,154 +29,117 @@ const (
 	OrderStatusCompleted  OrderStatus = "completed"
 )
 
-var (
-	ErrPermissionDenied = errors.New("permission denied")
-
-	ErrOrderStateMachineInvalidStatus             = errors.New("invalid order status")
-	ErrOrderStateMachineIncorrentStatusTransition = errors.New("incorrect order status transition")
+// Reasons of order status transitions recorded in the order status history.
+const (
+	OrderStatusReasonManualUpdate    = "manual update"
+	OrderStatusReasonPaymentReceived = "payment received"
+	OrderStatusReasonPaymentTimeout  = "order was not paid in time"
+	OrderStatusReasonNotPaid         = "order cancelled without payments"
+	OrderStatusReasonRefunded        = "order payments refunded"
+	OrderStatusReasonShipmentUpdated = "shipment status updated"
 )
 
-type OrderStateMachine struct {
-	status OrderStatus
-}
+// SubjectTypeSystem is the subject type of the service itself.
+const SubjectTypeSystem = "system"
 
-func NewOrderStateMachine(status OrderStatus) (*OrderStateMachine, error) {
-	switch status {
-	case OrderStatusCreated, OrderStatusPaid, OrderStatusCancelling, OrderStatusCancelled, OrderStatusProcessed, OrderStatusShipped, OrderStatusDelivered, OrderStatusCompleted:
-		return &OrderStateMachine{status: status}, nil
-	default:
-		return nil, fmt.Errorf(`invalid initial order status for state machine: "%s"`, status)
-	}
-}
+// systemActor makes order status transitions on behalf of the service itself.
+var systemActor = store.Actor{Type: SubjectTypeSystem, Id: "orders"}
 
-func (o *OrderStateMachine) TransitionString(newStatus string) error {
-	switch newStatus {
-	case string(OrderStatusCreated), string(OrderStatusPaid), string(OrderStatusCancelling), string(OrderStatusCancelled), string(OrderStatusProcessed), string(OrderStatusShipped), string(OrderStatusDelivered), string(OrderStatusCompleted):
-		return o.Transition(OrderStatus(newStatus))
-	default:
-		return fmt.Errorf(`%w: "%s"`, ErrOrderStateMachineInvalidStatus, newStatus)
-	}
-}
+// orderIdNamespace derives order ids from ids of their create order operations.
+var orderIdNamespace = uuid.MustParse("0d5b6e0a-5f3c-4b8e-9a57-2f0c3f6d8e41")
 
-func NewErrUnavailableTransition(fromStatus, toStatus OrderStatus, availableTransitions ...OrderStatus) error {
-	strAvailableTransitions := make([]string, 0, len(availableTransitions))
-	for _, t := range availableTransitions {
-		strAvailableTransitions = append(strAvailableTransitions, fmt.Sprintf(`"%s"`, string(t)))
-	}
-
-	return fmt.Errorf(
-		`%w: no status transition "%s" -> "%s", available transitions are: "%s" -> %s`,
-		ErrOrderStateMachineIncorrentStatusTransition,
-		fromStatus,
-		toStatus,
-		fromStatus,
-		strings.Join(strAvailableTransitions, ", "),
-	)
-}
-
-func NewErrNoTransitionAvailable(fromStatus OrderStatus) error {
-	return fmt.Errorf(
-		`%w: no available transition for status "%s"`,
-		ErrOrderStateMachineIncorrentStatusTransition,
-		fromStatus,
-	)
-}
-
-func (o *OrderStateMachine) Transition(newStatus OrderStatus) error {
-	switch o.status {
-	case OrderStatusCreated:
-		switch newStatus {
-		case OrderStatusPaid, OrderStatusCancelling:
-			o.status = newStatus
-			return nil
-		default:
-			return NewErrUnavailableTransition(o.status, newStatus, OrderStatusPaid, OrderStatusCancelling)
-		}
-	case OrderStatusPaid:
-		switch newStatus {
-		case OrderStatusProcessed, OrderStatusCancelling:
-			o.status = newStatus
-			return nil
-		default:
-			return NewErrUnavailableTransition(o.status, newStatus, OrderStatusProcessed, OrderStatusCancelling)
-		}
-	case OrderStatusCancelling:
-		switch newStatus {
-		case OrderStatusCancelled:
-			o.status = newStatus
-			return nil
-		default:
-			return NewErrUnavailableTransition(o.status, newStatus, OrderStatusCancelled)
-		}
-	case OrderStatusCancelled:
-		return NewErrNoTransitionAvailable(o.status)
-	case OrderStatusProcessed:
-		switch newStatus {
-		case OrderStatusShipped, OrderStatusCancelling:
-			o.status = newStatus
-			return nil
-		default:
-			return NewErrUnavailableTransition(o.status, newStatus, OrderStatusShipped, OrderStatusCancelling)
-		}
-	case OrderStatusShipped:
-		switch newStatus {
-		case OrderStatusDelivered, OrderStatusCompleted:
-			o.status = newStatus
-			return nil
-		default:
-			return NewErrUnavailableTransition(o.status, newStatus, OrderStatusDelivered, OrderStatusCompleted)
-		}
-	case OrderStatusDelivered:
-		switch newStatus {
-		case OrderStatusCompleted:
-			o.status = newStatus
-			return nil
-		default:
-			return NewErrUnavailableTransition(o.status, newStatus, OrderStatusCompleted)
-		}
-	case OrderStatusCompleted:
-		return NewErrNoTransitionAvailable(o.status)
-	default:
-		return fmt.Errorf(`%w: unknown status to transition from: "%s"`, ErrOrderStateMachineIncorrentStatusTransition, o.status)
-	}
+// newOrderId is the id of the order created by the operation. Products are held for the order
+// before it's created, so the id is known to the products service and stays the same on redeliveries.
+func newOrderId(operationId string) string {
+	return uuid.NewSHA1(orderIdNamespace, []byte(operationId)).String()
 }
 
-func (o *OrderStateMachine) Status() OrderStatus {
-	return o.status
-}
+var (
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrOrderConflict    = errors.New("order was updated concurrently, retry")
+	ErrOrderNotRefunded = errors.New("order payments are not refunded, state the reason of the manual refund")
+)
 
 func (s *Orders) ListOrders(ctx context.Context, req oapi_codegen.OrdersListOrdersParams) (oapi_codegen.OrdersListOrdersRes, error) {
 	return s.store.ListOrders(ctx, req.UserId, req.NextPageToken)
 }
 
-func (s *Orders) CreateOrder(ctx context.Context, userId string) (oapi_codegen.OrdersCreateOrderRes, error) {
+func (s *Orders) CreateOrder(ctx context.Context, req oapi_codegen.OrdersCreateOrderReq, userId string) (oapi_codegen.OrdersCreateOrderRes, error) {
+	delivery, err := s.newOrderDelivery(ctx, req, userId)
+	if err != nil {
+		return oapi_codegen.OrdersCreateOrderRes{}, err
+	}
+
+	operationId := uuid.NewString()
+	cartPublishRequest, err := store.NewCartPublishRequestMessage(operationId, userId)
+	if err != nil {
+		return oapi_codegen.OrdersCreateOrderRes{}, fmt.Errorf("get cart contents request message: %v", err)
+	}
+
 	operation, err := s.store.CreateOperation(ctx, store.CreateOperationDTOInput{
-		Id:        uuid.NewString(),
+		Id:        operationId,
 		Type:      OperationTypeCreateOrder,
 		Status:    OperationTypeCreateOrderStatusStarted,
 		UserId:    userId,
+		Delivery:  delivery,
 		CreatedAt: time.Now(),
+		Messages:  []outbox.Message{cartPublishRequest},
 	})
 	if err != nil {
 		return oapi_codegen.OrdersCreateOrderRes{}, fmt.Errorf("create operation: %v", err)
 	}
-
-	if err := s.store.ProducePublishCartContentsRequest(ctx, operation.Id, userId); err != nil {
-		return oapi_codegen.OrdersCreateOrderRes{}, fmt.Errorf("publish get cart contents request: %v", err)
-	}
+	s.relayOutbox(ctx)
 
 	return oapi_codegen.OrdersCreateOrderRes{Operation: operation}, nil
 }
 
-func (s *Orders) GetOrder(ctx context.Context, orderId string) (*oapi_codegen.OrdersGetOrderRes, error) {
-	return s.store.GetOrder(ctx, orderId)
+// orderRefunded tells whether all payments of the "cancelling" order are refunded.
+func (s *Orders) orderRefunded(ctx context.Context, order *oapi_codegen.OrdersGetOrderRes) (bool, error) {
+	if OrderStatus(order.Status) != OrderStatusCancelling {
+		return false, nil
+	}
+	refundedOrderIds, err := s.store.ListRefundedCancellingOrders(ctx, []string{order.Id})
+	if err != nil {
+		return false, fmt.Errorf("list refunded cancelling orders: %v", err)
+	}
+	return slices.Contains(refundedOrderIds, order.Id), nil
 }
 
-func (s *Orders) UpdateOrder(ctx context.Context, req oapi_codegen.OrdersUpdateOrderReq, orderId, subjectType string) (*oapi_codegen.OrdersUpdateOrderRes, error) {
-	if !slices.Contains([]string{shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin}, subjectType) {
-		return nil, ErrPermissionDenied
+// GetOrder returns the order with transitions the subject is allowed to make.
+func (s *Orders) GetOrder(ctx context.Context, orderId, subjectType, subjectId string) (*oapi_codegen.OrdersGetOrderRes, error) {
+	order, err := s.store.GetOrder(ctx, orderId)
+	if err != nil || order == nil {
+		return order, err
 	}
 
+	orderStateMachine, err := NewOrderStateMachine(OrderStatus(order.Status))
+	if err != nil {
+		return nil, fmt.Errorf("new order state machine: %v", err)
+	}
+	refunded, err := s.orderRefunded(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	order.AllowedTransitions = make([]string, 0)
+	for _, status := range orderStateMachine.AllowedTransitions(OrderTransitionContext{
+		Order:    order,
+		Actor:    store.Actor{Type: subjectType, Id: subjectId},
+		Refunded: refunded,
+	}) {
+		order.AllowedTransitions = append(order.AllowedTransitions, string(status))
+	}
+
+	if OrderStatus(order.Status) == OrderStatusCreated {
+		order.Payment, err = s.orderPayment(ctx, order.Id)
+		if err != nil {
+			return nil, fmt.Errorf("order payment: %v", err)
+		}
+	}
+
+	return order, nil
+}
+
+func (s *Orders) UpdateOrder(ctx context.Context, req oapi_codegen.OrdersUpdateOrderReq, orderId, subjectType, subjectId string) (*oapi_codegen.OrdersUpdateOrderRes, error) {
 	order, err := s.store.GetOrder(ctx, orderId)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve order: %v", err)
@@ -186,26 +149,120 @@ func (s *Orders) UpdateOrder(ctx context.Context, req oapi_codegen.OrdersUpdateO
 		return nil, nil
 	}
 
+	actor := store.Actor{Type: subjectType, Id: subjectId}
+	if !orderParticipant(order, actor) {
+		return nil, ErrPermissionDenied
+	}
+
 	orderStateMachine, err := NewOrderStateMachine(OrderStatus(order.Status))
 	if err != nil {
 		return nil, fmt.Errorf("new order state machine: %v", err)
 	}
 
-	if err := orderStateMachine.TransitionString(req.Status); err != nil {
+	refunded, err := s.orderRefunded(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	tc := OrderTransitionContext{Order: order, Actor: actor, Refunded: refunded}
+	if req.Reason != nil {
+		tc.Reason = *req.Reason
+	}
+	transition, err := orderStateMachine.TransitionString(req.Status, tc)
+	if err != nil {
+		return nil, err
+	}
+
+	tc.From, tc.To = OrderStatus(order.Status), orderStateMachine.Status()
+	msgs, err := orderTransitionMessages(transition, tc)
+	if err != nil {
 		return nil, err
 	}
 
-	orderUpdateRes, err := s.store.UpdateOrder(ctx, orderId, req.Status)
+	reason := OrderStatusReasonManualUpdate
+	if req.Reason != nil && *req.Reason != "" {
+		reason = *req.Reason
+	}
+
+	if orderStateMachine.Status() == OrderStatusCancelled {
+		// Cancellation is completed the same way it is after refunds.
+		if err := s.cancelOrders(ctx, []string{orderId}, actor, reason); err != nil {
+			if errors.Is(err, store.ErrOrderStatusConflict) {
+				return nil, ErrOrderConflict
+			}
+			return nil, err
+		}
+		return &oapi_codegen.OrdersUpdateOrderRes{Status: req.Status, UpdatedAt: time.Now().Format(time.RFC3339)}, nil
+	}
+	orderUpdateRes, err := s.store.UpdateOrder(ctx, store.UpdateOrderDTOInput{
+		OrderId:    orderId,
+		FromStatus: order.Status,
+		Status:     req.Status,
+		Actor:      actor,
+		Reason:     reason,
+		Messages:   msgs,
+	})
 	if err != nil {
+		if errors.Is(err, store.ErrOrderStatusConflict) {
+			return nil, ErrOrderConflict
+		}
 		return nil, fmt.Errorf("update order: %v", err)
 	}
+	s.relayOutbox(ctx)
 
 	return orderUpdateRes, nil
 }
 
+// orderParticipant reports whether the actor takes part in the order: its user, sellers of its shipments and admins.
+func orderParticipant(order *oapi_codegen.OrdersGetOrderRes, actor store.Actor) bool {
+	switch actor.Type {
+	case shared_api.SubjectTypeAdmin:
+		return true
+	case shared_api.SubjectTypeUser:
+		return order.UserId == actor.Id
+	case shared_api.SubjectTypeSeller:
+		return slices.ContainsFunc(order.Shipments, func(shipment oapi_codegen.OrdersGetOrderResShipment) bool {
+			return shipment.SellerId == actor.Id
+		})
+	default:
+		return false
+	}
+}
+
+func newCompletedOrderMessage(order *oapi_codegen.OrdersGetOrderRes) oapi_codegen.PrivateFeedbackProcessCompletedOrderReqMessage {
+	products := make([]oapi_codegen.PrivateFeedbackProcessCompletedOrderReqProduct, 0, len(order.Items))
+	for _, item := range order.Items {
+		products = append(products, oapi_codegen.PrivateFeedbackProcessCompletedOrderReqProduct{Id: item.ProductId})
+	}
+	return oapi_codegen.PrivateFeedbackProcessCompletedOrderReqMessage{
+		OrderId:  order.Id,
+		UserId:   order.UserId,
+		Products: products,
+	}
+}
+
+func newSellProductsMessage(order *oapi_codegen.OrdersGetOrderRes) oapi_codegen.PrivateSellProductsReqMessage {
+	return oapi_codegen.PrivateSellProductsReqMessage{OrderId: order.Id}
+}
+
+func newUnreserveProductsMessage(order *oapi_codegen.OrdersGetOrderRes) oapi_codegen.PrivateUnreserveProductsReqMessage {
+	products := make([]oapi_codegen.PrivateUnreserveProductsReqProduct, 0, len(order.Items))
+	for _, item := range order.Items {
+		products = append(products, oapi_codegen.PrivateUnreserveProductsReqProduct{
+			Id:    item.ProductId,
+			SkuId: item.SkuId,
+			Count: item.Count,
+		})
+	}
+	return oapi_codegen.PrivateUnreserveProductsReqMessage{
+		OrderId:  order.Id,
+		Products: products,
+	}
+}
+
 func (s *Orders) ProcessPublishedCartPositions(ctx context.Context, req oapi_codegen.PrivateOrderProcessPublishedCartPositionsReq) error {
 	var productsReservationMessages []oapi_codegen.PrivateReserveProductsReqMessage
 	var cancelOperationsMessages []oapi_codegen.PrivateOrderCancelOperationsReqMessage
+	promoCodes := make(map[string]string)
 
 	for _, message := range req.Messages {
 		if len(message.CartPositions) == 0 {
@@ -220,123 +277,124 @@ func (s *Orders) ProcessPublishedCartPositions(ctx context.Context, req oapi_cod
 		for _, pos := range message.CartPositions {
 			products = append(products, oapi_codegen.PrivateReserveProductsReqProduct{
 				Id:    pos.ProductId,
+				SkuId: pos.SkuId,
 				Count: pos.Count,
 			})
 		}
 
 		productsReservationMessages = append(productsReservationMessages, oapi_codegen.PrivateReserveProductsReqMessage{
 			OperationId: message.OperationId,
+			OrderId:     newOrderId(message.OperationId),
 			Products:    products,
 		})
+		if message.PromoCode != nil {
+			promoCodes[message.OperationId] = promo.NormalizeCode(*message.PromoCode)
+		}
 	}
 
 	s.l.Info("published cart positions", zap.Any("reservation_messages", productsReservationMessages), zap.Any("cancel_operations_messages", cancelOperationsMessages))
 
-	var wg sync.WaitGroup
-	var errs []error
-	var m sync.Mutex
-	if len(productsReservationMessages) > 0 {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if err := s.store.ProduceProductsReservationMessages(ctx, productsReservationMessages...); err != nil {
-				defer m.Unlock()
-				m.Lock()
-
-				errs = append(errs, fmt.Errorf("produce products reservation messages: %v", err))
-			}
-		}()
+	reservationMsgs, err := store.NewProductsReservationMessages(productsReservationMessages...)
+	if err != nil {
+		return fmt.Errorf("products reservation messages: %v", err)
 	}
-	if len(cancelOperationsMessages) > 0 {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if err := s.store.ProduceCancelOperationMessages(ctx, cancelOperationsMessages...); err != nil {
-				defer m.Unlock()
-				m.Lock()
-
-				errs = append(errs, fmt.Errorf("produce cancel operation messages: %v", err))
-			}
-		}()
+	cancelOperationMsgs, err := store.NewCancelOperationMessages(cancelOperationsMessages...)
+	if err != nil {
+		return fmt.Errorf("cancel operation messages: %v", err)
 	}
-
-	wg.Wait()
-
-	if len(errs) > 0 {
-		return errors.Join(errs...)
+	// Promo codes are recorded only for started operations, so redelivered cart contents don't change them.
+	if err := s.store.SetOperationsPromoCodes(ctx, store.SetOperationsPromoCodesDTOInput{
+		PromoCodes: promoCodes,
+		Status:     OperationTypeCreateOrderStatusStarted,
+		Messages:   append(reservationMsgs, cancelOperationMsgs...),
+	}); err != nil {
+		return fmt.Errorf("set operations promo codes and enqueue products reservation and cancel operation messages: %v", err)
 	}
+	s.relayOutbox(ctx)
 
 	return nil
 }
 
+// ProcessReservedProducts creates orders of the reserved products priced with their delivery. Orders creation,
+// completion of their operations and requests to clear carts of the users are committed in one transaction.
 func (s *Orders) ProcessReservedProducts(ctx context.Context, req oapi_codegen.PrivateOrdersProcessReservedProductsJSONRequestBody) error {
-	ops := make([]store.UpdateOperationManyDTOInputOperation, 0, len(req.Messages))
-	for _, message := range req.Messages {
-		ops = append(ops, store.UpdateOperationManyDTOInputOperation{
-			Id:        message.OperationId,
-			Status:    OperationTypeCreateOrderStatusCompleted,
-			OrderId:   ptr(uuid.NewString()),
-			UpdatedAt: time.Now(),
-		})
-	}
-
-	updateOpsManyRes, err := s.store.UpdateOperationMany(ctx, store.UpdateOperationManyDTOInput{Operations: ops})
-	if err != nil {
-		return fmt.Errorf("update operations many: %v", err)
-	}
-
-	products := make(map[string][]oapi_codegen.PrivateOrderProcessReservedProductsReqProduct, len(req.Messages))
-	for _, msg := range req.Messages {
-		products[msg.OperationId] = msg.Products
-	}
-
 	orders := make([]store.CreateOrderManyDTOInputOrder, 0, len(req.Messages))
-	for _, opUpdate := range updateOpsManyRes.OperationsUpdates {
+	for _, msg := range req.Messages {
 		orders = append(orders, store.CreateOrderManyDTOInputOrder{
-			Id:        *opUpdate.OrderId,
-			UserId:    opUpdate.UserId,
-			Status:    string(OrderStatusCreated),
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-			Products:  products[opUpdate.OperationId],
+			Id:          newOrderId(msg.OperationId),
+			OperationId: msg.OperationId,
+			Status:      string(OrderStatusCreated),
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+			Products:    msg.Products,
+			Cost: func(in store.OrderPricingInput) (store.OrderPricingOutput, error) {
+				var out store.OrderPricingOutput
+				discount, err := orderDiscount(msg.Products, in.Coupon, in.Usage, time.Now())
+				if in.PromoCode != nil && in.Coupon == nil {
+					err = fmt.Errorf("%w: coupon is deleted", promo.ErrNotApplicable)
+				}
+				if err != nil {
+					if !errors.Is(err, promo.ErrNotApplicable) {
+						return out, fmt.Errorf("compute discount: %w", err)
+					}
+					// The order is placed without discount, the promo code is not redeemed.
+					// The user may have seen the discount previewed, so the operation tells why it's not applied.
+					s.l.Info("promo code is not applied to order", zap.String("operation_id", msg.OperationId), zap.String("code", *in.PromoCode), zap.Error(err))
+					out.OperationDetails = ptr(fmt.Sprintf("%s: %v", OperationDetailsPromoCodeRejected, err))
+				}
+				out.Cost, err = priceOrder(msg.Products, in.Delivery, discount)
+				return out, err
+			},
 		})
 	}
 
-	_, err = s.store.CreateOrderMany(ctx, store.CreateOrderManyDTOInput{Orders: orders})
+	createRes, err := s.store.CreateOrderMany(ctx, store.CreateOrderManyDTOInput{
+		Orders:                   orders,
+		StartedOperationStatus:   OperationTypeCreateOrderStatusStarted,
+		CompletedOperationStatus: OperationTypeCreateOrderStatusCompleted,
+	})
 	if err != nil {
 		return fmt.Errorf("create order many: %v", err)
 	}
-
-	clearCartMessages := make([]oapi_codegen.PrivateClearCartPositionsReqMessage, 0, len(req.Messages))
-	for _, order := range orders {
-		clearCartMessages = append(clearCartMessages, oapi_codegen.PrivateClearCartPositionsReqMessage{UserId: order.UserId})
-	}
-
-	if len(clearCartMessages) > 0 {
-		if err := s.store.ProduceCartClearMessages(ctx, clearCartMessages...); err != nil {
-			return fmt.Errorf("publish clear cart messages: %v", err)
-		}
+	if len(createRes.OrderIds) < len(orders) {
+		s.l.Info("skipped orders of not started operations", zap.Int("orders", len(orders)), zap.Int("created", len(createRes.OrderIds)))
 	}
+	s.relayOutbox(ctx)
 
 	return nil
 }
 
+// ProcessUnreservedProducts cancels orders without payments right away,
+// paid orders stay "cancelling" until all of their payments are refunded.
 func (s *Orders) ProcessUnreservedProducts(ctx context.Context, req oapi_codegen.PrivateOrdersProcessUnreservedProductsJSONRequestBody) error {
-	orderUpdates := make([]store.UpdateOrderManyDTOInputOrderUpdate, 0, len(req.Messages))
-
+	orderIds := make([]string, 0, len(req.Messages))
 	for _, msg := range req.Messages {
-		orderUpdates = append(orderUpdates, store.UpdateOrderManyDTOInputOrderUpdate{
-			OrderId:   msg.OrderId,
-			Status:    string(OrderStatusCancelled),
-			UpdatedAt: time.Now(),
-		})
+		// Restocked items of received returns don't affect their orders.
+		if msg.ReturnId != nil {
+			continue
+		}
+		orderIds = append(orderIds, msg.OrderId)
+	}
+	if len(orderIds) == 0 {
+		return nil
 	}
 
-	_, err := s.store.UpdateOrderMany(ctx, store.UpdateOrderManyDTOInput{
-		OrderUpdates: orderUpdates,
-	})
+	unpaidOrderIds, err := s.createRefunds(ctx, orderIds)
 	if err != nil {
-		return fmt.Errorf("update orders: %v", err)
+		return err
+	}
+
+	if len(unpaidOrderIds) > 0 {
+		if err := s.cancelOrders(ctx, unpaidOrderIds, systemActor, OrderStatusReasonNotPaid); err != nil {
+			return err
+		}
+	}
+
+	if len(unpaidOrderIds) < len(orderIds) {
+		// Refunds are retried by timer, so failures here must not fail unreservation processing.
+		if err := s.ProcessRefunds(ctx, oapi_codegen.PrivateOrderProcessRefundsReq{}); err != nil {
+			s.l.Error("process refunds", zap.Error(err))
+		}
 	}
 
 	return nil
@@ -359,38 +417,87 @@ func (s *Orders) BatchCancelUnpaidOrders(ctx context.Context, req oapi_codegen.P
 	}
 
 	orderUpdates := make([]store.UpdateOrderManyDTOInputOrderUpdate, 0, len(unpaidOrders))
-	for _, order := range unpaidOrders {
-		orderUpdates = append(orderUpdates, store.UpdateOrderManyDTOInputOrderUpdate{
-			OrderId:   order.Id,
-			Status:    string(OrderStatusCancelling),
-			UpdatedAt: time.Now(),
-		})
-	}
-
-	_, err = s.store.UpdateOrderMany(ctx, store.UpdateOrderManyDTOInput{OrderUpdates: orderUpdates})
-	if err != nil {
-		return fmt.Errorf("update orders: %v", err)
-	}
-
 	for _, order := range unpaidOrders {
 		products := make([]oapi_codegen.PrivateUnreserveProductsReqProduct, 0)
 		for _, item := range order.Items {
 			products = append(products, oapi_codegen.PrivateUnreserveProductsReqProduct{
 				Id:    item.Id,
+				SkuId: item.SkuId,
 				Count: item.Count,
 			})
 		}
-		messages = append(messages, oapi_codegen.PrivateUnreserveProductsReqMessage{
+		message := oapi_codegen.PrivateUnreserveProductsReqMessage{
 			OrderId:  order.Id,
 			Products: products,
+		}
+		messages = append(messages, message)
+
+		// Products are unreserved only if the order is moved to "cancelling" by this update.
+		msgs, err := store.NewProductsUnreservationMessages(message)
+		if err != nil {
+			return fmt.Errorf("products unreservation message: %v", err)
+		}
+		orderUpdates = append(orderUpdates, store.UpdateOrderManyDTOInputOrderUpdate{
+			OrderId:    order.Id,
+			FromStatus: string(OrderStatusCreated),
+			Status:     string(OrderStatusCancelling),
+			UpdatedAt:  time.Now(),
+			Actor:      systemActor,
+			Reason:     OrderStatusReasonPaymentTimeout,
+			Messages:   msgs,
 		})
 	}
 
-	fmt.Printf("messages: %+v\n", messages)
+	s.l.Info("products unreservation messages", zap.Any("messages", messages))
 
-	if err := s.store.ProduceProductsUnreservationMessages(ctx, messages...); err != nil {
-		return fmt.Errorf("publish products unreservation messages: %v", err)
+	_, err = s.store.UpdateOrderMany(ctx, store.UpdateOrderManyDTOInput{OrderUpdates: orderUpdates})
+	if err != nil {
+		return fmt.Errorf("update orders: %v", err)
 	}
+	s.relayOutbox(ctx)
 
 	return nil
 }
+
+// BatchCompleteDeliveredOrders completes shipments delivered longer than the order completion delay ago,
+// whether the delivery was reported by the carrier or the seller. Orders are completed along with their last shipment.
+// Shipments with open returns are completed by later runs after their returns are resolved.
+func (s *Orders) BatchCompleteDeliveredOrders(ctx context.Context, req oapi_codegen.PrivateOrderBatchCompleteDeliveredOrdersReq) error {
+	shipments, err := s.store.ListDeliveredShipments(ctx, time.Now().Add(-s.orderCompletionDelay))
+	if err != nil {
+		return fmt.Errorf("list delivered shipments: %v", err)
+	}
+
+	var errs []error
+	var completed int
+	for _, key := range shipments {
+		order, err := s.store.GetOrder(ctx, key.OrderId)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(`retrieve order "%s": %v`, key.OrderId, err))
+			continue
+		}
+		if order == nil {
+			continue
+		}
+
+		if _, err := s.updateShipment(ctx, order, shipmentUpdate{
+			SellerId: key.SellerId,
+			Status:   string(ShipmentStatusCompleted),
+			Actor:    systemActor,
+		}); err != nil {
+			if errors.Is(err, ErrShipmentHasOpenReturns) || errors.Is(err, ErrShipmentConflict) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf(`complete shipment of order "%s" and seller "%s": %w`, key.OrderId, key.SellerId, err))
+			continue
+		}
+		completed++
+	}
+
+	s.l.Info("completed delivered shipments", zap.Int("shipments", completed))
+
+	if completed > 0 {
+		s.relayOutbox(ctx)
+	}
+	return errors.Join(errs...)
+}
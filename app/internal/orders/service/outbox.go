@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"go.uber.org/zap"
+)
+
+// RelayOutbox publishes messages of committed state changes left unpublished, i.e. by failed requests.
+func (s *Orders) RelayOutbox(ctx context.Context, _ oapi_codegen.PrivateRelayOutboxReq) (oapi_codegen.PrivateRelayOutboxRes, error) {
+	relayed, err := s.store.RelayOutbox(ctx)
+	if err != nil {
+		return oapi_codegen.PrivateRelayOutboxRes{}, fmt.Errorf("relay outbox: %v", err)
+	}
+	return oapi_codegen.PrivateRelayOutboxRes{Relayed: relayed}, nil
+}
+
+// relayOutbox publishes messages of the committed state change right away.
+// Messages it fails to publish are published by the outbox relay timer.
+func (s *Orders) relayOutbox(ctx context.Context) {
+	if _, err := s.store.RelayOutbox(ctx); err != nil {
+		s.l.Error("relay outbox", zap.Error(err))
+	}
+}
@@ -2,163 +2,244 @@ package service
 
 import (
 	"context"
-	"crypto/sha1"
-	"encoding/hex"
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
-	"time"
 
+	"github.com/bratushkadan/floral/internal/orders/payment"
 	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
 	"github.com/bratushkadan/floral/internal/orders/store"
-	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 var (
-	ErrYoomoneyPaymentNotificationValidation           = errors.New("invalid payment notificaiton input parameter")
-	ErrYoomoneyPaymentNotificationIntegrityCheckFailed = errors.New("payment notification integrity check failed")
+	ErrUnknownPaymentProvider     = errors.New("unknown payment provider")
+	ErrInvalidPaymentNotification = errors.New("invalid payment notification")
 )
 
+// OrderCurrencyIso4217 is the currency of order prices (RUB).
+const OrderCurrencyIso4217 = 643
+
+// ProcessPaymentNotifications records payments and marks orders paid once their total is covered.
+// Partial payments leave order in "created" status. Overpayments, payments in currency other than
+// the order currency and payments of orders that can no longer be paid are refunded.
+// Payments are keyed by provider operation id, duplicate notifications are acknowledged without side effects
+// and notifications without it are rejected.
+// Every payment is recorded along with the order transition in the transaction it reads the order balance in.
 func (s *Orders) ProcessPaymentNotifications(ctx context.Context, reqMessages []oapi_codegen.PrivateOrderProcessPaymentNotificationsReqMessage) error {
-	// TODO: check if there's enough funds in payments, otherwise do not change status of order to "Paid"
-	paymentRecords := make([]store.CreatePaymentDTOInput, 0, len(reqMessages))
-	orderUpdates := make([]store.UpdateOrderManyDTOInputOrderUpdate, 0, len(reqMessages))
-	updateTime := time.Now()
+	var processed int
 	for _, msg := range reqMessages {
-		paymentId := uuid.NewString()
-
-		paymentRecords = append(paymentRecords, store.CreatePaymentDTOInput{
-			Id:              paymentId,
-			OrderId:         msg.OrderId,
-			Amount:          msg.Amount,
-			CurrencyIso4217: uint32(msg.CurrencyIso4217),
-			Provider:        msg.ProviderMeta,
-			CreatedAt:       msg.Datetime,
-			RefundedAt:      nil,
+		id := notificationPaymentId(msg)
+		if id == "" {
+			// Such a payment can't be told from its redeliveries, so it's left for manual handling.
+			s.l.Error("payment notification without provider operation id is rejected", zap.String("order_id", msg.OrderId), zap.Any("provider_meta", msg.ProviderMeta))
+			continue
+		}
+
+		provider, _, ok := payment.ProviderMeta(msg.ProviderMeta)
+		if !ok {
+			s.l.Warn("unknown payment provider metadata format", zap.String("payment_id", id), zap.Any("provider", msg.ProviderMeta))
+		}
+
+		out, err := s.store.ProcessPayment(ctx, store.ProcessPaymentDTOInput{
+			Payment: store.CreatePaymentDTOInput{
+				Id:              id,
+				OrderId:         msg.OrderId,
+				Amount:          store.ToMinorUnits(msg.Amount),
+				CurrencyIso4217: uint32(msg.CurrencyIso4217),
+				Provider:        msg.ProviderMeta,
+				CreatedAt:       msg.Datetime,
+			},
+			Provider:        provider,
+			CurrencyIso4217: OrderCurrencyIso4217,
+		}, func(balance *store.OrderPaymentsBalance) (store.PaymentAllocation, error) {
+			return allocatePayment(msg, balance)
 		})
+		if err != nil {
+			return fmt.Errorf("process payment %s: %v", id, err)
+		}
+		if out.Duplicate {
+			s.l.Info("duplicate payment notification is acknowledged", zap.String("payment_id", id), zap.String("order_id", msg.OrderId))
+			continue
+		}
+		processed++
+
+		if out.Allocation.RefundAmount != nil {
+			s.l.Warn(
+				"payment is refunded",
+				zap.String("payment_id", id),
+				zap.String("order_id", msg.OrderId),
+				zap.Int("currency_iso_4217", msg.CurrencyIso4217),
+				zap.Float64("amount", msg.Amount),
+				zap.Float64("refund_amount", store.FromMinorUnits(*out.Allocation.RefundAmount)),
+			)
+		}
+	}
 
-		orderUpdates = append(orderUpdates, store.UpdateOrderManyDTOInputOrderUpdate{
-			OrderId:   msg.OrderId,
-			Status:    string(OrderStatusPaid),
-			UpdatedAt: updateTime,
-		})
+	if processed > 0 {
+		s.relayOutbox(ctx)
+	}
+	return nil
+}
+
+// allocatePayment decides on the payment given the balance of its order before the payment:
+// the part of the payment that can't be kept is refunded, the order is marked paid once its total is covered.
+func allocatePayment(msg oapi_codegen.PrivateOrderProcessPaymentNotificationsReqMessage, balance *store.OrderPaymentsBalance) (store.PaymentAllocation, error) {
+	switch {
+	case balance == nil,
+		msg.CurrencyIso4217 != OrderCurrencyIso4217,
+		balance.Status != string(OrderStatusCreated) && balance.Status != string(OrderStatusPaid):
+		return store.PaymentAllocation{RefundAmount: ptr(store.ToMinorUnits(msg.Amount))}, nil
 	}
 
-	_, err := s.store.CreatePaymentMany(ctx, paymentRecords)
-	if err != nil {
-		return fmt.Errorf("created payment many: %v", err)
+	var allocation store.PaymentAllocation
+	due := balance.Total - balance.Paid
+	amount := store.ToMinorUnits(msg.Amount)
+	if overpaid := amount - max(due, 0); overpaid > 0 {
+		allocation.RefundAmount = ptr(overpaid)
+		amount -= overpaid
 	}
 
-	_, err = s.store.UpdateOrderMany(ctx, store.UpdateOrderManyDTOInput{
-		OrderUpdates: orderUpdates,
-	})
+	if balance.Status != string(OrderStatusCreated) || balance.Total <= 0 || amount < due {
+		return allocation, nil
+	}
+	// Products are sold in the transaction the order is moved to "paid" in.
+	msgs, err := store.NewProductsSaleMessages(oapi_codegen.PrivateSellProductsReqMessage{OrderId: msg.OrderId})
 	if err != nil {
-		return fmt.Errorf("update order many: %v", err)
+		return store.PaymentAllocation{}, fmt.Errorf("products sale message: %v", err)
+	}
+	allocation.OrderUpdate = &store.UpdateOrderDTOInput{
+		OrderId:    msg.OrderId,
+		FromStatus: balance.Status,
+		Status:     string(OrderStatusPaid),
+		Actor:      systemActor,
+		Reason:     OrderStatusReasonPaymentReceived,
+		Messages:   msgs,
 	}
+	return allocation, nil
+}
 
-	return nil
+// notificationPaymentId keys payment by its provider operation id, so provider retries
+// and topic redeliveries of the same payment share it.
+func notificationPaymentId(msg oapi_codegen.PrivateOrderProcessPaymentNotificationsReqMessage) string {
+	if msg.PaymentId != nil && *msg.PaymentId != "" {
+		return *msg.PaymentId
+	}
+	// Notifications published before payment_id was introduced
+	if name, data, ok := payment.ProviderMeta(msg.ProviderMeta); ok {
+		if operationId, _ := data["operation_id"].(string); operationId != "" {
+			return newPaymentId(name, operationId)
+		}
+	}
+	return ""
 }
 
-type ProcessYoomoneyPaymentNotificationReq struct {
-	NotificationType string
-	OperationId      string
-	Amount           string
-	Currency         string
-	Datetime         string
-	Sender           string
-	Codepro          string
-	Label            string
-
-	Sha1Hash string
+func newPaymentId(provider, operationId string) string {
+	return provider + ":" + operationId
 }
 
-func (s *Orders) ProcessYoomoneyPaymentNotification(ctx context.Context, req ProcessYoomoneyPaymentNotificationReq) error {
-	amount, err := strconv.ParseFloat(req.Amount, 64)
-	if err != nil {
-		return fmt.Errorf(
-			`%w: invalid "amount" field value`,
-			ErrYoomoneyPaymentNotificationValidation,
-		)
+// ProcessPaymentNotification verifies payment notification with the named provider
+// and publishes it for processing.
+func (s *Orders) ProcessPaymentNotification(ctx context.Context, providerName string, req payment.NotificationReq) error {
+	provider, ok := s.paymentProviders.Get(providerName)
+	if !ok {
+		return fmt.Errorf(`%w: "%s"`, ErrUnknownPaymentProvider, providerName)
 	}
 
-	paymentTime, err := time.Parse(time.RFC3339, req.Datetime)
+	notification, err := provider.VerifyNotification(ctx, req)
 	if err != nil {
-		return fmt.Errorf(
-			`%w: invalid "datetime" field value`,
-			ErrYoomoneyPaymentNotificationValidation,
-		)
+		if errors.Is(err, payment.ErrInvalidNotification) {
+			return fmt.Errorf("%w: %v", ErrInvalidPaymentNotification, err)
+		}
+		return fmt.Errorf("verify payment notification: %v", err)
 	}
-
-	currency, err := strconv.Atoi(req.Currency)
-	if err != nil {
-		return fmt.Errorf(
-			`%w: invalid "currency" field value`,
-			ErrYoomoneyPaymentNotificationValidation,
-		)
-	}
-
-	integrityCheckString := strings.Join([]string{
-		req.NotificationType,
-		req.OperationId,
-		req.Amount,
-		req.Currency,
-		req.Datetime,
-		req.Sender,
-		req.Codepro,
-		s.yoomoneyPaymentNotificationSecret,
-		req.Label,
-	}, "&")
-	integrityCheckSha1 := sha1.New()
-	integrityCheckSha1.Write([]byte(integrityCheckString))
-	hashBytes := integrityCheckSha1.Sum(nil)
-	integrityHashString := hex.EncodeToString(hashBytes)
-
-	if integrityHashString != req.Sha1Hash {
-		return ErrYoomoneyPaymentNotificationIntegrityCheckFailed
-	}
-
-	labelParts := strings.Split(req.Label, ":")
-	orderId := labelParts[0]
-	if orderId == "" {
-		return fmt.Errorf(
-			`%w: invalid empty "label" field`,
-			ErrYoomoneyPaymentNotificationValidation,
-		)
+	if notification.OperationId == "" {
+		return fmt.Errorf("%w: no operation id", ErrInvalidPaymentNotification)
 	}
 
-	order, err := s.store.GetOrder(ctx, orderId)
+	order, err := s.store.GetOrder(ctx, notification.OrderId)
 	if err != nil {
 		return fmt.Errorf("find order: %v", err)
 	}
 	if order == nil {
 		return fmt.Errorf(
 			`%w: no order found with order_id="%s"`,
-			ErrYoomoneyPaymentNotificationValidation,
-			orderId,
+			ErrInvalidPaymentNotification,
+			notification.OrderId,
 		)
 	}
 
-	if err := s.store.ProduceProcessedPaymentsNotificationsMessages(ctx, oapi_codegen.PrivateOrderProcessPaymentNotificationsReqMessage{
-		OrderId:         orderId,
-		CurrencyIso4217: currency,
-		Datetime:        paymentTime,
-		Amount:          amount,
+	msgs, err := store.NewProcessedPaymentNotificationMessages(oapi_codegen.PrivateOrderProcessPaymentNotificationsReqMessage{
+		PaymentId:       ptr(newPaymentId(provider.Name(), notification.OperationId)),
+		OrderId:         notification.OrderId,
+		CurrencyIso4217: notification.CurrencyIso4217,
+		Datetime:        notification.Datetime,
+		Amount:          notification.Amount,
 		ProviderMeta: map[string]any{
-			"yoomoney": map[string]any{
-				"notification_type": req.NotificationType,
-				"operation_id":      req.OperationId,
-				"amount":            req.Amount,
-				"currency":          req.Currency,
-				"datetime":          req.Datetime,
-				"sender":            req.Sender,
-				"codepro":           req.Codepro,
-				"label":             req.Label,
-			},
+			provider.Name(): notification.Meta,
 		},
-	}); err != nil {
-		return fmt.Errorf("produce processed payment notification message: %v", err)
+	})
+	if err != nil {
+		return fmt.Errorf("processed payment notification message: %v", err)
 	}
+	if err := s.store.EnqueueMessages(ctx, msgs...); err != nil {
+		return fmt.Errorf("enqueue processed payment notification message: %v", err)
+	}
+	s.relayOutbox(ctx)
 
 	return nil
 }
+
+// orderPayment describes how to pay for the order with every provider that supports checkout.
+// It returns nil unless the order awaits payment.
+func (s *Orders) orderPayment(ctx context.Context, orderId string) (*oapi_codegen.OrdersPayment, error) {
+	balances, err := s.store.ListOrdersPaymentsBalances(ctx, store.ListOrdersPaymentsBalancesDTOInput{
+		OrderIds:        []string{orderId},
+		CurrencyIso4217: OrderCurrencyIso4217,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list order payments balance: %v", err)
+	}
+	balance, ok := balances[orderId]
+	if !ok || OrderStatus(balance.Status) != OrderStatusCreated {
+		return nil, nil
+	}
+	due := balance.Total - balance.Paid
+	if due <= 0 {
+		return nil, nil
+	}
+
+	res := &oapi_codegen.OrdersPayment{
+		Amount:          store.FromMinorUnits(due),
+		CurrencyIso4217: OrderCurrencyIso4217,
+		Checkouts:       make([]oapi_codegen.OrdersPaymentCheckout, 0),
+	}
+	for _, name := range s.paymentProviders.Names() {
+		provider, _ := s.paymentProviders.Get(name)
+		checkout, err := provider.Checkout(ctx, payment.CheckoutReq{
+			OrderId:         orderId,
+			Amount:          res.Amount,
+			CurrencyIso4217: res.CurrencyIso4217,
+		})
+		if err != nil {
+			if errors.Is(err, payment.ErrCheckoutNotSupported) {
+				continue
+			}
+			return nil, fmt.Errorf("build %s checkout: %v", name, err)
+		}
+
+		resCheckout := oapi_codegen.OrdersPaymentCheckout{
+			Provider: name,
+			Url:      checkout.Url,
+		}
+		if checkout.Form != nil {
+			resCheckout.Form = &oapi_codegen.OrdersPaymentCheckoutForm{
+				Action: checkout.Form.Action,
+				Method: checkout.Form.Method,
+				Params: checkout.Form.Params,
+			}
+		}
+		res.Checkouts = append(res.Checkouts, resCheckout)
+	}
+
+	return res, nil
+}
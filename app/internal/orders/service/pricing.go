@@ -0,0 +1,72 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/promo"
+	"github.com/bratushkadan/floral/internal/orders/store"
+)
+
+// OrderVatRatePercent is the VAT rate included in order prices.
+const OrderVatRatePercent = 20
+
+// shippingFees are fees of delivery methods in minor units of the order currency.
+var shippingFees = map[string]int64{
+	DeliveryMethodCourier: 300_00,
+	DeliveryMethodPost:    250_00,
+	DeliveryMethodPickup:  0,
+}
+
+// priceOrder computes the order cost in minor units of the order currency, the discount is subtracted from the subtotal.
+// Orders without delivery are placed before delivery was introduced, they're not charged for shipping.
+func priceOrder(products []oapi_codegen.PrivateOrderProcessReservedProductsReqProduct, delivery *oapi_codegen.OrdersDelivery, discount int64) (oapi_codegen.OrdersCost, error) {
+	var subtotal int64
+	for _, product := range products {
+		subtotal += store.ToMinorUnits(product.Price) * int64(product.Count)
+	}
+
+	var shippingFee int64
+	if delivery != nil {
+		fee, ok := shippingFees[delivery.Method]
+		if !ok {
+			return oapi_codegen.OrdersCost{}, fmt.Errorf(`unknown delivery method "%s"`, delivery.Method)
+		}
+		shippingFee = fee
+	}
+
+	cost := oapi_codegen.OrdersCost{
+		CurrencyIso4217: OrderCurrencyIso4217,
+		Subtotal:        subtotal,
+		Discount:        discount,
+		ShippingFee:     shippingFee,
+	}
+	cost.Total = cost.Subtotal - cost.Discount + cost.ShippingFee
+	cost.Vat = includedVat(cost.Total)
+	return cost, nil
+}
+
+// orderDiscount is the discount of the coupon to the order products, orders without coupon have none.
+// It returns promo.ErrNotApplicable if the coupon can't be applied to the order.
+func orderDiscount(products []oapi_codegen.PrivateOrderProcessReservedProductsReqProduct, coupon *promo.Coupon, usage promo.Usage, now time.Time) (int64, error) {
+	if coupon == nil {
+		return 0, nil
+	}
+
+	items := make([]promo.Item, 0, len(products))
+	for _, product := range products {
+		items = append(items, promo.Item{
+			ProductId: product.Id,
+			SellerId:  product.SellerId,
+			Price:     store.ToMinorUnits(product.Price),
+			Count:     product.Count,
+		})
+	}
+	return coupon.Discount(items, usage, now)
+}
+
+// includedVat is the VAT included in the amount, rounded half up.
+func includedVat(amount int64) int64 {
+	return (amount*OrderVatRatePercent*2 + 100 + OrderVatRatePercent) / (2 * (100 + OrderVatRatePercent))
+}
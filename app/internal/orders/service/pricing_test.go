@@ -0,0 +1,103 @@
+package service_test
+
+import (
+	"testing"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pricedProduct = oapi_codegen.PrivateOrderProcessReservedProductsReqProduct
+
+func TestPriceOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		products []pricedProduct
+		delivery *oapi_codegen.OrdersDelivery
+		discount int64
+		expected oapi_codegen.OrdersCost
+	}{
+		{
+			name:     "no delivery",
+			products: []pricedProduct{{Id: "product-1", Price: 23.49, Count: 2}},
+			expected: oapi_codegen.OrdersCost{Subtotal: 46_98, Total: 46_98, Vat: 7_83},
+		},
+		{
+			name:     "prices are rounded to minor units before they're summed",
+			products: []pricedProduct{{Id: "product-1", Price: 0.1, Count: 1}, {Id: "product-2", Price: 0.2, Count: 1}, {Id: "product-3", Price: 19.99, Count: 3}},
+			expected: oapi_codegen.OrdersCost{Subtotal: 60_27, Total: 60_27, Vat: 10_05},
+		},
+		{
+			name:     "courier with discount",
+			products: []pricedProduct{{Id: "product-1", Price: 19.99, Count: 3}},
+			delivery: &oapi_codegen.OrdersDelivery{Method: service.DeliveryMethodCourier},
+			discount: 5_00,
+			expected: oapi_codegen.OrdersCost{Subtotal: 59_97, Discount: 5_00, ShippingFee: 300_00, Total: 354_97, Vat: 59_16},
+		},
+		{
+			name:     "post",
+			products: []pricedProduct{{Id: "product-1", Price: 100, Count: 1}},
+			delivery: &oapi_codegen.OrdersDelivery{Method: service.DeliveryMethodPost},
+			expected: oapi_codegen.OrdersCost{Subtotal: 100_00, ShippingFee: 250_00, Total: 350_00, Vat: 58_33},
+		},
+		{
+			name:     "pickup is free",
+			products: []pricedProduct{{Id: "product-1", Price: 120, Count: 1}},
+			delivery: &oapi_codegen.OrdersDelivery{Method: service.DeliveryMethodPickup},
+			expected: oapi_codegen.OrdersCost{Subtotal: 120_00, Total: 120_00, Vat: 20_00},
+		},
+		{
+			name:     "discount of the whole subtotal",
+			products: []pricedProduct{{Id: "product-1", Price: 10, Count: 1}},
+			discount: 10_00,
+			expected: oapi_codegen.OrdersCost{Subtotal: 10_00, Discount: 10_00},
+		},
+		{
+			name:     "no products",
+			delivery: &oapi_codegen.OrdersDelivery{Method: service.DeliveryMethodCourier},
+			expected: oapi_codegen.OrdersCost{ShippingFee: 300_00, Total: 300_00, Vat: 50_00},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, err := service.PriceOrder(tt.products, tt.delivery, tt.discount)
+			require.NoError(t, err)
+
+			tt.expected.CurrencyIso4217 = service.OrderCurrencyIso4217
+			assert.Equal(t, tt.expected, cost)
+		})
+	}
+}
+
+func TestPriceOrderUnknownDeliveryMethod(t *testing.T) {
+	_, err := service.PriceOrder(
+		[]pricedProduct{{Id: "product-1", Price: 10, Count: 1}},
+		&oapi_codegen.OrdersDelivery{Method: "teleport"},
+		0,
+	)
+	assert.Error(t, err)
+}
+
+func TestIncludedVat(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		expected int64
+	}{
+		{amount: 0, expected: 0},
+		{amount: 1, expected: 0},
+		// 2 / 6 = 0.33
+		{amount: 2, expected: 0},
+		// 3 / 6 = 0.5 is rounded half up
+		{amount: 3, expected: 1},
+		{amount: 6, expected: 1},
+		{amount: 120_00, expected: 20_00},
+		// 100.01 / 6 = 16.668
+		{amount: 100_01, expected: 16_67},
+		{amount: 354_97, expected: 59_16},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, service.IncludedVat(tt.amount), "amount %d", tt.amount)
+	}
+}
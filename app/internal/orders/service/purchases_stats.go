@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	"go.uber.org/zap"
+)
+
+const (
+	ProductsPurchasesWindow = 30 * 24 * time.Hour
+
+	productsPurchasesStatsLag     = time.Minute
+	productsPurchasesStatsMaxStep = time.Hour
+)
+
+// Orders in these statuses were paid for and their items are counted as sold.
+var soldOrderStatuses = []OrderStatus{
+	OrderStatusPaid,
+	OrderStatusProcessed,
+	OrderStatusShipped,
+	OrderStatusDelivered,
+	OrderStatusCompleted,
+}
+
+// PublishProductsPurchasesStats accounts order status transitions made since the previous run in products
+// purchases counters and publishes the changed ones, so products leaving the rolling window get their
+// purchases_30d counter decreased down to zero as well.
+func (s *Orders) PublishProductsPurchasesStats(ctx context.Context, req oapi_codegen.PrivateOrderPublishProductsPurchasesStatsReq) error {
+	statuses := make([]string, 0, len(soldOrderStatuses))
+	for _, status := range soldOrderStatuses {
+		statuses = append(statuses, string(status))
+	}
+
+	// Transitions committed later than they are timestamped are still accounted within the lag.
+	until := time.Now().Add(-productsPurchasesStatsLag)
+
+	var products int
+	for {
+		out, err := s.store.RefreshProductsPurchasesStats(ctx, store.RefreshProductsPurchasesStatsDTOInput{
+			PaidStatus:       string(OrderStatusPaid),
+			CancellingStatus: string(OrderStatusCancelling),
+			SoldStatuses:     statuses,
+			Window:           ProductsPurchasesWindow,
+			Until:            until,
+			MaxStep:          productsPurchasesStatsMaxStep,
+		})
+		if err != nil {
+			return fmt.Errorf("refresh products purchases stats: %v", err)
+		}
+		products += out.Products
+		if !out.Watermark.Before(until) {
+			break
+		}
+	}
+
+	s.l.Info("published products purchases stats", zap.Int("products", products))
+	return nil
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bratushkadan/floral/internal/orders/payment"
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	"go.uber.org/zap"
+)
+
+const (
+	refundsBatchSize = 100
+	// refundClaimTtl exceeds the time a run takes to refund a batch, refunds of a crashed run are claimed again after it.
+	refundClaimTtl = 15 * time.Minute
+)
+
+// createRefunds creates refunds for every unrefunded payment of cancelled orders
+// and returns ids of orders that have nothing to refund.
+func (s *Orders) createRefunds(ctx context.Context, orderIds []string) ([]string, error) {
+	payments, err := s.store.ListOrdersUnrefundedPayments(ctx, orderIds)
+	if err != nil {
+		return nil, fmt.Errorf("list orders unrefunded payments: %v", err)
+	}
+
+	paidOrders := make(map[string]struct{}, len(payments))
+	refunds := make([]store.CreateRefundDTOInput, 0, len(payments))
+	for _, p := range payments {
+		paidOrders[p.OrderId] = struct{}{}
+
+		provider, _, ok := payment.ProviderMeta(p.Provider)
+		if !ok {
+			s.l.Warn("unknown payment provider metadata format", zap.String("payment_id", p.Id), zap.Any("provider", p.Provider))
+		}
+		refunds = append(refunds, store.CreateRefundDTOInput{
+			PaymentId:       p.Id,
+			Id:              store.CancellationRefundId(p.Id),
+			OrderId:         p.OrderId,
+			Amount:          p.Amount,
+			CurrencyIso4217: p.CurrencyIso4217,
+			Provider:        provider,
+			CreatedAt:       time.Now(),
+		})
+	}
+
+	if len(refunds) > 0 {
+		if err := s.store.CreateRefundMany(ctx, refunds); err != nil {
+			return nil, fmt.Errorf("create refunds: %v", err)
+		}
+	}
+
+	unpaidOrderIds := make([]string, 0, len(orderIds))
+	for _, id := range orderIds {
+		if _, ok := paidOrders[id]; !ok {
+			unpaidOrderIds = append(unpaidOrderIds, id)
+		}
+	}
+	return unpaidOrderIds, nil
+}
+
+// ProcessRefunds refunds pending refunds of cancelled orders and received returns via payment providers,
+// finalizes cancellation of orders with all payments refunded and marks fully refunded returns "refunded".
+func (s *Orders) ProcessRefunds(ctx context.Context, _ oapi_codegen.PrivateOrderProcessRefundsReq) error {
+	return errors.Join(s.processOrderRefunds(ctx), s.processReturnRefunds(ctx))
+}
+
+func (s *Orders) processOrderRefunds(ctx context.Context) error {
+	now := time.Now()
+	refunds, err := s.store.ClaimPendingRefunds(ctx, refundsBatchSize, now, now.Add(refundClaimTtl))
+	if err != nil {
+		return fmt.Errorf("claim pending refunds: %v", err)
+	}
+	if len(refunds) == 0 {
+		return nil
+	}
+
+	var errs []error
+	orderIds := make([]string, 0, len(refunds))
+	for _, refund := range refunds {
+		orderIds = append(orderIds, refund.OrderId)
+		outcome, err := s.refundPayment(ctx, refund)
+		if updErr := s.updateRefund(ctx, refund.PaymentId, refund.Id, outcome); updErr != nil {
+			err = errors.Join(err, updErr)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	refundedOrderIds, err := s.store.ListRefundedCancellingOrders(ctx, orderIds)
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("list refunded cancelling orders: %v", err))...)
+	}
+	if len(refundedOrderIds) > 0 {
+		if err := s.cancelOrders(ctx, refundedOrderIds, systemActor, OrderStatusReasonRefunded); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	s.l.Info("processed refunds", zap.Int("refunds", len(refunds)), zap.Strings("cancelled_orders", refundedOrderIds))
+
+	return errors.Join(errs...)
+}
+
+func (s *Orders) processReturnRefunds(ctx context.Context) error {
+	now := time.Now()
+	refunds, err := s.store.ClaimPendingReturnRefunds(ctx, refundsBatchSize, now, now.Add(refundClaimTtl))
+	if err != nil {
+		return fmt.Errorf("claim pending return refunds: %v", err)
+	}
+	if len(refunds) == 0 {
+		return nil
+	}
+
+	var errs []error
+	keys := make([]store.ReturnKey, 0, len(refunds))
+	for _, refund := range refunds {
+		keys = append(keys, store.ReturnKey{OrderId: refund.OrderId, Id: refund.ReturnId})
+		outcome, err := s.refundPayment(ctx, refund.ClaimedRefund)
+		if updErr := s.updateReturnRefund(ctx, refund.ReturnId, refund.PaymentId, outcome); updErr != nil {
+			err = errors.Join(err, updErr)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	refundedKeys, err := s.store.ListRefundedReceivedReturns(ctx, keys)
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("list refunded received returns: %v", err))...)
+	}
+	for _, k := range refundedKeys {
+		ret, err := s.store.GetReturn(ctx, k.OrderId, k.Id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("retrieve return %s: %v", k.Id, err))
+			continue
+		}
+		if ret == nil {
+			continue
+		}
+		if err := s.updateReturn(ctx, ret, string(ReturnStatusRefunded), nil, systemActor); err != nil {
+			errs = append(errs, fmt.Errorf("update return %s: %w", k.Id, err))
+		}
+	}
+
+	s.l.Info("processed return refunds", zap.Int("refunds", len(refunds)), zap.Int("refunded_returns", len(refundedKeys)))
+
+	return errors.Join(errs...)
+}
+
+// refundOutcome is the refund state to persist after the payment provider call.
+type refundOutcome struct {
+	Status           string
+	ProviderRefundId *string
+	Details          *string
+}
+
+// refundPayment refunds the payment via its provider with the refund id as the idempotency key.
+// Error is returned for transient failures only, the refund is retried on the next run then.
+// Refunds with unknown outcome stay "processing" and are retried once their claim expires.
+func (s *Orders) refundPayment(ctx context.Context, refund store.ClaimedRefund) (refundOutcome, error) {
+	provider, ok := s.paymentProviders.Get(refund.Provider)
+	if !ok {
+		s.l.Warn("no payment provider registered", zap.String("payment_id", refund.PaymentId), zap.String("provider", refund.Provider))
+		return refundOutcome{Status: store.RefundStatusPending}, nil
+	}
+
+	_, paymentMeta, _ := payment.ProviderMeta(refund.PaymentProvider)
+	res, err := provider.Refund(ctx, payment.RefundReq{
+		IdempotencyKey:  refund.Id,
+		PaymentId:       refund.PaymentId,
+		OrderId:         refund.OrderId,
+		Amount:          store.FromMinorUnits(refund.Amount),
+		CurrencyIso4217: refund.CurrencyIso4217,
+		PaymentMeta:     paymentMeta,
+	})
+	if err != nil {
+		if errors.Is(err, payment.ErrRefundNotSupported) {
+			s.l.Warn("payment requires manual refund", zap.String("payment_id", refund.PaymentId), zap.Error(err))
+			return refundOutcome{Status: store.RefundStatusFailed, Details: ptr(err.Error())}, nil
+		}
+		if errors.Is(err, payment.ErrRefundUncertain) {
+			s.l.Warn("refund outcome is unknown", zap.String("refund_id", refund.Id), zap.Error(err))
+			return refundOutcome{Status: store.RefundStatusProcessing, Details: ptr(err.Error())}, nil
+		}
+		// Transient errors are retried on the next run.
+		return refundOutcome{Status: store.RefundStatusPending, Details: ptr(err.Error())}, fmt.Errorf("refund payment %s: %v", refund.PaymentId, err)
+	}
+
+	return refundOutcome{Status: store.RefundStatusSucceeded, ProviderRefundId: &res.ProviderRefundId}, nil
+}
+
+func (s *Orders) updateRefund(ctx context.Context, paymentId, id string, outcome refundOutcome) error {
+	if err := s.store.UpdateRefund(ctx, store.UpdateRefundDTOInput{
+		PaymentId:        paymentId,
+		Id:               id,
+		Status:           outcome.Status,
+		ProviderRefundId: outcome.ProviderRefundId,
+		Details:          outcome.Details,
+		UpdatedAt:        time.Now(),
+	}); err != nil {
+		return fmt.Errorf("update refund %s of payment %s: %v", id, paymentId, err)
+	}
+	return nil
+}
+
+func (s *Orders) updateReturnRefund(ctx context.Context, returnId, paymentId string, outcome refundOutcome) error {
+	if err := s.store.UpdateReturnRefund(ctx, store.UpdateReturnRefundDTOInput{
+		ReturnId: returnId,
+		UpdateRefundDTOInput: store.UpdateRefundDTOInput{
+			PaymentId:        paymentId,
+			Status:           outcome.Status,
+			ProviderRefundId: outcome.ProviderRefundId,
+			Details:          outcome.Details,
+			UpdatedAt:        time.Now(),
+		},
+	}); err != nil {
+		return fmt.Errorf("update refund of return %s payment %s: %v", returnId, paymentId, err)
+	}
+	return nil
+}
+
+// cancelOrders completes cancellation of the cancelling orders: promo codes of the orders are released
+// and their shipments are cancelled in the same transaction.
+func (s *Orders) cancelOrders(ctx context.Context, orderIds []string, actor store.Actor, reason string) error {
+	orderUpdates := make([]store.UpdateOrderManyDTOInputOrderUpdate, 0, len(orderIds))
+	for _, id := range orderIds {
+		orderUpdates = append(orderUpdates, store.UpdateOrderManyDTOInputOrderUpdate{
+			OrderId:    id,
+			FromStatus: string(OrderStatusCancelling),
+			Status:     string(OrderStatusCancelled),
+			UpdatedAt:  time.Now(),
+			Actor:      actor,
+			Reason:     reason,
+		})
+	}
+
+	if _, err := s.store.UpdateOrderMany(ctx, store.UpdateOrderManyDTOInput{OrderUpdates: orderUpdates}); err != nil {
+		return fmt.Errorf("update orders: %w", err)
+	}
+	s.relayOutbox(ctx)
+	return nil
+}
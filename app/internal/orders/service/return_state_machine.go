@@ -0,0 +1,176 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+)
+
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = store.ReturnStatusRequested
+	ReturnStatusApproved  ReturnStatus = store.ReturnStatusApproved
+	ReturnStatusRejected  ReturnStatus = store.ReturnStatusRejected
+	ReturnStatusCancelled ReturnStatus = store.ReturnStatusCancelled
+	ReturnStatusReceived  ReturnStatus = store.ReturnStatusReceived
+	ReturnStatusRefunded  ReturnStatus = store.ReturnStatusRefunded
+)
+
+var (
+	ErrReturnInvalidStatus             = errors.New("invalid return status")
+	ErrReturnIncorrectStatusTransition = errors.New("incorrect return status transition")
+	ErrReturnCommentRequired           = errors.New("return comment is required")
+)
+
+// ReturnTransitionContext describes an attempt to transition the return.
+type ReturnTransitionContext struct {
+	Return  *oapi_codegen.OrdersReturn
+	From    ReturnStatus
+	To      ReturnStatus
+	Actor   store.Actor
+	Comment *string
+}
+
+// ReturnTransitionGuard rejects the transition with an error.
+type ReturnTransitionGuard func(tc ReturnTransitionContext) error
+
+// ReturnTransitionHook builds messages published in the same transaction as the new return status.
+type ReturnTransitionHook func(tc ReturnTransitionContext) ([]outbox.Message, error)
+
+type ReturnTransition struct {
+	To     ReturnStatus
+	Guards []ReturnTransitionGuard
+	Hooks  []ReturnTransitionHook
+	// Refund issues a partial refund of the return amount in the same transaction as the new return status.
+	Refund bool
+}
+
+// returnTransitions is the return lifecycle: seller resolves the request and receives returned items,
+// buyer may cancel the return until it's received. Final statuses have no transitions.
+var returnTransitions = map[ReturnStatus][]ReturnTransition{
+	ReturnStatusRequested: {
+		{To: ReturnStatusApproved, Guards: []ReturnTransitionGuard{returnPerformedBy(shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin)}},
+		{To: ReturnStatusRejected, Guards: []ReturnTransitionGuard{returnPerformedBy(shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin), commentRequired}},
+		{To: ReturnStatusCancelled, Guards: []ReturnTransitionGuard{returnPerformedBy(shared_api.SubjectTypeUser, shared_api.SubjectTypeAdmin)}},
+	},
+	ReturnStatusApproved: {
+		{To: ReturnStatusReceived, Guards: []ReturnTransitionGuard{returnPerformedBy(shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin)}, Hooks: []ReturnTransitionHook{restockReturnedProducts}, Refund: true},
+		{To: ReturnStatusCancelled, Guards: []ReturnTransitionGuard{returnPerformedBy(shared_api.SubjectTypeUser, shared_api.SubjectTypeAdmin)}},
+	},
+	ReturnStatusReceived: {
+		{To: ReturnStatusRefunded, Guards: []ReturnTransitionGuard{returnPerformedBy(SubjectTypeSystem)}},
+	},
+	ReturnStatusRejected:  nil,
+	ReturnStatusCancelled: nil,
+	ReturnStatusRefunded:  nil,
+}
+
+// Statuses of returns that block completion of the seller shipment.
+var openReturnStatuses = []ReturnStatus{
+	ReturnStatusRequested,
+	ReturnStatusApproved,
+}
+
+func returnPerformedBy(subjectTypes ...string) ReturnTransitionGuard {
+	return func(tc ReturnTransitionContext) error {
+		if !slices.Contains(subjectTypes, tc.Actor.Type) {
+			return fmt.Errorf(`%w: "%s" -> "%s" can't be performed by %s`, ErrPermissionDenied, tc.From, tc.To, tc.Actor.Type)
+		}
+		return nil
+	}
+}
+
+func commentRequired(tc ReturnTransitionContext) error {
+	if tc.Comment == nil || *tc.Comment == "" {
+		return fmt.Errorf(`%w: "%s"`, ErrReturnCommentRequired, tc.To)
+	}
+	return nil
+}
+
+// Received items are put back on stock the same way as items of cancelled orders.
+func restockReturnedProducts(tc ReturnTransitionContext) ([]outbox.Message, error) {
+	products := make([]oapi_codegen.PrivateUnreserveProductsReqProduct, 0, len(tc.Return.Items))
+	for _, item := range tc.Return.Items {
+		products = append(products, oapi_codegen.PrivateUnreserveProductsReqProduct{
+			Id:    item.ProductId,
+			SkuId: item.SkuId,
+			Count: item.Count,
+		})
+	}
+	msgs, err := store.NewProductsUnreservationMessages(oapi_codegen.PrivateUnreserveProductsReqMessage{
+		OrderId:  tc.Return.OrderId,
+		ReturnId: &tc.Return.Id,
+		Products: products,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("products unreservation message: %v", err)
+	}
+	return msgs, nil
+}
+
+// transitionReturn looks up the declared transition of the return and checks its guards.
+func transitionReturn(newStatus string, tc ReturnTransitionContext) (ReturnTransition, error) {
+	to := ReturnStatus(newStatus)
+	if _, ok := returnTransitions[to]; !ok {
+		return ReturnTransition{}, fmt.Errorf(`%w: "%s"`, ErrReturnInvalidStatus, newStatus)
+	}
+
+	transitions := returnTransitions[tc.From]
+	idx := slices.IndexFunc(transitions, func(t ReturnTransition) bool { return t.To == to })
+	if idx == -1 {
+		if len(transitions) == 0 {
+			return ReturnTransition{}, fmt.Errorf(`%w: no available transition for status "%s"`, ErrReturnIncorrectStatusTransition, tc.From)
+		}
+		available := make([]ReturnStatus, 0, len(transitions))
+		for _, t := range transitions {
+			available = append(available, t.To)
+		}
+		return ReturnTransition{}, fmt.Errorf(`%w: no status transition "%s" -> "%s", available transitions are: %v`, ErrReturnIncorrectStatusTransition, tc.From, to, available)
+	}
+
+	transition := transitions[idx]
+	tc.To = to
+	for _, guard := range transition.Guards {
+		if err := guard(tc); err != nil {
+			return ReturnTransition{}, err
+		}
+	}
+	return transition, nil
+}
+
+// allowedReturnTransitions lists statuses reachable from the return status whose guards pass for the transition context.
+// The comment is a part of the update request, so transitions requiring it are listed regardless of it.
+func allowedReturnTransitions(tc ReturnTransitionContext) []string {
+	tc.Comment = ptr("-")
+	allowed := make([]string, 0)
+outer:
+	for _, t := range returnTransitions[tc.From] {
+		tc.To = t.To
+		for _, guard := range t.Guards {
+			if guard(tc) != nil {
+				continue outer
+			}
+		}
+		allowed = append(allowed, string(t.To))
+	}
+	return allowed
+}
+
+// returnTransitionMessages runs hooks of the transition made by tc and collects their messages.
+func returnTransitionMessages(transition ReturnTransition, tc ReturnTransitionContext) ([]outbox.Message, error) {
+	var msgs []outbox.Message
+	for _, hook := range transition.Hooks {
+		hookMsgs, err := hook(tc)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, hookMsgs...)
+	}
+	return msgs, nil
+}
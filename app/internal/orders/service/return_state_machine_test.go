@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/service"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var returnStatuses = []service.ReturnStatus{
+	service.ReturnStatusRequested,
+	service.ReturnStatusApproved,
+	service.ReturnStatusRejected,
+	service.ReturnStatusCancelled,
+	service.ReturnStatusReceived,
+	service.ReturnStatusRefunded,
+}
+
+var returnActors = []store.Actor{
+	{Type: shared_api.SubjectTypeUser, Id: "user-1"},
+	{Type: shared_api.SubjectTypeSeller, Id: "seller-1"},
+	{Type: shared_api.SubjectTypeAdmin, Id: "admin-1"},
+	{Type: service.SubjectTypeSystem},
+}
+
+type returnTransitionKey struct {
+	from, to service.ReturnStatus
+}
+
+// Subject types allowed to make every declared transition, the rest of (from, to) pairs are not declared.
+var allowedReturnTransitions = map[returnTransitionKey][]string{
+	{service.ReturnStatusRequested, service.ReturnStatusApproved}:  {shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin},
+	{service.ReturnStatusRequested, service.ReturnStatusRejected}:  {shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin},
+	{service.ReturnStatusRequested, service.ReturnStatusCancelled}: {shared_api.SubjectTypeUser, shared_api.SubjectTypeAdmin},
+	{service.ReturnStatusApproved, service.ReturnStatusReceived}:   {shared_api.SubjectTypeSeller, shared_api.SubjectTypeAdmin},
+	{service.ReturnStatusApproved, service.ReturnStatusCancelled}:  {shared_api.SubjectTypeUser, shared_api.SubjectTypeAdmin},
+	{service.ReturnStatusReceived, service.ReturnStatusRefunded}:   {service.SubjectTypeSystem},
+}
+
+func newTestReturn(status service.ReturnStatus) *oapi_codegen.OrdersReturn {
+	return &oapi_codegen.OrdersReturn{
+		Id:       "return-1",
+		OrderId:  "order-1",
+		SellerId: "seller-1",
+		Status:   string(status),
+		Items: []oapi_codegen.OrdersReturnItem{
+			{ProductId: "product-1", Count: 1},
+		},
+	}
+}
+
+func TestTransitionReturn(t *testing.T) {
+	comment := "damaged"
+	for _, from := range returnStatuses {
+		for _, to := range returnStatuses {
+			for _, actor := range returnActors {
+				t.Run(fmt.Sprintf("%s to %s by %s", from, to, actor.Type), func(t *testing.T) {
+					_, err := service.TransitionReturn(string(to), service.ReturnTransitionContext{
+						Return:  newTestReturn(from),
+						From:    from,
+						Actor:   actor,
+						Comment: &comment,
+					})
+
+					subjectTypes, declared := allowedReturnTransitions[returnTransitionKey{from, to}]
+					switch {
+					case !declared:
+						assert.ErrorIs(t, err, service.ErrReturnIncorrectStatusTransition)
+					case slices.Contains(subjectTypes, actor.Type):
+						assert.NoError(t, err)
+					default:
+						assert.ErrorIs(t, err, service.ErrPermissionDenied)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestTransitionReturnInvalidStatus(t *testing.T) {
+	_, err := service.TransitionReturn("lost", service.ReturnTransitionContext{
+		Return: newTestReturn(service.ReturnStatusRequested),
+		From:   service.ReturnStatusRequested,
+		Actor:  store.Actor{Type: shared_api.SubjectTypeAdmin},
+	})
+	assert.ErrorIs(t, err, service.ErrReturnInvalidStatus)
+}
+
+func TestTransitionReturnRejectionComment(t *testing.T) {
+	empty := ""
+	for _, comment := range []*string{nil, &empty} {
+		_, err := service.TransitionReturn(string(service.ReturnStatusRejected), service.ReturnTransitionContext{
+			Return:  newTestReturn(service.ReturnStatusRequested),
+			From:    service.ReturnStatusRequested,
+			Actor:   store.Actor{Type: shared_api.SubjectTypeSeller, Id: "seller-1"},
+			Comment: comment,
+		})
+		assert.ErrorIs(t, err, service.ErrReturnCommentRequired)
+	}
+}
+
+func TestTransitionReturnReceived(t *testing.T) {
+	transition, err := service.TransitionReturn(string(service.ReturnStatusReceived), service.ReturnTransitionContext{
+		Return: newTestReturn(service.ReturnStatusApproved),
+		From:   service.ReturnStatusApproved,
+		Actor:  store.Actor{Type: shared_api.SubjectTypeSeller, Id: "seller-1"},
+	})
+	require.NoError(t, err)
+	assert.True(t, transition.Refund)
+	assert.Len(t, transition.Hooks, 1)
+
+	transition, err = service.TransitionReturn(string(service.ReturnStatusApproved), service.ReturnTransitionContext{
+		Return: newTestReturn(service.ReturnStatusRequested),
+		From:   service.ReturnStatusRequested,
+		Actor:  store.Actor{Type: shared_api.SubjectTypeSeller, Id: "seller-1"},
+	})
+	require.NoError(t, err)
+	assert.False(t, transition.Refund)
+	assert.Empty(t, transition.Hooks)
+}
+
+func TestAllowedReturnTransitions(t *testing.T) {
+	for _, from := range returnStatuses {
+		for _, actor := range returnActors {
+			t.Run(fmt.Sprintf("%s by %s", from, actor.Type), func(t *testing.T) {
+				expected := make([]string, 0)
+				for _, to := range returnStatuses {
+					if slices.Contains(allowedReturnTransitions[returnTransitionKey{from, to}], actor.Type) {
+						expected = append(expected, string(to))
+					}
+				}
+
+				// Transitions requiring a comment are listed without it.
+				allowed := service.AllowedReturnTransitions(service.ReturnTransitionContext{
+					Return: newTestReturn(from),
+					From:   from,
+					Actor:  actor,
+				})
+				assert.ElementsMatch(t, expected, allowed)
+			})
+		}
+	}
+}
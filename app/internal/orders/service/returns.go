@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/bratushkadan/floral/internal/orders/payment"
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ReturnPhotosLimitCount is the max count of photos of a return.
+const ReturnPhotosLimitCount = 3
+
+var (
+	ErrInvalidReturn          = errors.New("invalid return")
+	ErrReturnNotAllowed       = errors.New("return is not allowed")
+	ErrReturnConflict         = errors.New("return was updated concurrently, retry")
+	ErrReturnPhotosNotAllowed = errors.New("return photos are not allowed")
+)
+
+// CreateReturn requests return of delivered items of a single seller shipment on behalf of the buyer.
+// It returns nil response if the order does not exist.
+func (s *Orders) CreateReturn(ctx context.Context, req oapi_codegen.OrdersCreateReturnReq, orderId, subjectType, subjectId string) (*oapi_codegen.OrdersReturn, error) {
+	if subjectType != shared_api.SubjectTypeUser {
+		return nil, ErrPermissionDenied
+	}
+
+	order, err := s.store.GetOrder(ctx, orderId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve order: %v", err)
+	}
+	if order == nil {
+		return nil, nil
+	}
+	if order.UserId != subjectId {
+		return nil, ErrPermissionDenied
+	}
+
+	var sellerId string
+	items := make([]store.CreateReturnDTOInputItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		idx := slices.IndexFunc(order.Items, func(orderItem oapi_codegen.OrdersGetOrderResItem) bool {
+			return orderItem.ProductId == item.ProductId && sameSku(orderItem.SkuId, item.SkuId)
+		})
+		if idx == -1 {
+			return nil, fmt.Errorf(`%w: product "%s"%s is not in the order`, ErrInvalidReturn, item.ProductId, skuSuffix(item.SkuId))
+		}
+		if sellerId == "" {
+			sellerId = order.Items[idx].SellerId
+		}
+		if order.Items[idx].SellerId != sellerId {
+			return nil, fmt.Errorf("%w: items of different sellers must be returned separately", ErrInvalidReturn)
+		}
+		if slices.ContainsFunc(items, func(i store.CreateReturnDTOInputItem) bool {
+			return i.ProductId == item.ProductId && sameSku(i.SkuId, item.SkuId)
+		}) {
+			return nil, fmt.Errorf(`%w: product "%s"%s is listed more than once`, ErrInvalidReturn, item.ProductId, skuSuffix(item.SkuId))
+		}
+		items = append(items, store.CreateReturnDTOInputItem{ProductId: item.ProductId, SkuId: item.SkuId, Count: item.Count})
+	}
+
+	returnId := uuid.NewString()
+	if err := s.store.CreateReturn(ctx, store.CreateReturnDTOInput{
+		Id:        returnId,
+		OrderId:   orderId,
+		UserId:    order.UserId,
+		SellerId:  sellerId,
+		Reason:    req.Reason,
+		Items:     items,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		if errors.Is(err, store.ErrReturnNotDelivered) {
+			return nil, fmt.Errorf("%w: %v", ErrReturnNotAllowed, err)
+		}
+		if errors.Is(err, store.ErrReturnItemNotInShipment) || errors.Is(err, store.ErrReturnCountExceeded) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidReturn, err)
+		}
+		return nil, fmt.Errorf("create return: %v", err)
+	}
+
+	return s.getReturn(ctx, orderId, returnId, store.Actor{Type: subjectType, Id: subjectId})
+}
+
+// ListReturns lists returns of the order. Sellers see returns of their own items only.
+// It returns nil response if the order does not exist.
+func (s *Orders) ListReturns(ctx context.Context, orderId, subjectType, subjectId string) (*oapi_codegen.OrdersListReturnsRes, error) {
+	order, err := s.store.GetOrder(ctx, orderId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve order: %v", err)
+	}
+	if order == nil {
+		return nil, nil
+	}
+	switch subjectType {
+	case shared_api.SubjectTypeAdmin:
+	case shared_api.SubjectTypeUser:
+		if order.UserId != subjectId {
+			return nil, ErrPermissionDenied
+		}
+	case shared_api.SubjectTypeSeller:
+		if SellerOrderView(order, subjectId) == nil {
+			return nil, ErrPermissionDenied
+		}
+	default:
+		return nil, ErrPermissionDenied
+	}
+
+	returns, err := s.store.ListReturns(ctx, orderId)
+	if err != nil {
+		return nil, fmt.Errorf("list returns: %v", err)
+	}
+
+	actor := store.Actor{Type: subjectType, Id: subjectId}
+	res := &oapi_codegen.OrdersListReturnsRes{Returns: make([]oapi_codegen.OrdersReturn, 0, len(returns))}
+	for _, ret := range returns {
+		if subjectType == shared_api.SubjectTypeSeller && ret.SellerId != subjectId {
+			continue
+		}
+		ret.AllowedTransitions = allowedReturnTransitions(ReturnTransitionContext{Return: &ret, From: ReturnStatus(ret.Status), Actor: actor})
+		res.Returns = append(res.Returns, ret)
+	}
+	return res, nil
+}
+
+// GetReturn returns nil response if the order has no such return.
+func (s *Orders) GetReturn(ctx context.Context, orderId, returnId, subjectType, subjectId string) (*oapi_codegen.OrdersReturn, error) {
+	return s.getReturn(ctx, orderId, returnId, store.Actor{Type: subjectType, Id: subjectId})
+}
+
+func (s *Orders) getReturn(ctx context.Context, orderId, returnId string, actor store.Actor) (*oapi_codegen.OrdersReturn, error) {
+	ret, err := s.store.GetReturn(ctx, orderId, returnId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve return: %v", err)
+	}
+	if ret == nil {
+		return nil, nil
+	}
+	if err := authorizeReturnAccess(ret, actor); err != nil {
+		return nil, err
+	}
+	ret.AllowedTransitions = allowedReturnTransitions(ReturnTransitionContext{Return: ret, From: ReturnStatus(ret.Status), Actor: actor})
+	return ret, nil
+}
+
+// sameSku reports whether both items are of the same sku, nil for products without skus.
+func sameSku(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// skuSuffix describes the sku of the returned item in errors.
+func skuSuffix(skuId *string) string {
+	if skuId == nil {
+		return ""
+	}
+	return fmt.Sprintf(` sku "%s"`, *skuId)
+}
+
+// Returns are accessed by their buyer and seller only.
+func authorizeReturnAccess(ret *oapi_codegen.OrdersReturn, actor store.Actor) error {
+	switch actor.Type {
+	case shared_api.SubjectTypeAdmin, SubjectTypeSystem:
+		return nil
+	case shared_api.SubjectTypeUser:
+		if ret.UserId == actor.Id {
+			return nil
+		}
+	case shared_api.SubjectTypeSeller:
+		if ret.SellerId == actor.Id {
+			return nil
+		}
+	}
+	return ErrPermissionDenied
+}
+
+// UpdateReturn transitions the return, received return restocks returned products and refunds its amount.
+// It returns nil response if the order has no such return.
+func (s *Orders) UpdateReturn(ctx context.Context, req oapi_codegen.OrdersUpdateReturnReq, orderId, returnId, subjectType, subjectId string) (*oapi_codegen.OrdersReturn, error) {
+	actor := store.Actor{Type: subjectType, Id: subjectId}
+
+	ret, err := s.store.GetReturn(ctx, orderId, returnId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve return: %v", err)
+	}
+	if ret == nil {
+		return nil, nil
+	}
+	if err := authorizeReturnAccess(ret, actor); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateReturn(ctx, ret, req.Status, req.Comment, actor); err != nil {
+		return nil, err
+	}
+	s.relayOutbox(ctx)
+
+	return s.getReturn(ctx, orderId, returnId, actor)
+}
+
+func (s *Orders) updateReturn(ctx context.Context, ret *oapi_codegen.OrdersReturn, status string, comment *string, actor store.Actor) error {
+	tc := ReturnTransitionContext{
+		Return:  ret,
+		From:    ReturnStatus(ret.Status),
+		Actor:   actor,
+		Comment: comment,
+	}
+	transition, err := transitionReturn(status, tc)
+	if err != nil {
+		return err
+	}
+	tc.To = transition.To
+
+	msgs, err := returnTransitionMessages(transition, tc)
+	if err != nil {
+		return err
+	}
+
+	var refundPayments []store.ReturnRefundPayment
+	if transition.Refund {
+		payments, err := s.store.ListOrdersUnrefundedPayments(ctx, []string{ret.OrderId})
+		if err != nil {
+			return fmt.Errorf("list order unrefunded payments: %v", err)
+		}
+		for _, p := range payments {
+			provider, _, ok := payment.ProviderMeta(p.Provider)
+			if !ok {
+				s.l.Warn("unknown payment provider metadata format", zap.String("payment_id", p.Id), zap.Any("provider", p.Provider))
+			}
+			refundPayments = append(refundPayments, store.ReturnRefundPayment{
+				Id:              p.Id,
+				Amount:          p.Amount,
+				CurrencyIso4217: p.CurrencyIso4217,
+				Provider:        provider,
+			})
+		}
+	}
+
+	if err := s.store.UpdateReturn(ctx, store.UpdateReturnDTOInput{
+		OrderId:        ret.OrderId,
+		ReturnId:       ret.Id,
+		FromStatus:     ret.Status,
+		Status:         string(transition.To),
+		Comment:        comment,
+		UpdatedAt:      time.Now(),
+		RefundPayments: refundPayments,
+		Messages:       msgs,
+	}); err != nil {
+		if errors.Is(err, store.ErrReturnStatusConflict) {
+			return ErrReturnConflict
+		}
+		return fmt.Errorf("update return: %v", err)
+	}
+	return nil
+}
+
+// AddReturnPhoto attaches the uploaded photo to the requested return of the buyer.
+func (s *Orders) AddReturnPhoto(ctx context.Context, orderId, returnId string, photo oapi_codegen.OrdersReturnPhoto) error {
+	if err := s.store.AddReturnPhoto(ctx, store.AddReturnPhotoDTOInput{
+		OrderId:    orderId,
+		ReturnId:   returnId,
+		FromStatus: string(ReturnStatusRequested),
+		Photo:      photo,
+		Limit:      ReturnPhotosLimitCount,
+		UpdatedAt:  time.Now(),
+	}); err != nil {
+		if errors.Is(err, store.ErrReturnStatusConflict) {
+			return ErrReturnConflict
+		}
+		if errors.Is(err, store.ErrReturnPhotosLimitReached) {
+			return fmt.Errorf("%w: max amount of photos of %d is reached", ErrReturnPhotosNotAllowed, ReturnPhotosLimitCount)
+		}
+		return fmt.Errorf("add return photo: %v", err)
+	}
+	return nil
+}
+
+// CheckReturnPhotoUpload checks the photo may be uploaded to the return before it's uploaded to the storage.
+// It returns nil response if the order has no such return.
+func (s *Orders) CheckReturnPhotoUpload(ctx context.Context, orderId, returnId, subjectType, subjectId string) (*oapi_codegen.OrdersReturn, error) {
+	if subjectType != shared_api.SubjectTypeUser {
+		return nil, ErrPermissionDenied
+	}
+	ret, err := s.getReturn(ctx, orderId, returnId, store.Actor{Type: subjectType, Id: subjectId})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	if ReturnStatus(ret.Status) != ReturnStatusRequested {
+		return nil, fmt.Errorf(`%w: return status is "%s"`, ErrReturnPhotosNotAllowed, ret.Status)
+	}
+	if len(ret.Photos) >= ReturnPhotosLimitCount {
+		return nil, fmt.Errorf("%w: max amount of photos of %d is reached", ErrReturnPhotosNotAllowed, ReturnPhotosLimitCount)
+	}
+	return ret, nil
+}
+
+// hasOpenReturns reports whether the seller has unresolved returns of the order items.
+func (s *Orders) hasOpenReturns(ctx context.Context, orderId, sellerId string) (bool, error) {
+	returns, err := s.store.ListReturns(ctx, orderId)
+	if err != nil {
+		return false, fmt.Errorf("list returns: %v", err)
+	}
+	return slices.ContainsFunc(returns, func(ret oapi_codegen.OrdersReturn) bool {
+		return ret.SellerId == sellerId && slices.Contains(openReturnStatuses, ReturnStatus(ret.Status))
+	}), nil
+}
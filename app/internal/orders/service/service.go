@@ -2,7 +2,10 @@ package service
 
 import (
 	"errors"
+	"time"
 
+	"github.com/bratushkadan/floral/internal/orders/carrier"
+	"github.com/bratushkadan/floral/internal/orders/payment"
 	"github.com/bratushkadan/floral/internal/orders/store"
 	"go.uber.org/zap"
 )
@@ -11,7 +14,11 @@ type Orders struct {
 	l     *zap.Logger
 	store *store.Orders
 
-	yoomoneyPaymentNotificationSecret string
+	paymentProviders *payment.Registry
+
+	carriers *carrier.Registry
+	// orderCompletionDelay is the time buyers have to open a return of a delivered shipment before it's completed.
+	orderCompletionDelay time.Duration
 }
 
 type OrdersBuilder struct {
@@ -31,8 +38,18 @@ func (b *OrdersBuilder) Store(store *store.Orders) *OrdersBuilder {
 	return b
 }
 
-func (b *OrdersBuilder) YoomoneyPaymentNotificationSecret(secret string) *OrdersBuilder {
-	b.svc.yoomoneyPaymentNotificationSecret = secret
+func (b *OrdersBuilder) PaymentProviders(providers *payment.Registry) *OrdersBuilder {
+	b.svc.paymentProviders = providers
+	return b
+}
+
+func (b *OrdersBuilder) Carriers(carriers *carrier.Registry) *OrdersBuilder {
+	b.svc.carriers = carriers
+	return b
+}
+
+func (b *OrdersBuilder) OrderCompletionDelay(d time.Duration) *OrdersBuilder {
+	b.svc.orderCompletionDelay = d
 	return b
 }
 
@@ -45,8 +62,15 @@ func (b *OrdersBuilder) Build() (*Orders, error) {
 		b.svc.l = zap.NewNop()
 	}
 
-	if b.svc.yoomoneyPaymentNotificationSecret == "" {
-		return nil, errors.New("payment notification secret for Yoomoney is empty")
+	if b.svc.paymentProviders == nil {
+		return nil, errors.New("payment providers registry is nil")
+	}
+
+	if b.svc.carriers == nil {
+		b.svc.carriers = carrier.NewRegistry()
+	}
+	if b.svc.orderCompletionDelay < 0 {
+		return nil, errors.New("order completion delay is negative")
 	}
 
 	return &b.svc, nil
@@ -55,3 +79,10 @@ func (b *OrdersBuilder) Build() (*Orders, error) {
 func ptr[T any](v T) *T {
 	return &v
 }
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
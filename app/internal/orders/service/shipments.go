@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/store"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+)
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusCreated   ShipmentStatus = store.ShipmentStatusCreated
+	ShipmentStatusProcessed ShipmentStatus = store.ShipmentStatusProcessed
+	ShipmentStatusShipped   ShipmentStatus = store.ShipmentStatusShipped
+	ShipmentStatusDelivered ShipmentStatus = store.ShipmentStatusDelivered
+	ShipmentStatusCompleted ShipmentStatus = store.ShipmentStatusCompleted
+	ShipmentStatusCancelled ShipmentStatus = store.ShipmentStatusCancelled
+)
+
+var (
+	ErrShipmentInvalidStatus             = errors.New("invalid shipment status")
+	ErrShipmentIncorrectStatusTransition = errors.New("incorrect shipment status transition")
+	ErrShipmentConflict                  = errors.New("shipment was updated concurrently, retry")
+	ErrOrderNotInFulfillment             = errors.New("order is not in fulfillment")
+	ErrOrderStatusDerived                = errors.New("order fulfillment status is derived from its shipments")
+	ErrInvalidNextPageToken              = errors.New("invalid next page token")
+	ErrShipmentHasOpenReturns            = errors.New("shipment has open returns, resolve them first")
+)
+
+// Shipments are cancelled only along with their order.
+var shipmentTransitions = map[ShipmentStatus][]ShipmentStatus{
+	ShipmentStatusCreated:   {ShipmentStatusProcessed},
+	ShipmentStatusProcessed: {ShipmentStatusShipped},
+	ShipmentStatusShipped:   {ShipmentStatusDelivered, ShipmentStatusCompleted},
+	ShipmentStatusDelivered: {ShipmentStatusCompleted},
+}
+
+// Statuses of orders whose shipments can be advanced.
+var fulfillmentOrderStatuses = []OrderStatus{
+	OrderStatusPaid,
+	OrderStatusProcessed,
+	OrderStatusShipped,
+	OrderStatusDelivered,
+}
+
+func validateShipmentTransition(from ShipmentStatus, to string) error {
+	switch ShipmentStatus(to) {
+	case ShipmentStatusCreated, ShipmentStatusProcessed, ShipmentStatusShipped, ShipmentStatusDelivered, ShipmentStatusCompleted, ShipmentStatusCancelled:
+	default:
+		return fmt.Errorf(`%w: "%s"`, ErrShipmentInvalidStatus, to)
+	}
+
+	available, ok := shipmentTransitions[from]
+	if !ok {
+		return fmt.Errorf(`%w: no available transition for status "%s"`, ErrShipmentIncorrectStatusTransition, from)
+	}
+	if !slices.Contains(available, ShipmentStatus(to)) {
+		return fmt.Errorf(`%w: no status transition "%s" -> "%s", available transitions are: %v`, ErrShipmentIncorrectStatusTransition, from, to, available)
+	}
+	return nil
+}
+
+// UpdateShipment advances the seller shipment of the order and derives the order status from its shipments.
+// It returns nil response if either the order or the shipment does not exist.
+func (s *Orders) UpdateShipment(ctx context.Context, req oapi_codegen.OrdersUpdateShipmentReq, orderId, sellerId, subjectType, subjectId string) (*oapi_codegen.OrdersUpdateShipmentRes, error) {
+	switch subjectType {
+	case shared_api.SubjectTypeAdmin:
+	case shared_api.SubjectTypeSeller:
+		if sellerId != subjectId {
+			return nil, ErrPermissionDenied
+		}
+	default:
+		return nil, ErrPermissionDenied
+	}
+
+	order, err := s.store.GetOrder(ctx, orderId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve order: %v", err)
+	}
+	if order == nil {
+		return nil, nil
+	}
+
+	res, err := s.updateShipment(ctx, order, shipmentUpdate{
+		SellerId:       sellerId,
+		Status:         req.Status,
+		TrackingNumber: req.TrackingNumber,
+		Carrier:        req.Carrier,
+		Actor:          store.Actor{Type: subjectType, Id: subjectId},
+	})
+	if err != nil || res == nil {
+		return nil, err
+	}
+	s.relayOutbox(ctx)
+
+	return res, nil
+}
+
+type shipmentUpdate struct {
+	SellerId       string
+	Status         string
+	TrackingNumber *string
+	Carrier        *string
+	// CarrierDeliveredAt is set for deliveries reported by the carrier.
+	CarrierDeliveredAt *time.Time
+	Actor              store.Actor
+}
+
+// updateShipment validates and applies the update to the seller shipment of the order.
+// It returns nil response if the order has no shipment of the seller.
+func (s *Orders) updateShipment(ctx context.Context, order *oapi_codegen.OrdersGetOrderRes, update shipmentUpdate) (*oapi_codegen.OrdersUpdateShipmentRes, error) {
+	idx := slices.IndexFunc(order.Shipments, func(shipment oapi_codegen.OrdersGetOrderResShipment) bool {
+		return shipment.SellerId == update.SellerId
+	})
+	if idx == -1 {
+		return nil, nil
+	}
+	shipment := order.Shipments[idx]
+
+	if !slices.Contains(fulfillmentOrderStatuses, OrderStatus(order.Status)) {
+		return nil, fmt.Errorf(`%w: order status is "%s"`, ErrOrderNotInFulfillment, order.Status)
+	}
+	if err := validateShipmentTransition(ShipmentStatus(shipment.Status), update.Status); err != nil {
+		return nil, err
+	}
+	if err := validateShipmentTracking(update, order); err != nil {
+		return nil, err
+	}
+	if ShipmentStatus(update.Status) == ShipmentStatusCompleted {
+		open, err := s.hasOpenReturns(ctx, order.Id, update.SellerId)
+		if err != nil {
+			return nil, err
+		}
+		if open {
+			return nil, ErrShipmentHasOpenReturns
+		}
+	}
+
+	updatedAt := time.Now()
+	updateRes, err := s.store.UpdateShipment(ctx, store.UpdateShipmentDTOInput{
+		OrderId:            order.Id,
+		SellerId:           update.SellerId,
+		FromStatus:         shipment.Status,
+		Status:             update.Status,
+		TrackingNumber:     update.TrackingNumber,
+		Carrier:            update.Carrier,
+		CarrierDeliveredAt: update.CarrierDeliveredAt,
+		UpdatedAt:          updatedAt,
+		Actor:              update.Actor,
+		Reason:             OrderStatusReasonShipmentUpdated,
+		TransitionMessages: func(fromStatus, toStatus string) ([]outbox.Message, error) {
+			from, to := OrderStatus(fromStatus), OrderStatus(toStatus)
+			transition, ok := lookupOrderTransition(from, to)
+			if !ok {
+				return nil, fmt.Errorf(`%w: derived "%s" -> "%s"`, ErrOrderStateMachineIncorrentStatusTransition, from, to)
+			}
+			return orderTransitionMessages(transition, OrderTransitionContext{
+				Order:   order,
+				From:    from,
+				To:      to,
+				Actor:   update.Actor,
+				Derived: true,
+			})
+		},
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrShipmentStatusConflict) {
+			return nil, ErrShipmentConflict
+		}
+		return nil, fmt.Errorf("update shipment: %v", err)
+	}
+
+	res := &oapi_codegen.OrdersUpdateShipmentRes{
+		OrderId:        order.Id,
+		SellerId:       update.SellerId,
+		Status:         update.Status,
+		OrderStatus:    updateRes.OrderStatus,
+		TrackingNumber: shipment.TrackingNumber,
+		Carrier:        shipment.Carrier,
+		UpdatedAt:      updatedAt.Format(time.RFC3339),
+	}
+	if update.TrackingNumber != nil {
+		res.TrackingNumber, res.Carrier = update.TrackingNumber, update.Carrier
+	}
+	return res, nil
+}
+
+// ListSellerOrders lists the seller order inbox: orders with the seller shipment filtered by shipment statuses.
+func (s *Orders) ListSellerOrders(ctx context.Context, sellerId string, params oapi_codegen.OrdersListSellerOrdersParams, subjectType, subjectId string) (oapi_codegen.OrdersListSellerOrdersRes, error) {
+	switch subjectType {
+	case shared_api.SubjectTypeAdmin:
+	case shared_api.SubjectTypeSeller:
+		if sellerId != subjectId {
+			return oapi_codegen.OrdersListSellerOrdersRes{}, ErrPermissionDenied
+		}
+	default:
+		return oapi_codegen.OrdersListSellerOrdersRes{}, ErrPermissionDenied
+	}
+
+	var statuses []string
+	if params.Status != nil {
+		for _, status := range *params.Status {
+			switch ShipmentStatus(status) {
+			case ShipmentStatusCreated, ShipmentStatusProcessed, ShipmentStatusShipped, ShipmentStatusDelivered, ShipmentStatusCompleted, ShipmentStatusCancelled:
+			default:
+				return oapi_codegen.OrdersListSellerOrdersRes{}, fmt.Errorf(`%w: "%s"`, ErrShipmentInvalidStatus, status)
+			}
+			if !slices.Contains(statuses, status) {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+
+	res, err := s.store.ListSellerOrders(ctx, store.ListSellerOrdersDTOInput{
+		SellerId:      sellerId,
+		Statuses:      statuses,
+		NextPageToken: params.NextPageToken,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrInvalidListSellerOrdersNextPageToken) {
+			return oapi_codegen.OrdersListSellerOrdersRes{}, fmt.Errorf("%w: %v", ErrInvalidNextPageToken, err)
+		}
+		return oapi_codegen.OrdersListSellerOrdersRes{}, fmt.Errorf("list seller orders: %v", err)
+	}
+	return res, nil
+}
+
+// SellerOrderView scopes the order to the seller: only their items and shipment are left.
+// It returns nil if the order has no shipment of the seller.
+func SellerOrderView(order *oapi_codegen.OrdersGetOrderRes, sellerId string) *oapi_codegen.OrdersGetOrderRes {
+	view := *order
+	view.Payment = nil
+
+	view.Shipments = make([]oapi_codegen.OrdersGetOrderResShipment, 0, 1)
+	for _, shipment := range order.Shipments {
+		if shipment.SellerId == sellerId {
+			view.Shipments = append(view.Shipments, shipment)
+		}
+	}
+	if len(view.Shipments) == 0 {
+		return nil
+	}
+
+	view.Items = make([]oapi_codegen.OrdersGetOrderResItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		if item.SellerId == sellerId {
+			view.Items = append(view.Items, item)
+		}
+	}
+	return &view
+}
@@ -0,0 +1,308 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tableAddresses = "`orders/addresses`"
+)
+
+var (
+	ErrAddressesLimitReached = errors.New("addresses limit is reached")
+)
+
+var queryListAddresses = template.ReplaceAllPairs(`
+DECLARE $user_id AS Utf8;
+DECLARE $id AS Optional<Utf8>;
+
+SELECT
+  user_id,
+  id,
+  recipient_name,
+  phone,
+  country,
+  city,
+  street,
+  postal_code,
+  comment,
+  created_at,
+  updated_at,
+FROM {{table.addresses}}
+WHERE
+  user_id = $user_id
+    AND
+  ($id IS NULL OR id = $id)
+ORDER BY created_at, id;
+`,
+	"{{table.addresses}}",
+	tableAddresses,
+)
+
+func (s *Orders) ListAddresses(ctx context.Context, userId string) ([]oapi_codegen.OrdersAddress, error) {
+	return s.listAddresses(ctx, userId, nil)
+}
+
+// GetAddress returns nil if the user has no such address.
+func (s *Orders) GetAddress(ctx context.Context, userId, addressId string) (*oapi_codegen.OrdersAddress, error) {
+	addresses, err := s.listAddresses(ctx, userId, &addressId)
+	if err != nil || len(addresses) == 0 {
+		return nil, err
+	}
+	return &addresses[0], nil
+}
+
+func (s *Orders) listAddresses(ctx context.Context, userId string, addressId *string) ([]oapi_codegen.OrdersAddress, error) {
+	var out []oapi_codegen.OrdersAddress
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		res, err := tx.Query(ctx, queryListAddresses, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$user_id", types.UTF8Value(userId)),
+			table.ValueParam("$id", types.NullableUTF8Value(addressId)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		out, err = readAddresses(ctx, res)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func readAddresses(ctx context.Context, res query.Result) ([]oapi_codegen.OrdersAddress, error) {
+	rs, err := res.NextResultSet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("addresses result set: %w", err)
+	}
+
+	addresses := make([]oapi_codegen.OrdersAddress, 0)
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var address oapi_codegen.OrdersAddress
+		var createdAt, updatedAt time.Time
+		if err := row.ScanNamed(
+			query.Named("user_id", &address.UserId),
+			query.Named("id", &address.Id),
+			query.Named("recipient_name", &address.RecipientName),
+			query.Named("phone", &address.Phone),
+			query.Named("country", &address.Country),
+			query.Named("city", &address.City),
+			query.Named("street", &address.Street),
+			query.Named("postal_code", &address.PostalCode),
+			query.Named("comment", &address.Comment),
+			query.Named("created_at", &createdAt),
+			query.Named("updated_at", &updatedAt),
+		); err != nil {
+			return nil, err
+		}
+		address.CreatedAt = createdAt.Format(time.RFC3339)
+		address.UpdatedAt = updatedAt.Format(time.RFC3339)
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+var queryCountAddresses = template.ReplaceAllPairs(`
+DECLARE $user_id AS Utf8;
+
+SELECT COUNT(*) AS count
+FROM {{table.addresses}}
+WHERE user_id = $user_id;
+`,
+	"{{table.addresses}}",
+	tableAddresses,
+)
+
+var queryCreateAddress = template.ReplaceAllPairs(`
+DECLARE $user_id AS Utf8;
+DECLARE $id AS Utf8;
+DECLARE $recipient_name AS Utf8;
+DECLARE $phone AS Utf8;
+DECLARE $country AS Utf8;
+DECLARE $city AS Utf8;
+DECLARE $street AS Utf8;
+DECLARE $postal_code AS Utf8;
+DECLARE $comment AS Optional<Utf8>;
+DECLARE $created_at AS Timestamp;
+DECLARE $updated_at AS Timestamp;
+
+INSERT INTO {{table.addresses}} (user_id, id, recipient_name, phone, country, city, street, postal_code, comment, created_at, updated_at)
+VALUES ($user_id, $id, $recipient_name, $phone, $country, $city, $street, $postal_code, $comment, $created_at, $updated_at);
+`,
+	"{{table.addresses}}",
+	tableAddresses,
+)
+
+type CreateAddressDTOInput struct {
+	Id      string
+	UserId  string
+	Address oapi_codegen.OrdersAddressReq
+	// Limit is the max count of addresses of the user.
+	Limit     int
+	CreatedAt time.Time
+}
+
+func (s *Orders) CreateAddress(ctx context.Context, in CreateAddressDTOInput) error {
+	var validationErr error
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		validationErr = nil
+
+		res, err := tx.Query(ctx, queryCountAddresses, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$user_id", types.UTF8Value(in.UserId)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		row, err := nextResultSetRow(ctx, res)
+		if err != nil {
+			return err
+		}
+		var count uint64
+		if err := row.ScanNamed(query.Named("count", &count)); err != nil {
+			return err
+		}
+		if count >= uint64(in.Limit) {
+			validationErr = ErrAddressesLimitReached
+			return nil
+		}
+
+		return tx.Exec(ctx, queryCreateAddress, query.WithParameters(table.NewQueryParameters(append(
+			addressParams(in.UserId, in.Id, in.Address, in.CreatedAt),
+			table.ValueParam("$created_at", types.TimestampValueFromTime(in.CreatedAt)),
+		)...)))
+	}); err != nil {
+		return err
+	}
+
+	return validationErr
+}
+
+var queryUpdateAddress = template.ReplaceAllPairs(`
+DECLARE $user_id AS Utf8;
+DECLARE $id AS Utf8;
+DECLARE $recipient_name AS Utf8;
+DECLARE $phone AS Utf8;
+DECLARE $country AS Utf8;
+DECLARE $city AS Utf8;
+DECLARE $street AS Utf8;
+DECLARE $postal_code AS Utf8;
+DECLARE $comment AS Optional<Utf8>;
+DECLARE $updated_at AS Timestamp;
+
+UPDATE {{table.addresses}}
+SET
+  recipient_name = $recipient_name,
+  phone = $phone,
+  country = $country,
+  city = $city,
+  street = $street,
+  postal_code = $postal_code,
+  comment = $comment,
+  updated_at = $updated_at
+WHERE
+  user_id = $user_id
+    AND
+  id = $id;
+`,
+	"{{table.addresses}}",
+	tableAddresses,
+)
+
+type UpdateAddressDTOInput struct {
+	Id        string
+	UserId    string
+	Address   oapi_codegen.OrdersAddressReq
+	UpdatedAt time.Time
+}
+
+// UpdateAddress replaces the address, it reports whether the address exists.
+func (s *Orders) UpdateAddress(ctx context.Context, in UpdateAddressDTOInput) (bool, error) {
+	var found bool
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		res, err := tx.Query(ctx, queryListAddresses, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$user_id", types.UTF8Value(in.UserId)),
+			table.ValueParam("$id", types.NullableUTF8Value(&in.Id)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		addresses, err := readAddresses(ctx, res)
+		if err != nil {
+			return err
+		}
+		found = len(addresses) != 0
+		if !found {
+			return nil
+		}
+
+		return tx.Exec(ctx, queryUpdateAddress, query.WithParameters(table.NewQueryParameters(addressParams(in.UserId, in.Id, in.Address, in.UpdatedAt)...)))
+	}); err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+func addressParams(userId, id string, address oapi_codegen.OrdersAddressReq, updatedAt time.Time) []table.ParameterOption {
+	return []table.ParameterOption{
+		table.ValueParam("$user_id", types.UTF8Value(userId)),
+		table.ValueParam("$id", types.UTF8Value(id)),
+		table.ValueParam("$recipient_name", types.UTF8Value(address.RecipientName)),
+		table.ValueParam("$phone", types.UTF8Value(address.Phone)),
+		table.ValueParam("$country", types.UTF8Value(address.Country)),
+		table.ValueParam("$city", types.UTF8Value(address.City)),
+		table.ValueParam("$street", types.UTF8Value(address.Street)),
+		table.ValueParam("$postal_code", types.UTF8Value(address.PostalCode)),
+		table.ValueParam("$comment", types.NullableUTF8Value(address.Comment)),
+		table.ValueParam("$updated_at", types.TimestampValueFromTime(updatedAt)),
+	}
+}
+
+var queryDeleteAddress = template.ReplaceAllPairs(`
+DECLARE $user_id AS Utf8;
+DECLARE $id AS Utf8;
+
+DELETE FROM {{table.addresses}}
+WHERE user_id = $user_id AND id = $id;
+`,
+	"{{table.addresses}}",
+	tableAddresses,
+)
+
+// DeleteAddress deletes the address, orders keep snapshots of their addresses.
+func (s *Orders) DeleteAddress(ctx context.Context, userId, addressId string) error {
+	return s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		return tx.Exec(ctx, queryDeleteAddress, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$user_id", types.UTF8Value(userId)),
+			table.ValueParam("$id", types.UTF8Value(addressId)),
+		)))
+	})
+}
@@ -0,0 +1,430 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/bratushkadan/floral/internal/orders/promo"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tableCoupons           = "`orders/coupons`"
+	tableCouponRedemptions = "`orders/coupon_redemptions`"
+)
+
+// Redemptions of orders cancelled are released.
+const orderStatusCancelled = "cancelled"
+
+var (
+	ErrCouponExists = errors.New("coupon already exists")
+)
+
+// Coupon is the coupon along with its usage.
+type Coupon struct {
+	promo.Coupon
+	// Uses is the count of unreleased redemptions.
+	Uses      uint64
+	CreatedAt time.Time
+}
+
+const couponColumns = `
+  code,
+  owner_type,
+  owner_id,
+  discount_type,
+  discount_value,
+  min_subtotal,
+  max_uses,
+  max_uses_per_user,
+  starts_at,
+  expires_at,
+  product_ids,
+  seller_ids,
+  uses,
+  created_at,`
+
+var queryGetCoupon = template.ReplaceAllPairs(`
+DECLARE $code AS Utf8;
+
+SELECT {{coupon_columns}}
+FROM {{table.coupons}}
+WHERE code = $code;
+`,
+	"{{table.coupons}}",
+	tableCoupons,
+	"{{coupon_columns}}",
+	couponColumns,
+)
+
+// GetCoupon returns nil if there's no such coupon.
+func (s *Orders) GetCoupon(ctx context.Context, code string) (*Coupon, error) {
+	var out *Coupon
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		coupons, err := getCouponsTx(ctx, tx, queryGetCoupon, table.NewQueryParameters(
+			table.ValueParam("$code", types.UTF8Value(code)),
+		))
+		if err != nil {
+			return err
+		}
+		out = nil
+		if len(coupons) != 0 {
+			out = &coupons[0]
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+var queryListCoupons = template.ReplaceAllPairs(`
+SELECT {{coupon_columns}}
+FROM {{table.coupons}}
+ORDER BY code
+LIMIT 1000;
+`,
+	"{{table.coupons}}",
+	tableCoupons,
+	"{{coupon_columns}}",
+	couponColumns,
+)
+
+// Coupons of the owner come from the async index: a coupon may be listed a moment after it's created.
+var queryListOwnerCoupons = template.ReplaceAllPairs(`
+DECLARE $owner_type AS Utf8;
+DECLARE $owner_id AS Utf8;
+
+$codes = (
+  SELECT code
+  FROM {{table.coupons}} VIEW idx_owner
+  WHERE owner_type = $owner_type AND owner_id = $owner_id
+);
+
+SELECT {{coupon_columns}}
+FROM {{table.coupons}}
+WHERE code IN $codes
+ORDER BY code;
+`,
+	"{{table.coupons}}",
+	tableCoupons,
+	"{{coupon_columns}}",
+	couponColumns,
+)
+
+type ListCouponsDTOInput struct {
+	// Coupons of all owners are listed unless the owner is set.
+	OwnerType *string
+	OwnerId   *string
+}
+
+func (s *Orders) ListCoupons(ctx context.Context, in ListCouponsDTOInput) ([]Coupon, error) {
+	q, params := queryListCoupons, table.NewQueryParameters()
+	if in.OwnerType != nil && in.OwnerId != nil {
+		q, params = queryListOwnerCoupons, table.NewQueryParameters(
+			table.ValueParam("$owner_type", types.UTF8Value(*in.OwnerType)),
+			table.ValueParam("$owner_id", types.UTF8Value(*in.OwnerId)),
+		)
+	}
+
+	var out []Coupon
+	if err := s.db.Query().Do(ctx, func(ctx context.Context, ss query.Session) error {
+		res, err := ss.Query(ctx, q, query.WithParameters(params), query.WithTxControl(query.OnlineReadOnlyTxControl()))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		out, err = readCoupons(ctx, res)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func getCouponsTx(ctx context.Context, tx query.TxActor, q string, params *table.QueryParameters) ([]Coupon, error) {
+	res, err := tx.Query(ctx, q, query.WithParameters(params))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close(ctx) }()
+
+	return readCoupons(ctx, res)
+}
+
+func readCoupons(ctx context.Context, res query.Result) ([]Coupon, error) {
+	rs, err := res.NextResultSet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("coupons result set: %w", err)
+	}
+
+	coupons := make([]Coupon, 0)
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var coupon Coupon
+		var discountType string
+		var productIdsJsonData, sellerIdsJsonData *[]byte
+		if err := row.ScanNamed(
+			query.Named("code", &coupon.Code),
+			query.Named("owner_type", &coupon.OwnerType),
+			query.Named("owner_id", &coupon.OwnerId),
+			query.Named("discount_type", &discountType),
+			query.Named("discount_value", &coupon.DiscountValue),
+			query.Named("min_subtotal", &coupon.MinSubtotal),
+			query.Named("max_uses", &coupon.MaxUses),
+			query.Named("max_uses_per_user", &coupon.MaxUsesPerUser),
+			query.Named("starts_at", &coupon.StartsAt),
+			query.Named("expires_at", &coupon.ExpiresAt),
+			query.Named("product_ids", &productIdsJsonData),
+			query.Named("seller_ids", &sellerIdsJsonData),
+			query.Named("uses", &coupon.Uses),
+			query.Named("created_at", &coupon.CreatedAt),
+		); err != nil {
+			return nil, err
+		}
+		coupon.DiscountType = promo.DiscountType(discountType)
+		if productIdsJsonData != nil {
+			if err := json.Unmarshal(*productIdsJsonData, &coupon.ProductIds); err != nil {
+				return nil, fmt.Errorf("deserialize coupon product ids from database: %v", err)
+			}
+		}
+		if sellerIdsJsonData != nil {
+			if err := json.Unmarshal(*sellerIdsJsonData, &coupon.SellerIds); err != nil {
+				return nil, fmt.Errorf("deserialize coupon seller ids from database: %v", err)
+			}
+		}
+		coupons = append(coupons, coupon)
+	}
+	return coupons, nil
+}
+
+var queryCreateCoupon = template.ReplaceAllPairs(`
+DECLARE $code AS Utf8;
+DECLARE $owner_type AS Utf8;
+DECLARE $owner_id AS Utf8;
+DECLARE $discount_type AS Utf8;
+DECLARE $discount_value AS Int64;
+DECLARE $min_subtotal AS Int64;
+DECLARE $max_uses AS Uint64;
+DECLARE $max_uses_per_user AS Uint64;
+DECLARE $starts_at AS Optional<Timestamp>;
+DECLARE $expires_at AS Optional<Timestamp>;
+DECLARE $product_ids AS Optional<Json>;
+DECLARE $seller_ids AS Optional<Json>;
+DECLARE $created_at AS Timestamp;
+
+INSERT INTO {{table.coupons}} (code, owner_type, owner_id, discount_type, discount_value, min_subtotal, max_uses, max_uses_per_user, starts_at, expires_at, product_ids, seller_ids, uses, created_at)
+VALUES ($code, $owner_type, $owner_id, $discount_type, $discount_value, $min_subtotal, $max_uses, $max_uses_per_user, $starts_at, $expires_at, $product_ids, $seller_ids, 0ul, $created_at);
+`,
+	"{{table.coupons}}",
+	tableCoupons,
+)
+
+func (s *Orders) CreateCoupon(ctx context.Context, coupon promo.Coupon, createdAt time.Time) error {
+	productIds, err := nullableJsonValue(coupon.ProductIds)
+	if err != nil {
+		return fmt.Errorf("serialize coupon product ids: %v", err)
+	}
+	sellerIds, err := nullableJsonValue(coupon.SellerIds)
+	if err != nil {
+		return fmt.Errorf("serialize coupon seller ids: %v", err)
+	}
+
+	var validationErr error
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		validationErr = nil
+
+		existing, err := getCouponsTx(ctx, tx, queryGetCoupon, table.NewQueryParameters(
+			table.ValueParam("$code", types.UTF8Value(coupon.Code)),
+		))
+		if err != nil {
+			return err
+		}
+		if len(existing) != 0 {
+			validationErr = ErrCouponExists
+			return nil
+		}
+
+		return tx.Exec(ctx, queryCreateCoupon, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$code", types.UTF8Value(coupon.Code)),
+			table.ValueParam("$owner_type", types.UTF8Value(coupon.OwnerType)),
+			table.ValueParam("$owner_id", types.UTF8Value(coupon.OwnerId)),
+			table.ValueParam("$discount_type", types.UTF8Value(string(coupon.DiscountType))),
+			table.ValueParam("$discount_value", types.Int64Value(coupon.DiscountValue)),
+			table.ValueParam("$min_subtotal", types.Int64Value(coupon.MinSubtotal)),
+			table.ValueParam("$max_uses", types.Uint64Value(coupon.MaxUses)),
+			table.ValueParam("$max_uses_per_user", types.Uint64Value(coupon.MaxUsesPerUser)),
+			table.ValueParam("$starts_at", types.NullableTimestampValueFromTime(coupon.StartsAt)),
+			table.ValueParam("$expires_at", types.NullableTimestampValueFromTime(coupon.ExpiresAt)),
+			table.ValueParam("$product_ids", productIds),
+			table.ValueParam("$seller_ids", sellerIds),
+			table.ValueParam("$created_at", types.TimestampValueFromTime(createdAt)),
+		)))
+	}); err != nil {
+		return err
+	}
+
+	return validationErr
+}
+
+func nullableJsonValue(ids []string) (types.Value, error) {
+	if len(ids) == 0 {
+		return types.NullValue(types.TypeJSON), nil
+	}
+	b, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+	return types.OptionalValue(types.JSONValueFromBytes(b)), nil
+}
+
+var queryDeleteCoupon = template.ReplaceAllPairs(`
+DECLARE $code AS Utf8;
+
+DELETE FROM {{table.coupons}}
+WHERE code = $code;
+`,
+	"{{table.coupons}}",
+	tableCoupons,
+)
+
+// DeleteCoupon deletes the coupon, redemptions of placed orders are kept.
+func (s *Orders) DeleteCoupon(ctx context.Context, code string) error {
+	return s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		return tx.Exec(ctx, queryDeleteCoupon, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$code", types.UTF8Value(code)),
+		)))
+	})
+}
+
+var queryListCouponsUsage = template.ReplaceAllPairs(`
+DECLARE $redeemers AS List<Struct<code:Utf8, user_id:Utf8>>;
+
+SELECT {{coupon_columns}}
+FROM {{table.coupons}}
+WHERE code IN (SELECT DISTINCT code FROM AS_TABLE($redeemers));
+
+SELECT
+  r.code AS code,
+  r.user_id AS user_id,
+  COUNT(*) AS uses,
+FROM {{table.coupon_redemptions}} r
+JOIN AS_TABLE($redeemers) u ON u.code = r.code AND u.user_id = r.user_id
+WHERE r.released_at IS NULL
+GROUP BY r.code, r.user_id;
+`,
+	"{{table.coupons}}",
+	tableCoupons,
+	"{{table.coupon_redemptions}}",
+	tableCouponRedemptions,
+	"{{coupon_columns}}",
+	couponColumns,
+)
+
+// couponRedeemer is the user redeeming the coupon.
+type couponRedeemer struct {
+	Code   string
+	UserId string
+}
+
+// listCouponsUsageTx returns coupons by codes and counts of their unreleased redemptions by the users.
+func listCouponsUsageTx(ctx context.Context, tx query.TxActor, redeemers []couponRedeemer) (map[string]Coupon, map[couponRedeemer]uint64, error) {
+	coupons := make(map[string]Coupon)
+	userUses := make(map[couponRedeemer]uint64)
+	if len(redeemers) == 0 {
+		return coupons, userUses, nil
+	}
+
+	values := make([]types.Value, 0, len(redeemers))
+	for _, r := range redeemers {
+		values = append(values, types.StructValue(
+			types.StructFieldValue("code", types.UTF8Value(r.Code)),
+			types.StructFieldValue("user_id", types.UTF8Value(r.UserId)),
+		))
+	}
+
+	res, err := tx.Query(ctx, queryListCouponsUsage, query.WithParameters(table.NewQueryParameters(
+		table.ValueParam("$redeemers", types.ListValue(values...)),
+	)))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = res.Close(ctx) }()
+
+	list, err := readCoupons(ctx, res)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, coupon := range list {
+		coupons[coupon.Code] = coupon
+	}
+
+	rs, err := res.NextResultSet(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("coupon redemptions result set: %w", err)
+	}
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		var r couponRedeemer
+		var uses uint64
+		if err := row.ScanNamed(
+			query.Named("code", &r.Code),
+			query.Named("user_id", &r.UserId),
+			query.Named("uses", &uses),
+		); err != nil {
+			return nil, nil, err
+		}
+		userUses[r] = uses
+	}
+	return coupons, userUses, nil
+}
+
+// GetCouponUsage returns the coupon along with the count of its unreleased redemptions by the user,
+// nil coupon if there's no such coupon.
+func (s *Orders) GetCouponUsage(ctx context.Context, code, userId string) (*Coupon, uint64, error) {
+	var out *Coupon
+	var userUses uint64
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		redeemer := couponRedeemer{Code: code, UserId: userId}
+		coupons, uses, err := listCouponsUsageTx(ctx, tx, []couponRedeemer{redeemer})
+		if err != nil {
+			return err
+		}
+		out = nil
+		if coupon, ok := coupons[code]; ok {
+			out = &coupon
+		}
+		userUses = uses[redeemer]
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+
+	return out, userUses, nil
+}
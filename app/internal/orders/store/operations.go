@@ -8,7 +8,7 @@ import (
 
 	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
 	"github.com/bratushkadan/floral/pkg/template"
-	ydbtopic "github.com/bratushkadan/floral/pkg/ydb/topic"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -20,22 +20,6 @@ const (
 	topicCancelOperations = "orders/cancel_operations_topic"
 )
 
-func (s *Orders) ProduceCancelOperationMessages(ctx context.Context, messages ...oapi_codegen.PrivateOrderCancelOperationsReqMessage) error {
-	dataBytes := make([][]byte, 0, len(messages))
-	for _, message := range messages {
-		msgBytes, err := json.Marshal(message)
-		if err != nil {
-			return fmt.Errorf("serialize cancel operation message: %v", err)
-		}
-		dataBytes = append(dataBytes, msgBytes)
-	}
-
-	if err := ydbtopic.Produce(ctx, s.topicCancelOperations, dataBytes...); err != nil {
-		return fmt.Errorf("publish messages cancel operations: %v", err)
-	}
-	return nil
-}
-
 var queryGetOperation = template.ReplaceAllPairs(`
 DECLARE $id AS Utf8;
 
@@ -97,12 +81,13 @@ DECLARE $status AS Utf8;
 DECLARE $details AS Optional<Utf8>;
 DECLARE $user_id AS Utf8;
 DECLARE $order_id AS Optional<Utf8>;
+DECLARE $delivery AS Optional<Json>;
 DECLARE $created_at AS Timestamp;
 DECLARE $updated_at AS Timestamp;
 
-INSERT INTO {{table.operations}} (id, type, status, details, user_id, order_id, created_at, updated_at)
+INSERT INTO {{table.operations}} (id, type, status, details, user_id, order_id, delivery, created_at, updated_at)
 VALUES
-($id, $type, $status, $details, $user_id, $order_id, $created_at, $updated_at)
+($id, $type, $status, $details, $user_id, $order_id, $delivery, $created_at, $updated_at)
 RETURNING id, type, status, user_id, order_id, created_at, updated_at;
 `,
 	"{{table.operations}}",
@@ -116,12 +101,24 @@ type CreateOperationDTOInput struct {
 	Details   *string
 	UserId    string
 	OrderId   *string
+	Delivery  *oapi_codegen.OrdersDelivery
 	CreatedAt time.Time
+	// Messages are published if the operation is created.
+	Messages []outbox.Message
 }
 
 func (s *Orders) CreateOperation(ctx context.Context, in CreateOperationDTOInput) (oapi_codegen.OrdersCreateOrderResOperation, error) {
 	var out oapi_codegen.OrdersCreateOrderResOperation
 
+	delivery := types.NullValue(types.TypeJSON)
+	if in.Delivery != nil {
+		deliveryJsonData, err := json.Marshal(in.Delivery)
+		if err != nil {
+			return out, fmt.Errorf("serialize operation delivery: %v", err)
+		}
+		delivery = types.OptionalValue(types.JSONValueFromBytes(deliveryJsonData))
+	}
+
 	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
 		res, err := tx.Execute(ctx, queryCreateOperation, table.NewQueryParameters(
 			table.ValueParam("$id", types.UTF8Value(in.Id)),
@@ -130,6 +127,7 @@ func (s *Orders) CreateOperation(ctx context.Context, in CreateOperationDTOInput
 			table.ValueParam("$details", types.NullableUTF8Value(in.Details)),
 			table.ValueParam("$user_id", types.UTF8Value(in.UserId)),
 			table.ValueParam("$order_id", types.NullableUTF8Value(in.OrderId)),
+			table.ValueParam("$delivery", delivery),
 			table.ValueParam("$created_at", types.TimestampValueFromTime(in.CreatedAt)),
 			table.ValueParam("$updated_at", types.TimestampValueFromTime(in.CreatedAt)),
 		))
@@ -156,8 +154,11 @@ func (s *Orders) CreateOperation(ctx context.Context, in CreateOperationDTOInput
 				out.UpdatedAt = updatedAt.Format(time.RFC3339)
 			}
 		}
+		if err := res.Err(); err != nil {
+			return err
+		}
 
-		return res.Err()
+		return s.outbox.EnqueueTableTx(ctx, tx, in.Messages...)
 	}); err != nil {
 		return oapi_codegen.OrdersCreateOrderResOperation{}, err
 	}
@@ -165,21 +166,6 @@ func (s *Orders) CreateOperation(ctx context.Context, in CreateOperationDTOInput
 	return out, nil
 }
 
-func (s *Orders) ProducePublishCartContentsRequest(ctx context.Context, operationId, userId string) error {
-	msgBytes, err := json.Marshal(&oapi_codegen.PrivatePublishCartPositionsReqMessage{
-		OperationId: operationId,
-		UserId:      userId,
-	})
-	if err != nil {
-		return fmt.Errorf("serialize publish carts contents message: %v", err)
-	}
-	if err := ydbtopic.Produce(ctx, s.topicCartPublishRequests, msgBytes); err != nil {
-		return fmt.Errorf("publish message request publish cart contents: %v", err)
-	}
-
-	return nil
-}
-
 var queryUpdateOperation = template.ReplaceAllPairs(`
 DECLARE $id AS Utf8;
 DECLARE $status AS Utf8;
@@ -295,6 +281,7 @@ func (s *Orders) UpdateOperation(ctx context.Context, in UpdateOperationDTOInput
 var queryUpdateOperationMany = template.ReplaceAllPairs(`
 DECLARE $operations AS List<Struct<
   id:Utf8,
+  from_status:Utf8,
   status:Utf8,
   details:Optional<Utf8>,
   order_id:Optional<Utf8>,
@@ -310,6 +297,7 @@ $to_update = (
         u.updated_at AS updated_at,
     FROM AS_TABLE($operations) u
     JOIN {{table.operations}} o ON o.id = u.id
+    WHERE o.status = u.from_status
 );
 
 UPDATE {{table.operations}} ON
@@ -324,11 +312,13 @@ type UpdateOperationManyDTOInput struct {
 	Operations []UpdateOperationManyDTOInputOperation
 }
 type UpdateOperationManyDTOInputOperation struct {
-	Id        string
-	Status    string
-	Details   *string
-	OrderId   *string
-	UpdatedAt time.Time
+	Id string
+	// FromStatus is the status the operation is expected to be in, operations in other statuses are left as is.
+	FromStatus string
+	Status     string
+	Details    *string
+	OrderId    *string
+	UpdatedAt  time.Time
 }
 type UpdateOperationManyDTOOutput struct {
 	OperationsUpdates []UpdateOperationManyDTOOutputOperationUpdate
@@ -351,6 +341,7 @@ func (s *Orders) UpdateOperationMany(ctx context.Context, in UpdateOperationMany
 	for _, op := range in.Operations {
 		operations = append(operations, types.StructValue(
 			types.StructFieldValue("id", types.UTF8Value(op.Id)),
+			types.StructFieldValue("from_status", types.UTF8Value(op.FromStatus)),
 			types.StructFieldValue("status", types.UTF8Value(op.Status)),
 			types.StructFieldValue("details", types.NullableUTF8Value(op.Details)),
 			types.StructFieldValue("order_id", types.NullableUTF8Value(op.OrderId)),
@@ -391,3 +382,58 @@ func (s *Orders) UpdateOperationMany(ctx context.Context, in UpdateOperationMany
 
 	return out, nil
 }
+
+var querySetOperationsPromoCodes = template.ReplaceAllPairs(`
+DECLARE $operations AS List<Struct<
+  id:Utf8,
+  promo_code:Utf8,
+>>;
+DECLARE $status AS Utf8;
+
+$to_update = (
+    SELECT
+        u.id AS id,
+        u.promo_code AS promo_code,
+    FROM AS_TABLE($operations) u
+    JOIN {{table.operations}} o ON o.id = u.id
+    WHERE o.status = $status
+);
+
+UPDATE {{table.operations}} ON
+SELECT * FROM $to_update;
+`,
+	"{{table.operations}}",
+	tableOperations,
+)
+
+type SetOperationsPromoCodesDTOInput struct {
+	// PromoCodes are promo codes applied to the carts by ids of operations ordering them.
+	PromoCodes map[string]string
+	// Status is the status the operations are expected to be in, operations in other statuses are left as is.
+	Status string
+	// Messages are published along with setting the promo codes.
+	Messages []outbox.Message
+}
+
+// SetOperationsPromoCodes records promo codes the orders of the operations are to be discounted with.
+func (s *Orders) SetOperationsPromoCodes(ctx context.Context, in SetOperationsPromoCodesDTOInput) error {
+	operations := make([]types.Value, 0, len(in.PromoCodes))
+	for id, code := range in.PromoCodes {
+		operations = append(operations, types.StructValue(
+			types.StructFieldValue("id", types.UTF8Value(id)),
+			types.StructFieldValue("promo_code", types.UTF8Value(code)),
+		))
+	}
+
+	return s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		if len(operations) > 0 {
+			if _, err := tx.Execute(ctx, querySetOperationsPromoCodes, table.NewQueryParameters(
+				table.ValueParam("$operations", types.ListValue(operations...)),
+				table.ValueParam("$status", types.UTF8Value(in.Status)),
+			)); err != nil {
+				return err
+			}
+		}
+		return s.outbox.EnqueueTableTx(ctx, tx, in.Messages...)
+	})
+}
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+const (
+	topicOrderEvents = "orders/order_events_topic"
+
+	OrderEventSchemaVersion = 1
+)
+
+// Order statuses that emit order events.
+var orderEventTypes = map[string]oapi_codegen.OrderEventMessageType{
+	"created":   oapi_codegen.OrderCreated,
+	"paid":      oapi_codegen.OrderPaid,
+	"cancelled": oapi_codegen.OrderCancelled,
+	"shipped":   oapi_codegen.OrderShipped,
+	"delivered": oapi_codegen.OrderDelivered,
+	"completed": oapi_codegen.OrderCompleted,
+}
+
+// readOrderEvents reads order transitions and items of the transitioned orders from the next two result sets
+// and builds events of the transitions that emit them. Ids of all transitioned orders are returned as well.
+// Event id is the id of the transition in the order status history.
+func readOrderEvents(ctx context.Context, res query.Result) ([]oapi_codegen.OrderEventMessage, map[string]bool, error) {
+	rs, err := res.NextResultSet(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("order transitions result set: %w", err)
+	}
+
+	var events []oapi_codegen.OrderEventMessage
+	eventIdxs := make(map[string][]int)
+	transitioned := make(map[string]bool)
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var event oapi_codegen.OrderEventMessage
+		if err := row.ScanNamed(
+			query.Named("order_id", &event.OrderId),
+			query.Named("user_id", &event.UserId),
+			query.Named("created_at", &event.OccurredAt),
+			query.Named("id", &event.Id),
+			query.Named("from_status", &event.PreviousStatus),
+			query.Named("status", &event.Status),
+			query.Named("actor_type", &event.Actor.Type),
+			query.Named("actor_id", &event.Actor.Id),
+			query.Named("reason", &event.Reason),
+		); err != nil {
+			return nil, nil, err
+		}
+		transitioned[event.OrderId] = true
+
+		eventType, ok := orderEventTypes[event.Status]
+		if !ok {
+			continue
+		}
+		event.Type = eventType
+		event.SchemaVersion = OrderEventSchemaVersion
+		event.Items = make([]oapi_codegen.OrderEventItem, 0)
+		eventIdxs[event.OrderId] = append(eventIdxs[event.OrderId], len(events))
+		events = append(events, event)
+	}
+
+	rs, err = res.NextResultSet(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("order items result set: %w", err)
+	}
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var orderId string
+		var count uint32
+		var item oapi_codegen.OrderEventItem
+		if err := row.ScanNamed(
+			query.Named("order_id", &orderId),
+			query.Named("product_id", &item.ProductId),
+			query.Named("seller_id", &item.SellerId),
+			query.Named("count", &count),
+		); err != nil {
+			return nil, nil, err
+		}
+		item.Count = int(count)
+
+		for _, idx := range eventIdxs[orderId] {
+			events[idx].Items = append(events[idx].Items, item)
+		}
+	}
+
+	return events, transitioned, nil
+}
+
+// nextResultSetRow reads the single row of the next result set.
+func nextResultSetRow(ctx context.Context, res query.Result) (query.Row, error) {
+	rs, err := res.NextResultSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rs.NextRow(ctx)
+}
+
+// enqueueOrderEventsTx writes order events along with other messages of the transition to the outbox within the transaction.
+func (s *Orders) enqueueOrderEventsTx(ctx context.Context, tx query.TxActor, events []oapi_codegen.OrderEventMessage, msgs ...outbox.Message) error {
+	eventMsgs, err := newOrderEventMessages(events...)
+	if err != nil {
+		return fmt.Errorf("serialize order events: %v", err)
+	}
+	if err := s.outbox.EnqueueTx(ctx, tx, append(eventMsgs, msgs...)...); err != nil {
+		return fmt.Errorf("enqueue order events: %v", err)
+	}
+	return nil
+}
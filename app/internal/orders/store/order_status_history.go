@@ -0,0 +1,15 @@
+package store
+
+const (
+	tableOrderStatusHistory = "`orders/order_status_history`"
+)
+
+const (
+	OrderStatusReasonCreated = "order created"
+)
+
+// Actor is the subject whose request caused an order status transition.
+type Actor struct {
+	Type string
+	Id   string
+}
@@ -3,14 +3,21 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"slices"
+	"strings"
 	"time"
 
 	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/internal/orders/promo"
+	shared_api "github.com/bratushkadan/floral/pkg/shared/api"
 	"github.com/bratushkadan/floral/pkg/template"
 	"github.com/bratushkadan/floral/pkg/token"
-	ydbtopic "github.com/bratushkadan/floral/pkg/ydb/topic"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -21,12 +28,16 @@ const (
 	tableProducts   = "`products/products`"
 	tableOrders     = "`orders/orders`"
 	tableOrderItems = "`orders/order_items`"
+	tableShipments  = "`orders/shipments`"
 
 	topicProductsReservations   = "products/products_reservartions_topic"
 	topicProductsUnreservations = "products/products_unreservations_topic"
+	topicProductsSales          = "products/products_sales_topic"
 
 	topicCartPublishRequests = "cart/cart_contents_publish_requests_topic"
 	topicCartClearRequests   = "cart/cart_clear_requests_topic"
+
+	topicCompletedOrders = "orders/completed_orders_topic"
 )
 
 const (
@@ -35,55 +46,9 @@ const (
 
 var (
 	ErrInvalidListOrdersNextPageToken = "invalid list orders next page token"
-)
-
-func (s *Orders) ProduceProductsReservationMessages(ctx context.Context, messages ...oapi_codegen.PrivateReserveProductsReqMessage) error {
-	dataBytes := make([][]byte, 0, len(messages))
-	for _, message := range messages {
-		msgBytes, err := json.Marshal(message)
-		if err != nil {
-			return fmt.Errorf("serialize products reservation message: %v", err)
-		}
-		dataBytes = append(dataBytes, msgBytes)
-	}
-
-	if err := ydbtopic.Produce(ctx, s.topicProductsReservations, dataBytes...); err != nil {
-		return fmt.Errorf("publish message products reservation: %v", err)
-	}
-	return nil
-}
-
-func (s *Orders) ProduceProductsUnreservationMessages(ctx context.Context, messages ...oapi_codegen.PrivateUnreserveProductsReqMessage) error {
-	dataBytes := make([][]byte, 0, len(messages))
-	for _, message := range messages {
-		msgBytes, err := json.Marshal(message)
-		if err != nil {
-			return fmt.Errorf("serialize products unreservation message: %v", err)
-		}
-		dataBytes = append(dataBytes, msgBytes)
-	}
-
-	if err := ydbtopic.Produce(ctx, s.topicProductsUnreservations, dataBytes...); err != nil {
-		return fmt.Errorf("publish message products unreservation: %v", err)
-	}
-	return nil
-}
-
-func (s *Orders) ProduceCartClearMessages(ctx context.Context, messages ...oapi_codegen.PrivateClearCartPositionsReqMessage) error {
-	dataBytes := make([][]byte, 0, len(messages))
-	for _, message := range messages {
-		msgBytes, err := json.Marshal(message)
-		if err != nil {
-			return fmt.Errorf("serialize publish carts contents message: %v", err)
-		}
-		dataBytes = append(dataBytes, msgBytes)
-	}
 
-	if err := ydbtopic.Produce(ctx, s.topicCartClearRequests, dataBytes...); err != nil {
-		return fmt.Errorf("publish message request publish cart contents: %v", err)
-	}
-	return nil
-}
+	ErrOrderStatusConflict = errors.New("order status was changed concurrently")
+)
 
 var queryGetOrder = template.ReplaceAllPairs(`
 DECLARE $id AS Utf8;
@@ -92,9 +57,18 @@ SELECT
     o.id AS id,
     o.user_id AS user_id,
     o.status AS status,
+    o.delivery AS delivery,
+    o.promo_code AS promo_code,
+    o.currency_iso_4217 AS currency_iso_4217,
+    o.subtotal AS subtotal,
+    o.discount AS discount,
+    o.shipping_fee AS shipping_fee,
+    o.vat AS vat,
+    o.total AS total,
     o.created_at AS created_at,
     o.updated_at AS updated_at,
     i.product_id AS product_id,
+    i.sku_id AS product_sku_id,
     i.name AS product_name,
     i.seller_id AS product_seller_id,
     i.count AS product_count,
@@ -103,11 +77,36 @@ SELECT
 FROM {{table.orders}} o
 JOIN {{table.order_items}} i ON i.order_id = o.id
 WHERE o.id = $id;
+
+SELECT
+    seller_id,
+    status,
+    tracking_number,
+    carrier,
+    updated_at
+FROM {{table.shipments}}
+WHERE order_id = $id
+ORDER BY seller_id;
+
+SELECT
+    from_status,
+    status,
+    actor_type,
+    actor_id,
+    reason,
+    created_at
+FROM {{table.order_status_history}}
+WHERE order_id = $id
+ORDER BY created_at, id;
 `,
 	"{{table.orders}}",
 	tableOrders,
 	"{{table.order_items}}",
 	tableOrderItems,
+	"{{table.shipments}}",
+	tableShipments,
+	"{{table.order_status_history}}",
+	tableOrderStatusHistory,
 )
 
 func (s *Orders) GetOrder(ctx context.Context, orderId string) (*oapi_codegen.OrdersGetOrderRes, error) {
@@ -122,22 +121,38 @@ func (s *Orders) GetOrder(ctx context.Context, orderId string) (*oapi_codegen.Or
 		}
 		defer func() { _ = res.Close() }()
 
-		for res.NextResultSet(ctx) {
+		if res.NextResultSet(ctx) {
 			for res.NextRow() {
 				if out == nil {
-					out = &oapi_codegen.OrdersGetOrderRes{}
+					out = &oapi_codegen.OrdersGetOrderRes{
+						Shipments:     make([]oapi_codegen.OrdersGetOrderResShipment, 0),
+						StatusHistory: make([]oapi_codegen.OrdersGetOrderResStatusHistoryEntry, 0),
+					}
 				}
 				var orderItem oapi_codegen.OrdersGetOrderResItem
 				var productCount uint32
+				var productSkuId string
+				var deliveryJsonData *[]byte
+				var currencyIso4217 *uint32
+				var subtotal, discount, shippingFee, vat, total *int64
 				var createdAt, updatedAt time.Time
 				if err := res.ScanNamed(
 					named.Required("id", &out.Id),
 					named.Required("user_id", &out.UserId),
 					named.Required("status", &out.Status),
+					named.Optional("delivery", &deliveryJsonData),
+					named.Optional("promo_code", &out.PromoCode),
+					named.Optional("currency_iso_4217", &currencyIso4217),
+					named.Optional("subtotal", &subtotal),
+					named.Optional("discount", &discount),
+					named.Optional("shipping_fee", &shippingFee),
+					named.Optional("vat", &vat),
+					named.Optional("total", &total),
 					named.Required("created_at", &createdAt),
 					named.Required("updated_at", &updatedAt),
 
 					named.Required("product_id", &orderItem.ProductId),
+					named.Required("product_sku_id", &productSkuId),
 					named.Required("product_name", &orderItem.Name),
 					named.Required("product_seller_id", &orderItem.SellerId),
 					named.Required("product_count", &productCount),
@@ -147,6 +162,23 @@ func (s *Orders) GetOrder(ctx context.Context, orderId string) (*oapi_codegen.Or
 					return err
 				}
 				orderItem.Count = int(productCount)
+				orderItem.SkuId = skuIdFromKey(productSkuId)
+				if deliveryJsonData != nil && out.Delivery == nil {
+					if err := json.Unmarshal(*deliveryJsonData, &out.Delivery); err != nil {
+						return fmt.Errorf("deserialize order delivery from database: %v", err)
+					}
+				}
+				// Orders placed before the cost was introduced have none.
+				if total != nil && out.Cost == nil {
+					out.Cost = &oapi_codegen.OrdersCost{
+						CurrencyIso4217: int(deref(currencyIso4217)),
+						Subtotal:        deref(subtotal),
+						Discount:        deref(discount),
+						ShippingFee:     deref(shippingFee),
+						Vat:             deref(vat),
+						Total:           *total,
+					}
+				}
 				out.CreatedAt = createdAt.Format(time.RFC3339)
 				out.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -154,6 +186,47 @@ func (s *Orders) GetOrder(ctx context.Context, orderId string) (*oapi_codegen.Or
 			}
 		}
 
+		if res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var shipment oapi_codegen.OrdersGetOrderResShipment
+				var updatedAt time.Time
+				if err := res.ScanNamed(
+					named.Required("seller_id", &shipment.SellerId),
+					named.Required("status", &shipment.Status),
+					named.Optional("tracking_number", &shipment.TrackingNumber),
+					named.Optional("carrier", &shipment.Carrier),
+					named.Required("updated_at", &updatedAt),
+				); err != nil {
+					return err
+				}
+				shipment.UpdatedAt = updatedAt.Format(time.RFC3339)
+				if out != nil {
+					out.Shipments = append(out.Shipments, shipment)
+				}
+			}
+		}
+
+		if res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var entry oapi_codegen.OrdersGetOrderResStatusHistoryEntry
+				var createdAt time.Time
+				if err := res.ScanNamed(
+					named.Optional("from_status", &entry.FromStatus),
+					named.Required("status", &entry.Status),
+					named.Required("actor_type", &entry.ActorType),
+					named.Required("actor_id", &entry.ActorId),
+					named.Required("reason", &entry.Reason),
+					named.Required("created_at", &createdAt),
+				); err != nil {
+					return err
+				}
+				entry.CreatedAt = createdAt.Format(time.RFC3339Nano)
+				if out != nil {
+					out.StatusHistory = append(out.StatusHistory, entry)
+				}
+			}
+		}
+
 		return res.Err()
 	}); err != nil {
 		return nil, err
@@ -196,6 +269,7 @@ SELECT
     o.created_at AS created_at,
     o.updated_at AS updated_at,
     i.product_id AS product_id,
+    i.sku_id AS product_sku_id,
     i.name AS product_name,
     i.seller_id AS product_seller_id,
     i.count AS produt_count,
@@ -230,6 +304,7 @@ type ListOrdersRow struct {
 	UpdatedAt time.Time
 
 	ProductId       string
+	ProductSkuId    string
 	ProductName     string
 	ProductSellerId string
 	ProductCount    uint32
@@ -288,6 +363,7 @@ func (s *Orders) ListOrders(ctx context.Context, userId string, nextPageToken *s
 					named.Required("created_at", &orderRow.CreatedAt),
 					named.Required("updated_at", &orderRow.UpdatedAt),
 					named.Required("product_id", &orderRow.ProductId),
+					named.Required("product_sku_id", &orderRow.ProductSkuId),
 					named.Required("product_name", &orderRow.ProductName),
 					named.Required("product_seller_id", &orderRow.ProductSellerId),
 					named.Required("produt_count", &orderRow.ProductCount),
@@ -324,6 +400,7 @@ func (s *Orders) ListOrders(ctx context.Context, userId string, nextPageToken *s
 
 		orders[order.Id].Items = append(orders[order.Id].Items, oapi_codegen.OrdersListOrdersResItem{
 			ProductId:  order.ProductId,
+			SkuId:      skuIdFromKey(order.ProductSkuId),
 			Name:       order.ProductName,
 			Count:      int(order.ProductCount),
 			Price:      order.ProductPrice,
@@ -389,10 +466,12 @@ DECLARE $updated_at AS Datetime;
 
 DECLARE $order_items AS List<Struct<
   product_id:Utf8,
+  sku_id:Utf8,
   seller_id:Utf8,
   name:Utf8,
   count:Uint32,
   price:Double,
+  price_minor:Int64,
   picture:Optional<Utf8>,
 >>;
 
@@ -400,15 +479,17 @@ INSERT INTO {{table.orders}} (id, user_id, status, created_at, updated_at)
 VALUES ($id, $user_id, $status, $created_at, $updated_at);
 
 INSERT INTO {{table.order_items}} (
-    order_id, product_id, seller_id, name, count, price, picture
+    order_id, product_id, sku_id, seller_id, name, count, price, price_minor, picture
 )
 SELECT
     $id AS order_id,
     product_id,
+    sku_id,
     seller_id,
     name,
     count,
     price,
+    price_minor,
     picture
 FROM
     AS_TABLE($order_items);
@@ -438,10 +519,12 @@ func (s *Orders) CreateOrder(ctx context.Context, in CreateOrderDTOInput) (Creat
 	for _, p := range in.Products {
 		orderItems = append(orderItems, types.StructValue(
 			types.StructFieldValue("product_id", types.StringValueFromString(p.Id)),
+			types.StructFieldValue("sku_id", types.UTF8Value(deref(p.SkuId))),
 			types.StructFieldValue("seller_id", types.StringValueFromString(p.SellerId)),
 			types.StructFieldValue("name", types.StringValueFromString(p.Name)),
 			types.StructFieldValue("count", types.Uint32Value(uint32(p.Count))),
 			types.StructFieldValue("price", types.DoubleValue(p.Price)),
+			types.StructFieldValue("price_minor", types.Int64Value(ToMinorUnits(p.Price))),
 			types.StructFieldValue("picture", types.NullableUTF8Value(p.Picture)),
 		))
 	}
@@ -544,101 +627,342 @@ $orders = AsList(
 );
 */
 
+var queryListStartedOperations = template.ReplaceAllPairs(`
+DECLARE $ids AS List<Utf8>;
+DECLARE $status AS Utf8;
+
+SELECT id, user_id, delivery, promo_code
+FROM {{table.operations}}
+WHERE id IN $ids AND status = $status;
+`,
+	"{{table.operations}}",
+	tableOperations,
+)
+
 var queryCreateOrderMany = template.ReplaceAllPairs(`
+DECLARE $operation_status AS Utf8;
 DECLARE $orders AS List<Struct<
   id:Utf8,
+  operation_id:Utf8,
   user_id:Utf8,
   status:Utf8,
+  delivery:Optional<Json>,
+  promo_code:Optional<Utf8>,
+  currency_iso_4217:Uint32,
+  subtotal:Int64,
+  discount:Int64,
+  shipping_fee:Int64,
+  vat:Int64,
+  total:Int64,
   created_at:Datetime,
   updated_at:Datetime,
+  history_id:Utf8,
+  operation_details:Optional<Utf8>,
   order_items:List<Struct<
   	product_id:Utf8,
+  	sku_id:Utf8,
   	seller_id:Utf8,
   	name:Utf8,
   	count:Uint32,
   	price:Double,
+  	price_minor:Int64,
   	picture:Optional<Utf8>,
   >>
 >>;
 
-INSERT INTO {{table.orders}} (id, user_id, status, created_at, updated_at)
+INSERT INTO {{table.orders}} (id, user_id, status, delivery, promo_code, currency_iso_4217, subtotal, discount, shipping_fee, vat, total, created_at, updated_at)
 SELECT
   id,
   user_id,
   status,
+  delivery,
+  promo_code,
+  currency_iso_4217,
+  subtotal,
+  discount,
+  shipping_fee,
+  vat,
+  total,
   created_at,
   updated_at
 FROM AS_TABLE($orders);
 
-INSERT INTO {{table.order_items}} (product_id, order_id, seller_id, name, count, price, picture)
+$redemptions = (
+  SELECT
+    Unwrap(promo_code) AS code,
+    user_id,
+    id AS order_id,
+    discount,
+    CAST(created_at AS Timestamp) AS created_at,
+  FROM AS_TABLE($orders)
+  WHERE promo_code IS NOT NULL
+);
+
+UPDATE {{table.coupons}} ON
+SELECT
+  c.code AS code,
+  c.uses + r.count AS uses,
+FROM {{table.coupons}} c
+JOIN (
+  SELECT code, COUNT(*) AS count
+  FROM $redemptions
+  GROUP BY code
+) r ON r.code = c.code;
+
+INSERT INTO {{table.coupon_redemptions}} (code, user_id, order_id, discount, created_at)
+SELECT code, user_id, order_id, discount, created_at
+FROM $redemptions;
+
+INSERT INTO {{table.order_items}} (product_id, sku_id, order_id, seller_id, name, count, price, price_minor, picture)
 SELECT 
   oi.product_id AS product_id,
+  oi.sku_id AS sku_id,
   o.id AS order_id,
   oi.seller_id AS seller_id,
   oi.name AS name,
   oi.count AS count,
   oi.price AS price,
+  oi.price_minor AS price_minor,
   oi.picture AS picture,
 FROM AS_TABLE($orders) o
 FLATTEN LIST BY order_items AS oi;
+
+-- One shipment per seller of the order
+INSERT INTO {{table.shipments}} (order_id, seller_id, status, created_at, updated_at)
+SELECT DISTINCT
+  o.id AS order_id,
+  oi.seller_id AS seller_id,
+  "{{shipment_status.created}}"u AS status,
+  o.created_at AS created_at,
+  o.updated_at AS updated_at,
+FROM AS_TABLE($orders) o
+FLATTEN LIST BY order_items AS oi;
+
+UPDATE {{table.operations}} ON
+SELECT
+  operation_id AS id,
+  $operation_status AS status,
+  operation_details AS details,
+  Just(id) AS order_id,
+  CAST(updated_at AS Timestamp) AS updated_at,
+FROM AS_TABLE($orders);
+
+$transitions = (
+  SELECT
+    id AS order_id,
+    user_id,
+    CAST(created_at AS Timestamp) AS created_at,
+    history_id AS id,
+    CAST(NULL AS Utf8?) AS from_status,
+    status,
+    "{{actor_type.user}}"u AS actor_type,
+    user_id AS actor_id,
+    "{{reason.created}}"u AS reason,
+  FROM AS_TABLE($orders)
+);
+
+INSERT INTO {{table.order_status_history}} (order_id, created_at, id, from_status, status, actor_type, actor_id, reason)
+SELECT order_id, created_at, id, from_status, status, actor_type, actor_id, reason
+FROM $transitions;
+
+SELECT order_id, user_id, created_at, id, from_status, status, actor_type, actor_id, reason
+FROM $transitions;
+
+SELECT
+  o.id AS order_id,
+  oi.product_id AS product_id,
+  oi.seller_id AS seller_id,
+  oi.count AS count,
+FROM AS_TABLE($orders) o
+FLATTEN LIST BY order_items AS oi;
 `,
 	"{{table.orders}}",
 	tableOrders,
 	"{{table.order_items}}",
 	tableOrderItems,
+	"{{table.shipments}}",
+	tableShipments,
+	"{{table.operations}}",
+	tableOperations,
+	"{{table.order_status_history}}",
+	tableOrderStatusHistory,
+	"{{table.coupons}}",
+	tableCoupons,
+	"{{table.coupon_redemptions}}",
+	tableCouponRedemptions,
+	"{{actor_type.user}}",
+	shared_api.SubjectTypeUser,
+	"{{reason.created}}",
+	OrderStatusReasonCreated,
+	"{{shipment_status.created}}",
+	ShipmentStatusCreated,
 )
 
 type CreateOrderManyDTOInput struct {
 	Orders []CreateOrderManyDTOInputOrder
+	// Create order operations of the orders are moved from the started to the completed status.
+	// Orders of operations not in the started status are not created, so that redelivered
+	// reservations don't create orders twice.
+	StartedOperationStatus   string
+	CompletedOperationStatus string
 }
 type CreateOrderManyDTOInputOrder struct {
-	Id        string
-	UserId    string
-	Status    string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Products  []oapi_codegen.PrivateOrderProcessReservedProductsReqProduct
+	Id          string
+	OperationId string
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Products    []oapi_codegen.PrivateOrderProcessReservedProductsReqProduct
+	// Cost prices the order with the delivery and the promo code of its operation.
+	Cost func(in OrderPricingInput) (OrderPricingOutput, error)
+}
+
+type OrderPricingInput struct {
+	Delivery *oapi_codegen.OrdersDelivery
+	// PromoCode is the promo code applied to the cart when the order was placed.
+	PromoCode *string
+	// Coupon of the promo code is nil if the order has no promo code or its coupon is deleted.
+	Coupon *promo.Coupon
+	// Usage of the coupon includes redemptions of the orders created earlier in the same batch.
+	Usage promo.Usage
+}
+type OrderPricingOutput struct {
+	Cost oapi_codegen.OrdersCost
+	// OperationDetails are recorded in the completed create order operation, e.g. why the promo code is rejected.
+	OperationDetails *string
 }
 
-type CreateOrderManyDTOOutput struct{}
+type CreateOrderManyDTOOutput struct {
+	// Orders created, by operation ids
+	OrderIds map[string]string
+}
 
+// CreateOrderMany creates orders of the started create order operations and completes the operations.
+// Order creation is recorded in the order status history, its order event and the request to clear
+// the cart of the user are published in the same transaction.
+// Promo codes of orders priced with a discount are redeemed.
 func (s *Orders) CreateOrderMany(ctx context.Context, in CreateOrderManyDTOInput) (*CreateOrderManyDTOOutput, error) {
 	var out *CreateOrderManyDTOOutput
 
-	orders := make([]types.Value, 0, len(in.Orders))
+	operationIds := make([]types.Value, 0, len(in.Orders))
 	for _, order := range in.Orders {
-		orderItems := make([]types.Value, 0, len(order.Products))
-		for _, product := range order.Products {
-			orderItems = append(orderItems, types.StructValue(
-				types.StructFieldValue("product_id", types.UTF8Value(product.Id)),
-				types.StructFieldValue("seller_id", types.UTF8Value(product.SellerId)),
-				types.StructFieldValue("name", types.UTF8Value(product.Name)),
-				types.StructFieldValue("count", types.Uint32Value(uint32(product.Count))),
-				types.StructFieldValue("price", types.DoubleValue(product.Price)),
-				types.StructFieldValue("picture", types.NullableUTF8Value(product.Picture)),
-			))
+		operationIds = append(operationIds, types.UTF8Value(order.OperationId))
+	}
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		out = &CreateOrderManyDTOOutput{OrderIds: make(map[string]string)}
+
+		operations, err := s.listStartedOperations(ctx, tx, operationIds, in.StartedOperationStatus)
+		if err != nil {
+			return fmt.Errorf("list started operations: %w", err)
 		}
 
-		orders = append(orders, types.StructValue(
-			types.StructFieldValue("id", types.UTF8Value(order.Id)),
-			types.StructFieldValue("user_id", types.UTF8Value(order.UserId)),
-			types.StructFieldValue("status", types.UTF8Value(order.Status)),
-			types.StructFieldValue("created_at", types.DatetimeValueFromTime(order.UpdatedAt)),
-			types.StructFieldValue("updated_at", types.DatetimeValueFromTime(order.UpdatedAt)),
-			types.StructFieldValue("order_items", types.ListValue(orderItems...)),
-		))
-	}
+		var redeemers []couponRedeemer
+		for _, operation := range operations {
+			if operation.PromoCode != nil {
+				redeemers = append(redeemers, couponRedeemer{Code: *operation.PromoCode, UserId: operation.UserId})
+			}
+		}
+		coupons, userUses, err := listCouponsUsageTx(ctx, tx, redeemers)
+		if err != nil {
+			return fmt.Errorf("list coupons usage: %w", err)
+		}
+		uses := make(map[string]uint64, len(coupons))
+		for code, coupon := range coupons {
+			uses[code] = coupon.Uses
+		}
 
-	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
-		res, err := tx.Execute(ctx, queryCreateOrderMany, table.NewQueryParameters(
+		orders := make([]types.Value, 0, len(in.Orders))
+		cartClearMessages := make([]outbox.Message, 0, len(in.Orders))
+		for _, order := range in.Orders {
+			operation, ok := operations[order.OperationId]
+			if !ok {
+				continue
+			}
+			userId := operation.UserId
+
+			orderItems := make([]types.Value, 0, len(order.Products))
+			for _, product := range order.Products {
+				orderItems = append(orderItems, types.StructValue(
+					types.StructFieldValue("product_id", types.UTF8Value(product.Id)),
+					types.StructFieldValue("sku_id", types.UTF8Value(deref(product.SkuId))),
+					types.StructFieldValue("seller_id", types.UTF8Value(product.SellerId)),
+					types.StructFieldValue("name", types.UTF8Value(product.Name)),
+					types.StructFieldValue("count", types.Uint32Value(uint32(product.Count))),
+					types.StructFieldValue("price", types.DoubleValue(product.Price)),
+					types.StructFieldValue("price_minor", types.Int64Value(ToMinorUnits(product.Price))),
+					types.StructFieldValue("picture", types.NullableUTF8Value(product.Picture)),
+				))
+			}
+
+			pricing := OrderPricingInput{Delivery: operation.Delivery, PromoCode: operation.PromoCode}
+			var redeemer couponRedeemer
+			if operation.PromoCode != nil {
+				if coupon, ok := coupons[*operation.PromoCode]; ok {
+					redeemer = couponRedeemer{Code: coupon.Code, UserId: userId}
+					pricing.Coupon = &coupon.Coupon
+					pricing.Usage = promo.Usage{Uses: uses[redeemer.Code], UserUses: userUses[redeemer]}
+				}
+			}
+
+			priced, err := order.Cost(pricing)
+			if err != nil {
+				return fmt.Errorf(`price order of operation "%s": %w`, order.OperationId, err)
+			}
+			cost := priced.Cost
+
+			var promoCode *string
+			if pricing.Coupon != nil && cost.Discount > 0 {
+				promoCode = &redeemer.Code
+				uses[redeemer.Code]++
+				userUses[redeemer]++
+			}
+
+			orders = append(orders, types.StructValue(
+				types.StructFieldValue("id", types.UTF8Value(order.Id)),
+				types.StructFieldValue("operation_id", types.UTF8Value(order.OperationId)),
+				types.StructFieldValue("user_id", types.UTF8Value(userId)),
+				types.StructFieldValue("status", types.UTF8Value(order.Status)),
+				types.StructFieldValue("delivery", operation.DeliveryValue),
+				types.StructFieldValue("promo_code", types.NullableUTF8Value(promoCode)),
+				types.StructFieldValue("currency_iso_4217", types.Uint32Value(uint32(cost.CurrencyIso4217))),
+				types.StructFieldValue("subtotal", types.Int64Value(cost.Subtotal)),
+				types.StructFieldValue("discount", types.Int64Value(cost.Discount)),
+				types.StructFieldValue("shipping_fee", types.Int64Value(cost.ShippingFee)),
+				types.StructFieldValue("vat", types.Int64Value(cost.Vat)),
+				types.StructFieldValue("total", types.Int64Value(cost.Total)),
+				types.StructFieldValue("created_at", types.DatetimeValueFromTime(order.UpdatedAt)),
+				types.StructFieldValue("updated_at", types.DatetimeValueFromTime(order.UpdatedAt)),
+				types.StructFieldValue("history_id", types.UTF8Value(uuid.NewString())),
+				types.StructFieldValue("operation_details", types.NullableUTF8Value(priced.OperationDetails)),
+				types.StructFieldValue("order_items", types.ListValue(orderItems...)),
+			))
+
+			msg, err := newCartClearMessage(order.Id, userId)
+			if err != nil {
+				return err
+			}
+			cartClearMessages = append(cartClearMessages, msg)
+			out.OrderIds[order.OperationId] = order.Id
+		}
+		if len(orders) == 0 {
+			return nil
+		}
+
+		res, err := tx.Query(ctx, queryCreateOrderMany, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$operation_status", types.UTF8Value(in.CompletedOperationStatus)),
 			table.ValueParam("$orders", types.ListValue(orders...)),
-		))
+		)))
 		if err != nil {
 			return err
 		}
-		defer func() { _ = res.Close() }()
+		defer func() { _ = res.Close(ctx) }()
 
-		return res.Err()
+		events, _, err := readOrderEvents(ctx, res)
+		if err != nil {
+			return err
+		}
+		return s.enqueueOrderEventsTx(ctx, tx, events, cartClearMessages...)
 	}); err != nil {
 		return nil, err
 	}
@@ -646,103 +970,384 @@ func (s *Orders) CreateOrderMany(ctx context.Context, in CreateOrderManyDTOInput
 	return out, nil
 }
 
+type startedOperation struct {
+	UserId   string
+	Delivery *oapi_codegen.OrdersDelivery
+	// DeliveryValue is passed to the created order as is.
+	DeliveryValue types.Value
+	PromoCode     *string
+}
+
+// listStartedOperations returns operations in the started status by operation ids.
+func (s *Orders) listStartedOperations(ctx context.Context, tx query.TxActor, operationIds []types.Value, status string) (map[string]startedOperation, error) {
+	operations := make(map[string]startedOperation, len(operationIds))
+	if len(operationIds) == 0 {
+		return operations, nil
+	}
+
+	res, err := tx.Query(ctx, queryListStartedOperations, query.WithParameters(table.NewQueryParameters(
+		table.ValueParam("$ids", types.ListValue(operationIds...)),
+		table.ValueParam("$status", types.UTF8Value(status)),
+	)))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close(ctx) }()
+
+	rs, err := res.NextResultSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var operationId, userId string
+		var deliveryJsonData *[]byte
+		var promoCode *string
+		if err := row.ScanNamed(
+			query.Named("id", &operationId),
+			query.Named("user_id", &userId),
+			query.Named("delivery", &deliveryJsonData),
+			query.Named("promo_code", &promoCode),
+		); err != nil {
+			return nil, err
+		}
+		operation := startedOperation{UserId: userId, DeliveryValue: types.NullValue(types.TypeJSON), PromoCode: promoCode}
+		if deliveryJsonData != nil {
+			if err := json.Unmarshal(*deliveryJsonData, &operation.Delivery); err != nil {
+				return nil, fmt.Errorf("deserialize operation delivery from database: %v", err)
+			}
+			operation.DeliveryValue = types.OptionalValue(types.JSONValueFromBytes(*deliveryJsonData))
+		}
+		operations[operationId] = operation
+	}
+	return operations, nil
+}
+
+// The order is updated only from the expected status. An order already in the new status is left as is,
+// so redelivered updates are no-ops.
 var queryUpdateOrder = template.ReplaceAllPairs(`
 DECLARE $id AS Utf8;
+DECLARE $from_status AS Utf8;
 DECLARE $status AS Utf8;
-DECLARE $updated_at AS Datetime;
+DECLARE $updated_at AS Timestamp;
+DECLARE $history_id AS Utf8;
+DECLARE $actor_type AS Utf8;
+DECLARE $actor_id AS Utf8;
+DECLARE $reason AS Utf8;
 
-$to_update = (
+$current = (
     SELECT
         id,
-        $status AS status,
-        $updated_at AS updated_at
+        user_id,
+        status
     FROM
         {{table.orders}}
-    WHERE id = $id
+    WHERE id = $id AND (status = $from_status OR status = $status)
 );
 
+SELECT status
+FROM {{table.orders}}
+WHERE id = $id;
+
+$transitions = (
+    SELECT
+        id AS order_id,
+        user_id,
+        $updated_at AS created_at,
+        $history_id AS id,
+        Just(status) AS from_status,
+        $status AS status,
+        $actor_type AS actor_type,
+        $actor_id AS actor_id,
+        $reason AS reason,
+    FROM $current
+    WHERE status != $status
+);
+
+SELECT order_id, user_id, created_at, id, from_status, status, actor_type, actor_id, reason
+FROM $transitions;
+
+SELECT
+    i.order_id AS order_id,
+    i.product_id AS product_id,
+    i.seller_id AS seller_id,
+    i.count AS count
+FROM $transitions t
+JOIN {{table.order_items}} i ON i.order_id = t.order_id;
+
+UPSERT INTO {{table.order_status_history}} (order_id, created_at, id, from_status, status, actor_type, actor_id, reason)
+SELECT order_id, created_at, id, from_status, status, actor_type, actor_id, reason
+FROM $transitions;
+
 UPDATE {{table.orders}} ON
-SELECT * FROM $to_update
+SELECT
+    id,
+    $status AS status,
+    CAST($updated_at AS Datetime) AS updated_at
+FROM $current
 RETURNING id, status, updated_at;
 `,
 	"{{table.orders}}",
 	tableOrders,
+	"{{table.order_items}}",
+	tableOrderItems,
+	"{{table.order_status_history}}",
+	tableOrderStatusHistory,
 )
 
-func (s *Orders) UpdateOrder(ctx context.Context, orderId, orderStatus string) (*oapi_codegen.OrdersUpdateOrderRes, error) {
+type UpdateOrderDTOInput struct {
+	OrderId string
+	// FromStatus is the status the order is expected to be in.
+	FromStatus string
+	Status     string
+	Actor      Actor
+	Reason     string
+	// Messages are published along with the order event if the order status is changed.
+	Messages []outbox.Message
+}
+
+// UpdateOrder updates the order status, records the transition in the order status history
+// and publishes its order event in the same transaction.
+// ErrOrderStatusConflict is returned if the order is neither in the expected nor in the new status.
+func (s *Orders) UpdateOrder(ctx context.Context, in UpdateOrderDTOInput) (*oapi_codegen.OrdersUpdateOrderRes, error) {
 	var out *oapi_codegen.OrdersUpdateOrderRes
 
-	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
-		res, err := tx.Execute(ctx, queryUpdateOrder, table.NewQueryParameters(
-			table.ValueParam("$id", types.UTF8Value(orderId)),
-			table.ValueParam("$status", types.UTF8Value(orderStatus)),
-			table.ValueParam("$updated_at", types.DatetimeValueFromTime(time.Now())),
-		))
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		var err error
+		out, err = s.updateOrderTx(ctx, tx, in)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// updateOrderTx updates the order within the transaction, nil is returned if the order does not exist.
+func (s *Orders) updateOrderTx(ctx context.Context, tx query.TxActor, in UpdateOrderDTOInput) (*oapi_codegen.OrdersUpdateOrderRes, error) {
+	res, err := tx.Query(ctx, queryUpdateOrder, query.WithParameters(table.NewQueryParameters(
+		table.ValueParam("$id", types.UTF8Value(in.OrderId)),
+		table.ValueParam("$from_status", types.UTF8Value(in.FromStatus)),
+		table.ValueParam("$status", types.UTF8Value(in.Status)),
+		table.ValueParam("$updated_at", types.TimestampValueFromTime(time.Now())),
+		table.ValueParam("$history_id", types.UTF8Value(uuid.NewString())),
+		table.ValueParam("$actor_type", types.UTF8Value(in.Actor.Type)),
+		table.ValueParam("$actor_id", types.UTF8Value(in.Actor.Id)),
+		table.ValueParam("$reason", types.UTF8Value(in.Reason)),
+	)))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close(ctx) }()
+
+	row, err := nextResultSetRow(ctx, res)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var currentStatus string
+	if err := row.ScanNamed(query.Named("status", &currentStatus)); err != nil {
+		return nil, err
+	}
+	if currentStatus != in.FromStatus && currentStatus != in.Status {
+		return nil, fmt.Errorf(`%w: order "%s" is "%s", expected "%s"`, ErrOrderStatusConflict, in.OrderId, currentStatus, in.FromStatus)
+	}
+
+	events, transitioned, err := readOrderEvents(ctx, res)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *oapi_codegen.OrdersUpdateOrderRes
+	rs, err := res.NextResultSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
 		if err != nil {
-			return err
+			return nil, err
 		}
-		defer func() { _ = res.Close() }()
-
-		for res.NextResultSet(ctx) {
-			for res.NextRow() {
-				var id string
-				out = &oapi_codegen.OrdersUpdateOrderRes{}
-				var updatedAt time.Time
-				if err := res.ScanNamed(
-					named.Required("id", &id),
-					named.Required("status", &out.Status),
-					named.Required("updated_at", &updatedAt),
-				); err != nil {
-					return err
-				}
-				out.UpdatedAt = updatedAt.Format(time.RFC3339)
-				_ = id
-			}
+		out = &oapi_codegen.OrdersUpdateOrderRes{}
+		var updatedAt time.Time
+		if err := row.ScanNamed(
+			query.Named("status", &out.Status),
+			query.Named("updated_at", &updatedAt),
+		); err != nil {
+			return nil, err
 		}
+		out.UpdatedAt = updatedAt.Format(time.RFC3339)
+	}
 
-		return res.Err()
-	}); err != nil {
+	if !transitioned[in.OrderId] {
+		return out, nil
+	}
+	if err := s.enqueueOrderEventsTx(ctx, tx, events, in.Messages...); err != nil {
 		return nil, err
 	}
-
 	return out, nil
 }
 
 var queryUpdateOrderMany = template.ReplaceAllPairs(`
 DECLARE $order_updates AS List<Struct<
   id:Utf8,
-	status:Utf8,
-	updated_at:Datetime,
+  from_status:Utf8,
+  status:Utf8,
+  updated_at:Timestamp,
+  history_id:Utf8,
+  actor_type:Utf8,
+  actor_id:Utf8,
+  reason:Utf8,
 >>;
 
+-- Orders neither in the expected nor in the new status were changed concurrently
+SELECT u.id AS id
+FROM AS_TABLE($order_updates) u
+JOIN {{table.orders}} o ON o.id = u.id
+WHERE o.status != u.from_status AND o.status != u.status;
+
 -- Existing orders only
 $to_update = (
   SELECT
     u.id AS id,
+    o.user_id AS user_id,
     u.status AS status,
     u.updated_at AS updated_at,
+    u.history_id AS history_id,
+    u.actor_type AS actor_type,
+    u.actor_id AS actor_id,
+    u.reason AS reason,
+    o.status AS previous_status,
+    o.promo_code AS promo_code,
   FROM AS_TABLE($order_updates) u
   JOIN {{table.orders}} o ON o.id = u.id
+  WHERE o.status = u.from_status OR o.status = u.status
+);
+
+-- Promo codes of cancelled orders may be used again
+$released_redemptions = (
+  SELECT
+    r.code AS code,
+    r.user_id AS user_id,
+    r.order_id AS order_id,
+    Just(u.updated_at) AS released_at,
+  FROM $to_update u
+  JOIN {{table.coupon_redemptions}} r ON r.code = u.promo_code AND r.user_id = u.user_id AND r.order_id = u.id
+  WHERE u.status = "{{order_status.cancelled}}"u AND u.previous_status != u.status AND r.released_at IS NULL
 );
 
+$transitions = (
+  SELECT
+    id AS order_id,
+    user_id,
+    updated_at AS created_at,
+    history_id AS id,
+    Just(previous_status) AS from_status,
+    status,
+    actor_type,
+    actor_id,
+    reason,
+  FROM $to_update
+  WHERE previous_status != status
+);
+
+SELECT order_id, user_id, created_at, id, from_status, status, actor_type, actor_id, reason
+FROM $transitions;
+
+SELECT
+  i.order_id AS order_id,
+  i.product_id AS product_id,
+  i.seller_id AS seller_id,
+  i.count AS count,
+FROM $transitions t
+JOIN {{table.order_items}} i ON i.order_id = t.order_id;
+
+UPSERT INTO {{table.order_status_history}} (order_id, created_at, id, from_status, status, actor_type, actor_id, reason)
+SELECT order_id, created_at, id, from_status, status, actor_type, actor_id, reason
+FROM $transitions;
+
+UPDATE {{table.coupons}} ON
+SELECT
+  c.code AS code,
+  IF(c.uses > r.count, c.uses - r.count, 0ul) AS uses,
+FROM {{table.coupons}} c
+JOIN (
+  SELECT code, COUNT(*) AS count
+  FROM $released_redemptions
+  GROUP BY code
+) r ON r.code = c.code;
+
+UPDATE {{table.coupon_redemptions}} ON
+SELECT code, user_id, order_id, released_at
+FROM $released_redemptions;
+
+-- Shipments of cancelled orders are cancelled along with them
+UPDATE {{table.shipments}} ON
+SELECT
+  s.order_id AS order_id,
+  s.seller_id AS seller_id,
+  "{{shipment_status.cancelled}}"u AS status,
+  CAST(u.updated_at AS Datetime) AS updated_at,
+FROM $to_update u
+JOIN {{table.shipments}} s ON s.order_id = u.id
+WHERE u.status = "{{order_status.cancelled}}"u AND s.status != "{{shipment_status.cancelled}}"u;
+
 UPDATE {{table.orders}} ON
-SELECT * FROM $to_update
-RETURNING id, status, updated_at;
+SELECT
+  id,
+  status,
+  CAST(updated_at AS Datetime) AS updated_at,
+FROM $to_update;
 `,
 	"{{table.orders}}",
 	tableOrders,
+	"{{table.order_items}}",
+	tableOrderItems,
+	"{{table.order_status_history}}",
+	tableOrderStatusHistory,
+	"{{table.coupons}}",
+	tableCoupons,
+	"{{table.coupon_redemptions}}",
+	tableCouponRedemptions,
+	"{{table.shipments}}",
+	tableShipments,
+	"{{order_status.cancelled}}",
+	orderStatusCancelled,
+	"{{shipment_status.cancelled}}",
+	ShipmentStatusCancelled,
 )
 
 type UpdateOrderManyDTOInput struct {
 	OrderUpdates []UpdateOrderManyDTOInputOrderUpdate
 }
 type UpdateOrderManyDTOInputOrderUpdate struct {
-	OrderId   string
-	Status    string
-	UpdatedAt time.Time
+	OrderId string
+	// FromStatus is the status the order is expected to be in.
+	FromStatus string
+	Status     string
+	UpdatedAt  time.Time
+	Actor      Actor
+	Reason     string
+	// Messages are published along with the order event if the order status is changed.
+	Messages []outbox.Message
 }
 type UpdateOrderManyDTOOutput struct{}
 
+// UpdateOrderMany updates statuses of existing orders, records the transitions in the order status history
+// and publishes their order events in the same transaction. Promo codes of cancelled orders are released
+// and their shipments are cancelled.
+// Nothing is updated and ErrOrderStatusConflict is returned if any of the orders is neither in the expected nor in the new status.
 func (s *Orders) UpdateOrderMany(ctx context.Context, in UpdateOrderManyDTOInput) (UpdateOrderManyDTOOutput, error) {
 	var out UpdateOrderManyDTOOutput
 
@@ -750,20 +1355,60 @@ func (s *Orders) UpdateOrderMany(ctx context.Context, in UpdateOrderManyDTOInput
 	for _, u := range in.OrderUpdates {
 		updates = append(updates, types.StructValue(
 			types.StructFieldValue("id", types.UTF8Value(u.OrderId)),
+			types.StructFieldValue("from_status", types.UTF8Value(u.FromStatus)),
 			types.StructFieldValue("status", types.UTF8Value(u.Status)),
-			types.StructFieldValue("updated_at", types.DatetimeValueFromTime(u.UpdatedAt)),
+			types.StructFieldValue("updated_at", types.TimestampValueFromTime(u.UpdatedAt)),
+			types.StructFieldValue("history_id", types.UTF8Value(uuid.NewString())),
+			types.StructFieldValue("actor_type", types.UTF8Value(u.Actor.Type)),
+			types.StructFieldValue("actor_id", types.UTF8Value(u.Actor.Id)),
+			types.StructFieldValue("reason", types.UTF8Value(u.Reason)),
 		))
 	}
-	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
-		res, err := tx.Execute(ctx, queryUpdateOrderMany, table.NewQueryParameters(
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		res, err := tx.Query(ctx, queryUpdateOrderMany, query.WithParameters(table.NewQueryParameters(
 			table.ValueParam("$order_updates", types.ListValue(updates...)),
-		))
+		)))
 		if err != nil {
 			return err
 		}
-		defer func() { _ = res.Close() }()
+		defer func() { _ = res.Close(ctx) }()
 
-		return res.Err()
+		rs, err := res.NextResultSet(ctx)
+		if err != nil {
+			return err
+		}
+		var conflicts []string
+		for {
+			row, err := rs.NextRow(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			var id string
+			if err := row.ScanNamed(query.Named("id", &id)); err != nil {
+				return err
+			}
+			conflicts = append(conflicts, id)
+		}
+		if len(conflicts) > 0 {
+			// The transaction is rolled back.
+			return fmt.Errorf("%w: orders %s", ErrOrderStatusConflict, strings.Join(conflicts, ", "))
+		}
+
+		events, transitioned, err := readOrderEvents(ctx, res)
+		if err != nil {
+			return err
+		}
+
+		var msgs []outbox.Message
+		for _, u := range in.OrderUpdates {
+			if transitioned[u.OrderId] {
+				msgs = append(msgs, u.Messages...)
+			}
+		}
+		return s.enqueueOrderEventsTx(ctx, tx, events, msgs...)
 	}); err != nil {
 		return UpdateOrderManyDTOOutput{}, err
 	}
@@ -787,6 +1432,7 @@ LIMIT 10000
 SELECT
     o.id AS id,
     oi.product_id AS product_id,
+    oi.sku_id AS sku_id,
     oi.count AS count,
 FROM $unpaid_orders o
 JOIN {{table.orderItems}} oi on oi.order_id = o.id;
@@ -808,6 +1454,7 @@ type ListUnpaidOrdersDTOOutputOrder struct {
 }
 type ListUnpaidOrdersDTOOutputOrderItem struct {
 	Id    string
+	SkuId *string
 	Count int
 }
 
@@ -823,17 +1470,19 @@ func (s *Orders) ListUnpaidOrders(ctx context.Context) (ListUnpaidOrdersDTOOutpu
 
 		for res.NextResultSet(ctx) {
 			for res.NextRow() {
-				var id, productId string
+				var id, productId, skuId string
 				var count uint32
 				if err := res.ScanNamed(
 					named.Required("id", &id),
 					named.Required("product_id", &productId),
+					named.Required("sku_id", &skuId),
 					named.Required("count", &count),
 				); err != nil {
 					return err
 				}
 				orders[id] = append(orders[id], ListUnpaidOrdersDTOOutputOrderItem{
 					Id:    productId,
+					SkuId: skuIdFromKey(skuId),
 					Count: int(count),
 				})
 			}
@@ -857,6 +1506,25 @@ func (s *Orders) ListUnpaidOrders(ctx context.Context) (ListUnpaidOrdersDTOOutpu
 	return out, nil
 }
 
+// noSkuId keys order items of products without SKUs, an order has several SKUs of the product.
+const noSkuId = ""
+
+// skuIdFromKey returns the sku id of the item key, nil for products without SKUs.
+func skuIdFromKey(skuId string) *string {
+	if skuId == noSkuId {
+		return nil
+	}
+	return &skuId
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
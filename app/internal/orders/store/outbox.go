@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+const (
+	// Path of the outbox table, the outbox quotes it in queries.
+	tableOutbox = "orders/outbox"
+)
+
+// RelayOutbox publishes messages of committed state changes to their topics.
+func (s *Orders) RelayOutbox(ctx context.Context) (int, error) {
+	return s.outbox.Relay(ctx)
+}
+
+// EnqueueMessages writes messages that don't follow a state change of the service to the outbox,
+// so they're published by the relay with retries like the rest of the messages.
+func (s *Orders) EnqueueMessages(ctx context.Context, msgs ...outbox.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	return s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		return s.outbox.EnqueueTableTx(ctx, tx, msgs...)
+	})
+}
+
+// dedupKey identifies the message of the kind published for the resource,
+// i.e. "order:<id>:products_unreservation".
+func dedupKey(resource, id, kind string) string {
+	return fmt.Sprintf("%s:%s:%s", resource, id, kind)
+}
+
+// NewCartPublishRequestMessage requests publishing cart contents of the user for the create order operation.
+func NewCartPublishRequestMessage(operationId, userId string) (outbox.Message, error) {
+	return outbox.NewMessage(topicCartPublishRequests, dedupKey("operation", operationId, "cart_publish_request"), oapi_codegen.PrivatePublishCartPositionsReqMessage{
+		OperationId: operationId,
+		UserId:      userId,
+	})
+}
+
+// NewProductsReservationMessages requests holding products for orders of the create order operations.
+func NewProductsReservationMessages(messages ...oapi_codegen.PrivateReserveProductsReqMessage) ([]outbox.Message, error) {
+	return outbox.NewMessages(topicProductsReservations, func(m oapi_codegen.PrivateReserveProductsReqMessage) string {
+		return dedupKey("operation", m.OperationId, "products_reservation")
+	}, messages...)
+}
+
+// NewCancelOperationMessages requests aborting create order operations that can't be completed.
+func NewCancelOperationMessages(messages ...oapi_codegen.PrivateOrderCancelOperationsReqMessage) ([]outbox.Message, error) {
+	return outbox.NewMessages(topicCancelOperations, func(m oapi_codegen.PrivateOrderCancelOperationsReqMessage) string {
+		return dedupKey("operation", m.OperationId, "cancel")
+	}, messages...)
+}
+
+// NewProcessedPaymentNotificationMessages requests processing of verified payment notifications.
+func NewProcessedPaymentNotificationMessages(messages ...oapi_codegen.PrivateOrderProcessPaymentNotificationsReqMessage) ([]outbox.Message, error) {
+	return outbox.NewMessages(topicProcessedPaymentsNotifications, func(m oapi_codegen.PrivateOrderProcessPaymentNotificationsReqMessage) string {
+		if m.PaymentId == nil {
+			return ""
+		}
+		return dedupKey("payment", *m.PaymentId, "notification")
+	}, messages...)
+}
+
+// NewProductsUnreservationMessages requests restocking products of cancelled orders or received returns.
+func NewProductsUnreservationMessages(messages ...oapi_codegen.PrivateUnreserveProductsReqMessage) ([]outbox.Message, error) {
+	return outbox.NewMessages(topicProductsUnreservations, func(m oapi_codegen.PrivateUnreserveProductsReqMessage) string {
+		if m.ReturnId != nil {
+			return dedupKey("return", *m.ReturnId, "products_unreservation")
+		}
+		return dedupKey("order", m.OrderId, "products_unreservation")
+	}, messages...)
+}
+
+// NewProductsSaleMessages requests deducting products held for paid orders from stock.
+func NewProductsSaleMessages(messages ...oapi_codegen.PrivateSellProductsReqMessage) ([]outbox.Message, error) {
+	return outbox.NewMessages(topicProductsSales, func(m oapi_codegen.PrivateSellProductsReqMessage) string {
+		return dedupKey("order", m.OrderId, "products_sale")
+	}, messages...)
+}
+
+func NewCompletedOrderMessages(messages ...oapi_codegen.PrivateFeedbackProcessCompletedOrderReqMessage) ([]outbox.Message, error) {
+	return outbox.NewMessages(topicCompletedOrders, func(m oapi_codegen.PrivateFeedbackProcessCompletedOrderReqMessage) string {
+		return dedupKey("order", m.OrderId, "completed")
+	}, messages...)
+}
+
+func newCartClearMessage(orderId, userId string) (outbox.Message, error) {
+	return outbox.NewMessage(topicCartClearRequests, dedupKey("order", orderId, "cart_clear"), oapi_codegen.PrivateClearCartPositionsReqMessage{
+		UserId:  userId,
+		OrderId: &orderId,
+	})
+}
+
+// Order event id is unique, so it's used as the dedup key.
+func newOrderEventMessages(events ...oapi_codegen.OrderEventMessage) ([]outbox.Message, error) {
+	return outbox.NewMessages(topicOrderEvents, func(e oapi_codegen.OrderEventMessage) string {
+		return e.Id
+	}, events...)
+}
@@ -3,12 +3,15 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
 	"time"
 
-	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
 	"github.com/bratushkadan/floral/pkg/template"
-	ydbtopic "github.com/bratushkadan/floral/pkg/ydb/topic"
+	ydbpkg "github.com/bratushkadan/floral/pkg/ydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
@@ -20,16 +23,34 @@ const (
 	topicProcessedPaymentsNotifications = "orders/payment_notifications_topic"
 )
 
+// Amounts are summed and compared in minor currency units to avoid floating point errors.
+func ToMinorUnits(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+func FromMinorUnits(amount int64) float64 {
+	return float64(amount) / 100
+}
+func majorUnitsPtr(amount *int64) *float64 {
+	if amount == nil {
+		return nil
+	}
+	v := FromMinorUnits(*amount)
+	return &v
+}
+
 var queryCreatePaymentMany = template.ReplaceAllPairs(`
 DECLARE $payments AS List<Struct<
   id:Utf8,
   order_id:Utf8,
   amount:Double,
+  amount_minor:Int64,
   currency_iso_4217:Uint32,
   provider:Json,
   created_at:Timestamp,
   updated_at:Timestamp,
   refunded_at:Optional<Timestamp>,
+  refund_amount:Optional<Double>,
+  refund_amount_minor:Optional<Int64>,
 >>;
 
 -- $payments = AsList(
@@ -66,11 +87,14 @@ var queryCreatePayment = template.ReplaceAllPairs(`
 DECLARE $id AS Utf8;
 DECLARE $order_id AS Utf8;
 DECLARE $amount AS Double;
+DECLARE $amount_minor AS Int64;
 DECLARE $currency_iso_4217 AS Uint32;
 DECLARE $provider AS Json;
 DECLARE $created_at AS Timestamp;
 DECLARE $updated_at AS Timestamp;
 DECLARE $refunded_at AS Optional<Timestamp>;
+DECLARE $refund_amount AS Optional<Double>;
+DECLARE $refund_amount_minor AS Optional<Int64>;
 
 -- $id = UNWRAP(CAST("op1" AS Utf8));
 -- $order_id = UNWRAP(CAST("" AS Utf8));
@@ -80,22 +104,25 @@ DECLARE $refunded_at AS Optional<Timestamp>;
 -- $updated_at = CurrentUtcTimestamp();
 -- $provider = @@{"name": "yoomoney"}@@j;
 
-INSERT INTO {{table.payments}} (id, order_id, amount, currency_iso_4217, provider, created_at, updated_at, refunded_at)
-VALUES($id, $order_id, $amount, $currency_iso_4217, $provider, $created_at, $updated_at, $refunded_at)
-RETURNING id, order_id, amount, currency_iso_4217, provider, created_at, updated_at, refunded_at;
+INSERT INTO {{table.payments}} (id, order_id, amount, amount_minor, currency_iso_4217, provider, created_at, updated_at, refunded_at, refund_amount, refund_amount_minor)
+VALUES($id, $order_id, $amount, $amount_minor, $currency_iso_4217, $provider, $created_at, $updated_at, $refunded_at, $refund_amount, $refund_amount_minor)
+RETURNING id, order_id, amount, currency_iso_4217, provider, created_at, updated_at, refunded_at, refund_amount;
 `,
 	"{{table.payments}}",
 	tablePayments,
 )
 
 type CreatePaymentDTOInput struct {
-	Id              string
-	OrderId         string
-	Amount          float64
+	Id      string
+	OrderId string
+	// Amount is in minor units of the payment currency.
+	Amount          int64
 	CurrencyIso4217 uint32
 	Provider        map[string]any
 	CreatedAt       time.Time
 	RefundedAt      *time.Time
+	// RefundAmount flags the part of the payment to be returned to the customer, in minor units.
+	RefundAmount *int64
 }
 type CreatePaymentDTOOutput struct {
 	Id              string
@@ -106,6 +133,7 @@ type CreatePaymentDTOOutput struct {
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	RefundedAt      *time.Time
+	RefundAmount    *float64
 }
 
 func (s *Orders) CreatePayment(ctx context.Context, in CreatePaymentDTOInput) (CreatePaymentDTOOutput, error) {
@@ -119,12 +147,15 @@ func (s *Orders) CreatePayment(ctx context.Context, in CreatePaymentDTOInput) (C
 	tableQueryParameters := table.NewQueryParameters(
 		table.ValueParam("$id", types.UTF8Value(in.Id)),
 		table.ValueParam("$order_id", types.UTF8Value(in.OrderId)),
-		table.ValueParam("$amount", types.DoubleValue(in.Amount)),
+		table.ValueParam("$amount", types.DoubleValue(FromMinorUnits(in.Amount))),
+		table.ValueParam("$amount_minor", types.Int64Value(in.Amount)),
 		table.ValueParam("$currency_iso_4217", types.Uint32Value(in.CurrencyIso4217)),
 		table.ValueParam("$provider", types.JSONValueFromBytes(provider)),
 		table.ValueParam("$created_at", types.TimestampValueFromTime(in.CreatedAt)),
 		table.ValueParam("$updated_at", types.TimestampValueFromTime(in.CreatedAt)),
 		table.ValueParam("$refunded_at", types.NullableTimestampValueFromTime(in.RefundedAt)),
+		table.ValueParam("$refund_amount", types.NullableDoubleValue(majorUnitsPtr(in.RefundAmount))),
+		table.ValueParam("$refund_amount_minor", types.NullableInt64Value(in.RefundAmount)),
 	)
 
 	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
@@ -146,6 +177,7 @@ func (s *Orders) CreatePayment(ctx context.Context, in CreatePaymentDTOInput) (C
 					named.Required("created_at", &out.CreatedAt),
 					named.Required("updated_at", &out.UpdatedAt),
 					named.Optional("refunded_at", &out.RefundedAt),
+					named.Optional("refund_amount", &out.RefundAmount),
 				); err != nil {
 					return err
 				}
@@ -165,6 +197,29 @@ func (s *Orders) CreatePayment(ctx context.Context, in CreatePaymentDTOInput) (C
 }
 
 func (s *Orders) CreatePaymentMany(ctx context.Context, in []CreatePaymentDTOInput) ([]CreatePaymentDTOOutput, error) {
+	payments, err := paymentsValue(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, queryCreatePaymentMany, table.NewQueryParameters(
+			table.ValueParam("$payments", payments),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func paymentsValue(in []CreatePaymentDTOInput) (types.Value, error) {
 	rows := make([]types.Value, 0, len(in))
 	for _, record := range in {
 		provider, err := json.Marshal(&record.Provider)
@@ -175,30 +230,294 @@ func (s *Orders) CreatePaymentMany(ctx context.Context, in []CreatePaymentDTOInp
 		rows = append(rows, types.StructValue(
 			types.StructFieldValue("id", types.UTF8Value(record.Id)),
 			types.StructFieldValue("order_id", types.UTF8Value(record.OrderId)),
-			types.StructFieldValue("amount", types.DoubleValue(record.Amount)),
+			types.StructFieldValue("amount", types.DoubleValue(FromMinorUnits(record.Amount))),
+			types.StructFieldValue("amount_minor", types.Int64Value(record.Amount)),
 			types.StructFieldValue("currency_iso_4217", types.Uint32Value(record.CurrencyIso4217)),
 			types.StructFieldValue("provider", types.JSONValueFromBytes(provider)),
 			types.StructFieldValue("created_at", types.TimestampValueFromTime(record.CreatedAt)),
 			types.StructFieldValue("updated_at", types.TimestampValueFromTime(record.CreatedAt)),
 			types.StructFieldValue("refunded_at", types.NullableTimestampValueFromTime(record.RefundedAt)),
+			types.StructFieldValue("refund_amount", types.NullableDoubleValue(majorUnitsPtr(record.RefundAmount))),
+			types.StructFieldValue("refund_amount_minor", types.NullableInt64Value(record.RefundAmount)),
 		))
 	}
+	return types.ListValue(rows...), nil
+}
 
-	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
-		res, err := tx.Execute(ctx, queryCreatePaymentMany, table.NewQueryParameters(
-			table.ValueParam("$payments", types.ListValue(rows...)),
+var queryGetPaymentId = template.ReplaceAllPairs(`
+DECLARE $id AS Utf8;
+
+SELECT id
+FROM {{table.payments}}
+WHERE id = $id;
+`,
+	"{{table.payments}}",
+	tablePayments,
+)
+
+// Payments are read via idx_order_id in the same transaction they're written in,
+// so the index is synchronous and balances see payments recorded by concurrent transactions.
+var queryListOrdersPaymentsBalances = template.ReplaceAllPairs(`
+DECLARE $order_ids AS List<Utf8>;
+DECLARE $currency_iso_4217 AS Uint32;
+
+$totals = (
+  SELECT
+    order_id,
+    SUM(price_minor * CAST(count AS Int64)) AS total,
+  FROM {{table.order_items}}
+  WHERE order_id IN $order_ids
+  GROUP BY order_id
+);
+
+$paid = (
+  SELECT
+    order_id,
+    SUM(amount_minor - COALESCE(refund_amount_minor, 0l)) AS paid,
+  FROM {{table.payments}} VIEW idx_order_id
+  WHERE
+    order_id IN $order_ids
+      AND
+    currency_iso_4217 = $currency_iso_4217
+      AND
+    refunded_at IS NULL
+  GROUP BY order_id
+);
+
+-- Orders placed before the cost was introduced are paid for their items.
+SELECT
+  o.id AS order_id,
+  o.status AS status,
+  COALESCE(o.total, t.total) AS total,
+  p.paid AS paid,
+FROM {{table.orders}} o
+LEFT JOIN $totals t ON t.order_id = o.id
+LEFT JOIN $paid p ON p.order_id = o.id
+WHERE o.id IN $order_ids;
+`,
+	"{{table.orders}}",
+	tableOrders,
+	"{{table.order_items}}",
+	tableOrderItems,
+	"{{table.payments}}",
+	tablePayments,
+)
+
+type ListOrdersPaymentsBalancesDTOInput struct {
+	OrderIds        []string
+	CurrencyIso4217 uint32
+}
+type OrderPaymentsBalance struct {
+	OrderId string
+	Status  string
+	// Total is the order cost total in minor units.
+	Total int64
+	// Paid is the sum of payments in order currency that are kept by the shop, in minor units.
+	Paid int64
+}
+
+// ListOrdersPaymentsBalances returns balances of existing orders keyed by order id.
+func (s *Orders) ListOrdersPaymentsBalances(ctx context.Context, in ListOrdersPaymentsBalancesDTOInput) (map[string]OrderPaymentsBalance, error) {
+	orderIds := make([]types.Value, 0, len(in.OrderIds))
+	for _, id := range in.OrderIds {
+		orderIds = append(orderIds, types.UTF8Value(id))
+	}
+
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	var out map[string]OrderPaymentsBalance
+
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		out = make(map[string]OrderPaymentsBalance, len(in.OrderIds))
+
+		_, res, err := ss.Execute(ctx, readTx, queryListOrdersPaymentsBalances, table.NewQueryParameters(
+			table.ValueParam("$order_ids", types.ListValue(orderIds...)),
+			table.ValueParam("$currency_iso_4217", types.Uint32Value(in.CurrencyIso4217)),
 		))
 		if err != nil {
 			return err
 		}
 		defer func() { _ = res.Close() }()
 
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var balance OrderPaymentsBalance
+				if err := res.ScanNamed(
+					named.Required("order_id", &balance.OrderId),
+					named.Required("status", &balance.Status),
+					named.OptionalWithDefault("total", &balance.Total),
+					named.OptionalWithDefault("paid", &balance.Paid),
+				); err != nil {
+					return err
+				}
+				out[balance.OrderId] = balance
+			}
+		}
+
 		return res.Err()
 	}); err != nil {
 		return nil, err
 	}
 
-	return nil, nil
+	return out, nil
+}
+
+// errPaymentExists rolls back the transaction of the duplicate payment.
+var errPaymentExists = errors.New("payment exists")
+
+type ProcessPaymentDTOInput struct {
+	Payment CreatePaymentDTOInput
+	// Provider is the name of the provider the payment is refunded with.
+	Provider string
+	// CurrencyIso4217 is the currency of the order balance.
+	CurrencyIso4217 uint32
+}
+
+// PaymentAllocation is the decision on the payment made upon the order balance.
+type PaymentAllocation struct {
+	// RefundAmount is the part of the payment to be returned to the customer in minor units,
+	// it's refunded by the overpayment refund of the payment.
+	RefundAmount *int64
+	// OrderUpdate transitions the order, the order status is kept if nil.
+	OrderUpdate *UpdateOrderDTOInput
+}
+type ProcessPaymentDTOOutput struct {
+	// Duplicate is true if the payment is already recorded, nothing is changed then.
+	Duplicate  bool
+	Allocation PaymentAllocation
+}
+
+// ProcessPayment records the payment upon the balance of its order read in the same transaction:
+// allocate decides on the payment given the order balance before the payment (nil if the order does not exist).
+// The payment, its overpayment refund and the order transition are committed together.
+// Payment already recorded, including by a concurrent transaction that makes the insert conflict, is a duplicate.
+func (s *Orders) ProcessPayment(ctx context.Context, in ProcessPaymentDTOInput, allocate func(balance *OrderPaymentsBalance) (PaymentAllocation, error)) (ProcessPaymentDTOOutput, error) {
+	var out ProcessPaymentDTOOutput
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		out = ProcessPaymentDTOOutput{}
+
+		duplicate, err := s.paymentExistsTx(ctx, tx, in.Payment.Id)
+		if err != nil {
+			return fmt.Errorf("get payment: %w", err)
+		}
+		if duplicate {
+			out.Duplicate = true
+			return nil
+		}
+
+		balance, err := s.getOrderPaymentsBalanceTx(ctx, tx, in.Payment.OrderId, in.CurrencyIso4217)
+		if err != nil {
+			return fmt.Errorf("get order payments balance: %w", err)
+		}
+		out.Allocation, err = allocate(balance)
+		if err != nil {
+			return err
+		}
+
+		if u := out.Allocation.OrderUpdate; u != nil {
+			if _, err := s.updateOrderTx(ctx, tx, *u); err != nil {
+				return fmt.Errorf("update order: %w", err)
+			}
+		}
+
+		record := in.Payment
+		record.RefundAmount = out.Allocation.RefundAmount
+		payments, err := paymentsValue([]CreatePaymentDTOInput{record})
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec(ctx, queryCreatePaymentMany, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$payments", payments),
+		))); err != nil {
+			if ydbpkg.IsUniqueConstraintViolation(err) {
+				return errPaymentExists
+			}
+			return fmt.Errorf("create payment: %w", err)
+		}
+
+		if record.RefundAmount == nil || *record.RefundAmount <= 0 {
+			return nil
+		}
+		if err := tx.Exec(ctx, queryCreateRefundMany, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$refunds", refundsValue([]CreateRefundDTOInput{{
+				PaymentId:       record.Id,
+				Id:              OverpaymentRefundId(record.Id),
+				OrderId:         record.OrderId,
+				Amount:          *record.RefundAmount,
+				CurrencyIso4217: record.CurrencyIso4217,
+				Provider:        in.Provider,
+				CreatedAt:       record.CreatedAt,
+			}})),
+		))); err != nil {
+			return fmt.Errorf("create overpayment refund: %w", err)
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, errPaymentExists) {
+			return ProcessPaymentDTOOutput{Duplicate: true}, nil
+		}
+		return ProcessPaymentDTOOutput{}, err
+	}
+
+	return out, nil
+}
+
+func (s *Orders) paymentExistsTx(ctx context.Context, tx query.TxActor, id string) (bool, error) {
+	res, err := tx.Query(ctx, queryGetPaymentId, query.WithParameters(table.NewQueryParameters(
+		table.ValueParam("$id", types.UTF8Value(id)),
+	)))
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = res.Close(ctx) }()
+
+	_, err = nextResultSetRow(ctx, res)
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// getOrderPaymentsBalanceTx returns nil if the order does not exist.
+func (s *Orders) getOrderPaymentsBalanceTx(ctx context.Context, tx query.TxActor, orderId string, currencyIso4217 uint32) (*OrderPaymentsBalance, error) {
+	res, err := tx.Query(ctx, queryListOrdersPaymentsBalances, query.WithParameters(table.NewQueryParameters(
+		table.ValueParam("$order_ids", types.ListValue(types.UTF8Value(orderId))),
+		table.ValueParam("$currency_iso_4217", types.Uint32Value(currencyIso4217)),
+	)))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close(ctx) }()
+
+	row, err := nextResultSetRow(ctx, res)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var balance OrderPaymentsBalance
+	var total *int64
+	var paid *int64
+	if err := row.ScanNamed(
+		query.Named("order_id", &balance.OrderId),
+		query.Named("status", &balance.Status),
+		query.Named("total", &total),
+		query.Named("paid", &paid),
+	); err != nil {
+		return nil, err
+	}
+	if total != nil {
+		balance.Total = *total
+	}
+	if paid != nil {
+		balance.Paid = *paid
+	}
+	return &balance, nil
 }
 
 var queryGetPayment = template.ReplaceAllPairs(`
@@ -334,19 +653,3 @@ func (s *Orders) UpdatePayment(ctx context.Context, in UpdatePaymentDTOInput) (*
 
 	return out, nil
 }
-
-func (s *Orders) ProduceProcessedPaymentsNotificationsMessages(ctx context.Context, messages ...oapi_codegen.PrivateOrderProcessPaymentNotificationsReqMessage) error {
-	dataBytes := make([][]byte, 0, len(messages))
-	for _, message := range messages {
-		msgBytes, err := json.Marshal(message)
-		if err != nil {
-			return fmt.Errorf("serialize processed payment notification message: %v", err)
-		}
-		dataBytes = append(dataBytes, msgBytes)
-	}
-
-	if err := ydbtopic.Produce(ctx, s.topicProcessedPaymentsNotifications, dataBytes...); err != nil {
-		return fmt.Errorf("publish processed payment notificaiton message: %v", err)
-	}
-	return nil
-}
@@ -0,0 +1,289 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	topicProductsPurchasesStats = "orders/products_purchases_stats_topic"
+
+	tableProductsPurchasesStats = "`orders/products_purchases_stats`"
+	tableJobWatermarks          = "`orders/job_watermarks`"
+
+	jobProductsPurchasesStats = "products_purchases_stats"
+)
+
+var queryGetJobWatermark = template.ReplaceAllPairs(`
+DECLARE $job AS Utf8;
+
+SELECT watermark
+FROM {{table.job_watermarks}}
+WHERE job = $job;
+`,
+	"{{table.job_watermarks}}",
+	tableJobWatermarks,
+)
+
+// Counters are updated by the status transitions made within ($from, $to]:
+//   - paid orders enter both counters;
+//   - orders cancelled after being paid leave the all-time counter,
+//     and the window counter if they were paid within the window;
+//   - orders paid within ($from - window, $to - window] and not cancelled by $to leave the window counter.
+//
+// Only products with changed counters are returned, so products whose counters drop to zero are returned as well.
+var queryRefreshProductsPurchasesStats = template.ReplaceAllPairs(`
+DECLARE $job AS Utf8;
+DECLARE $from AS Timestamp;
+DECLARE $to AS Timestamp;
+DECLARE $window_from AS Timestamp;
+DECLARE $window_to AS Timestamp;
+DECLARE $paid_status AS Utf8;
+DECLARE $cancelling_status AS Utf8;
+DECLARE $sold_statuses AS List<Utf8>;
+
+$paid = (
+  SELECT order_id
+  FROM {{table.order_status_history}} VIEW idx_status_created_at
+  WHERE status = $paid_status AND created_at > $from AND created_at <= $to
+);
+
+$cancelled_orders = (
+  SELECT DISTINCT order_id
+  FROM {{table.order_status_history}} VIEW idx_status_created_at
+  WHERE status = $cancelling_status AND from_status IN $sold_statuses AND created_at > $from AND created_at <= $to
+);
+$cancelled_paid_at = (
+  SELECT h.order_id AS order_id, MAX(h.created_at) AS paid_at
+  FROM $cancelled_orders AS c
+  JOIN {{table.order_status_history}} AS h ON h.order_id = c.order_id
+  WHERE h.status = $paid_status
+  GROUP BY h.order_id
+);
+$cancelled = (
+  SELECT c.order_id AS order_id, COALESCE(p.paid_at > $window_from, false) AS in_window
+  FROM $cancelled_orders AS c
+  LEFT JOIN $cancelled_paid_at AS p ON p.order_id = c.order_id
+);
+
+$window_exited_orders = (
+  SELECT DISTINCT order_id
+  FROM {{table.order_status_history}} VIEW idx_status_created_at
+  WHERE status = $paid_status AND created_at > $window_from AND created_at <= $window_to
+);
+$window_exited_cancelled = (
+  SELECT DISTINCT h.order_id AS order_id
+  FROM $window_exited_orders AS e
+  JOIN {{table.order_status_history}} AS h ON h.order_id = e.order_id
+  WHERE h.status = $cancelling_status AND h.from_status IN $sold_statuses AND h.created_at <= $to
+);
+$window_exited = (
+  SELECT e.order_id AS order_id
+  FROM $window_exited_orders AS e
+  LEFT ONLY JOIN $window_exited_cancelled AS c ON c.order_id = e.order_id
+);
+
+$deltas = (
+  SELECT product_id, SUM(alltime) AS alltime, SUM(window) AS window
+  FROM (
+    SELECT oi.product_id AS product_id, CAST(oi.count AS Int64) AS alltime, CAST(oi.count AS Int64) AS window
+    FROM $paid AS p
+    JOIN {{table.order_items}} AS oi ON oi.order_id = p.order_id
+    UNION ALL
+    SELECT oi.product_id AS product_id, -CAST(oi.count AS Int64) AS alltime, IF(c.in_window, -CAST(oi.count AS Int64), 0l) AS window
+    FROM $cancelled AS c
+    JOIN {{table.order_items}} AS oi ON oi.order_id = c.order_id
+    UNION ALL
+    SELECT oi.product_id AS product_id, 0l AS alltime, -CAST(oi.count AS Int64) AS window
+    FROM $window_exited AS e
+    JOIN {{table.order_items}} AS oi ON oi.order_id = e.order_id
+  )
+  GROUP BY product_id
+);
+
+$stats = (
+  SELECT
+    d.product_id AS product_id,
+    MAX_OF(COALESCE(s.purchases_alltime, 0l) + d.alltime, 0l) AS purchases_alltime,
+    MAX_OF(COALESCE(s.purchases_window, 0l) + d.window, 0l) AS purchases_window,
+  FROM $deltas AS d
+  LEFT JOIN {{table.products_purchases_stats}} AS s ON s.product_id = d.product_id
+  WHERE d.alltime != 0 OR d.window != 0
+);
+
+SELECT product_id, purchases_alltime, purchases_window
+FROM $stats;
+
+UPSERT INTO {{table.products_purchases_stats}}
+SELECT product_id, purchases_alltime, purchases_window, $to AS updated_at
+FROM $stats;
+
+UPSERT INTO {{table.job_watermarks}} (job, watermark)
+VALUES ($job, $to);
+`,
+	"{{table.order_status_history}}",
+	tableOrderStatusHistory,
+	"{{table.order_items}}",
+	tableOrderItems,
+	"{{table.products_purchases_stats}}",
+	tableProductsPurchasesStats,
+	"{{table.job_watermarks}}",
+	tableJobWatermarks,
+)
+
+var queryInitJobWatermark = template.ReplaceAllPairs(`
+DECLARE $job AS Utf8;
+DECLARE $watermark AS Timestamp;
+
+UPSERT INTO {{table.job_watermarks}} (job, watermark)
+VALUES ($job, $watermark);
+`,
+	"{{table.job_watermarks}}",
+	tableJobWatermarks,
+)
+
+type RefreshProductsPurchasesStatsDTOInput struct {
+	PaidStatus       string
+	CancellingStatus string
+	// Statuses of orders whose items are counted as sold.
+	SoldStatuses []string
+	Window       time.Duration
+	// Transitions are accounted up to the time, at most MaxStep past the watermark per call.
+	Until   time.Time
+	MaxStep time.Duration
+}
+type RefreshProductsPurchasesStatsDTOOutput struct {
+	// Number of products whose counters changed and were published.
+	Products  int
+	Watermark time.Time
+}
+
+// RefreshProductsPurchasesStats accounts status transitions made after the job watermark in the counters
+// and publishes the changed counters within the same transaction.
+// The job starts from the time of the first call unless the watermark is set by the migration.
+func (s *Orders) RefreshProductsPurchasesStats(ctx context.Context, in RefreshProductsPurchasesStatsDTOInput) (*RefreshProductsPurchasesStatsDTOOutput, error) {
+	soldStatuses := make([]types.Value, 0, len(in.SoldStatuses))
+	for _, status := range in.SoldStatuses {
+		soldStatuses = append(soldStatuses, types.UTF8Value(status))
+	}
+
+	var out *RefreshProductsPurchasesStatsDTOOutput
+
+	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		out = &RefreshProductsPurchasesStatsDTOOutput{}
+
+		from, err := s.getJobWatermarkTx(ctx, tx, jobProductsPurchasesStats)
+		if err != nil {
+			return fmt.Errorf("get job watermark: %w", err)
+		}
+		if from == nil {
+			out.Watermark = in.Until
+			res, err := tx.Execute(ctx, queryInitJobWatermark, table.NewQueryParameters(
+				table.ValueParam("$job", types.UTF8Value(jobProductsPurchasesStats)),
+				table.ValueParam("$watermark", types.TimestampValueFromTime(in.Until)),
+			))
+			if err != nil {
+				return fmt.Errorf("init job watermark: %w", err)
+			}
+			defer func() { _ = res.Close() }()
+			return res.Err()
+		}
+
+		to := in.Until
+		if in.MaxStep > 0 && to.Sub(*from) > in.MaxStep {
+			to = from.Add(in.MaxStep)
+		}
+		out.Watermark = *from
+		if !to.After(*from) {
+			return nil
+		}
+		out.Watermark = to
+
+		res, err := tx.Execute(ctx, queryRefreshProductsPurchasesStats, table.NewQueryParameters(
+			table.ValueParam("$job", types.UTF8Value(jobProductsPurchasesStats)),
+			table.ValueParam("$from", types.TimestampValueFromTime(*from)),
+			table.ValueParam("$to", types.TimestampValueFromTime(to)),
+			table.ValueParam("$window_from", types.TimestampValueFromTime(from.Add(-in.Window))),
+			table.ValueParam("$window_to", types.TimestampValueFromTime(to.Add(-in.Window))),
+			table.ValueParam("$paid_status", types.UTF8Value(in.PaidStatus)),
+			table.ValueParam("$cancelling_status", types.UTF8Value(in.CancellingStatus)),
+			table.ValueParam("$sold_statuses", types.ListValue(soldStatuses...)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		var messages []oapi_codegen.PrivateCatalogSyncProductsPurchasesReqMessage
+		if res.NextResultSet(ctx) {
+			if res.CurrentResultSet().Truncated() {
+				return fmt.Errorf("changed products purchases stats exceed the result set limit, decrease the step")
+			}
+			for res.NextRow() {
+				var productId string
+				var alltime, window int64
+				if err := res.ScanNamed(
+					named.Required("product_id", &productId),
+					named.Required("purchases_alltime", &alltime),
+					named.Required("purchases_window", &window),
+				); err != nil {
+					return err
+				}
+				messages = append(messages, oapi_codegen.PrivateCatalogSyncProductsPurchasesReqMessage{
+					ProductId:        productId,
+					PurchasesAlltime: int(alltime),
+					Purchases30d:     int(window),
+				})
+			}
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
+		out.Products = len(messages)
+
+		msgs, err := newProductsPurchasesStatsMessages(to, messages...)
+		if err != nil {
+			return err
+		}
+		return s.outbox.EnqueueTableTx(ctx, tx, msgs...)
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (s *Orders) getJobWatermarkTx(ctx context.Context, tx table.TransactionActor, job string) (*time.Time, error) {
+	res, err := tx.Execute(ctx, queryGetJobWatermark, table.NewQueryParameters(
+		table.ValueParam("$job", types.UTF8Value(job)),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close() }()
+
+	var watermark *time.Time
+	if res.NextResultSet(ctx) && res.NextRow() {
+		var t time.Time
+		if err := res.ScanNamed(named.Required("watermark", &t)); err != nil {
+			return nil, err
+		}
+		watermark = &t
+	}
+	return watermark, res.Err()
+}
+
+// Counters published at the watermark supersede the earlier ones of the product.
+func newProductsPurchasesStatsMessages(watermark time.Time, messages ...oapi_codegen.PrivateCatalogSyncProductsPurchasesReqMessage) ([]outbox.Message, error) {
+	return outbox.NewMessages(topicProductsPurchasesStats, func(m oapi_codegen.PrivateCatalogSyncProductsPurchasesReqMessage) string {
+		return dedupKey("product", m.ProductId, fmt.Sprintf("purchases_stats:%d", watermark.UnixMicro()))
+	}, messages...)
+}
@@ -0,0 +1,433 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tableRefunds = "`orders/refunds`"
+
+	RefundStatusPending    = "pending"
+	RefundStatusProcessing = "processing"
+	RefundStatusSucceeded  = "succeeded"
+	RefundStatusFailed     = "failed"
+)
+
+// CancellationRefundId identifies the refund of the payment amount kept by the shop for a cancelled order.
+func CancellationRefundId(paymentId string) string {
+	return paymentId + ":cancellation"
+}
+
+// ReturnRefundId identifies the refund of the return amount share of the payment.
+func ReturnRefundId(returnId, paymentId string) string {
+	return paymentId + ":return:" + returnId
+}
+
+// OverpaymentRefundId identifies the refund of the payment part flagged for refund when the payment is recorded.
+func OverpaymentRefundId(paymentId string) string {
+	return paymentId + ":overpayment"
+}
+
+var queryListOrdersUnrefundedPayments = template.ReplaceAllPairs(`
+DECLARE $order_ids AS List<Utf8>;
+
+-- The part of the payment flagged for refund is refunded separately
+SELECT
+  id,
+  order_id,
+  amount_minor - COALESCE(refund_amount_minor, 0l) AS amount,
+  currency_iso_4217,
+  provider,
+FROM {{table.payments}} VIEW idx_order_id
+WHERE
+  order_id IN $order_ids
+    AND
+  refunded_at IS NULL
+    AND
+  amount_minor > COALESCE(refund_amount_minor, 0l);
+`,
+	"{{table.payments}}",
+	tablePayments,
+)
+
+type UnrefundedPayment struct {
+	Id      string
+	OrderId string
+	// Amount is the part of the payment kept by the shop, in minor units.
+	Amount          int64
+	CurrencyIso4217 uint32
+	Provider        map[string]any
+}
+
+func (s *Orders) ListOrdersUnrefundedPayments(ctx context.Context, orderIds []string) ([]UnrefundedPayment, error) {
+	ids := make([]types.Value, 0, len(orderIds))
+	for _, id := range orderIds {
+		ids = append(ids, types.UTF8Value(id))
+	}
+
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	var out []UnrefundedPayment
+
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		out = make([]UnrefundedPayment, 0)
+
+		_, res, err := ss.Execute(ctx, readTx, queryListOrdersUnrefundedPayments, table.NewQueryParameters(
+			table.ValueParam("$order_ids", types.ListValue(ids...)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var payment UnrefundedPayment
+				var providerJsonData []byte
+				if err := res.ScanNamed(
+					named.Required("id", &payment.Id),
+					named.Required("order_id", &payment.OrderId),
+					named.Required("amount", &payment.Amount),
+					named.Required("currency_iso_4217", &payment.CurrencyIso4217),
+					named.Required("provider", &providerJsonData),
+				); err != nil {
+					return err
+				}
+				if err := json.Unmarshal(providerJsonData, &payment.Provider); err != nil {
+					return fmt.Errorf("deserialize payment provider data from database: %v", err)
+				}
+				out = append(out, payment)
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+var queryCreateRefundMany = template.ReplaceAllPairs(`
+DECLARE $refunds AS List<Struct<
+  payment_id:Utf8,
+  id:Utf8,
+  order_id:Utf8,
+  amount:Double,
+  amount_minor:Int64,
+  currency_iso_4217:Uint32,
+  provider:Utf8,
+  status:Utf8,
+  created_at:Timestamp,
+  updated_at:Timestamp,
+>>;
+
+-- Redelivered cancellations must not reset refunds that are already processed
+INSERT INTO {{table.refunds}}
+SELECT u.* FROM AS_TABLE($refunds) u
+LEFT ONLY JOIN {{table.refunds}} r ON r.payment_id = u.payment_id AND r.id = u.id;
+`,
+	"{{table.refunds}}",
+	tableRefunds,
+)
+
+type CreateRefundDTOInput struct {
+	PaymentId string
+	Id        string
+	OrderId   string
+	// Amount is in minor units of the payment currency.
+	Amount          int64
+	CurrencyIso4217 uint32
+	Provider        string
+	CreatedAt       time.Time
+}
+
+// CreateRefundMany creates pending refunds, refunds with the same ids are created once.
+func (s *Orders) CreateRefundMany(ctx context.Context, in []CreateRefundDTOInput) error {
+	return s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, queryCreateRefundMany, table.NewQueryParameters(
+			table.ValueParam("$refunds", refundsValue(in)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		return res.Err()
+	})
+}
+
+func refundsValue(in []CreateRefundDTOInput) types.Value {
+	rows := make([]types.Value, 0, len(in))
+	for _, refund := range in {
+		rows = append(rows, types.StructValue(
+			types.StructFieldValue("payment_id", types.UTF8Value(refund.PaymentId)),
+			types.StructFieldValue("id", types.UTF8Value(refund.Id)),
+			types.StructFieldValue("order_id", types.UTF8Value(refund.OrderId)),
+			types.StructFieldValue("amount", types.DoubleValue(FromMinorUnits(refund.Amount))),
+			types.StructFieldValue("amount_minor", types.Int64Value(refund.Amount)),
+			types.StructFieldValue("currency_iso_4217", types.Uint32Value(refund.CurrencyIso4217)),
+			types.StructFieldValue("provider", types.UTF8Value(refund.Provider)),
+			types.StructFieldValue("status", types.UTF8Value(RefundStatusPending)),
+			types.StructFieldValue("created_at", types.TimestampValueFromTime(refund.CreatedAt)),
+			types.StructFieldValue("updated_at", types.TimestampValueFromTime(refund.CreatedAt)),
+		))
+	}
+	return types.ListValue(rows...)
+}
+
+var queryClaimPendingRefunds = template.ReplaceAllPairs(`
+DECLARE $limit AS Uint64;
+DECLARE $updated_at AS Timestamp;
+DECLARE $claimed_until AS Timestamp;
+
+-- Secondary index is not used on purpose: stale reads could make a refund be processed twice.
+-- Refunds claimed before claims expired have no claimed_until.
+$pending = (
+  SELECT
+    payment_id,
+    id,
+    order_id,
+    amount_minor AS amount,
+    currency_iso_4217,
+    provider,
+  FROM {{table.refunds}}
+  WHERE
+    status = "{{status.pending}}"
+      OR
+    (status = "{{status.processing}}" AND COALESCE(claimed_until, updated_at) <= $updated_at)
+  LIMIT $limit
+);
+
+SELECT
+  r.payment_id AS payment_id,
+  r.id AS id,
+  r.order_id AS order_id,
+  r.amount AS amount,
+  r.currency_iso_4217 AS currency_iso_4217,
+  r.provider AS provider,
+  p.provider AS payment_provider,
+FROM $pending r
+JOIN {{table.payments}} p ON p.id = r.payment_id;
+
+UPDATE {{table.refunds}} ON
+SELECT
+  payment_id,
+  id,
+  "{{status.processing}}"u AS status,
+  $claimed_until AS claimed_until,
+  $updated_at AS updated_at,
+FROM $pending;
+`,
+	"{{table.refunds}}",
+	tableRefunds,
+	"{{table.payments}}",
+	tablePayments,
+	"{{status.pending}}",
+	RefundStatusPending,
+	"{{status.processing}}",
+	RefundStatusProcessing,
+)
+
+type ClaimedRefund struct {
+	PaymentId string
+	// Id identifies the refund to the payment provider, so a refund claimed again is not made twice.
+	Id      string
+	OrderId string
+	// Amount is in minor units of the payment currency.
+	Amount          int64
+	CurrencyIso4217 uint32
+	Provider        string
+	PaymentProvider map[string]any
+}
+
+// ClaimPendingRefunds moves pending refunds to "processing" status until claimedUntil so that concurrent runs
+// never call payment provider for the same refund. Processing refunds with expired claims are claimed again.
+func (s *Orders) ClaimPendingRefunds(ctx context.Context, limit uint64, updatedAt, claimedUntil time.Time) ([]ClaimedRefund, error) {
+	var out []ClaimedRefund
+
+	if err := s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		out = make([]ClaimedRefund, 0)
+
+		res, err := tx.Execute(ctx, queryClaimPendingRefunds, table.NewQueryParameters(
+			table.ValueParam("$limit", types.Uint64Value(limit)),
+			table.ValueParam("$updated_at", types.TimestampValueFromTime(updatedAt)),
+			table.ValueParam("$claimed_until", types.TimestampValueFromTime(claimedUntil)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var refund ClaimedRefund
+				var providerJsonData []byte
+				if err := res.ScanNamed(
+					named.Required("payment_id", &refund.PaymentId),
+					named.Required("id", &refund.Id),
+					named.Required("order_id", &refund.OrderId),
+					named.Required("amount", &refund.Amount),
+					named.Required("currency_iso_4217", &refund.CurrencyIso4217),
+					named.Required("provider", &refund.Provider),
+					named.Required("payment_provider", &providerJsonData),
+				); err != nil {
+					return err
+				}
+				if err := json.Unmarshal(providerJsonData, &refund.PaymentProvider); err != nil {
+					return fmt.Errorf("deserialize payment provider data from database: %v", err)
+				}
+				out = append(out, refund)
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+var queryUpdateRefund = template.ReplaceAllPairs(`
+DECLARE $payment_id AS Utf8;
+DECLARE $id AS Utf8;
+DECLARE $refunds_payment AS Bool;
+DECLARE $status AS Utf8;
+DECLARE $provider_refund_id AS Optional<Utf8>;
+DECLARE $details AS Optional<Utf8>;
+DECLARE $updated_at AS Timestamp;
+
+UPDATE {{table.refunds}}
+SET
+  status = $status,
+  provider_refund_id = $provider_refund_id,
+  details = $details,
+  updated_at = $updated_at
+WHERE payment_id = $payment_id AND id = $id;
+
+UPDATE {{table.payments}}
+SET
+  refunded_at = $updated_at,
+  updated_at = $updated_at
+WHERE
+  id = $payment_id
+    AND
+  $refunds_payment
+    AND
+  $status = "{{status.succeeded}}";
+`,
+	"{{table.refunds}}",
+	tableRefunds,
+	"{{table.payments}}",
+	tablePayments,
+	"{{status.succeeded}}",
+	RefundStatusSucceeded,
+)
+
+type UpdateRefundDTOInput struct {
+	PaymentId        string
+	Id               string
+	Status           string
+	ProviderRefundId *string
+	Details          *string
+	UpdatedAt        time.Time
+}
+
+// UpdateRefund sets refund status, succeeded cancellation refund marks its payment refunded.
+func (s *Orders) UpdateRefund(ctx context.Context, in UpdateRefundDTOInput) error {
+	return s.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, queryUpdateRefund, table.NewQueryParameters(
+			table.ValueParam("$payment_id", types.UTF8Value(in.PaymentId)),
+			table.ValueParam("$id", types.UTF8Value(in.Id)),
+			table.ValueParam("$refunds_payment", types.BoolValue(in.Id == CancellationRefundId(in.PaymentId))),
+			table.ValueParam("$status", types.UTF8Value(in.Status)),
+			table.ValueParam("$provider_refund_id", types.NullableUTF8Value(in.ProviderRefundId)),
+			table.ValueParam("$details", types.NullableUTF8Value(in.Details)),
+			table.ValueParam("$updated_at", types.TimestampValueFromTime(in.UpdatedAt)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		return res.Err()
+	})
+}
+
+var queryListRefundedCancellingOrders = template.ReplaceAllPairs(`
+DECLARE $order_ids AS List<Utf8>;
+
+-- Only the order_id is covered by the index, refunded_at is read from the main table
+$unrefunded = (
+  SELECT DISTINCT p.order_id AS order_id
+  FROM (
+    SELECT id
+    FROM {{table.payments}} VIEW idx_order_id
+    WHERE order_id IN $order_ids
+  ) i
+  JOIN {{table.payments}} p ON p.id = i.id
+  WHERE p.refunded_at IS NULL AND p.amount_minor > COALESCE(p.refund_amount_minor, 0l)
+);
+
+SELECT o.id AS id
+FROM {{table.orders}} o
+LEFT ONLY JOIN $unrefunded u ON u.order_id = o.id
+WHERE
+  o.id IN $order_ids
+    AND
+  o.status = "cancelling";
+`,
+	"{{table.orders}}",
+	tableOrders,
+	"{{table.payments}}",
+	tablePayments,
+)
+
+// ListRefundedCancellingOrders returns ids of "cancelling" orders without unrefunded payments.
+func (s *Orders) ListRefundedCancellingOrders(ctx context.Context, orderIds []string) ([]string, error) {
+	ids := make([]types.Value, 0, len(orderIds))
+	for _, id := range orderIds {
+		ids = append(ids, types.UTF8Value(id))
+	}
+
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	var out []string
+
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		out = make([]string, 0)
+
+		_, res, err := ss.Execute(ctx, readTx, queryListRefundedCancellingOrders, table.NewQueryParameters(
+			table.ValueParam("$order_ids", types.ListValue(ids...)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var id string
+				if err := res.ScanNamed(named.Required("id", &id)); err != nil {
+					return err
+				}
+				out = append(out, id)
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,1003 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tableReturns       = "`orders/returns`"
+	tableReturnItems   = "`orders/return_items`"
+	tableReturnRefunds = "`orders/return_refunds`"
+
+	ReturnStatusRequested = "requested"
+	ReturnStatusApproved  = "approved"
+	ReturnStatusRejected  = "rejected"
+	ReturnStatusCancelled = "cancelled"
+	ReturnStatusReceived  = "received"
+	ReturnStatusRefunded  = "refunded"
+)
+
+var (
+	ErrReturnNotDelivered       = errors.New("order items of the seller are not delivered")
+	ErrReturnItemNotInShipment  = errors.New("product is not in the seller shipment")
+	ErrReturnCountExceeded      = errors.New("returned count exceeds count of ordered items left to return")
+	ErrReturnStatusConflict     = errors.New("return status was changed concurrently")
+	ErrReturnPhotosLimitReached = errors.New("return photos limit is reached")
+)
+
+var queryListReturns = template.ReplaceAllPairs(`
+DECLARE $order_id AS Utf8;
+DECLARE $id AS Optional<Utf8>;
+
+SELECT
+  order_id,
+  id,
+  user_id,
+  seller_id,
+  status,
+  reason,
+  comment,
+  refund_amount,
+  photos,
+  created_at,
+  updated_at,
+FROM {{table.returns}}
+WHERE
+  order_id = $order_id
+    AND
+  ($id IS NULL OR id = $id)
+ORDER BY created_at;
+
+SELECT
+  r.return_id AS return_id,
+  r.product_id AS product_id,
+  r.sku_id AS sku_id,
+  r.count AS count,
+  i.name AS name,
+  i.price AS price,
+FROM {{table.return_items}} r
+JOIN {{table.order_items}} i ON i.order_id = r.order_id AND i.product_id = r.product_id AND i.sku_id = r.sku_id
+WHERE
+  r.order_id = $order_id
+    AND
+  ($id IS NULL OR r.return_id = $id);
+`,
+	"{{table.returns}}",
+	tableReturns,
+	"{{table.return_items}}",
+	tableReturnItems,
+	"{{table.order_items}}",
+	tableOrderItems,
+)
+
+// ListReturns lists returns of the order, oldest first.
+// Allowed transitions of the returns are left empty.
+func (s *Orders) ListReturns(ctx context.Context, orderId string) ([]oapi_codegen.OrdersReturn, error) {
+	return s.listReturns(ctx, orderId, nil)
+}
+
+// GetReturn returns nil if the order has no such return.
+func (s *Orders) GetReturn(ctx context.Context, orderId, returnId string) (*oapi_codegen.OrdersReturn, error) {
+	returns, err := s.listReturns(ctx, orderId, &returnId)
+	if err != nil {
+		return nil, err
+	}
+	if len(returns) == 0 {
+		return nil, nil
+	}
+	return &returns[0], nil
+}
+
+func (s *Orders) listReturns(ctx context.Context, orderId string, returnId *string) ([]oapi_codegen.OrdersReturn, error) {
+	var out []oapi_codegen.OrdersReturn
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		res, err := tx.Query(ctx, queryListReturns, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$order_id", types.UTF8Value(orderId)),
+			table.ValueParam("$id", types.NullableUTF8Value(returnId)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		out, err = readReturns(ctx, res)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// readReturns reads returns and their items from the next two result sets.
+func readReturns(ctx context.Context, res query.Result) ([]oapi_codegen.OrdersReturn, error) {
+	rs, err := res.NextResultSet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("returns result set: %w", err)
+	}
+
+	returns := make([]oapi_codegen.OrdersReturn, 0)
+	returnIdxs := make(map[string]int)
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var ret oapi_codegen.OrdersReturn
+		var photosJsonData []byte
+		var createdAt, updatedAt time.Time
+		if err := row.ScanNamed(
+			query.Named("order_id", &ret.OrderId),
+			query.Named("id", &ret.Id),
+			query.Named("user_id", &ret.UserId),
+			query.Named("seller_id", &ret.SellerId),
+			query.Named("status", &ret.Status),
+			query.Named("reason", &ret.Reason),
+			query.Named("comment", &ret.Comment),
+			query.Named("refund_amount", &ret.RefundAmount),
+			query.Named("photos", &photosJsonData),
+			query.Named("created_at", &createdAt),
+			query.Named("updated_at", &updatedAt),
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(photosJsonData, &ret.Photos); err != nil {
+			return nil, fmt.Errorf("deserialize return photos from database: %v", err)
+		}
+		ret.CreatedAt = createdAt.Format(time.RFC3339)
+		ret.UpdatedAt = updatedAt.Format(time.RFC3339)
+		ret.Items = make([]oapi_codegen.OrdersReturnItem, 0)
+		ret.AllowedTransitions = make([]string, 0)
+
+		returnIdxs[ret.Id] = len(returns)
+		returns = append(returns, ret)
+	}
+
+	rs, err = res.NextResultSet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("return items result set: %w", err)
+	}
+	for {
+		row, err := rs.NextRow(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var returnId, skuId string
+		var count uint32
+		var item oapi_codegen.OrdersReturnItem
+		if err := row.ScanNamed(
+			query.Named("return_id", &returnId),
+			query.Named("product_id", &item.ProductId),
+			query.Named("sku_id", &skuId),
+			query.Named("count", &count),
+			query.Named("name", &item.Name),
+			query.Named("price", &item.Price),
+		); err != nil {
+			return nil, err
+		}
+		item.Count = int(count)
+		item.SkuId = skuIdFromKey(skuId)
+
+		if idx, ok := returnIdxs[returnId]; ok {
+			returns[idx].Items = append(returns[idx].Items, item)
+		}
+	}
+
+	return returns, nil
+}
+
+// Items of rejected and cancelled returns may be returned again.
+var queryListReturnableItems = template.ReplaceAllPairs(`
+DECLARE $order_id AS Utf8;
+DECLARE $seller_id AS Utf8;
+
+SELECT
+  o.status AS order_status,
+  s.status AS shipment_status,
+FROM {{table.orders}} o
+JOIN {{table.shipments}} s ON s.order_id = o.id
+WHERE
+  o.id = $order_id
+    AND
+  s.seller_id = $seller_id;
+
+$returned = (
+  SELECT
+    i.product_id AS product_id,
+    i.sku_id AS sku_id,
+    SUM(i.count) AS count,
+  FROM {{table.return_items}} i
+  JOIN {{table.returns}} r ON r.order_id = i.order_id AND r.id = i.return_id
+  WHERE
+    i.order_id = $order_id
+      AND
+    r.status NOT IN ("{{status.rejected}}", "{{status.cancelled}}")
+  GROUP BY i.product_id, i.sku_id
+);
+
+SELECT
+  i.product_id AS product_id,
+  i.sku_id AS sku_id,
+  CAST(i.count AS Int64) - CAST(r.count ?? 0 AS Int64) AS count,
+  i.price_minor AS price,
+FROM {{table.order_items}} i
+LEFT JOIN $returned r ON r.product_id = i.product_id AND r.sku_id = i.sku_id
+WHERE
+  i.order_id = $order_id
+    AND
+  i.seller_id = $seller_id;
+`,
+	"{{table.orders}}",
+	tableOrders,
+	"{{table.shipments}}",
+	tableShipments,
+	"{{table.order_items}}",
+	tableOrderItems,
+	"{{table.returns}}",
+	tableReturns,
+	"{{table.return_items}}",
+	tableReturnItems,
+	"{{status.rejected}}",
+	ReturnStatusRejected,
+	"{{status.cancelled}}",
+	ReturnStatusCancelled,
+)
+
+var queryCreateReturn = template.ReplaceAllPairs(`
+DECLARE $order_id AS Utf8;
+DECLARE $id AS Utf8;
+DECLARE $user_id AS Utf8;
+DECLARE $seller_id AS Utf8;
+DECLARE $reason AS Utf8;
+DECLARE $refund_amount AS Double;
+DECLARE $refund_amount_minor AS Int64;
+DECLARE $created_at AS Timestamp;
+DECLARE $items AS List<Struct<
+  product_id:Utf8,
+  sku_id:Utf8,
+  count:Uint32,
+>>;
+
+INSERT INTO {{table.returns}} (order_id, id, user_id, seller_id, status, reason, refund_amount, refund_amount_minor, photos, created_at, updated_at)
+VALUES ($order_id, $id, $user_id, $seller_id, "{{status.requested}}"u, $reason, $refund_amount, $refund_amount_minor, Json("[]"), $created_at, $created_at);
+
+INSERT INTO {{table.return_items}}
+SELECT
+  $order_id AS order_id,
+  $id AS return_id,
+  product_id,
+  sku_id,
+  count,
+FROM AS_TABLE($items);
+`,
+	"{{table.returns}}",
+	tableReturns,
+	"{{table.return_items}}",
+	tableReturnItems,
+	"{{status.requested}}",
+	ReturnStatusRequested,
+)
+
+type CreateReturnDTOInput struct {
+	Id        string
+	OrderId   string
+	UserId    string
+	SellerId  string
+	Reason    string
+	Items     []CreateReturnDTOInputItem
+	CreatedAt time.Time
+}
+type CreateReturnDTOInputItem struct {
+	ProductId string
+	SkuId     *string
+	Count     int
+}
+
+// CreateReturn requests return of delivered items of the seller shipment.
+// Returned items are checked against the items left to return in the same transaction,
+// refund amount is the price of returned items.
+func (s *Orders) CreateReturn(ctx context.Context, in CreateReturnDTOInput) error {
+	var validationErr error
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		validationErr = nil
+
+		res, err := tx.Query(ctx, queryListReturnableItems, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$order_id", types.UTF8Value(in.OrderId)),
+			table.ValueParam("$seller_id", types.UTF8Value(in.SellerId)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		row, err := nextResultSetRow(ctx, res)
+		if errors.Is(err, io.EOF) {
+			validationErr = ErrReturnNotDelivered
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var orderStatus, shipmentStatus string
+		if err := row.ScanNamed(
+			query.Named("order_status", &orderStatus),
+			query.Named("shipment_status", &shipmentStatus),
+		); err != nil {
+			return err
+		}
+		if orderStatus != "delivered" || shipmentStatus != ShipmentStatusDelivered {
+			validationErr = ErrReturnNotDelivered
+			return nil
+		}
+
+		type returnableKey struct {
+			productId string
+			skuId     string
+		}
+		type returnable struct {
+			count int64
+			price int64
+		}
+		returnables := make(map[returnableKey]returnable)
+		rs, err := res.NextResultSet(ctx)
+		if err != nil {
+			return err
+		}
+		for {
+			row, err := rs.NextRow(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			var key returnableKey
+			var r returnable
+			if err := row.ScanNamed(
+				query.Named("product_id", &key.productId),
+				query.Named("sku_id", &key.skuId),
+				query.Named("count", &r.count),
+				query.Named("price", &r.price),
+			); err != nil {
+				return err
+			}
+			returnables[key] = r
+		}
+
+		var refundAmount int64
+		items := make([]types.Value, 0, len(in.Items))
+		for _, item := range in.Items {
+			r, ok := returnables[returnableKey{productId: item.ProductId, skuId: deref(item.SkuId)}]
+			if !ok {
+				validationErr = fmt.Errorf(`%w: "%s"%s`, ErrReturnItemNotInShipment, item.ProductId, skuSuffix(item.SkuId))
+				return nil
+			}
+			if item.Count > int(r.count) {
+				validationErr = fmt.Errorf(`%w: product "%s"%s, %d left`, ErrReturnCountExceeded, item.ProductId, skuSuffix(item.SkuId), max(r.count, 0))
+				return nil
+			}
+			refundAmount += r.price * int64(item.Count)
+			items = append(items, types.StructValue(
+				types.StructFieldValue("product_id", types.UTF8Value(item.ProductId)),
+				types.StructFieldValue("sku_id", types.UTF8Value(deref(item.SkuId))),
+				types.StructFieldValue("count", types.Uint32Value(uint32(item.Count))),
+			))
+		}
+
+		return tx.Exec(ctx, queryCreateReturn, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$order_id", types.UTF8Value(in.OrderId)),
+			table.ValueParam("$id", types.UTF8Value(in.Id)),
+			table.ValueParam("$user_id", types.UTF8Value(in.UserId)),
+			table.ValueParam("$seller_id", types.UTF8Value(in.SellerId)),
+			table.ValueParam("$reason", types.UTF8Value(in.Reason)),
+			table.ValueParam("$refund_amount", types.DoubleValue(FromMinorUnits(refundAmount))),
+			table.ValueParam("$refund_amount_minor", types.Int64Value(refundAmount)),
+			table.ValueParam("$created_at", types.TimestampValueFromTime(in.CreatedAt)),
+			table.ValueParam("$items", types.ListValue(items...)),
+		)))
+	}); err != nil {
+		return err
+	}
+
+	return validationErr
+}
+
+// skuSuffix describes the sku of the returned item in errors.
+func skuSuffix(skuId *string) string {
+	if skuId == nil {
+		return ""
+	}
+	return fmt.Sprintf(` sku "%s"`, *skuId)
+}
+
+var queryGetReturnRefundState = template.ReplaceAllPairs(`
+DECLARE $order_id AS Utf8;
+DECLARE $id AS Utf8;
+
+SELECT
+  status,
+  refund_amount_minor AS refund_amount,
+FROM {{table.returns}}
+WHERE
+  order_id = $order_id
+    AND
+  id = $id;
+
+SELECT SUM(refund_amount_minor) AS refunded_amount
+FROM {{table.returns}}
+WHERE
+  order_id = $order_id
+    AND
+  status IN ("{{status.received}}", "{{status.refunded}}");
+`,
+	"{{table.returns}}",
+	tableReturns,
+	"{{status.received}}",
+	ReturnStatusReceived,
+	"{{status.refunded}}",
+	ReturnStatusRefunded,
+)
+
+var queryUpdateReturn = template.ReplaceAllPairs(`
+DECLARE $order_id AS Utf8;
+DECLARE $id AS Utf8;
+DECLARE $status AS Utf8;
+DECLARE $comment AS Optional<Utf8>;
+DECLARE $updated_at AS Timestamp;
+
+UPDATE {{table.returns}}
+SET
+  status = $status,
+  comment = $comment ?? comment,
+  updated_at = $updated_at
+WHERE
+  order_id = $order_id
+    AND
+  id = $id;
+`,
+	"{{table.returns}}",
+	tableReturns,
+)
+
+var queryCreateReturnRefundMany = template.ReplaceAllPairs(`
+DECLARE $refunds AS List<Struct<
+  return_id:Utf8,
+  payment_id:Utf8,
+  order_id:Utf8,
+  amount:Double,
+  amount_minor:Int64,
+  currency_iso_4217:Uint32,
+  provider:Utf8,
+  status:Utf8,
+  created_at:Timestamp,
+  updated_at:Timestamp,
+>>;
+
+INSERT INTO {{table.return_refunds}}
+SELECT * FROM AS_TABLE($refunds);
+`,
+	"{{table.return_refunds}}",
+	tableReturnRefunds,
+)
+
+type UpdateReturnDTOInput struct {
+	OrderId  string
+	ReturnId string
+	// FromStatus guards against concurrent updates of the return.
+	FromStatus string
+	Status     string
+	Comment    *string
+	UpdatedAt  time.Time
+	// RefundPayments are the order payments the return amount is refunded from, nothing is refunded if empty.
+	RefundPayments []ReturnRefundPayment
+	// Messages are published in the same transaction as the new return status.
+	Messages []outbox.Message
+}
+
+type ReturnRefundPayment struct {
+	Id string
+	// Amount is the part of the payment kept by the shop, in minor units.
+	Amount          int64
+	CurrencyIso4217 uint32
+	// Provider is the name of the payment provider.
+	Provider string
+}
+
+// UpdateReturn sets the return status. Refunds of the return amount are created in the same transaction:
+// the amount is split between payments in the order of their ids, less the amount of previously refunded returns.
+func (s *Orders) UpdateReturn(ctx context.Context, in UpdateReturnDTOInput) error {
+	var applied bool
+
+	payments := slices.Clone(in.RefundPayments)
+	slices.SortFunc(payments, func(a, b ReturnRefundPayment) int {
+		if a.Id < b.Id {
+			return -1
+		}
+		if a.Id > b.Id {
+			return 1
+		}
+		return 0
+	})
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		applied = false
+
+		res, err := tx.Query(ctx, queryGetReturnRefundState, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$order_id", types.UTF8Value(in.OrderId)),
+			table.ValueParam("$id", types.UTF8Value(in.ReturnId)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		row, err := nextResultSetRow(ctx, res)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var status string
+		var refundAmount int64
+		if err := row.ScanNamed(
+			query.Named("status", &status),
+			query.Named("refund_amount", &refundAmount),
+		); err != nil {
+			return err
+		}
+		if status != in.FromStatus {
+			return nil
+		}
+		applied = true
+
+		row, err = nextResultSetRow(ctx, res)
+		if err != nil {
+			return err
+		}
+		var refundedAmount *int64
+		if err := row.ScanNamed(query.Named("refunded_amount", &refundedAmount)); err != nil {
+			return err
+		}
+
+		refunds := make([]types.Value, 0, len(payments))
+		// Previously refunded returns took the same payments in the same order.
+		skip := int64(0)
+		if refundedAmount != nil {
+			skip = *refundedAmount
+		}
+		left := refundAmount
+		for _, p := range payments {
+			if left <= 0 {
+				break
+			}
+			available := p.Amount - skip
+			skip = max(skip-p.Amount, 0)
+			if available <= 0 {
+				continue
+			}
+			amount := min(available, left)
+			left -= amount
+
+			refunds = append(refunds, types.StructValue(
+				types.StructFieldValue("return_id", types.UTF8Value(in.ReturnId)),
+				types.StructFieldValue("payment_id", types.UTF8Value(p.Id)),
+				types.StructFieldValue("order_id", types.UTF8Value(in.OrderId)),
+				types.StructFieldValue("amount", types.DoubleValue(FromMinorUnits(amount))),
+				types.StructFieldValue("amount_minor", types.Int64Value(amount)),
+				types.StructFieldValue("currency_iso_4217", types.Uint32Value(p.CurrencyIso4217)),
+				types.StructFieldValue("provider", types.UTF8Value(p.Provider)),
+				types.StructFieldValue("status", types.UTF8Value(RefundStatusPending)),
+				types.StructFieldValue("created_at", types.TimestampValueFromTime(in.UpdatedAt)),
+				types.StructFieldValue("updated_at", types.TimestampValueFromTime(in.UpdatedAt)),
+			))
+		}
+
+		if err := tx.Exec(ctx, queryUpdateReturn, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$order_id", types.UTF8Value(in.OrderId)),
+			table.ValueParam("$id", types.UTF8Value(in.ReturnId)),
+			table.ValueParam("$status", types.UTF8Value(in.Status)),
+			table.ValueParam("$comment", types.NullableUTF8Value(in.Comment)),
+			table.ValueParam("$updated_at", types.TimestampValueFromTime(in.UpdatedAt)),
+		))); err != nil {
+			return err
+		}
+		if len(refunds) > 0 {
+			if err := tx.Exec(ctx, queryCreateReturnRefundMany, query.WithParameters(table.NewQueryParameters(
+				table.ValueParam("$refunds", types.ListValue(refunds...)),
+			))); err != nil {
+				return err
+			}
+		}
+
+		if err := s.outbox.EnqueueTx(ctx, tx, in.Messages...); err != nil {
+			return fmt.Errorf("enqueue return messages: %v", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if !applied {
+		return ErrReturnStatusConflict
+	}
+	return nil
+}
+
+var queryGetReturnPhotos = template.ReplaceAllPairs(`
+DECLARE $order_id AS Utf8;
+DECLARE $id AS Utf8;
+
+SELECT
+  status,
+  photos,
+FROM {{table.returns}}
+WHERE
+  order_id = $order_id
+    AND
+  id = $id;
+`,
+	"{{table.returns}}",
+	tableReturns,
+)
+
+var queryUpdateReturnPhotos = template.ReplaceAllPairs(`
+DECLARE $order_id AS Utf8;
+DECLARE $id AS Utf8;
+DECLARE $photos AS Json;
+DECLARE $updated_at AS Timestamp;
+
+UPDATE {{table.returns}}
+SET
+  photos = $photos,
+  updated_at = $updated_at
+WHERE
+  order_id = $order_id
+    AND
+  id = $id;
+`,
+	"{{table.returns}}",
+	tableReturns,
+)
+
+type AddReturnPhotoDTOInput struct {
+	OrderId  string
+	ReturnId string
+	// FromStatus is the only return status photos may be added in.
+	FromStatus string
+	Photo      oapi_codegen.OrdersReturnPhoto
+	// Limit is the max count of the return photos.
+	Limit     int
+	UpdatedAt time.Time
+}
+
+// AddReturnPhoto appends the uploaded photo to the return photos.
+func (s *Orders) AddReturnPhoto(ctx context.Context, in AddReturnPhotoDTOInput) error {
+	var validationErr error
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		validationErr = nil
+
+		res, err := tx.Query(ctx, queryGetReturnPhotos, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$order_id", types.UTF8Value(in.OrderId)),
+			table.ValueParam("$id", types.UTF8Value(in.ReturnId)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		row, err := nextResultSetRow(ctx, res)
+		if errors.Is(err, io.EOF) {
+			validationErr = ErrReturnStatusConflict
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var status string
+		var photosJsonData []byte
+		if err := row.ScanNamed(
+			query.Named("status", &status),
+			query.Named("photos", &photosJsonData),
+		); err != nil {
+			return err
+		}
+		if status != in.FromStatus {
+			validationErr = ErrReturnStatusConflict
+			return nil
+		}
+
+		var photos []oapi_codegen.OrdersReturnPhoto
+		if err := json.Unmarshal(photosJsonData, &photos); err != nil {
+			return fmt.Errorf("deserialize return photos from database: %v", err)
+		}
+		if len(photos) >= in.Limit {
+			validationErr = ErrReturnPhotosLimitReached
+			return nil
+		}
+		photosJsonData, err = json.Marshal(append(photos, in.Photo))
+		if err != nil {
+			return fmt.Errorf("serialize return photos: %v", err)
+		}
+
+		return tx.Exec(ctx, queryUpdateReturnPhotos, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$order_id", types.UTF8Value(in.OrderId)),
+			table.ValueParam("$id", types.UTF8Value(in.ReturnId)),
+			table.ValueParam("$photos", types.JSONValueFromBytes(photosJsonData)),
+			table.ValueParam("$updated_at", types.TimestampValueFromTime(in.UpdatedAt)),
+		)))
+	}); err != nil {
+		return err
+	}
+
+	return validationErr
+}
+
+var queryClaimPendingReturnRefunds = template.ReplaceAllPairs(`
+DECLARE $limit AS Uint64;
+DECLARE $updated_at AS Timestamp;
+DECLARE $claimed_until AS Timestamp;
+
+-- Secondary index is not used on purpose: stale reads could make a refund be processed twice.
+-- Refunds claimed before claims expired have no claimed_until.
+$pending = (
+  SELECT
+    return_id,
+    payment_id,
+    order_id,
+    amount_minor AS amount,
+    currency_iso_4217,
+    provider,
+  FROM {{table.return_refunds}}
+  WHERE
+    status = "{{status.pending}}"
+      OR
+    (status = "{{status.processing}}" AND COALESCE(claimed_until, updated_at) <= $updated_at)
+  LIMIT $limit
+);
+
+SELECT
+  r.return_id AS return_id,
+  r.payment_id AS payment_id,
+  r.order_id AS order_id,
+  r.amount AS amount,
+  r.currency_iso_4217 AS currency_iso_4217,
+  r.provider AS provider,
+  p.provider AS payment_provider,
+FROM $pending r
+JOIN {{table.payments}} p ON p.id = r.payment_id;
+
+UPDATE {{table.return_refunds}} ON
+SELECT
+  return_id,
+  payment_id,
+  "{{status.processing}}"u AS status,
+  $claimed_until AS claimed_until,
+  $updated_at AS updated_at,
+FROM $pending;
+`,
+	"{{table.return_refunds}}",
+	tableReturnRefunds,
+	"{{table.payments}}",
+	tablePayments,
+	"{{status.pending}}",
+	RefundStatusPending,
+	"{{status.processing}}",
+	RefundStatusProcessing,
+)
+
+type ClaimedReturnRefund struct {
+	ReturnId string
+	ClaimedRefund
+}
+
+// ClaimPendingReturnRefunds moves pending return refunds to "processing" status until claimedUntil so that concurrent runs
+// never call payment provider for the same refund. Processing refunds with expired claims are claimed again.
+func (s *Orders) ClaimPendingReturnRefunds(ctx context.Context, limit uint64, updatedAt, claimedUntil time.Time) ([]ClaimedReturnRefund, error) {
+	var out []ClaimedReturnRefund
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		out = make([]ClaimedReturnRefund, 0)
+
+		res, err := tx.Query(ctx, queryClaimPendingReturnRefunds, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$limit", types.Uint64Value(limit)),
+			table.ValueParam("$updated_at", types.TimestampValueFromTime(updatedAt)),
+			table.ValueParam("$claimed_until", types.TimestampValueFromTime(claimedUntil)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		rs, err := res.NextResultSet(ctx)
+		if err != nil {
+			return err
+		}
+		for {
+			row, err := rs.NextRow(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			var refund ClaimedReturnRefund
+			var providerJsonData []byte
+			if err := row.ScanNamed(
+				query.Named("return_id", &refund.ReturnId),
+				query.Named("payment_id", &refund.PaymentId),
+				query.Named("order_id", &refund.OrderId),
+				query.Named("amount", &refund.Amount),
+				query.Named("currency_iso_4217", &refund.CurrencyIso4217),
+				query.Named("provider", &refund.Provider),
+				query.Named("payment_provider", &providerJsonData),
+			); err != nil {
+				return err
+			}
+			if err := json.Unmarshal(providerJsonData, &refund.PaymentProvider); err != nil {
+				return fmt.Errorf("deserialize payment provider data from database: %v", err)
+			}
+			refund.Id = ReturnRefundId(refund.ReturnId, refund.PaymentId)
+			out = append(out, refund)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+var queryUpdateReturnRefund = template.ReplaceAllPairs(`
+DECLARE $return_id AS Utf8;
+DECLARE $payment_id AS Utf8;
+DECLARE $status AS Utf8;
+DECLARE $provider_refund_id AS Optional<Utf8>;
+DECLARE $details AS Optional<Utf8>;
+DECLARE $updated_at AS Timestamp;
+
+UPDATE {{table.return_refunds}}
+SET
+  status = $status,
+  provider_refund_id = $provider_refund_id,
+  details = $details,
+  updated_at = $updated_at
+WHERE
+  return_id = $return_id
+    AND
+  payment_id = $payment_id;
+`,
+	"{{table.return_refunds}}",
+	tableReturnRefunds,
+)
+
+type UpdateReturnRefundDTOInput struct {
+	ReturnId string
+	UpdateRefundDTOInput
+}
+
+// UpdateReturnRefund sets return refund status, payment stays unrefunded since the refund is partial.
+func (s *Orders) UpdateReturnRefund(ctx context.Context, in UpdateReturnRefundDTOInput) error {
+	return s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		return tx.Exec(ctx, queryUpdateReturnRefund, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$return_id", types.UTF8Value(in.ReturnId)),
+			table.ValueParam("$payment_id", types.UTF8Value(in.PaymentId)),
+			table.ValueParam("$status", types.UTF8Value(in.Status)),
+			table.ValueParam("$provider_refund_id", types.NullableUTF8Value(in.ProviderRefundId)),
+			table.ValueParam("$details", types.NullableUTF8Value(in.Details)),
+			table.ValueParam("$updated_at", types.TimestampValueFromTime(in.UpdatedAt)),
+		)))
+	})
+}
+
+var queryListRefundedReceivedReturns = template.ReplaceAllPairs(`
+DECLARE $returns AS List<Struct<
+  order_id:Utf8,
+  id:Utf8,
+>>;
+
+$unrefunded = (
+  SELECT DISTINCT f.return_id AS return_id
+  FROM AS_TABLE($returns) k
+  JOIN {{table.return_refunds}} f ON f.return_id = k.id
+  WHERE f.status != "{{refund_status.succeeded}}"
+);
+
+SELECT
+  r.order_id AS order_id,
+  r.id AS id,
+FROM AS_TABLE($returns) k
+JOIN {{table.returns}} r ON r.order_id = k.order_id AND r.id = k.id
+LEFT ONLY JOIN $unrefunded u ON u.return_id = r.id
+WHERE r.status = "{{status.received}}";
+`,
+	"{{table.returns}}",
+	tableReturns,
+	"{{table.return_refunds}}",
+	tableReturnRefunds,
+	"{{refund_status.succeeded}}",
+	RefundStatusSucceeded,
+	"{{status.received}}",
+	ReturnStatusReceived,
+)
+
+type ReturnKey struct {
+	OrderId string
+	Id      string
+}
+
+// ListRefundedReceivedReturns returns keys of "received" returns with all refunds succeeded.
+func (s *Orders) ListRefundedReceivedReturns(ctx context.Context, keys []ReturnKey) ([]ReturnKey, error) {
+	rows := make([]types.Value, 0, len(keys))
+	for _, k := range keys {
+		rows = append(rows, types.StructValue(
+			types.StructFieldValue("order_id", types.UTF8Value(k.OrderId)),
+			types.StructFieldValue("id", types.UTF8Value(k.Id)),
+		))
+	}
+
+	var out []ReturnKey
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		out = make([]ReturnKey, 0)
+
+		res, err := tx.Query(ctx, queryListRefundedReceivedReturns, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$returns", types.ListValue(rows...)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		rs, err := res.NextResultSet(ctx)
+		if err != nil {
+			return err
+		}
+		for {
+			row, err := rs.NextRow(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			var k ReturnKey
+			if err := row.ScanNamed(
+				query.Named("order_id", &k.OrderId),
+				query.Named("id", &k.Id),
+			); err != nil {
+				return err
+			}
+			out = append(out, k)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
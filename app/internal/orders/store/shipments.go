@@ -0,0 +1,551 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/orders/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/bratushkadan/floral/pkg/token"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	ShipmentStatusCreated   = "created"
+	ShipmentStatusProcessed = "processed"
+	ShipmentStatusShipped   = "shipped"
+	ShipmentStatusDelivered = "delivered"
+	ShipmentStatusCompleted = "completed"
+	ShipmentStatusCancelled = "cancelled"
+)
+
+var (
+	ErrShipmentStatusConflict = errors.New("shipment status was changed concurrently")
+)
+
+// Order status is the status of its least advanced active shipment,
+// it's derived only for orders in fulfillment ("paid" order has all shipments "created" or more advanced).
+var queryUpdateShipment = template.ReplaceAllPairs(`
+DECLARE $order_id AS Utf8;
+DECLARE $seller_id AS Utf8;
+DECLARE $from_status AS Utf8;
+DECLARE $status AS Utf8;
+DECLARE $tracking_number AS Optional<Utf8>;
+DECLARE $carrier AS Optional<Utf8>;
+DECLARE $carrier_delivered_at AS Optional<Timestamp>;
+DECLARE $updated_at AS Timestamp;
+DECLARE $history_id AS Utf8;
+DECLARE $actor_type AS Utf8;
+DECLARE $actor_id AS Utf8;
+DECLARE $reason AS Utf8;
+
+$current_status = (
+  SELECT status
+  FROM {{table.shipments}}
+  WHERE order_id = $order_id AND seller_id = $seller_id
+);
+$applied = ($current_status = $from_status) ?? false;
+
+$rank = ($s) -> {
+  RETURN CASE $s
+    WHEN "{{shipment_status.created}}" THEN 0
+    WHEN "{{shipment_status.processed}}" THEN 1
+    WHEN "{{shipment_status.shipped}}" THEN 2
+    WHEN "{{shipment_status.delivered}}" THEN 3
+    WHEN "{{shipment_status.completed}}" THEN 4
+    ELSE NULL
+  END;
+};
+
+$min_rank = (
+  SELECT MIN($rank(IF(seller_id = $seller_id, $status, status)))
+  FROM {{table.shipments}}
+  WHERE order_id = $order_id
+);
+
+$derived_order_status = CASE $min_rank
+  WHEN 0 THEN "paid"u
+  WHEN 1 THEN "processed"u
+  WHEN 2 THEN "shipped"u
+  WHEN 3 THEN "delivered"u
+  WHEN 4 THEN "completed"u
+  ELSE NULL
+END;
+
+$previous_order_status = (
+  SELECT status
+  FROM {{table.orders}}
+  WHERE id = $order_id
+);
+$order_in_fulfillment = ($previous_order_status IN ("paid", "processed", "shipped", "delivered")) ?? false;
+$order_status = IF($applied AND $order_in_fulfillment, $derived_order_status ?? $previous_order_status, $previous_order_status);
+
+SELECT
+  $applied AS applied,
+  $previous_order_status AS previous_order_status,
+  $order_status AS order_status;
+
+$order_status_changed = ($order_status != $previous_order_status) ?? false;
+
+$transitions = (
+  SELECT
+    id AS order_id,
+    user_id,
+    $updated_at AS created_at,
+    $history_id AS id,
+    $previous_order_status AS from_status,
+    UNWRAP($order_status) AS status,
+    $actor_type AS actor_type,
+    $actor_id AS actor_id,
+    $reason AS reason,
+  FROM {{table.orders}}
+  WHERE id = $order_id AND $order_status_changed
+);
+
+SELECT order_id, user_id, created_at, id, from_status, status, actor_type, actor_id, reason
+FROM $transitions;
+
+SELECT
+  i.order_id AS order_id,
+  i.product_id AS product_id,
+  i.seller_id AS seller_id,
+  i.count AS count,
+FROM $transitions t
+JOIN {{table.order_items}} i ON i.order_id = t.order_id;
+
+UPSERT INTO {{table.order_status_history}} (order_id, created_at, id, from_status, status, actor_type, actor_id, reason)
+SELECT order_id, created_at, id, from_status, status, actor_type, actor_id, reason
+FROM $transitions;
+
+UPDATE {{table.shipments}}
+SET
+  status = $status,
+  tracking_number = $tracking_number ?? tracking_number,
+  carrier = $carrier ?? carrier,
+  carrier_delivered_at = $carrier_delivered_at ?? carrier_delivered_at,
+  updated_at = CAST($updated_at AS Datetime)
+WHERE
+  order_id = $order_id
+    AND
+  seller_id = $seller_id
+    AND
+  $applied;
+
+UPDATE {{table.orders}}
+SET
+  status = $order_status ?? status,
+  updated_at = CAST($updated_at AS Datetime)
+WHERE
+  id = $order_id
+    AND
+  $order_status_changed;
+`,
+	"{{table.orders}}",
+	tableOrders,
+	"{{table.order_items}}",
+	tableOrderItems,
+	"{{table.order_status_history}}",
+	tableOrderStatusHistory,
+	"{{table.shipments}}",
+	tableShipments,
+	"{{shipment_status.created}}",
+	ShipmentStatusCreated,
+	"{{shipment_status.processed}}",
+	ShipmentStatusProcessed,
+	"{{shipment_status.shipped}}",
+	ShipmentStatusShipped,
+	"{{shipment_status.delivered}}",
+	ShipmentStatusDelivered,
+	"{{shipment_status.completed}}",
+	ShipmentStatusCompleted,
+)
+
+type UpdateShipmentDTOInput struct {
+	OrderId  string
+	SellerId string
+	// FromStatus guards against concurrent updates of the shipment.
+	FromStatus string
+	Status     string
+	// TrackingNumber and Carrier are kept as is if not set.
+	TrackingNumber *string
+	Carrier        *string
+	// CarrierDeliveredAt is set when the carrier reports delivery of the shipment.
+	CarrierDeliveredAt *time.Time
+	UpdatedAt          time.Time
+	// Actor and Reason are recorded in the order status history if the order status is derived anew.
+	Actor  Actor
+	Reason string
+	// TransitionMessages builds messages published along with the order event if the order status is derived anew.
+	TransitionMessages func(from, to string) ([]outbox.Message, error)
+}
+type UpdateShipmentDTOOutput struct {
+	PreviousOrderStatus string
+	OrderStatus         string
+}
+
+// UpdateShipment updates shipment status and derives status of its order in the same transaction.
+// Order event and messages of the derived order status are published in the same transaction as well.
+func (s *Orders) UpdateShipment(ctx context.Context, in UpdateShipmentDTOInput) (UpdateShipmentDTOOutput, error) {
+	var out UpdateShipmentDTOOutput
+	var applied bool
+
+	if err := s.db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		res, err := tx.Query(ctx, queryUpdateShipment, query.WithParameters(table.NewQueryParameters(
+			table.ValueParam("$order_id", types.UTF8Value(in.OrderId)),
+			table.ValueParam("$seller_id", types.UTF8Value(in.SellerId)),
+			table.ValueParam("$from_status", types.UTF8Value(in.FromStatus)),
+			table.ValueParam("$status", types.UTF8Value(in.Status)),
+			table.ValueParam("$tracking_number", types.NullableUTF8Value(in.TrackingNumber)),
+			table.ValueParam("$carrier", types.NullableUTF8Value(in.Carrier)),
+			table.ValueParam("$carrier_delivered_at", types.NullableTimestampValueFromTime(in.CarrierDeliveredAt)),
+			table.ValueParam("$updated_at", types.TimestampValueFromTime(in.UpdatedAt)),
+			table.ValueParam("$history_id", types.UTF8Value(uuid.NewString())),
+			table.ValueParam("$actor_type", types.UTF8Value(in.Actor.Type)),
+			table.ValueParam("$actor_id", types.UTF8Value(in.Actor.Id)),
+			table.ValueParam("$reason", types.UTF8Value(in.Reason)),
+		)))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close(ctx) }()
+
+		row, err := nextResultSetRow(ctx, res)
+		if err != nil {
+			return err
+		}
+		out = UpdateShipmentDTOOutput{}
+		var previousOrderStatus, orderStatus *string
+		if err := row.ScanNamed(
+			query.Named("applied", &applied),
+			query.Named("previous_order_status", &previousOrderStatus),
+			query.Named("order_status", &orderStatus),
+		); err != nil {
+			return err
+		}
+		if previousOrderStatus != nil {
+			out.PreviousOrderStatus = *previousOrderStatus
+		}
+		if orderStatus != nil {
+			out.OrderStatus = *orderStatus
+		}
+
+		events, _, err := readOrderEvents(ctx, res)
+		if err != nil {
+			return err
+		}
+
+		var msgs []outbox.Message
+		if applied && out.OrderStatus != out.PreviousOrderStatus && in.TransitionMessages != nil {
+			msgs, err = in.TransitionMessages(out.PreviousOrderStatus, out.OrderStatus)
+			if err != nil {
+				return err
+			}
+		}
+		return s.enqueueOrderEventsTx(ctx, tx, events, msgs...)
+	}); err != nil {
+		return UpdateShipmentDTOOutput{}, err
+	}
+
+	if !applied {
+		return UpdateShipmentDTOOutput{}, ErrShipmentStatusConflict
+	}
+
+	return out, nil
+}
+
+const (
+	ListSellerOrdersPageSize uint32 = 20
+)
+
+var (
+	ErrInvalidListSellerOrdersNextPageToken = errors.New("invalid list seller orders next page token")
+)
+
+// Shipment fields come from the async index: the inbox may lag behind the latest shipment updates.
+var queryListSellerOrders = template.ReplaceAllPairs(`
+DECLARE $seller_id AS Utf8;
+DECLARE $statuses AS List<Utf8>;
+DECLARE $last_paginated_order_id AS Optional<Utf8>;
+DECLARE $last_paginated_created_at AS Optional<Datetime>;
+DECLARE $page_size AS Uint32;
+
+$shipments = (
+  SELECT
+    order_id,
+    status,
+    created_at,
+    updated_at,
+  FROM {{table.shipments}}
+  VIEW idx_seller_inbox
+  WHERE
+    seller_id = $seller_id
+      AND
+    (ListLength($statuses) = 0 OR status IN $statuses)
+      AND
+    (COALESCE($last_paginated_created_at, created_at), COALESCE($last_paginated_order_id, order_id)) >= (created_at, order_id)
+  ORDER BY created_at DESC, order_id DESC
+  LIMIT $page_size + 1
+);
+
+SELECT
+  s.order_id AS order_id,
+  s.status AS status,
+  s.created_at AS created_at,
+  s.updated_at AS updated_at,
+  o.user_id AS user_id,
+  o.status AS order_status
+FROM $shipments s
+JOIN {{table.orders}} o ON o.id = s.order_id
+ORDER BY created_at DESC, order_id DESC;
+
+SELECT
+  i.order_id AS order_id,
+  i.product_id AS product_id,
+  i.name AS product_name,
+  i.count AS product_count,
+  i.price AS product_price,
+  i.picture AS product_picture
+FROM $shipments s
+JOIN {{table.order_items}} i ON i.order_id = s.order_id
+WHERE i.seller_id = $seller_id;
+`,
+	"{{table.orders}}",
+	tableOrders,
+	"{{table.order_items}}",
+	tableOrderItems,
+	"{{table.shipments}}",
+	tableShipments,
+)
+
+type ListSellerOrdersDTOInput struct {
+	SellerId string
+	// Statuses are shipment statuses to filter by, empty matches any status.
+	Statuses      []string
+	NextPageToken *string
+}
+
+// ListSellerOrders lists orders having a shipment of the seller, newest first.
+// Only the seller items are listed for each order.
+func (s *Orders) ListSellerOrders(ctx context.Context, in ListSellerOrdersDTOInput) (oapi_codegen.OrdersListSellerOrdersRes, error) {
+	var out oapi_codegen.OrdersListSellerOrdersRes
+
+	var nextPage ListOrdersNextPage
+	if in.NextPageToken != nil {
+		token, err := token.DecryptToken(*in.NextPageToken, nextPageTokenEncryptKey)
+		if err != nil {
+			return oapi_codegen.OrdersListSellerOrdersRes{}, fmt.Errorf("%w: %v", ErrInvalidListSellerOrdersNextPageToken, err)
+		}
+
+		var nextPageDto ListOrdersNextPageDto
+		if err := json.Unmarshal([]byte(token), &nextPageDto); err != nil {
+			return oapi_codegen.OrdersListSellerOrdersRes{}, fmt.Errorf("%w: decode: %v", ErrInvalidListSellerOrdersNextPageToken, err)
+		}
+		createdAt, err := time.Parse(time.RFC3339, nextPageDto.CreatedAt)
+		if err != nil {
+			return oapi_codegen.OrdersListSellerOrdersRes{}, fmt.Errorf("%w: parse created_at as RFC3339: %v", ErrInvalidListSellerOrdersNextPageToken, err)
+		}
+		nextPage.CreatedAt = &createdAt
+		nextPage.OrderId = &nextPageDto.OrderId
+	}
+
+	statuses := make([]types.Value, 0, len(in.Statuses))
+	for _, status := range in.Statuses {
+		statuses = append(statuses, types.UTF8Value(status))
+	}
+	statusesParam := types.ZeroValue(types.List(types.TypeUTF8))
+	if len(statuses) > 0 {
+		statusesParam = types.ListValue(statuses...)
+	}
+
+	readTx := table.TxControl(table.BeginTx(table.WithStaleReadOnly()), table.CommitTx())
+
+	var orders []oapi_codegen.OrdersListSellerOrdersResOrder
+	var items map[string][]oapi_codegen.OrdersListOrdersResItem
+
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		_, res, err := ss.Execute(ctx, readTx, queryListSellerOrders, table.NewQueryParameters(
+			table.ValueParam("$seller_id", types.UTF8Value(in.SellerId)),
+			table.ValueParam("$statuses", statusesParam),
+			table.ValueParam("$last_paginated_order_id", types.NullableUTF8Value(nextPage.OrderId)),
+			table.ValueParam("$last_paginated_created_at", types.NullableDatetimeValueFromTime(nextPage.CreatedAt)),
+			table.ValueParam("$page_size", types.Uint32Value(ListSellerOrdersPageSize)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		orders = nil
+		items = make(map[string][]oapi_codegen.OrdersListOrdersResItem)
+
+		if res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var order oapi_codegen.OrdersListSellerOrdersResOrder
+				var createdAt, updatedAt time.Time
+				if err := res.ScanNamed(
+					named.Required("order_id", &order.Id),
+					named.Required("status", &order.Shipment.Status),
+					named.Required("created_at", &createdAt),
+					named.Required("updated_at", &updatedAt),
+					named.Required("user_id", &order.UserId),
+					named.Required("order_status", &order.Status),
+				); err != nil {
+					return err
+				}
+				order.Shipment.SellerId = in.SellerId
+				order.Shipment.UpdatedAt = updatedAt.Format(time.RFC3339)
+				order.CreatedAt = createdAt.Format(time.RFC3339)
+				orders = append(orders, order)
+			}
+		}
+
+		if res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var orderId string
+				var count uint32
+				item := oapi_codegen.OrdersListOrdersResItem{SellerId: in.SellerId}
+				if err := res.ScanNamed(
+					named.Required("order_id", &orderId),
+					named.Required("product_id", &item.ProductId),
+					named.Required("product_name", &item.Name),
+					named.Required("product_count", &count),
+					named.Required("product_price", &item.Price),
+					named.Optional("product_picture", &item.PictureUrl),
+				); err != nil {
+					return err
+				}
+				item.Count = int(count)
+				items[orderId] = append(items[orderId], item)
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return oapi_codegen.OrdersListSellerOrdersRes{}, err
+	}
+
+	if len(orders) > int(ListSellerOrdersPageSize) {
+		lastOrder := orders[len(orders)-1]
+		orders = orders[:len(orders)-1]
+
+		tokenBytes, err := json.Marshal(&ListOrdersNextPageDto{
+			OrderId:   lastOrder.Id,
+			CreatedAt: lastOrder.CreatedAt,
+		})
+		if err != nil {
+			return oapi_codegen.OrdersListSellerOrdersRes{}, fmt.Errorf("serialize next page: %v", err)
+		}
+
+		token, err := token.EncryptToken(string(tokenBytes), nextPageTokenEncryptKey)
+		if err != nil {
+			return oapi_codegen.OrdersListSellerOrdersRes{}, fmt.Errorf("encrypt next page token: %w", err)
+		}
+
+		out.NextPageToken = &token
+	}
+
+	out.Orders = make([]oapi_codegen.OrdersListSellerOrdersResOrder, 0, len(orders))
+	for _, order := range orders {
+		order.Items = items[order.Id]
+		if order.Items == nil {
+			order.Items = []oapi_codegen.OrdersListOrdersResItem{}
+		}
+		out.Orders = append(out.Orders, order)
+	}
+
+	return out, nil
+}
+
+// ShipmentKey identifies the seller shipment of the order.
+type ShipmentKey struct {
+	OrderId  string
+	SellerId string
+}
+
+// Tracking numbers come from the async index: a shipment may be found a moment after it's shipped.
+var queryListShipmentsByTracking = template.ReplaceAllPairs(`
+DECLARE $carrier AS Utf8;
+DECLARE $tracking_number AS Utf8;
+
+SELECT order_id, seller_id
+FROM {{table.shipments}}
+VIEW idx_tracking
+WHERE carrier = $carrier AND tracking_number = $tracking_number;
+`,
+	"{{table.shipments}}",
+	tableShipments,
+)
+
+func (s *Orders) ListShipmentsByTracking(ctx context.Context, carrier, trackingNumber string) ([]ShipmentKey, error) {
+	readTx := table.TxControl(table.BeginTx(table.WithStaleReadOnly()), table.CommitTx())
+	return s.listShipmentKeys(ctx, readTx, queryListShipmentsByTracking, table.NewQueryParameters(
+		table.ValueParam("$carrier", types.UTF8Value(carrier)),
+		table.ValueParam("$tracking_number", types.UTF8Value(trackingNumber)),
+	))
+}
+
+// Shipments delivered by sellers are delivered at their last update.
+var queryListDeliveredShipments = template.ReplaceAllPairs(`
+DECLARE $delivered_before AS Timestamp;
+
+SELECT order_id, seller_id
+FROM {{table.shipments}}
+WHERE
+  status = "{{shipment_status.delivered}}"
+    AND
+  COALESCE(carrier_delivered_at, CAST(updated_at AS Timestamp)) <= $delivered_before
+LIMIT 1000;
+`,
+	"{{table.shipments}}",
+	tableShipments,
+	"{{shipment_status.delivered}}",
+	ShipmentStatusDelivered,
+)
+
+// ListDeliveredShipments lists "delivered" shipments delivered before the given time.
+func (s *Orders) ListDeliveredShipments(ctx context.Context, deliveredBefore time.Time) ([]ShipmentKey, error) {
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+	return s.listShipmentKeys(ctx, readTx, queryListDeliveredShipments, table.NewQueryParameters(
+		table.ValueParam("$delivered_before", types.TimestampValueFromTime(deliveredBefore)),
+	))
+}
+
+func (s *Orders) listShipmentKeys(ctx context.Context, readTx *table.TransactionControl, q string, params *table.QueryParameters) ([]ShipmentKey, error) {
+	var out []ShipmentKey
+
+	if err := s.db.Table().Do(ctx, func(ctx context.Context, ss table.Session) error {
+		_, res, err := ss.Execute(ctx, readTx, q, params)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		out = nil
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var key ShipmentKey
+				if err := res.ScanNamed(
+					named.Required("order_id", &key.OrderId),
+					named.Required("seller_id", &key.SellerId),
+				); err != nil {
+					return err
+				}
+				out = append(out, key)
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -2,10 +2,10 @@ package store
 
 import (
 	"errors"
+	"fmt"
 
-	ydbtopic "github.com/bratushkadan/floral/pkg/ydb/topic"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
 	"github.com/ydb-platform/ydb-go-sdk/v3"
-	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
 	"go.uber.org/zap"
 )
 
@@ -30,46 +30,15 @@ func (b *OrdersBuilder) Build() (*Orders, error) {
 	if b.store.db == nil {
 		return nil, errors.New("ydb driver is nil")
 	}
-
-	topicCartPublishRequests, err := ydbtopic.NewProducer(b.store.db, topicCartPublishRequests)
-	if err != nil {
-		return nil, errors.New("setup CartPublishRequsts topic: %w")
-	}
-	b.store.topicCartPublishRequests = topicCartPublishRequests
-
-	topicCartClearRequests, err := ydbtopic.NewProducer(b.store.db, topicCartClearRequests)
-	if err != nil {
-		return nil, errors.New("setup CartClearRequests topic: %w")
-	}
-	b.store.topicCartClearRequests = topicCartClearRequests
-
-	topicProductsReservations, err := ydbtopic.NewProducer(b.store.db, topicProductsReservations)
-	if err != nil {
-		return nil, errors.New("setup ProductsReservations topic: %w")
-	}
-	b.store.topicProductsReservations = topicProductsReservations
-
-	topicProductsUnreservations, err := ydbtopic.NewProducer(b.store.db, topicProductsUnreservations)
-	if err != nil {
-		return nil, errors.New("setup ProductsUnreservations topic: %w")
-	}
-	b.store.topicProductsUnreservations = topicProductsUnreservations
-
-	topicCancelOperations, err := ydbtopic.NewProducer(b.store.db, topicCancelOperations)
-	if err != nil {
-		return nil, errors.New("setup ProductsUnreservations topic: %w")
+	if b.store.logger == nil {
+		b.store.logger = zap.NewNop()
 	}
-	b.store.topicCancelOperations = topicCancelOperations
 
-	topicProcessedPaymentsNotifications, err := ydbtopic.NewProducer(b.store.db, topicProcessedPaymentsNotifications)
+	outbox, err := outbox.NewBuilder().Ydb(b.store.db).Table(tableOutbox).Logger(b.store.logger).Build()
 	if err != nil {
-		return nil, errors.New("setup ProductsUnreservations topic: %w")
-	}
-	b.store.topicProcessedPaymentsNotifications = topicProcessedPaymentsNotifications
-
-	if b.store.logger == nil {
-		b.store.logger = zap.NewNop()
+		return nil, fmt.Errorf("setup outbox: %w", err)
 	}
+	b.store.outbox = outbox
 
 	return &b.store, nil
 }
@@ -78,13 +47,6 @@ type Orders struct {
 	db     *ydb.Driver
 	logger *zap.Logger
 
-	topicCartPublishRequests *topicwriter.Writer
-	topicCartClearRequests   *topicwriter.Writer
-
-	topicProductsReservations   *topicwriter.Writer
-	topicProductsUnreservations *topicwriter.Writer
-
-	topicCancelOperations *topicwriter.Writer
-
-	topicProcessedPaymentsNotifications *topicwriter.Writer
+	// outbox publishes messages of state changes
+	outbox *outbox.Outbox
 }
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
@@ -23,6 +24,40 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CreateProductCampaignResStatus.
+const (
+	CreateProductCampaignResStatusActive    CreateProductCampaignResStatus = "active"
+	CreateProductCampaignResStatusCancelled CreateProductCampaignResStatus = "cancelled"
+	CreateProductCampaignResStatusExhausted CreateProductCampaignResStatus = "exhausted"
+	CreateProductCampaignResStatusFinished  CreateProductCampaignResStatus = "finished"
+	CreateProductCampaignResStatusScheduled CreateProductCampaignResStatus = "scheduled"
+)
+
+// Defines values for ListProductCampaignsResCampaignStatus.
+const (
+	ListProductCampaignsResCampaignStatusActive    ListProductCampaignsResCampaignStatus = "active"
+	ListProductCampaignsResCampaignStatusCancelled ListProductCampaignsResCampaignStatus = "cancelled"
+	ListProductCampaignsResCampaignStatusExhausted ListProductCampaignsResCampaignStatus = "exhausted"
+	ListProductCampaignsResCampaignStatusFinished  ListProductCampaignsResCampaignStatus = "finished"
+	ListProductCampaignsResCampaignStatusScheduled ListProductCampaignsResCampaignStatus = "scheduled"
+)
+
+// Defines values for OrderEventMessageType.
+const (
+	OrderCancelled OrderEventMessageType = "order.cancelled"
+	OrderCompleted OrderEventMessageType = "order.completed"
+	OrderCreated   OrderEventMessageType = "order.created"
+	OrderDelivered OrderEventMessageType = "order.delivered"
+	OrderPaid      OrderEventMessageType = "order.paid"
+	OrderShipped   OrderEventMessageType = "order.shipped"
+)
+
+// Defines values for OrdersCreateCouponReqDiscountType.
+const (
+	Fixed   OrdersCreateCouponReqDiscountType = "fixed"
+	Percent OrdersCreateCouponReqDiscountType = "percent"
+)
+
 // AuthenticateReq defines model for AuthenticateReq.
 type AuthenticateReq struct {
 	Email    string `json:"email"`
@@ -35,13 +70,26 @@ type AuthenticateRes struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// CancelProductCampaignRes defines model for CancelProductCampaignRes.
+type CancelProductCampaignRes struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// CartApplyPromoCodeReq defines model for CartApplyPromoCodeReq.
+type CartApplyPromoCodeReq struct {
+	// Code promo code, case insensitive
+	Code string `json:"code"`
+}
+
 // CartClearCartRes defines model for CartClearCartRes.
 type CartClearCartRes = map[string]interface{}
 
 // CartClearCartResPosition defines model for CartClearCartResPosition.
 type CartClearCartResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CartDeleteCartPositionRes defines model for CartDeleteCartPositionRes.
@@ -51,8 +99,9 @@ type CartDeleteCartPositionRes struct {
 
 // CartDeleteCartPositionResPosition defines model for CartDeleteCartPositionResPosition.
 type CartDeleteCartPositionResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CartGetCartPositionsRes defines model for CartGetCartPositionsRes.
@@ -62,10 +111,21 @@ type CartGetCartPositionsRes struct {
 
 // CartGetCartPositionsResPosition defines model for CartGetCartPositionsResPosition.
 type CartGetCartPositionsResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// CartPromoCodeRes promo code applied to the cart. Codes stay applied until removed, orders are discounted with the coupon
+// of the code if it's applicable to them. Discounts are previewed with the orders coupon preview.
+type CartPromoCodeRes struct {
+	AppliedAt time.Time `json:"applied_at"`
+	Code      string    `json:"code"`
 }
 
+// CartRemovePromoCodeRes defines model for CartRemovePromoCodeRes.
+type CartRemovePromoCodeRes = map[string]interface{}
+
 // CartSetCartPositionRes defines model for CartSetCartPositionRes.
 type CartSetCartPositionRes struct {
 	SetPosition CartSetCartPositionResPosition `json:"set_position"`
@@ -73,14 +133,23 @@ type CartSetCartPositionRes struct {
 
 // CartSetCartPositionResPosition defines model for CartSetCartPositionResPosition.
 type CartSetCartPositionResPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
 // CatalogGetRes defines model for CatalogGetRes.
 type CatalogGetRes struct {
-	NextPageToken *string                `json:"next_page_token"`
-	Products      []CatalogGetResProduct `json:"products"`
+	// Categories category facets, numbers of found products by category paths
+	Categories    []CatalogGetResCategory `json:"categories"`
+	NextPageToken *string                 `json:"next_page_token"`
+	Products      []CatalogGetResProduct  `json:"products"`
+}
+
+// CatalogGetResCategory defines model for CatalogGetResCategory.
+type CatalogGetResCategory struct {
+	Count int    `json:"count"`
+	Path  string `json:"path"`
 }
 
 // CatalogGetResProduct defines model for CatalogGetResProduct.
@@ -104,29 +173,104 @@ type CreateAccessTokenRes struct {
 	ExpiresAt   string `json:"expires_at"`
 }
 
+// CreateProductCampaignReq defines model for CreateProductCampaignReq.
+type CreateProductCampaignReq struct {
+	// Boost Catalog ranking multiplier applied while the campaign is active
+	Boost float64 `json:"boost"`
+
+	// Budget Budget the campaign spends while active, the campaign is exhausted once it's spent
+	Budget   float64   `json:"budget"`
+	EndsAt   time.Time `json:"ends_at"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// CreateProductCampaignRes defines model for CreateProductCampaignRes.
+type CreateProductCampaignRes struct {
+	Boost     float64 `json:"boost"`
+	Budget    float64 `json:"budget"`
+	CreatedAt string  `json:"created_at"`
+	EndsAt    string  `json:"ends_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+
+	// Spent Budget spent by the campaign so far
+	Spent     float64                        `json:"spent"`
+	StartsAt  string                         `json:"starts_at"`
+	Status    CreateProductCampaignResStatus `json:"status"`
+	UpdatedAt string                         `json:"updated_at"`
+}
+
+// CreateProductCampaignResStatus defines model for CreateProductCampaignRes.Status.
+type CreateProductCampaignResStatus string
+
+// CreateProductCategoryReq defines model for CreateProductCategoryReq.
+type CreateProductCategoryReq struct {
+	Name string `json:"name"`
+
+	// ParentId id of the parent category, the category is created at the root if absent
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Position position of the category among its siblings, the category is put after its siblings if absent
+	Position *int `json:"position,omitempty"`
+
+	// Slug unique lowercase latin letters and digits separated by hyphens, i.e. "garden-roses"
+	Slug string `json:"slug"`
+}
+
+// CreateProductCategoryRes defines model for CreateProductCategoryRes.
+type CreateProductCategoryRes = ProductCategory
+
 // CreateProductReq defines model for CreateProductReq.
 type CreateProductReq struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	Description string                 `json:"description"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Price       float64                `json:"price"`
-	Stock       int                    `json:"stock"`
+
+	// Options option axes of the product variants, skus are added with "POST /api/v1/products/{product_id}/skus"
+	Options *[]GetProductResOption `json:"options,omitempty"`
+	Price   float64                `json:"price"`
+	Stock   int                    `json:"stock"`
 }
 
 // CreateProductRes defines model for CreateProductRes.
 type CreateProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	CreatedAt   string                 `json:"created_at"`
 	Description string                 `json:"description"`
 	Id          string                 `json:"id"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Pictures    GetProductResPictures  `json:"pictures"`
-	Price       float64                `json:"price"`
-	SellerId    string                 `json:"seller_id"`
-	Stock       int                    `json:"stock"`
-	UpdatedAt   string                 `json:"updated_at"`
+
+	// Options option axes of the product variants, i.e. size or colour
+	Options  []GetProductResOption `json:"options"`
+	Pictures GetProductResPictures `json:"pictures"`
+	Price    float64               `json:"price"`
+	SellerId string                `json:"seller_id"`
+
+	// Skus variants of the product, stock of the product with skus is the sum of their stocks
+	Skus      []GetProductResSku `json:"skus"`
+	Stock     int                `json:"stock"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// CreateProductSkuReq defines model for CreateProductSkuReq.
+type CreateProductSkuReq struct {
+	// Options values of every product option by option names
+	Options map[string]string `json:"options"`
+
+	// PictureIds ids of the product pictures of the sku
+	PictureIds *[]string `json:"picture_ids,omitempty"`
+	Price      float64   `json:"price"`
+	Stock      int       `json:"stock"`
 }
 
+// CreateProductSkuRes defines model for CreateProductSkuRes.
+type CreateProductSkuRes = GetProductResSku
+
 // CreateSellerAccountReq defines model for CreateSellerAccountReq.
 type CreateSellerAccountReq struct {
 	AccessToken string `json:"access_token"`
@@ -156,6 +300,11 @@ type CreateUserAccountRes struct {
 	Name  string  `json:"name"`
 }
 
+// DeleteProductCategoryRes defines model for DeleteProductCategoryRes.
+type DeleteProductCategoryRes struct {
+	Id string `json:"id"`
+}
+
 // DeleteProductPictureRes defines model for DeleteProductPictureRes.
 type DeleteProductPictureRes struct {
 	Id string `json:"id"`
@@ -166,6 +315,11 @@ type DeleteProductRes struct {
 	Id string `json:"id"`
 }
 
+// DeleteProductSkuRes defines model for DeleteProductSkuRes.
+type DeleteProductSkuRes struct {
+	Id string `json:"id"`
+}
+
 // Err defines model for Err.
 type Err struct {
 	Code    int    `json:"code"`
@@ -196,8 +350,16 @@ type FeedbackDeleteProductReviewRes struct {
 
 // FeedbackGetProductRatingRes defines model for FeedbackGetProductRatingRes.
 type FeedbackGetProductRatingRes struct {
-	ProductId string `json:"product_id"`
-	Rating    int    `json:"rating"`
+	Distribution []FeedbackGetProductRatingResDistributionBucket `json:"distribution"`
+	ProductId    string                                          `json:"product_id"`
+	Rating       float64                                         `json:"rating"`
+	ReviewsCount int                                             `json:"reviews_count"`
+}
+
+// FeedbackGetProductRatingResDistributionBucket defines model for FeedbackGetProductRatingResDistributionBucket.
+type FeedbackGetProductRatingResDistributionBucket struct {
+	Count int `json:"count"`
+	Stars int `json:"stars"`
 }
 
 // FeedbackGetProductReviewRes defines model for FeedbackGetProductReviewRes.
@@ -211,17 +373,6 @@ type FeedbackGetProductReviewRes struct {
 	UserId    string  `json:"user_id"`
 }
 
-// FeedbackListProductRatingsRes defines model for FeedbackListProductRatingsRes.
-type FeedbackListProductRatingsRes struct {
-	Ratings []FeedbackListProductRatingsResRating `json:"ratings"`
-}
-
-// FeedbackListProductRatingsResRating defines model for FeedbackListProductRatingsResRating.
-type FeedbackListProductRatingsResRating struct {
-	ProductId *string `json:"product_id,omitempty"`
-	Rating    int     `json:"rating"`
-}
-
 // FeedbackListProductReviewsRes defines model for FeedbackListProductReviewsRes.
 type FeedbackListProductReviewsRes struct {
 	NextPageToken *string                               `json:"next_page_token"`
@@ -247,23 +398,38 @@ type FeedbackUpdateProductReviewReq struct {
 
 // FeedbackUpdateProductReviewRes defines model for FeedbackUpdateProductReviewRes.
 type FeedbackUpdateProductReviewRes struct {
-	Id     string   `json:"id"`
-	Rating *float64 `json:"rating,omitempty"`
-	Review *string  `json:"review,omitempty"`
+	Id        string  `json:"id"`
+	Rating    float64 `json:"rating"`
+	Review    string  `json:"review"`
+	UpdatedAt string  `json:"updated_at"`
 }
 
 // GetProductRes defines model for GetProductRes.
 type GetProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                `json:"category_id,omitempty"`
 	CreatedAt   string                 `json:"created_at"`
 	Description string                 `json:"description"`
 	Id          string                 `json:"id"`
 	Metadata    map[string]interface{} `json:"metadata"`
 	Name        string                 `json:"name"`
-	Pictures    GetProductResPictures  `json:"pictures"`
-	Price       float64                `json:"price"`
-	SellerId    string                 `json:"seller_id"`
-	Stock       int                    `json:"stock"`
-	UpdatedAt   string                 `json:"updated_at"`
+
+	// Options option axes of the product variants, i.e. size or colour
+	Options  []GetProductResOption `json:"options"`
+	Pictures GetProductResPictures `json:"pictures"`
+	Price    float64               `json:"price"`
+	SellerId string                `json:"seller_id"`
+
+	// Skus variants of the product, stock of the product with skus is the sum of their stocks
+	Skus      []GetProductResSku `json:"skus"`
+	Stock     int                `json:"stock"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// GetProductResOption defines model for GetProductResOption.
+type GetProductResOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
 }
 
 // GetProductResPicture defines model for GetProductResPicture.
@@ -275,6 +441,49 @@ type GetProductResPicture struct {
 // GetProductResPictures defines model for GetProductResPictures.
 type GetProductResPictures = []GetProductResPicture
 
+// GetProductResSku defines model for GetProductResSku.
+type GetProductResSku struct {
+	Id string `json:"id"`
+
+	// Options values of the product options by option names
+	Options map[string]string `json:"options"`
+
+	// PictureIds ids of the product pictures of the sku
+	PictureIds []string `json:"picture_ids"`
+	Price      float64  `json:"price"`
+	Stock      int      `json:"stock"`
+}
+
+// ListProductCampaignsRes defines model for ListProductCampaignsRes.
+type ListProductCampaignsRes struct {
+	Campaigns []ListProductCampaignsResCampaign `json:"campaigns"`
+}
+
+// ListProductCampaignsResCampaign defines model for ListProductCampaignsResCampaign.
+type ListProductCampaignsResCampaign struct {
+	Boost     float64 `json:"boost"`
+	Budget    float64 `json:"budget"`
+	CreatedAt string  `json:"created_at"`
+	EndsAt    string  `json:"ends_at"`
+	Id        string  `json:"id"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+
+	// Spent Budget spent by the campaign so far
+	Spent     float64                               `json:"spent"`
+	StartsAt  string                                `json:"starts_at"`
+	Status    ListProductCampaignsResCampaignStatus `json:"status"`
+	UpdatedAt string                                `json:"updated_at"`
+}
+
+// ListProductCampaignsResCampaignStatus defines model for ListProductCampaignsResCampaign.Status.
+type ListProductCampaignsResCampaignStatus string
+
+// ListProductCategoriesRes defines model for ListProductCategoriesRes.
+type ListProductCategoriesRes struct {
+	Categories []ProductCategory `json:"categories"`
+}
+
 // ListProductsRes defines model for ListProductsRes.
 type ListProductsRes struct {
 	NextPageToken *string                  `json:"next_page_token"`
@@ -283,6 +492,8 @@ type ListProductsRes struct {
 
 // ListProductsResProduct defines model for ListProductsResProduct.
 type ListProductsResProduct struct {
+	// CategoryId id of the product category
+	CategoryId *string `json:"category_id,omitempty"`
 	Id         string  `json:"id"`
 	Name       string  `json:"name"`
 	PictureUrl string  `json:"picture_url"`
@@ -290,6 +501,173 @@ type ListProductsResProduct struct {
 	SellerId   string  `json:"seller_id"`
 }
 
+// OrderEventActor defines model for OrderEventActor.
+type OrderEventActor struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// OrderEventItem defines model for OrderEventItem.
+type OrderEventItem struct {
+	Count     int    `json:"count"`
+	ProductId string `json:"product_id"`
+	SellerId  string `json:"seller_id"`
+}
+
+// OrderEventMessage order lifecycle domain event published to orders/order_events_topic
+type OrderEventMessage struct {
+	Actor OrderEventActor `json:"actor"`
+
+	// Id unique event id, consumers deduplicate redelivered events by it
+	Id             string           `json:"id"`
+	Items          []OrderEventItem `json:"items"`
+	OccurredAt     time.Time        `json:"occurred_at"`
+	OrderId        string           `json:"order_id"`
+	PreviousStatus *string          `json:"previous_status"`
+	Reason         string           `json:"reason"`
+
+	// SchemaVersion incremented on breaking changes of the event schema
+	SchemaVersion int                   `json:"schema_version"`
+	Status        string                `json:"status"`
+	Type          OrderEventMessageType `json:"type"`
+	UserId        string                `json:"user_id"`
+}
+
+// OrderEventMessageType defines model for OrderEventMessage.Type.
+type OrderEventMessageType string
+
+// OrdersAddress defines model for OrdersAddress.
+type OrdersAddress struct {
+	City          string  `json:"city"`
+	Comment       *string `json:"comment,omitempty"`
+	Country       string  `json:"country"`
+	CreatedAt     string  `json:"created_at"`
+	Id            string  `json:"id"`
+	Phone         string  `json:"phone"`
+	PostalCode    string  `json:"postal_code"`
+	RecipientName string  `json:"recipient_name"`
+	Street        string  `json:"street"`
+	UpdatedAt     string  `json:"updated_at"`
+	UserId        string  `json:"user_id"`
+}
+
+// OrdersAddressReq defines model for OrdersAddressReq.
+type OrdersAddressReq struct {
+	City string `json:"city"`
+
+	// Comment note for the courier
+	Comment *string `json:"comment,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 country code
+	Country       string `json:"country"`
+	Phone         string `json:"phone"`
+	PostalCode    string `json:"postal_code"`
+	RecipientName string `json:"recipient_name"`
+
+	// Street street, building and apartment
+	Street string `json:"street"`
+}
+
+// OrdersCost order cost computed at order creation, absent for orders placed before it was introduced.
+// Amounts are in minor units of the currency (e.g. kopecks), prices include VAT.
+type OrdersCost struct {
+	CurrencyIso4217 int   `json:"currency_iso_4217"`
+	Discount        int64 `json:"discount"`
+	ShippingFee     int64 `json:"shipping_fee"`
+
+	// Subtotal sum of the order items prices
+	Subtotal int64 `json:"subtotal"`
+
+	// Total amount to pay, subtotal less discount plus shipping fee
+	Total int64 `json:"total"`
+
+	// Vat VAT included in the total
+	Vat int64 `json:"vat"`
+}
+
+// OrdersCoupon defines model for OrdersCoupon.
+type OrdersCoupon struct {
+	Code         string `json:"code"`
+	CreatedAt    string `json:"created_at"`
+	DiscountType string `json:"discount_type"`
+
+	// DiscountValue percent from 1 to 100 or fixed amount in minor units of the currency
+	DiscountValue int64   `json:"discount_value"`
+	ExpiresAt     *string `json:"expires_at,omitempty"`
+
+	// MaxUses maximum redemptions of the coupon, 0 is unlimited
+	MaxUses int64 `json:"max_uses"`
+
+	// MaxUsesPerUser maximum redemptions of the coupon by a user, 0 is unlimited
+	MaxUsesPerUser int64 `json:"max_uses_per_user"`
+
+	// MinSubtotal minimum sum of the order items prices in minor units of the currency
+	MinSubtotal int64  `json:"min_subtotal"`
+	OwnerId     string `json:"owner_id"`
+
+	// OwnerType subject type of the coupon creator
+	OwnerType  string   `json:"owner_type"`
+	ProductIds []string `json:"product_ids"`
+	SellerIds  []string `json:"seller_ids"`
+	StartsAt   *string  `json:"starts_at,omitempty"`
+
+	// Uses redemptions of the coupon by orders that aren't cancelled
+	Uses int64 `json:"uses"`
+}
+
+// OrdersCouponPreviewRes discount the coupon gives to the items. Coupons that are not applicable to the items don't discount them.
+// Amounts are in minor units of the currency, shipping isn't included.
+type OrdersCouponPreviewRes struct {
+	Applicable      bool   `json:"applicable"`
+	Code            string `json:"code"`
+	CurrencyIso4217 int    `json:"currency_iso_4217"`
+	Discount        int64  `json:"discount"`
+
+	// Reason why the coupon isn't applicable
+	Reason   *string `json:"reason,omitempty"`
+	Subtotal int64   `json:"subtotal"`
+	Total    int64   `json:"total"`
+}
+
+// OrdersCreateCouponReq defines model for OrdersCreateCouponReq.
+type OrdersCreateCouponReq struct {
+	// Code promo code of 3 to 32 latin letters, digits, "-" or "_", case insensitive
+	Code         string                            `json:"code"`
+	DiscountType OrdersCreateCouponReqDiscountType `json:"discount_type"`
+
+	// DiscountValue percent from 1 to 100 or fixed amount in minor units of the currency
+	DiscountValue int64      `json:"discount_value"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+
+	// MaxUses maximum redemptions of the coupon, 0 is unlimited
+	MaxUses *int64 `json:"max_uses,omitempty"`
+
+	// MaxUsesPerUser maximum redemptions of the coupon by a user, 0 is unlimited
+	MaxUsesPerUser *int64 `json:"max_uses_per_user,omitempty"`
+
+	// MinSubtotal minimum sum of the order items prices in minor units of the currency
+	MinSubtotal *int64 `json:"min_subtotal,omitempty"`
+
+	// ProductIds discount items of the products only
+	ProductIds *[]string `json:"product_ids,omitempty"`
+
+	// SellerIds discount items of the sellers only, coupons of sellers discount items of the seller
+	SellerIds *[]string  `json:"seller_ids,omitempty"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+}
+
+// OrdersCreateCouponReqDiscountType defines model for OrdersCreateCouponReq.DiscountType.
+type OrdersCreateCouponReqDiscountType string
+
+// OrdersCreateOrderReq defines model for OrdersCreateOrderReq.
+type OrdersCreateOrderReq struct {
+	// AddressId address book entry to deliver the order to, required unless the order is picked up
+	AddressId *string `json:"address_id,omitempty"`
+
+	// DeliveryMethod "courier", "post" or "pickup"
+	DeliveryMethod string `json:"delivery_method"`
+}
+
 // OrdersCreateOrderRes defines model for OrdersCreateOrderRes.
 type OrdersCreateOrderRes struct {
 	Operation OrdersCreateOrderResOperation `json:"operation"`
@@ -306,26 +684,95 @@ type OrdersCreateOrderResOperation struct {
 	UserId    string  `json:"user_id"`
 }
 
+// OrdersCreateReturnReq defines model for OrdersCreateReturnReq.
+type OrdersCreateReturnReq struct {
+	Items  []OrdersCreateReturnReqItem `json:"items"`
+	Reason string                      `json:"reason"`
+}
+
+// OrdersCreateReturnReqItem defines model for OrdersCreateReturnReqItem.
+type OrdersCreateReturnReqItem struct {
+	Count     int    `json:"count"`
+	ProductId string `json:"product_id"`
+
+	// SkuId returned sku, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
+}
+
+// OrdersDeleteAddressRes defines model for OrdersDeleteAddressRes.
+type OrdersDeleteAddressRes struct {
+	Id string `json:"id"`
+}
+
+// OrdersDeleteCouponRes defines model for OrdersDeleteCouponRes.
+type OrdersDeleteCouponRes struct {
+	Code string `json:"code"`
+}
+
+// OrdersDelivery delivery of the order, absent for orders placed before delivery was introduced
+type OrdersDelivery struct {
+	// Address snapshot of the address book entry taken when the order was placed
+	Address *OrdersDeliveryAddress `json:"address,omitempty"`
+	Method  string                 `json:"method"`
+}
+
+// OrdersDeliveryAddress snapshot of the address book entry taken when the order was placed
+type OrdersDeliveryAddress struct {
+	AddressId     string  `json:"address_id"`
+	City          string  `json:"city"`
+	Comment       *string `json:"comment,omitempty"`
+	Country       string  `json:"country"`
+	Phone         string  `json:"phone"`
+	PostalCode    string  `json:"postal_code"`
+	RecipientName string  `json:"recipient_name"`
+	Street        string  `json:"street"`
+}
+
 // OrdersGetOperationRes defines model for OrdersGetOperationRes.
 type OrdersGetOperationRes struct {
-	CreatedAt string  `json:"created_at"`
-	Details   *string `json:"details,omitempty"`
-	Id        string  `json:"id"`
-	OrderId   *string `json:"order_id,omitempty"`
-	Status    string  `json:"status"`
-	Type      string  `json:"type"`
-	UpdatedAt string  `json:"updated_at"`
-	UserId    string  `json:"user_id"`
+	CreatedAt string `json:"created_at"`
+
+	// Details Why the operation is aborted, or for completed operations why the order is placed without
+	// the discount of the promo code applied to the cart ("promo_code_rejected: <reason>")
+	Details *string `json:"details,omitempty"`
+	Id      string  `json:"id"`
+	OrderId *string `json:"order_id,omitempty"`
+
+	// Payment how to pay for the order, present only while the order awaits payment
+	Payment   *OrdersPayment `json:"payment,omitempty"`
+	Status    string         `json:"status"`
+	Type      string         `json:"type"`
+	UpdatedAt string         `json:"updated_at"`
+	UserId    string         `json:"user_id"`
 }
 
 // OrdersGetOrderRes defines model for OrdersGetOrderRes.
 type OrdersGetOrderRes struct {
-	CreatedAt string                  `json:"created_at"`
-	Id        string                  `json:"id"`
-	Items     []OrdersGetOrderResItem `json:"items"`
-	Status    string                  `json:"status"`
-	UpdatedAt string                  `json:"updated_at"`
-	UserId    string                  `json:"user_id"`
+	// AllowedTransitions statuses the requesting subject may set with order update
+	AllowedTransitions []string `json:"allowed_transitions"`
+
+	// Cost order cost computed at order creation, absent for orders placed before it was introduced.
+	// Amounts are in minor units of the currency (e.g. kopecks), prices include VAT.
+	Cost      *OrdersCost `json:"cost,omitempty"`
+	CreatedAt string      `json:"created_at"`
+
+	// Delivery delivery of the order, absent for orders placed before delivery was introduced
+	Delivery *OrdersDelivery         `json:"delivery,omitempty"`
+	Id       string                  `json:"id"`
+	Items    []OrdersGetOrderResItem `json:"items"`
+
+	// Payment how to pay for the order, present only while the order awaits payment
+	Payment *OrdersPayment `json:"payment,omitempty"`
+
+	// PromoCode promo code redeemed by the order
+	PromoCode *string                     `json:"promo_code,omitempty"`
+	Shipments []OrdersGetOrderResShipment `json:"shipments"`
+	Status    string                      `json:"status"`
+
+	// StatusHistory order status transitions, oldest first
+	StatusHistory []OrdersGetOrderResStatusHistoryEntry `json:"status_history"`
+	UpdatedAt     string                                `json:"updated_at"`
+	UserId        string                                `json:"user_id"`
 }
 
 // OrdersGetOrderResItem defines model for OrdersGetOrderResItem.
@@ -336,6 +783,42 @@ type OrdersGetOrderResItem struct {
 	Price      float64 `json:"price"`
 	ProductId  string  `json:"product_id"`
 	SellerId   string  `json:"seller_id"`
+	SkuId      *string `json:"sku_id,omitempty"`
+}
+
+// OrdersGetOrderResShipment defines model for OrdersGetOrderResShipment.
+type OrdersGetOrderResShipment struct {
+	Carrier  *string `json:"carrier,omitempty"`
+	SellerId string  `json:"seller_id"`
+	Status   string  `json:"status"`
+
+	// TrackingNumber carrier tracking number, set once the shipment is shipped
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// OrdersGetOrderResStatusHistoryEntry defines model for OrdersGetOrderResStatusHistoryEntry.
+type OrdersGetOrderResStatusHistoryEntry struct {
+	ActorId string `json:"actor_id"`
+
+	// ActorType subject type of the actor, "system" for transitions made by the service itself
+	ActorType string `json:"actor_type"`
+	CreatedAt string `json:"created_at"`
+
+	// FromStatus null for order creation
+	FromStatus *string `json:"from_status"`
+	Reason     string  `json:"reason"`
+	Status     string  `json:"status"`
+}
+
+// OrdersListAddressesRes defines model for OrdersListAddressesRes.
+type OrdersListAddressesRes struct {
+	Addresses []OrdersAddress `json:"addresses"`
+}
+
+// OrdersListCouponsRes defines model for OrdersListCouponsRes.
+type OrdersListCouponsRes struct {
+	Coupons []OrdersCoupon `json:"coupons"`
 }
 
 // OrdersListOrdersRes defines model for OrdersListOrdersRes.
@@ -352,6 +835,7 @@ type OrdersListOrdersResItem struct {
 	Price      float64 `json:"price"`
 	ProductId  string  `json:"product_id"`
 	SellerId   string  `json:"seller_id"`
+	SkuId      *string `json:"sku_id,omitempty"`
 }
 
 // OrdersListOrdersResOrder defines model for OrdersListOrdersResOrder.
@@ -364,92 +848,340 @@ type OrdersListOrdersResOrder struct {
 	UserId    string                    `json:"user_id"`
 }
 
-// OrdersUpdateOrderReq defines model for OrdersUpdateOrderReq.
-type OrdersUpdateOrderReq struct {
-	Status string `json:"status"`
+// OrdersListReturnsRes defines model for OrdersListReturnsRes.
+type OrdersListReturnsRes struct {
+	Returns []OrdersReturn `json:"returns"`
 }
 
-// OrdersUpdateOrderRes defines model for OrdersUpdateOrderRes.
-type OrdersUpdateOrderRes struct {
-	Status    string `json:"status"`
-	UpdatedAt string `json:"updated_at"`
+// OrdersListSellerOrdersRes defines model for OrdersListSellerOrdersRes.
+type OrdersListSellerOrdersRes struct {
+	NextPageToken *string                          `json:"next_page_token"`
+	Orders        []OrdersListSellerOrdersResOrder `json:"orders"`
 }
 
-// PrivateClearCartPositionsReq defines model for PrivateClearCartPositionsReq.
-type PrivateClearCartPositionsReq struct {
-	Messages []PrivateClearCartPositionsReqMessage `json:"messages"`
-}
+// OrdersListSellerOrdersResOrder defines model for OrdersListSellerOrdersResOrder.
+type OrdersListSellerOrdersResOrder struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
 
-// PrivateClearCartPositionsReqMessage defines model for PrivateClearCartPositionsReqMessage.
-type PrivateClearCartPositionsReqMessage struct {
+	// Items seller items of the order
+	Items    []OrdersListOrdersResItem `json:"items"`
+	Shipment OrdersGetOrderResShipment `json:"shipment"`
+
+	// Status order status
+	Status string `json:"status"`
 	UserId string `json:"user_id"`
 }
 
-// PrivateClearCartPositionsRes defines model for PrivateClearCartPositionsRes.
-type PrivateClearCartPositionsRes = map[string]interface{}
+// OrdersPayment how to pay for the order, present only while the order awaits payment
+type OrdersPayment struct {
+	// Amount amount left to pay
+	Amount          float64                 `json:"amount"`
+	Checkouts       []OrdersPaymentCheckout `json:"checkouts"`
+	CurrencyIso4217 int                     `json:"currency_iso_4217"`
+}
 
-// PrivateFeedbackProcessCompletedOrderReq defines model for PrivateFeedbackProcessCompletedOrderReq.
-type PrivateFeedbackProcessCompletedOrderReq struct {
-	Messages []PrivateFeedbackProcessCompletedOrderReqMessage `json:"messages"`
+// OrdersPaymentCheckout defines model for OrdersPaymentCheckout.
+type OrdersPaymentCheckout struct {
+	// Form form to submit instead of following the checkout link
+	Form     *OrdersPaymentCheckoutForm `json:"form,omitempty"`
+	Provider string                     `json:"provider"`
+
+	// Url link to pay for the order with
+	Url string `json:"url"`
 }
 
-// PrivateFeedbackProcessCompletedOrderReqMessage defines model for PrivateFeedbackProcessCompletedOrderReqMessage.
-type PrivateFeedbackProcessCompletedOrderReqMessage struct {
-	OrderId  string                                          `json:"order_id"`
-	Products PrivateFeedbackProcessCompletedOrderReqProducts `json:"products"`
+// OrdersPaymentCheckoutForm form to submit instead of following the checkout link
+type OrdersPaymentCheckoutForm struct {
+	Action string            `json:"action"`
+	Method string            `json:"method"`
+	Params map[string]string `json:"params"`
 }
 
-// PrivateFeedbackProcessCompletedOrderReqProducts defines model for PrivateFeedbackProcessCompletedOrderReqProducts.
-type PrivateFeedbackProcessCompletedOrderReqProducts struct {
-	Id string `json:"id"`
+// OrdersPreviewCouponReq defines model for OrdersPreviewCouponReq.
+type OrdersPreviewCouponReq struct {
+	Items []OrdersPreviewCouponReqItem `json:"items"`
 }
 
-// PrivateFeedbackProcessCompletedOrderRes defines model for PrivateFeedbackProcessCompletedOrderRes.
-type PrivateFeedbackProcessCompletedOrderRes = map[string]interface{}
+// OrdersPreviewCouponReqItem defines model for OrdersPreviewCouponReqItem.
+type OrdersPreviewCouponReqItem struct {
+	Count int `json:"count"`
 
-// PrivateOrderBatchCancelUnpaidOrdersReq defines model for PrivateOrderBatchCancelUnpaidOrdersReq.
-type PrivateOrderBatchCancelUnpaidOrdersReq = map[string]interface{}
+	// Price price of the product or its sku
+	Price     float64 `json:"price"`
+	ProductId string  `json:"product_id"`
+	SellerId  string  `json:"seller_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
 
-// PrivateOrderBatchCancelUnpaidOrdersRes defines model for PrivateOrderBatchCancelUnpaidOrdersRes.
-type PrivateOrderBatchCancelUnpaidOrdersRes = map[string]interface{}
+// OrdersProcessCarrierEventsReq tracking events webhook, its format is specific to the carrier
+type OrdersProcessCarrierEventsReq = map[string]interface{}
 
-// PrivateOrderCancelOperationsReq defines model for PrivateOrderCancelOperationsReq.
-type PrivateOrderCancelOperationsReq struct {
-	Messages []PrivateOrderCancelOperationsReqMessage `json:"messages"`
-}
+// OrdersProcessCarrierEventsRes defines model for OrdersProcessCarrierEventsRes.
+type OrdersProcessCarrierEventsRes = map[string]interface{}
 
-// PrivateOrderCancelOperationsReqMessage defines model for PrivateOrderCancelOperationsReqMessage.
-type PrivateOrderCancelOperationsReqMessage struct {
-	Details     string `json:"details"`
-	OperationId string `json:"operation_id"`
-}
+// OrdersProcessPaymentReq payment notification, its format is specific to the payment provider
+type OrdersProcessPaymentReq = map[string]interface{}
 
-// PrivateOrderCancelOperationsRes defines model for PrivateOrderCancelOperationsRes.
-type PrivateOrderCancelOperationsRes = map[string]interface{}
+// OrdersProcessPaymentRes defines model for OrdersProcessPaymentRes.
+type OrdersProcessPaymentRes = map[string]interface{}
 
-// PrivateOrderProcessPublishedCartPositionsReq defines model for PrivateOrderProcessPublishedCartPositionsReq.
-type PrivateOrderProcessPublishedCartPositionsReq struct {
-	Messages []PrivateOrderProcessPublishedCartPositionsReqMessage `json:"messages"`
-}
+// OrdersReturn defines model for OrdersReturn.
+type OrdersReturn struct {
+	// AllowedTransitions statuses the requesting subject may set with return update
+	AllowedTransitions []string `json:"allowed_transitions"`
 
-// PrivateOrderProcessPublishedCartPositionsReqCartPosition defines model for PrivateOrderProcessPublishedCartPositionsReqCartPosition.
-type PrivateOrderProcessPublishedCartPositionsReqCartPosition struct {
-	Count     int    `json:"count"`
-	ProductId string `json:"product_id"`
+	// Comment seller comment on the return resolution
+	Comment   *string             `json:"comment,omitempty"`
+	CreatedAt string              `json:"created_at"`
+	Id        string              `json:"id"`
+	Items     []OrdersReturnItem  `json:"items"`
+	OrderId   string              `json:"order_id"`
+	Photos    []OrdersReturnPhoto `json:"photos"`
+	Reason    string              `json:"reason"`
+
+	// RefundAmount amount refunded to the buyer once the seller receives returned items
+	RefundAmount float64 `json:"refund_amount"`
+	SellerId     string  `json:"seller_id"`
+	Status       string  `json:"status"`
+	UpdatedAt    string  `json:"updated_at"`
+	UserId       string  `json:"user_id"`
 }
 
-// PrivateOrderProcessPublishedCartPositionsReqMessage defines model for PrivateOrderProcessPublishedCartPositionsReqMessage.
-type PrivateOrderProcessPublishedCartPositionsReqMessage struct {
-	CartPositions []PrivateOrderProcessPublishedCartPositionsReqCartPosition `json:"cart_positions"`
-	OperationId   string                                                     `json:"operation_id"`
+// OrdersReturnItem defines model for OrdersReturnItem.
+type OrdersReturnItem struct {
+	Count     int     `json:"count"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
 }
 
-// PrivateOrderProcessPublishedCartPositionsRes defines model for PrivateOrderProcessPublishedCartPositionsRes.
-type PrivateOrderProcessPublishedCartPositionsRes = map[string]interface{}
+// OrdersReturnPhoto defines model for OrdersReturnPhoto.
+type OrdersReturnPhoto struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
 
-// PrivateOrderProcessReservedProductsReq defines model for PrivateOrderProcessReservedProductsReq.
-type PrivateOrderProcessReservedProductsReq struct {
-	Messages []PrivateOrderProcessReservedProductsReqMessage `json:"messages"`
+// OrdersUpdateOrderReq defines model for OrdersUpdateOrderReq.
+type OrdersUpdateOrderReq struct {
+	// Reason recorded in the order status history; required to complete cancellation of an order whose payments are not refunded (the manual refund reason)
+	Reason *string `json:"reason,omitempty"`
+	Status string  `json:"status"`
+}
+
+// OrdersUpdateOrderRes defines model for OrdersUpdateOrderRes.
+type OrdersUpdateOrderRes struct {
+	Status    string `json:"status"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// OrdersUpdateReturnReq defines model for OrdersUpdateReturnReq.
+type OrdersUpdateReturnReq struct {
+	// Comment seller comment, required to reject the return
+	Comment *string `json:"comment,omitempty"`
+	Status  string  `json:"status"`
+}
+
+// OrdersUpdateShipmentReq defines model for OrdersUpdateShipmentReq.
+type OrdersUpdateShipmentReq struct {
+	// Carrier required to ship the shipment unless the order is picked up
+	Carrier *string `json:"carrier,omitempty"`
+	Status  string  `json:"status"`
+
+	// TrackingNumber required to ship the shipment unless the order is picked up
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+}
+
+// OrdersUpdateShipmentRes defines model for OrdersUpdateShipmentRes.
+type OrdersUpdateShipmentRes struct {
+	Carrier *string `json:"carrier,omitempty"`
+	OrderId string  `json:"order_id"`
+
+	// OrderStatus order status derived from its shipments
+	OrderStatus    string  `json:"order_status"`
+	SellerId       string  `json:"seller_id"`
+	Status         string  `json:"status"`
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// OrdersUploadReturnPhotoRes defines model for OrdersUploadReturnPhotoRes.
+type OrdersUploadReturnPhotoRes struct {
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
+// PrivateApplyAdCampaignsReq defines model for PrivateApplyAdCampaignsReq.
+type PrivateApplyAdCampaignsReq = map[string]interface{}
+
+// PrivateApplyAdCampaignsRes defines model for PrivateApplyAdCampaignsRes.
+type PrivateApplyAdCampaignsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductRatingsReq defines model for PrivateCatalogSyncProductRatingsReq.
+type PrivateCatalogSyncProductRatingsReq struct {
+	Messages []PrivateCatalogSyncProductRatingsReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductRatingsReqMessage defines model for PrivateCatalogSyncProductRatingsReqMessage.
+type PrivateCatalogSyncProductRatingsReqMessage struct {
+	ProductId    string  `json:"product_id"`
+	Rating       float64 `json:"rating"`
+	ReviewsCount int     `json:"reviews_count"`
+
+	// Version version of the product rating, ratings older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductRatingsRes defines model for PrivateCatalogSyncProductRatingsRes.
+type PrivateCatalogSyncProductRatingsRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsAdBoostReq defines model for PrivateCatalogSyncProductsAdBoostReq.
+type PrivateCatalogSyncProductsAdBoostReq struct {
+	Messages []PrivateCatalogSyncProductsAdBoostReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsAdBoostReqMessage defines model for PrivateCatalogSyncProductsAdBoostReqMessage.
+type PrivateCatalogSyncProductsAdBoostReqMessage struct {
+	AdBoost   float64 `json:"ad_boost"`
+	ProductId string  `json:"product_id"`
+
+	// Version time the ad boost was computed at in unix milliseconds, ad boosts older than the applied one are skipped; messages without version are always applied
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PrivateCatalogSyncProductsAdBoostRes defines model for PrivateCatalogSyncProductsAdBoostRes.
+type PrivateCatalogSyncProductsAdBoostRes = map[string]interface{}
+
+// PrivateCatalogSyncProductsPurchasesReq defines model for PrivateCatalogSyncProductsPurchasesReq.
+type PrivateCatalogSyncProductsPurchasesReq struct {
+	Messages []PrivateCatalogSyncProductsPurchasesReqMessage `json:"messages"`
+}
+
+// PrivateCatalogSyncProductsPurchasesReqMessage defines model for PrivateCatalogSyncProductsPurchasesReqMessage.
+type PrivateCatalogSyncProductsPurchasesReqMessage struct {
+	ProductId        string `json:"product_id"`
+	Purchases30d     int    `json:"purchases_30d"`
+	PurchasesAlltime int    `json:"purchases_alltime"`
+}
+
+// PrivateCatalogSyncProductsPurchasesRes defines model for PrivateCatalogSyncProductsPurchasesRes.
+type PrivateCatalogSyncProductsPurchasesRes = map[string]interface{}
+
+// PrivateClearCartPositionsReq defines model for PrivateClearCartPositionsReq.
+type PrivateClearCartPositionsReq struct {
+	Messages []PrivateClearCartPositionsReqMessage `json:"messages"`
+}
+
+// PrivateClearCartPositionsReqMessage defines model for PrivateClearCartPositionsReqMessage.
+type PrivateClearCartPositionsReqMessage struct {
+	// OrderId the order the cart is cleared for, the cart is cleared once per order; messages without order id are always applied
+	OrderId *string `json:"order_id,omitempty"`
+	UserId  string  `json:"user_id"`
+}
+
+// PrivateClearCartPositionsRes defines model for PrivateClearCartPositionsRes.
+type PrivateClearCartPositionsRes = map[string]interface{}
+
+// PrivateFeedbackProcessCompletedOrderReq defines model for PrivateFeedbackProcessCompletedOrderReq.
+type PrivateFeedbackProcessCompletedOrderReq struct {
+	Messages []PrivateFeedbackProcessCompletedOrderReqMessage `json:"messages"`
+}
+
+// PrivateFeedbackProcessCompletedOrderReqMessage defines model for PrivateFeedbackProcessCompletedOrderReqMessage.
+type PrivateFeedbackProcessCompletedOrderReqMessage struct {
+	OrderId  string                                           `json:"order_id"`
+	Products []PrivateFeedbackProcessCompletedOrderReqProduct `json:"products"`
+	UserId   string                                           `json:"user_id"`
+}
+
+// PrivateFeedbackProcessCompletedOrderReqProduct defines model for PrivateFeedbackProcessCompletedOrderReqProduct.
+type PrivateFeedbackProcessCompletedOrderReqProduct struct {
+	Id string `json:"id"`
+}
+
+// PrivateFeedbackProcessCompletedOrderRes defines model for PrivateFeedbackProcessCompletedOrderRes.
+type PrivateFeedbackProcessCompletedOrderRes = map[string]interface{}
+
+// PrivateOrderBatchCancelUnpaidOrdersReq defines model for PrivateOrderBatchCancelUnpaidOrdersReq.
+type PrivateOrderBatchCancelUnpaidOrdersReq = map[string]interface{}
+
+// PrivateOrderBatchCancelUnpaidOrdersRes defines model for PrivateOrderBatchCancelUnpaidOrdersRes.
+type PrivateOrderBatchCancelUnpaidOrdersRes = map[string]interface{}
+
+// PrivateOrderBatchCompleteDeliveredOrdersReq defines model for PrivateOrderBatchCompleteDeliveredOrdersReq.
+type PrivateOrderBatchCompleteDeliveredOrdersReq = map[string]interface{}
+
+// PrivateOrderBatchCompleteDeliveredOrdersRes defines model for PrivateOrderBatchCompleteDeliveredOrdersRes.
+type PrivateOrderBatchCompleteDeliveredOrdersRes = map[string]interface{}
+
+// PrivateOrderCancelOperationsReq defines model for PrivateOrderCancelOperationsReq.
+type PrivateOrderCancelOperationsReq struct {
+	Messages []PrivateOrderCancelOperationsReqMessage `json:"messages"`
+}
+
+// PrivateOrderCancelOperationsReqMessage defines model for PrivateOrderCancelOperationsReqMessage.
+type PrivateOrderCancelOperationsReqMessage struct {
+	Details     string `json:"details"`
+	OperationId string `json:"operation_id"`
+}
+
+// PrivateOrderCancelOperationsRes defines model for PrivateOrderCancelOperationsRes.
+type PrivateOrderCancelOperationsRes = map[string]interface{}
+
+// PrivateOrderProcessPaymentNotificationsReq defines model for PrivateOrderProcessPaymentNotificationsReq.
+type PrivateOrderProcessPaymentNotificationsReq struct {
+	Messages []PrivateOrderProcessPaymentNotificationsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessPaymentNotificationsReqMessage defines model for PrivateOrderProcessPaymentNotificationsReqMessage.
+type PrivateOrderProcessPaymentNotificationsReqMessage struct {
+	Amount          float64   `json:"amount"`
+	CurrencyIso4217 int       `json:"currency_iso_4217"`
+	Datetime        time.Time `json:"datetime"`
+	OrderId         string    `json:"order_id"`
+
+	// PaymentId payment id derived from the provider operation id, duplicate notifications share it
+	PaymentId    *string                `json:"payment_id,omitempty"`
+	ProviderMeta map[string]interface{} `json:"provider_meta"`
+}
+
+// PrivateOrderProcessPaymentNotificationsRes defines model for PrivateOrderProcessPaymentNotificationsRes.
+type PrivateOrderProcessPaymentNotificationsRes = map[string]interface{}
+
+// PrivateOrderProcessPublishedCartPositionsReq defines model for PrivateOrderProcessPublishedCartPositionsReq.
+type PrivateOrderProcessPublishedCartPositionsReq struct {
+	Messages []PrivateOrderProcessPublishedCartPositionsReqMessage `json:"messages"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsReqCartPosition defines model for PrivateOrderProcessPublishedCartPositionsReqCartPosition.
+type PrivateOrderProcessPublishedCartPositionsReqCartPosition struct {
+	Count     int     `json:"count"`
+	ProductId string  `json:"product_id"`
+	SkuId     *string `json:"sku_id,omitempty"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsReqMessage defines model for PrivateOrderProcessPublishedCartPositionsReqMessage.
+type PrivateOrderProcessPublishedCartPositionsReqMessage struct {
+	CartPositions []PrivateOrderProcessPublishedCartPositionsReqCartPosition `json:"cart_positions"`
+	OperationId   string                                                     `json:"operation_id"`
+
+	// PromoCode promo code applied to the cart, orders of the operation are discounted with its coupon
+	PromoCode *string `json:"promo_code,omitempty"`
+}
+
+// PrivateOrderProcessPublishedCartPositionsRes defines model for PrivateOrderProcessPublishedCartPositionsRes.
+type PrivateOrderProcessPublishedCartPositionsRes = map[string]interface{}
+
+// PrivateOrderProcessRefundsReq defines model for PrivateOrderProcessRefundsReq.
+type PrivateOrderProcessRefundsReq = map[string]interface{}
+
+// PrivateOrderProcessRefundsRes defines model for PrivateOrderProcessRefundsRes.
+type PrivateOrderProcessRefundsRes = map[string]interface{}
+
+// PrivateOrderProcessReservedProductsReq defines model for PrivateOrderProcessReservedProductsReq.
+type PrivateOrderProcessReservedProductsReq struct {
+	Messages []PrivateOrderProcessReservedProductsReqMessage `json:"messages"`
 }
 
 // PrivateOrderProcessReservedProductsReqMessage defines model for PrivateOrderProcessReservedProductsReqMessage.
@@ -466,6 +1198,9 @@ type PrivateOrderProcessReservedProductsReqProduct struct {
 	Picture  *string `json:"picture,omitempty"`
 	Price    float64 `json:"price"`
 	SellerId string  `json:"seller_id"`
+
+	// SkuId reserved sku, price and picture are the ones of the sku
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateOrderProcessReservedProductsRes defines model for PrivateOrderProcessReservedProductsRes.
@@ -479,11 +1214,20 @@ type PrivateOrderProcessUnreservedProductsReq struct {
 // PrivateOrderProcessUnreservedProductsReqMessage defines model for PrivateOrderProcessUnreservedProductsReqMessage.
 type PrivateOrderProcessUnreservedProductsReqMessage struct {
 	OrderId string `json:"order_id"`
+
+	// ReturnId return whose received items are restocked, absent for cancelled orders
+	ReturnId *string `json:"return_id,omitempty"`
 }
 
 // PrivateOrderProcessUnreservedProductsRes defines model for PrivateOrderProcessUnreservedProductsRes.
 type PrivateOrderProcessUnreservedProductsRes = map[string]interface{}
 
+// PrivateOrderPublishProductsPurchasesStatsReq defines model for PrivateOrderPublishProductsPurchasesStatsReq.
+type PrivateOrderPublishProductsPurchasesStatsReq = map[string]interface{}
+
+// PrivateOrderPublishProductsPurchasesStatsRes defines model for PrivateOrderPublishProductsPurchasesStatsRes.
+type PrivateOrderPublishProductsPurchasesStatsRes = map[string]interface{}
+
 // PrivatePublishCartPositionsReq defines model for PrivatePublishCartPositionsReq.
 type PrivatePublishCartPositionsReq struct {
 	Messages []PrivatePublishCartPositionsReqMessage `json:"messages"`
@@ -498,6 +1242,24 @@ type PrivatePublishCartPositionsReqMessage struct {
 // PrivatePublishCartPositionsRes defines model for PrivatePublishCartPositionsRes.
 type PrivatePublishCartPositionsRes = map[string]interface{}
 
+// PrivateRelayOutboxReq defines model for PrivateRelayOutboxReq.
+type PrivateRelayOutboxReq = map[string]interface{}
+
+// PrivateRelayOutboxRes defines model for PrivateRelayOutboxRes.
+type PrivateRelayOutboxRes struct {
+	// Relayed Number of messages published to topics
+	Relayed int `json:"relayed"`
+}
+
+// PrivateReleaseExpiredReservationsReq defines model for PrivateReleaseExpiredReservationsReq.
+type PrivateReleaseExpiredReservationsReq = map[string]interface{}
+
+// PrivateReleaseExpiredReservationsRes defines model for PrivateReleaseExpiredReservationsRes.
+type PrivateReleaseExpiredReservationsRes struct {
+	// Released Number of released reservation holds
+	Released int `json:"released"`
+}
+
 // PrivateReserveProductsReq defines model for PrivateReserveProductsReq.
 type PrivateReserveProductsReq struct {
 	Messages []PrivateReserveProductsReqMessage `json:"messages"`
@@ -505,19 +1267,39 @@ type PrivateReserveProductsReq struct {
 
 // PrivateReserveProductsReqMessage defines model for PrivateReserveProductsReqMessage.
 type PrivateReserveProductsReqMessage struct {
-	OperationId string                             `json:"operation_id"`
-	Products    []PrivateReserveProductsReqProduct `json:"products"`
+	OperationId string `json:"operation_id"`
+
+	// OrderId id of the order to be created of the reserved products, products are held for it until it's paid
+	OrderId  string                             `json:"order_id"`
+	Products []PrivateReserveProductsReqProduct `json:"products"`
 }
 
 // PrivateReserveProductsReqProduct defines model for PrivateReserveProductsReqProduct.
 type PrivateReserveProductsReqProduct struct {
 	Count int    `json:"count"`
 	Id    string `json:"id"`
+
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateReserveProductsRes defines model for PrivateReserveProductsRes.
 type PrivateReserveProductsRes = map[string]interface{}
 
+// PrivateSellProductsReq defines model for PrivateSellProductsReq.
+type PrivateSellProductsReq struct {
+	Messages []PrivateSellProductsReqMessage `json:"messages"`
+}
+
+// PrivateSellProductsReqMessage defines model for PrivateSellProductsReqMessage.
+type PrivateSellProductsReqMessage struct {
+	// OrderId paid order whose reserved products are sold
+	OrderId string `json:"order_id"`
+}
+
+// PrivateSellProductsRes defines model for PrivateSellProductsRes.
+type PrivateSellProductsRes = map[string]interface{}
+
 // PrivateUnreserveProductsReq defines model for PrivateUnreserveProductsReq.
 type PrivateUnreserveProductsReq struct {
 	Messages []PrivateUnreserveProductsReqMessage `json:"messages"`
@@ -527,17 +1309,41 @@ type PrivateUnreserveProductsReq struct {
 type PrivateUnreserveProductsReqMessage struct {
 	OrderId  string                               `json:"order_id"`
 	Products []PrivateUnreserveProductsReqProduct `json:"products"`
+
+	// ReturnId return whose received items are restocked, absent for cancelled orders
+	ReturnId *string `json:"return_id,omitempty"`
 }
 
 // PrivateUnreserveProductsReqProduct defines model for PrivateUnreserveProductsReqProduct.
 type PrivateUnreserveProductsReqProduct struct {
 	Count int    `json:"count"`
 	Id    string `json:"id"`
+
+	// SkuId sku of the product, required for products with skus
+	SkuId *string `json:"sku_id,omitempty"`
 }
 
 // PrivateUnreserveProductsRes defines model for PrivateUnreserveProductsRes.
 type PrivateUnreserveProductsRes = map[string]interface{}
 
+// ProductCategory defines model for ProductCategory.
+type ProductCategory struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+
+	// ParentId id of the parent category, absent for root categories
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Path slugs of the category ancestors and the category joined with "/", i.e. "flowers/roses"
+	Path string `json:"path"`
+
+	// Position position of the category among its siblings
+	Position  int    `json:"position"`
+	Slug      string `json:"slug"`
+	UpdatedAt string `json:"updated_at"`
+}
+
 // ReplaceRefreshTokenReq defines model for ReplaceRefreshTokenReq.
 type ReplaceRefreshTokenReq struct {
 	RefreshToken string `json:"refresh_token"`
@@ -549,28 +1355,63 @@ type ReplaceRefreshTokenRes struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// UpdateProductCategoryReq defines model for UpdateProductCategoryReq.
+type UpdateProductCategoryReq struct {
+	Name *string `json:"name,omitempty"`
+
+	// ParentId id of the new parent category, empty string moves the category to the root
+	ParentId *string `json:"parent_id,omitempty"`
+	Position *int    `json:"position,omitempty"`
+	Slug     *string `json:"slug,omitempty"`
+}
+
+// UpdateProductCategoryRes defines model for UpdateProductCategoryRes.
+type UpdateProductCategoryRes = ProductCategory
+
 // UpdateProductReq defines model for UpdateProductReq.
 type UpdateProductReq struct {
+	// CategoryId id of the product category
+	CategoryId  *string                 `json:"category_id,omitempty"`
 	Description *string                 `json:"description,omitempty"`
 	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
 	Name        *string                 `json:"name,omitempty"`
-	Price       *float64                `json:"price,omitempty"`
+
+	// Options option axes of the product variants, values of existing skus must stay valid
+	Options *[]GetProductResOption `json:"options,omitempty"`
+	Price   *float64               `json:"price,omitempty"`
 
 	// StockDelta The amount of "in stock" product count change, either of:
 	// - positive: stock amount is increased (seller releases more products)
 	// - negative: stock amount is decreased (item purchased)
+	//
+	// Stock of the product with skus is changed with sku updates.
 	StockDelta *int `json:"stock_delta,omitempty"`
 }
 
 // UpdateProductRes defines model for UpdateProductRes.
 type UpdateProductRes struct {
+	// CategoryId id of the product category
+	CategoryId  *string                 `json:"category_id,omitempty"`
 	Description *string                 `json:"description,omitempty"`
 	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
 	Name        *string                 `json:"name,omitempty"`
+	Options     *[]GetProductResOption  `json:"options,omitempty"`
 	Price       *float64                `json:"price,omitempty"`
 	Stock       *int                    `json:"stock,omitempty"`
 }
 
+// UpdateProductSkuReq defines model for UpdateProductSkuReq.
+type UpdateProductSkuReq struct {
+	PictureIds *[]string `json:"picture_ids,omitempty"`
+	Price      *float64  `json:"price,omitempty"`
+
+	// StockDelta The amount of sku stock change, negative values withdraw products from stock
+	StockDelta *int `json:"stock_delta,omitempty"`
+}
+
+// UpdateProductSkuRes defines model for UpdateProductSkuRes.
+type UpdateProductSkuRes = GetProductResSku
+
 // UploadProductPictureRes defines model for UploadProductPictureRes.
 type UploadProductPictureRes struct {
 	Id  string `json:"id"`
@@ -584,7 +1425,7 @@ type Error struct {
 
 // ProductsListParams defines parameters for ProductsList.
 type ProductsListParams struct {
-	// Filter Filter, such as "seller.id=foo" or "seller.id=foo&name=bar&in_stock=*"
+	// Filter Filter, such as "seller.id=foo" or "seller.id=foo&name=bar&in_stock=*&category.slug=roses" (products of the category and its descendants)
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 
 	// MaxPageSize Max number of returned results
@@ -598,30 +1439,89 @@ type ProductsUploadPictureMultipartBody struct {
 	File    *openapi_types.File `json:"file,omitempty"`
 }
 
+// ProductsApplyAdCampaignsJSONRequestBody defines body for ProductsApplyAdCampaigns for application/json ContentType.
+type ProductsApplyAdCampaignsJSONRequestBody = PrivateApplyAdCampaignsReq
+
+// ProductsRelayOutboxJSONRequestBody defines body for ProductsRelayOutbox for application/json ContentType.
+type ProductsRelayOutboxJSONRequestBody = PrivateRelayOutboxReq
+
+// ProductsReleaseExpiredReservationsJSONRequestBody defines body for ProductsReleaseExpiredReservations for application/json ContentType.
+type ProductsReleaseExpiredReservationsJSONRequestBody = PrivateReleaseExpiredReservationsReq
+
 // ProductsReserveJSONRequestBody defines body for ProductsReserve for application/json ContentType.
 type ProductsReserveJSONRequestBody = PrivateReserveProductsReq
 
+// ProductsSellJSONRequestBody defines body for ProductsSell for application/json ContentType.
+type ProductsSellJSONRequestBody = PrivateSellProductsReq
+
 // ProductsUnreserveJSONRequestBody defines body for ProductsUnreserve for application/json ContentType.
 type ProductsUnreserveJSONRequestBody = PrivateUnreserveProductsReq
 
+// ProductsCreateCategoryJSONRequestBody defines body for ProductsCreateCategory for application/json ContentType.
+type ProductsCreateCategoryJSONRequestBody = CreateProductCategoryReq
+
+// ProductsUpdateCategoryJSONRequestBody defines body for ProductsUpdateCategory for application/json ContentType.
+type ProductsUpdateCategoryJSONRequestBody = UpdateProductCategoryReq
+
 // ProductsCreateJSONRequestBody defines body for ProductsCreate for application/json ContentType.
 type ProductsCreateJSONRequestBody = CreateProductReq
 
 // ProductsUpdateJSONRequestBody defines body for ProductsUpdate for application/json ContentType.
 type ProductsUpdateJSONRequestBody = UpdateProductReq
 
+// ProductsCreateCampaignJSONRequestBody defines body for ProductsCreateCampaign for application/json ContentType.
+type ProductsCreateCampaignJSONRequestBody = CreateProductCampaignReq
+
 // ProductsUploadPictureMultipartRequestBody defines body for ProductsUploadPicture for multipart/form-data ContentType.
 type ProductsUploadPictureMultipartRequestBody ProductsUploadPictureMultipartBody
 
+// ProductsCreateSkuJSONRequestBody defines body for ProductsCreateSku for application/json ContentType.
+type ProductsCreateSkuJSONRequestBody = CreateProductSkuReq
+
+// ProductsUpdateSkuJSONRequestBody defines body for ProductsUpdateSku for application/json ContentType.
+type ProductsUpdateSkuJSONRequestBody = UpdateProductSkuReq
+
 // Method & Path constants for routes.
+// Apply ad campaigns
+const ProductsApplyAdCampaignsMethod = "POST"
+const ProductsApplyAdCampaignsPath = "/api/private/v1/products/apply-ad-campaigns"
+
+// Relay outbox
+const ProductsRelayOutboxMethod = "POST"
+const ProductsRelayOutboxPath = "/api/private/v1/products/relay-outbox"
+
+// Release expired reservations
+const ProductsReleaseExpiredReservationsMethod = "POST"
+const ProductsReleaseExpiredReservationsPath = "/api/private/v1/products/release-expired-reservations"
+
 // Reserve products
 const ProductsReserveMethod = "POST"
 const ProductsReservePath = "/api/private/v1/products/reserve"
 
+// Sell reserved products
+const ProductsSellMethod = "POST"
+const ProductsSellPath = "/api/private/v1/products/sell"
+
 // List products
 const ProductsUnreserveMethod = "POST"
 const ProductsUnreservePath = "/api/private/v1/products/unreserve"
 
+// List product categories
+const ProductsListCategoriesMethod = "GET"
+const ProductsListCategoriesPath = "/api/v1/categories"
+
+// Create product category
+const ProductsCreateCategoryMethod = "POST"
+const ProductsCreateCategoryPath = "/api/v1/categories"
+
+// Delete product category
+const ProductsDeleteCategoryMethod = "DELETE"
+const ProductsDeleteCategoryPath = "/api/v1/categories/:id"
+
+// Update product category
+const ProductsUpdateCategoryMethod = "PATCH"
+const ProductsUpdateCategoryPath = "/api/v1/categories/:id"
+
 // List products
 const ProductsListMethod = "GET"
 const ProductsListPath = "/api/v1/products"
@@ -640,6 +1540,18 @@ const ProductsGetPath = "/api/v1/products/:product_id"
 const ProductsUpdateMethod = "PATCH"
 const ProductsUpdatePath = "/api/v1/products/:product_id"
 
+// List product ad campaigns
+const ProductsListCampaignsMethod = "GET"
+const ProductsListCampaignsPath = "/api/v1/products/:product_id/campaigns"
+
+// Create product ad campaign
+const ProductsCreateCampaignMethod = "POST"
+const ProductsCreateCampaignPath = "/api/v1/products/:product_id/campaigns"
+
+// Cancel product ad campaign
+const ProductsCancelCampaignMethod = "DELETE"
+const ProductsCancelCampaignPath = "/api/v1/products/:product_id/campaigns/:id"
+
 // Upload a product picture
 const ProductsUploadPictureMethod = "POST"
 const ProductsUploadPicturePath = "/api/v1/products/:product_id/pictures"
@@ -648,14 +1560,50 @@ const ProductsUploadPicturePath = "/api/v1/products/:product_id/pictures"
 const ProductsDeletePictureMethod = "DELETE"
 const ProductsDeletePicturePath = "/api/v1/products/:product_id/pictures/:id"
 
+// Create product sku
+const ProductsCreateSkuMethod = "POST"
+const ProductsCreateSkuPath = "/api/v1/products/:product_id/skus"
+
+// Delete product sku
+const ProductsDeleteSkuMethod = "DELETE"
+const ProductsDeleteSkuPath = "/api/v1/products/:product_id/skus/:id"
+
+// Update product sku
+const ProductsUpdateSkuMethod = "PATCH"
+const ProductsUpdateSkuPath = "/api/v1/products/:product_id/skus/:id"
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Apply ad campaigns
+	// (POST /api/private/v1/products/apply-ad-campaigns)
+	ProductsApplyAdCampaigns(c *gin.Context)
+	// Relay outbox
+	// (POST /api/private/v1/products/relay-outbox)
+	ProductsRelayOutbox(c *gin.Context)
+	// Release expired reservations
+	// (POST /api/private/v1/products/release-expired-reservations)
+	ProductsReleaseExpiredReservations(c *gin.Context)
 	// Reserve products
 	// (POST /api/private/v1/products/reserve)
 	ProductsReserve(c *gin.Context)
+	// Sell reserved products
+	// (POST /api/private/v1/products/sell)
+	ProductsSell(c *gin.Context)
 	// List products
 	// (POST /api/private/v1/products/unreserve)
 	ProductsUnreserve(c *gin.Context)
+	// List product categories
+	// (GET /api/v1/categories)
+	ProductsListCategories(c *gin.Context)
+	// Create product category
+	// (POST /api/v1/categories)
+	ProductsCreateCategory(c *gin.Context)
+	// Delete product category
+	// (DELETE /api/v1/categories/{id})
+	ProductsDeleteCategory(c *gin.Context, id string)
+	// Update product category
+	// (PATCH /api/v1/categories/{id})
+	ProductsUpdateCategory(c *gin.Context, id string)
 	// List products
 	// (GET /api/v1/products)
 	ProductsList(c *gin.Context, params ProductsListParams)
@@ -671,12 +1619,30 @@ type ServerInterface interface {
 
 	// (PATCH /api/v1/products/{product_id})
 	ProductsUpdate(c *gin.Context, productId string)
+	// List product ad campaigns
+	// (GET /api/v1/products/{product_id}/campaigns)
+	ProductsListCampaigns(c *gin.Context, productId string)
+	// Create product ad campaign
+	// (POST /api/v1/products/{product_id}/campaigns)
+	ProductsCreateCampaign(c *gin.Context, productId string)
+	// Cancel product ad campaign
+	// (DELETE /api/v1/products/{product_id}/campaigns/{id})
+	ProductsCancelCampaign(c *gin.Context, productId string, id string)
 	// Upload a product picture
 	// (POST /api/v1/products/{product_id}/pictures)
 	ProductsUploadPicture(c *gin.Context, productId string)
 	// Delete a product picture
 	// (DELETE /api/v1/products/{product_id}/pictures/{id})
 	ProductsDeletePicture(c *gin.Context, productId string, id string)
+	// Create product sku
+	// (POST /api/v1/products/{product_id}/skus)
+	ProductsCreateSku(c *gin.Context, productId string)
+	// Delete product sku
+	// (DELETE /api/v1/products/{product_id}/skus/{id})
+	ProductsDeleteSku(c *gin.Context, productId string, id string)
+	// Update product sku
+	// (PATCH /api/v1/products/{product_id}/skus/{id})
+	ProductsUpdateSku(c *gin.Context, productId string, id string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -688,6 +1654,45 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// ProductsApplyAdCampaigns operation middleware
+func (siw *ServerInterfaceWrapper) ProductsApplyAdCampaigns(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsApplyAdCampaigns(c)
+}
+
+// ProductsRelayOutbox operation middleware
+func (siw *ServerInterfaceWrapper) ProductsRelayOutbox(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsRelayOutbox(c)
+}
+
+// ProductsReleaseExpiredReservations operation middleware
+func (siw *ServerInterfaceWrapper) ProductsReleaseExpiredReservations(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsReleaseExpiredReservations(c)
+}
+
 // ProductsReserve operation middleware
 func (siw *ServerInterfaceWrapper) ProductsReserve(c *gin.Context) {
 
@@ -701,6 +1706,19 @@ func (siw *ServerInterfaceWrapper) ProductsReserve(c *gin.Context) {
 	siw.Handler.ProductsReserve(c)
 }
 
+// ProductsSell operation middleware
+func (siw *ServerInterfaceWrapper) ProductsSell(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsSell(c)
+}
+
 // ProductsUnreserve operation middleware
 func (siw *ServerInterfaceWrapper) ProductsUnreserve(c *gin.Context) {
 
@@ -714,6 +1732,86 @@ func (siw *ServerInterfaceWrapper) ProductsUnreserve(c *gin.Context) {
 	siw.Handler.ProductsUnreserve(c)
 }
 
+// ProductsListCategories operation middleware
+func (siw *ServerInterfaceWrapper) ProductsListCategories(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsListCategories(c)
+}
+
+// ProductsCreateCategory operation middleware
+func (siw *ServerInterfaceWrapper) ProductsCreateCategory(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsCreateCategory(c)
+}
+
+// ProductsDeleteCategory operation middleware
+func (siw *ServerInterfaceWrapper) ProductsDeleteCategory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsDeleteCategory(c, id)
+}
+
+// ProductsUpdateCategory operation middleware
+func (siw *ServerInterfaceWrapper) ProductsUpdateCategory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsUpdateCategory(c, id)
+}
+
 // ProductsList operation middleware
 func (siw *ServerInterfaceWrapper) ProductsList(c *gin.Context) {
 
@@ -847,6 +1945,93 @@ func (siw *ServerInterfaceWrapper) ProductsUpdate(c *gin.Context) {
 	siw.Handler.ProductsUpdate(c, productId)
 }
 
+// ProductsListCampaigns operation middleware
+func (siw *ServerInterfaceWrapper) ProductsListCampaigns(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "product_id" -------------
+	var productId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "product_id", c.Param("product_id"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter product_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsListCampaigns(c, productId)
+}
+
+// ProductsCreateCampaign operation middleware
+func (siw *ServerInterfaceWrapper) ProductsCreateCampaign(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "product_id" -------------
+	var productId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "product_id", c.Param("product_id"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter product_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsCreateCampaign(c, productId)
+}
+
+// ProductsCancelCampaign operation middleware
+func (siw *ServerInterfaceWrapper) ProductsCancelCampaign(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "product_id" -------------
+	var productId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "product_id", c.Param("product_id"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter product_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsCancelCampaign(c, productId, id)
+}
+
 // ProductsUploadPicture operation middleware
 func (siw *ServerInterfaceWrapper) ProductsUploadPicture(c *gin.Context) {
 
@@ -908,6 +2093,102 @@ func (siw *ServerInterfaceWrapper) ProductsDeletePicture(c *gin.Context) {
 	siw.Handler.ProductsDeletePicture(c, productId, id)
 }
 
+// ProductsCreateSku operation middleware
+func (siw *ServerInterfaceWrapper) ProductsCreateSku(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "product_id" -------------
+	var productId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "product_id", c.Param("product_id"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter product_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsCreateSku(c, productId)
+}
+
+// ProductsDeleteSku operation middleware
+func (siw *ServerInterfaceWrapper) ProductsDeleteSku(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "product_id" -------------
+	var productId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "product_id", c.Param("product_id"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter product_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsDeleteSku(c, productId, id)
+}
+
+// ProductsUpdateSku operation middleware
+func (siw *ServerInterfaceWrapper) ProductsUpdateSku(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "product_id" -------------
+	var productId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "product_id", c.Param("product_id"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter product_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ProductsUpdateSku(c, productId, id)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -935,72 +2216,183 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.POST(options.BaseURL+"/api/private/v1/products/apply-ad-campaigns", wrapper.ProductsApplyAdCampaigns)
+	router.POST(options.BaseURL+"/api/private/v1/products/relay-outbox", wrapper.ProductsRelayOutbox)
+	router.POST(options.BaseURL+"/api/private/v1/products/release-expired-reservations", wrapper.ProductsReleaseExpiredReservations)
 	router.POST(options.BaseURL+"/api/private/v1/products/reserve", wrapper.ProductsReserve)
+	router.POST(options.BaseURL+"/api/private/v1/products/sell", wrapper.ProductsSell)
 	router.POST(options.BaseURL+"/api/private/v1/products/unreserve", wrapper.ProductsUnreserve)
+	router.GET(options.BaseURL+"/api/v1/categories", wrapper.ProductsListCategories)
+	router.POST(options.BaseURL+"/api/v1/categories", wrapper.ProductsCreateCategory)
+	router.DELETE(options.BaseURL+"/api/v1/categories/:id", wrapper.ProductsDeleteCategory)
+	router.PATCH(options.BaseURL+"/api/v1/categories/:id", wrapper.ProductsUpdateCategory)
 	router.GET(options.BaseURL+"/api/v1/products", wrapper.ProductsList)
 	router.POST(options.BaseURL+"/api/v1/products", wrapper.ProductsCreate)
 	router.DELETE(options.BaseURL+"/api/v1/products/:product_id", wrapper.ProductsDelete)
 	router.GET(options.BaseURL+"/api/v1/products/:product_id", wrapper.ProductsGet)
 	router.PATCH(options.BaseURL+"/api/v1/products/:product_id", wrapper.ProductsUpdate)
+	router.GET(options.BaseURL+"/api/v1/products/:product_id/campaigns", wrapper.ProductsListCampaigns)
+	router.POST(options.BaseURL+"/api/v1/products/:product_id/campaigns", wrapper.ProductsCreateCampaign)
+	router.DELETE(options.BaseURL+"/api/v1/products/:product_id/campaigns/:id", wrapper.ProductsCancelCampaign)
 	router.POST(options.BaseURL+"/api/v1/products/:product_id/pictures", wrapper.ProductsUploadPicture)
 	router.DELETE(options.BaseURL+"/api/v1/products/:product_id/pictures/:id", wrapper.ProductsDeletePicture)
+	router.POST(options.BaseURL+"/api/v1/products/:product_id/skus", wrapper.ProductsCreateSku)
+	router.DELETE(options.BaseURL+"/api/v1/products/:product_id/skus/:id", wrapper.ProductsDeleteSku)
+	router.PATCH(options.BaseURL+"/api/v1/products/:product_id/skus/:id", wrapper.ProductsUpdateSku)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+wdaW/bOPavCNoFdmYhV8l0Z7proB86nUxQ7BQN0hRYoClcWnq22eoKSaVOA//3BS+d",
-	"lHXYctw23xSLfPfFR5G5t704TOIIIkbt6b1NgCZxREH8cUZITPiDF0cMIsYfUZIE2EMMx5H7icYR/416",
-	"KwiReOv7mL9CwQWJEyAMc0gLFFBw7KTw070NHLh4wgxC8fB3Agt7av/NzWlyJWzqnhFibxyb3SVgT21E",
-	"CLqzNxvHJnCTYgK+PX2vQX7IhsXzT+Axe8MH+kA9ghNOnT2VQwUAhYDjf5GyFUSMsweXcNOXoRDhgD8o",
-	"5JQRHC050Qmi9EtMfMPLKgcCRmFGnRenQibtS+Y6wQToDDEjrQQWBOhqxuLPELUTXB7uFKGbSH+JCHsZ",
-	"ACL8oQvtrRAuYoqlTntJwYvTqCgAHDFYgjCxhMR+6rEZ7qCvwlhHwWxi+w8IgAF/0iT3150vYPizpMD0",
-	"NqdpxJtJrcpQDUMvdr4ZZZwDK5JO+6tCC6h7BGvAm6uiJbrlGHtw9c1o5G2Z9v4KocB6+UUdYaNTlEB3",
-	"Z+AbkT1DQbw8B9Zf5BGs2SxBS8izRZQGAZoHYE8ZSfP4XciFkqw+blMg8ELObvcVjcWpEdkqBI2jnyyM",
-	"KnHsCIVgrgmwx1ICMqoXK5OU8AKggxyxJ2YvYhLyXG77cconZGOjNJwDqYlG2IMgSwMxSoQAYvDC84DS",
-	"Ky63/vXQTpVER5r6WiwSkxtJcrZXRxWKS8DaSx9BvTKu/tIsGYmB8BAY8hFDhZc57mYr7GxFjk1Z7H02",
-	"xaWKWJRtFQkukKfhtBtfJqq+SvbEdL+pwm2TZIMnDxSwdPPWKHcOLOf3Qk/qqyEIAiCzBgYa9efYaeI3",
-	"S8wUP3JMjlnfGd9bVO8UNVUiotko3grELzyR0Pp7Uav/S8ZEgddxcdes+s6rPiXBDou/WmEiqHXKfHWW",
-	"Ht3bCrdBCI2pp5nEd3QH9Y6qJa0enTu3LdENvByXsOXyTYUcFW/609ilDsV+OwEHxXxGSO+K3Adz4AyB",
-	"UrTsoA0BIh9voutPAH+OvM+V7HeL4cuA4gsxTka3vEEEkg4lmgSaTejNxZ4zeUOi27pKcvYkmpak6dgp",
-	"bUrFJkctrdb0VKcm8X4pU+ui4mvDdLGDx2k6CkWO4GtA12W4akO0xmEa2tNfHTvEkXw+cdqq2ZJiFPyO",
-	"PD4a/UMa/V+Ylq1tQJNPUtK9V7EVt3xqbV1onL15u8xUfNQO1cGHiuwJE6CH6U1Je9tN3Rm98qld3Qpn",
-	"t0ZVF4yP0eYBos07MexYirZ+ZNL9tDpHKTkbKopSu+SxPfTYHrJNEtqPXack6Mg2H9mVtu5ZxshZLa04",
-	"diEn0CPdy6mQONJuTgOWg+znzMzWsj9H3bKbU/Q67UJFskyyekN8IFQu1sVzf8vhz6jLrqsJ15tscpWz",
-	"HGxXut8UCRm9BIo51uZgilhKja/kD2MXO2JIRkex3ukTVKWUz4Floh0h3TKEA/oo+SbJD3PKYTadBfFO",
-	"0bxG4ysGoSkzbdHJXkWfizqTvmRkR9kLvvb2EcdBUkjLAq1HhiktmwyFneR023aulCfPyvLpMMWJiBJ9",
-	"jblEpHhoLU4Unm6liQHLo3Ht27ik3o41XtaVf4wRs1nUsoWgQmPfDkcjX9WNbTmuIxV0X1T0W+rmKX57",
-	"Prkg+BYxyL5aLnyf2Vd+av+wu81tw/1abUa2RbgMaY05x15PGFpS6coC0wwl2P7QwvXrfNu0B/OdTVsP",
-	"3Be9tN7U6QBJ99wuSOwBpS/jMBHfVg90naGqbyPjIFbQlYieK89tC4Fic2IPYtINhW3NiTyaZpSNIayL",
-	"AmcH2MDdneJh/iMm/46Yt3qJIg+Cd1GCsK9T580IMHegU4LLlskHDO1N6A/i123I+37j2twLyNpBnTJA",
-	"aXTeZNgjizvYivKSi3QeYLoC/4Fqgk60HM6K2igp/n28Jyr2xe0wB/IQyU+qjGMQJTUYVi79/LRCcGX6",
-	"WNLd3XcvgQK5BT/fZngIrzVQcXB/3ULDwG2ELgXdHiU2YBNqr0a6haS9RbkdDicddCOrfwdoZ2HvHgve",
-	"ReQoooGRjoPHg61U7G15Z+rB7sMZTdQPsxCVex6otmvAfhBraMG956zQuSlUWRLs0iMyczjMTlREOnzo",
-	"qCM+iHU0oz2ScqFOYNcKoWJhGQH7EdNhigJTVh6+1KlxMcxHsqh8eC8xoT6In2xDPFKXdFe5dPYTTdCO",
-	"PrKNhm/JSwx89PSTS0gC5MGlPEJ/LOf1jVR9U9cVVT6XbpdoiKPir6dHeop/5kMgkZTvnrhagYVCbshW",
-	"vLCubRxZYvy1bSlPtYSZW94KRUtwLMBsBcSKF9PraGLJRs4tTOUsDQpTC0ceAUTBt36SazyLQMB/oFYY",
-	"E9DQ6c8cTARLZAbjQwaGBysrSYm34n//bBvPnbQplH4vCm24lsHAfxAjf5Rjv7t/KC0aBl5KMLt7y7ON",
-	"RDYHRIC8SNlKoOZmugLki2PvUoD2/yb8dUzwV6S+L9f5J8H/hTt5/xuOFrEgD7OAvzvz4tB6cfHKduxb",
-	"IFQ6wMmT0ycnqo0Z8eg6tZ8+OXlyIs57s5UgyEUJdlUEdm9PXQ8R5noBIDJR9+SJYeuJGjMRcBhJYeOY",
-	"JydyPTFg+kJtvLnyKyQ3kcvYmad34Gax/himG0Ax3J0j5q0mntj0mKRij2ySf0/VB1JWE1NXgusLQHE0",
-	"SXQvd8JFNik1uYfA0+v8SbECGgIojXYApWe4Coa6WYzVQ7MqpS1TG/KVb0/tQvEgIEm3A8p+j/27Xpc4",
-	"DlokcScTrl64P/KXk5PREVPT7Y76tSVCr3i9QGnAmpBkVLtn+b2QaRgicmcWfV6I6fp14/RVeWY4zUr/",
-	"C1PWrvGsfhxX58ZV2GG0bqyQR9d7Vfp9lX576qKUrVwvjhaYhGf6Qo/15M7jo5eIwRd0N/HUPaghsFXs",
-	"U87Hm7dX4isSvOQJTwAtQBVJ4151sDZuMRZ2GOXe5xuZm+oUcQ9a+ccsx2SWWwTgZsf+ekzJztma5qi3",
-	"xinuvXyok15LN/fFdox5sM5oDW/ce71SrUwvxvklDPZbPkoUFgSFwAQp76uQ/sQBA+JYNPVWFqLWtdoj",
-	"eYL954s4vratmNR+TE9OfvmN10bP54jIv3A0E4Xi839e27Yjy6ibFMhdXkUtBCrbKbhmrZSrkvcarS1Z",
-	"ivIFAwGWkgh8iwBNA8G5CVGI1hdoCW/xVyhhyw6Rn5qK+XsjrAjWjAO7Ugu6Zto/jBimqkfvjiA0ObUw",
-	"I2SZH5DijCMcqdaQPZ+j/9yEHib/+nxze/p0vvp1dSN25Mgt9mCG5DVGciz6BM8AP0s+/xYkv5wsbv79",
-	"7OkiL7pFoCaBLEMVDnFWtUrQLQqwj5i8Ylr9AZfFBKYDn86OZj+Sp8BGSn61a/tGzni1u++abWknU1IL",
-	"LRFyikus9x+4p+SWJunRtva9m5ohyFfTpboiudkc5W07bYFd91PEelhENr7AzANb+d6ZbAEtD7s8TJSr",
-	"XdllMM1XPk8EbAWWukk6s5xR7PQ7j3vG4uJsjfji3ipEu+l19PHjx+vo/OzKqtsv9jfi/dfrqLEWOQf2",
-	"HVps+ZqIkSJpFirPgf0ocVK0w7xVcxCUjdaHNKn9lwK13YCRS4Fas3qLAasjSNYCQ+DTESuDHzr9u8X7",
-	"WswtG9lht1C2YaKmWCy2fEyTAN1Zi5gUBvwUorV1aiViU0SQ5Fj8p6cWjiwWMxT83Nz6kf189bneEXpb",
-	"mAYMJ4gwdxGTcKK3QiDyYl/fFoQDKMy6Uiu/EC3B/ZTA0rHkc6J2E3JKqh8/N2/DaBzZfsocR0isI1sv",
-	"TypvYKj+zog+b96gMbj+H4ghXmylYgqvtPK7YcZcETRZuP0YHGRwEBVX95XCw3pvraWj41UTnmNdkLQ4",
-	"i16NyFH/oAdZPEusP7SrpBQIFX1w/S+ryr1U+d6r/muHrYPU1zoNA0qXnJuGkfp3KXzYJlNK1SUK/3AL",
-	"x5GlJJy7BOfOrntS1vKrTciUXp+k/hVJfY7uzZumEGYaT5hhsDzrWB+umuGNXFiqA1+fqRv39ubD5v8B",
-	"AAD//2crxBdPbgAA",
+	"H4sIAAAAAAAC/+x9a3PctrLgX0FxtyrJFkcj2+fk7NWt80FxfLKpvefaZTl3typKzcGQPRpEJEEDoKSJ",
+	"Sv/9Fl4kSILPecix/cljEY9Go7vR6Bceg4imOc0gEzy4eAwY8JxmHNR/3jBGmfwR0UxAJuRPnOcJibAg",
+	"NFv+zmkm/8ajLaRYfY1jIj/h5B2jOTBB5EgbnHAIg9z502MAcnD1iwhI1Y//yWATXAT/Y1nBtNRj8+Ub",
+	"xoKnMBC7HIKLADOGd8HTUxgw+FgQBnFw8asd8reyGV3/DpEInmTDGHjESC6hCy50UzWAmUDOf1mILWRC",
+	"Lg/ew8epC0oxSeQPMzkXjGQ3Eugcc35PWez52FyBGsPp0V5L2ACTTwXzIScM+AoLL6wMNgz4diXoLWTD",
+	"ANebh+7oPtBf4ywCCWNcROI1TnNMbrLpayCxF3YusCj4MNAkDsrGfiiZuMzzZPeO0ZS+pvEMaohoDPLf",
+	"OtnlckAkv4UowhwQyThknAhyB0EYpPjhPyC7Edvg4tXLMEhJZv/7IhxYk5qvazGvE8BM/hiD6sER3lFO",
+	"9HomYqTIXJojmYAbUFyda4JYde3rbeH/1MCBM0xopuvCyI+QgAD5y65mOhXGaox4lTv46BNhnfPan60F",
+	"tWaYtJzPYZ9+AuGuik/fJYu78UdNx7zVLg0cQ9WME1b1OWyWIy6Hd6lLMCKlYUCMBEViCyjCTJwhOSpH",
+	"XOBd+b3IBEkQg5TeQRwiymJgHGEGKCZcAQoxuidiq4ehRU6z64xuzH9jQGSDiPiGI6PTrBMwk6Zn6Ecz",
+	"hh4xZ3BH4N4d0Mynx7UNzq6zoLlFBl5z4G4oS+WvIMYCFoKkEITtzbGnxwiZH7oTdO3Me4WlSfvjHeeq",
+	"TrjTuZGDmCQv2xN2Csva0L+NXsCfn/EETujNTyCm70aEBdxQVp5nLkeabzu0wREIHqKsSNeS4ukGbWiR",
+	"xcgAyNF6h8rWORZbHoRjJa0D+2szRFu+hkEGD2KV4xuo9NKsSBLJssGFYAV4eMiCN0HuO9AYHXVY2NtZ",
+	"QhebbYgHt65c/uHoEIut86WLzGSr0QRm0XIQfT3DKXg/5CQSBfPozwVLgnDM1pMI6tKWFrJD2VYTs/9m",
+	"oMCyg3gxwgALuIwi4PyD3N3p14O9rlkjYZoqDbDq3AlS2H91bEBcG2z4Xqigb90Lp2J1TSkXbaoxFIwY",
+	"zm5JdoPSIhFEnpmsVCbutyQBrRmY2RHhCEfmYtamoxQ/kLRIg4sX5+qiZv7TIrAwWBfxDXig+kH9vT4n",
+	"zyGLuYFGzx62oIKHLS641G5oFoFWYWRHofAcJQUnd/BPC5JmEc8CbINzD8wSikkKCxeYiSldGuSid65E",
+	"ljtgBc0EyuFzKWdQYrgbOqJxpOCLu8wtDqJb3zqk5pB6AUkCrPNrDlk3Laqv8jivEyVFG8y8XNBabo0O",
+	"egw0kEnS+1VZ3+IigTgIg4rbSEb4Vv0tUgYj/b2ke4cQqrGLPO5GtE/M1/SrCmuhhxYNcxnw/cRZ2+oa",
+	"OCPIVh/90wVe9wmKGWSWRup7TWJkLkG6Uam7WUmj/ycljVkRwlpMMUqFvDThNdcIaU/rKNP1We0XO3c5",
+	"DU5pdoOI4IiTdUKyG96GIy8EwhsBrNauBopHnDmqEE+KG482kZGPBaCE3gNT1rgEC5KhBIRQV8ksRjG5",
+	"UVNCjplCxXqHtrt8CxkPETmDM3Qd3GAWQ7ZglAO/DgZFnYLFaBkTSGOybt8veCarZLMISm8P2lCmiaem",
+	"InvmENv28BJfvE02WQRcULNNtU+/U5LZm/p1sLwOyp3aqK3my86tOhAFB300uL/kcinIgTgsdfm5gmiG",
+	"mdsgYYgw9PglznyYr/X1oCkFgWMssPOxWkYn3dK8NP7VgdMfEH4A3oTyDjOCM3nn5beFNgDhOK5I6t3b",
+	"qw9oiXOyvHuxNJ348rE6UJ6WsqMisFE3z59AlDvA3+Z+K+OUa00YcEGjW9+9sEFQhohc3DiotuMM34Yq",
+	"+J+PggZE3hCBdYjE56A7Ja84+QMQZSiiCS3YoWlJX7GnDffOdppKjP066W3hwZNFRgNJIVIU2USd4kzF",
+	"rYSrD7xITRvCdBc+C4NXt4UPfZ3sNUecO7qnlx3LvapxpqUwg8Amq+5xDFzdFtNPAofg/b2GODK4w0mh",
+	"mQLuQJoSzd4ajlnv7C+JJB54VmEQtSIx98mSFsNZxNq/89vCJZIWvAcRyH2aaoM8qi22e6pHGbeDB/Kq",
+	"H3JjXeSbcT+bfR3YS8XeXRtaX2L39l4pUXEZKWvtdB4dtPBpUSQ/jY1t6bkwjA16MTJvROxLo6OBNqyv",
+	"azT2+MECfDqQ0Glc7gbxF77H9h51l+z22FtHX4SSZy2fFrJ1vMTed+wxbjwSD0Ng9KrnA+D5Zj7cSTVu",
+	"chlHOC+Sq63ppcA5vhnvqLftfXD9AyBe4+i2cZuSQQUzvEtYSDAuHvscF38d8FvoiAalsVRhaX85/7fv",
+	"hwxcZvZyhMnLPY2pa8CO3ofDHlxNs+6EQcG7LkaDNmvbNWxhfJrub/eiIRHm7cUerGnhcO5fal3TgYiJ",
+	"nHld2Kv+qGtfz/Q/OuP9UES3IPxa42yC8jLleSeh8VWn278viKRBJnaUsI6viVvjwc3BIhi4wDpEfEBo",
+	"da1e9+8LbvAs7KsQek4h9B+E13diRsjnnGghwxKTpYUXXv1rMHbIzjkuWmjMjF9J9hlI9hfV7E+nsk1b",
+	"z4GMSSehDh8FtLd6YHdrVuCvPo2vPo2vPo0/mU/DRzXzwmt6E7FCY9+uqQ4DPVKS/aybvhjQEQzyzBSD",
+	"y3xXRc7uL6wLlozcbtlyLGzjdSzvyjyE2yLur36Pz8bv4Wi7NrqTzzmQTdfRtNcxr/09qNtXM05Ylf39",
+	"NXj1a/DqJxy8WqNeG9C3b/bRKK6sz7obwYXlFAMLOZGZYXJSUgPEGWlJY2wLHbM8261nZsLQyq+xHE6x",
+	"70kXcpnQHnIuWD60v2UxsDd3kInLSFB2GL1F/2EIdPU19Jriw+BhIfAN13RE7rCAFc5J8FsNYqm/ni5n",
+	"cvyedErGDiPwuNX+s3L1TchqVjnCKCEbiHZRAiimKSaZDG/KBMqLdaJOCpl0rFrypfpnpb7LcIacRO10",
+	"YkspfVKjSVglS3kD4DU8JA5RRDNepMA4iiEudKUXQAxiSMgdMIh1W6W8Em8GQCnURkm3Bjl5VFIaRQVj",
+	"E9OnNRo7VRC4I7Tgq+pAH2EdxrzDwqKXsroDxr1h4ySLGKSQ6XwttGaAVRJatMXZTaWq6z3Qg/nDx7uK",
+	"m1Qcb/UStfwzc6IHBh1nOSbVf1zVRP+Fb0meO/8vt7zqQ9Nc1aPwqzBjDbINhIVaihp5VO6ca6YtVZfm",
+	"1oWGG8oNsvRXp5vpPM8v45gBn6zRELHz7lBE0xQy0fGtyATr6DfPQr+lWcc5SbnAyaqjvIBEY0RyAplY",
+	"dR61XDAAcXyTfbX9DaDs+irMhRrxJWz1dU7TbWvbPyMfwlCAY39/eX4eDtmDHPqoS4+MClDpM6aGBSPA",
+	"6oWCXpyfn4e9VFUf8eert+jVi++/X7xAOMm3ePESmbbIhqk4sNcgfxn20NqU2kUtQnTX8/1g5zaVTkR3",
+	"RcN13Oi/h2hdkCSWQlrmFuEcM5GaLLNqnr8OztPy9u1Fxt3E+trc/icrJhHlAkmpXpgkP/NnyS6EZrX0",
+	"LfWJozzBkcyCgw1lgIhA95gjkgmldEF8dp1dplXZFJKhlGSUoSIjldVbCeYs2qFv4ezmDN3SHKJb/l2I",
+	"lNosh4uSIgb0X5cffPVUbPcV4XT1l5cv/ubXKG0RmJrWQDLx/V/8x6s8/kh2s9oAjO1SrAUVOPHQUWmo",
+	"NyhVp5JZXxCOGbxjZKywKzXGHO9CZEFACXBe1r1BeVJwZFeE5IpGzXmHPTzxX5cf7I7EckPlojRwI8Zs",
+	"XsdbW+dg0dmyxnZoyCxO+hhB1uLZL7xvvOfNALvquG85LZQduI3ZHFik2IvRFL2Qe/ri/Fw6xzbkQfKj",
+	"3up+Hhq3sQM1/1L8sCq4rwiM8TyrG0Bq7NYWAoXsEJ1L71SRJSQlWtscAY+dcJUDkz/YjJnlFQQj2Xkm",
+	"DCRbdXOwcbOjXk4+yN7Q+6z7rqI/WgprChlF/kh+baBGka7Si3sMsPVb2qBvoLxKT+3Xa1T1k13vppuT",
+	"SGyxkIdM9o1A7m1mskzSSo+DaWdPmmzeYuoGHTm85KPyOvprODWoqImdIUn3jo0OzqjjtzwoHMzekDvg",
+	"tuia2l5ZdU1+qnCNMira1dIMT8RUboU7dDpJHQirE4twOZQ9dTqLqkX6zl6S1ZrSBHDWU0AtPJL2UNkH",
+	"6oi+3+5cHOt1ObD7dFNHJk3RE2bSfQ2Y8efz4FGsqFjTz+GLp0rSeSWp79XLes2G0BRsCNF1sLgO5GF6",
+	"HaxkBYBJ1VZfhSPOe2ttMce4cv08dBhHPlVVYJw57cj6QX/pjtPrCgPwPIve0A9T41DvEPYahLoXhiOa",
+	"JbtJQQJ1RWDMXLqHnio0W6I+2g993YJwrrIxowyVEYoDh/6Q2FO/Z2RnaqOT131mvqE1pbcIlLVGUGRs",
+	"tA6ZCRoiuyBJ5bJP9ZVwGSJyKz/l/hIcarzdKgWxpR4wrgNjg5Ii9VrZJ6yUlQMX+Yj6N81JRmKTT85H",
+	"B4bHlBn1zfW27NxOybZfxsL91gXk6NHZva6PET6E4xp1DT+V5vvKyDvdRqux/B5EwWaoGDP8VM0Zrcuq",
+	"J5jP1QxbNttpxsOaf2M0Vvbx0vYl2kyoctu82knIIJaxaY6okibG8kgqA18HZcnIGrkaNTq/rTTtnzCz",
+	"zZ3f6sT8MHaqsc8AlCAo2Tv1vmi61VSaYfNw2a1uJA46Dr5xTGiXYPbRRLOb06ofOYMHTnPsaVjiGc75",
+	"lgqLJd+ZjW8hQ/dbyJxT+R5bxAX9KkH7Mntof+PzeA4b2+QsOjye1+QnEOXJfPBsvxgEJolHQf5/xiBQ",
+	"qhGqzOyaMqFr2CtmKh3tVTNZE3bX0OQ0r0lhSQtxncmPpSJdafk9ZfXRt9f6Uq1wtWIgMQTxBbouzs9f",
+	"RfrMUb/hOvhuQpxWf/wF3lnaHOb2d6bx56a8SOqbp9fiRBYxjFeC4cx52aLpyJQwgtb95UKAC2lYszbj",
+	"FO8QB5NioilKgz3pthVRPnIblYtyTDZTdTyNPwl6CHGOludsTVdQ0lwSrpit17rFIAZIdbHRkuW9tsIt",
+	"yVP7dNe8JV6ZITpu010cpz+ttoQL6osy0DSlWyGHVENEkxi4QBvCuBibvdSGWg38f/Tsb9Q54IH/SPEo",
+	"pQCwsUbVNrQQE3r5dU+JcdjQy5ME1e4X9z/nxQxPBpo1XnfXsuxmj4noxowRbaqcutaeU47hSIYOrgxa",
+	"PU92qFmRbWie7AiVqFe14pVZzSxJ6hBV0N9eKQkurqvjcSJVe3h6anUzQTvxqj+O96Sq9tLGxXdcQHod",
+	"6CisiolRimOwEpoDuyOqFj+HZDMjj1ca/p2A1Dp8Mjy1umWV8TljXsLoC1wd+VqeC5qzvQ5Cwwr1YWWp",
+	"GOXIlIkH5rY1J4UE264TD0Dn8tibRlGN378E4yidc6tXHacaolSvQejt4P2w61+nSX/RZoKJq60BqX4M",
+	"rtzMMy75xTPL1xP2uCesZ0tPYRyfcx1o08U0Tfk0Smg/qrU5eAaPa2vtVJTp6UaUv9GD98Oui3b+KYRU",
+	"A9SjiirvXCfloYYOpeCpO3DtvfXg3OYo5bMvu13KlntzDcIjMGwJfXWBHKUqvauMDhOM0lt6b6KFyzQC",
+	"Y73PGSjzvfTKO+9f6fXje0wER9bQ0dK7UnvaecOTE9jYGOVxmdfRFqJbWkw2ZBicvDbdvUaqMQFfTbUv",
+	"NedYu7ML6+BelXBNY0yJsFnr/4fsqHWBOxJ33D+NslHfuIRkt146USbCMX44PWF3KZBugKcRtESOhJQX",
+	"65QIRDIuAMf6VUppapH3Xgm93SYkl+bLoux5Z8TvTVKP4OB0WkWQ/grWBoxy0nKKHgzqoM+5oXVzVKDm",
+	"lH653BR/A3qJd9AjeaqNFt00uMobe7M4i3liShVOmfho3vNq4GWFpAEv+DtGI+D8tbYVqdxbm+dWx09p",
+	"RTIpv/ew3lJ6GyoEadQoE1IOEdmQyHEsmSS1SQBwbymyWg8jO7zAmoNKBihLYEz+Uj+kto8jvEYC0Aes",
+	"UX6f26mjlet5Xp2OXESj4pnvMplZA6ImYsBpUhhpdqA81jmySqO/M5u81zG5pYLOm++d7OqbsMf+xmBT",
+	"ZPFqQJXSrSq/7brYAXOMuXpPGESg4vfL8BqrWu5fye5EV1t/5rfPsFxaGOsYrNRps5GH8704RHUCo9AB",
+	"jT5zDpWJphyXAT6RqnMaMF3GdGYsbldCB4OIMicTsubnNE6/f6/C2gQtgzlskhK2bwjizGrYW8rL04iX",
+	"eTYl538rJ0pxVuDE/BFp8L7reIl3lFnftBuJv8nv/M+UGn4gR7KphnhuROjIcy+s7a6OmXEOwlHJ+Qfe",
+	"I2vWmLHmyk/ZpPNqjdJgUfccDgWW1zEwIh1/vs/zhIDuuzn8gE7kXkVGfxxj4EIxMHIHsU48UjcfJ5bh",
+	"qK7rveSCoyx4VYQaBkZLj4Ti2DnODlb0ev8T7Z0uUnOZ58nuMnZKRn5s30T6itt0jsNnjWMe1r/aZVHt",
+	"WYgZhVPM2zhTSgEOgmCLdg2ZKsq5JxUKmgDANFQcvsB+z3shYdBZucp8aJpI9Pyh+ZergC5pMMRaI7Jx",
+	"pjQDpcrwWxVi8u/IYtlGrCI7vmyFk3u847bzjDTuMQ+dHHh7D8Uz/DL+gVIunpNpHBieiWs8EEyNBVlN",
+	"KYQ7wGWdXCFICia8H6n5VPy+W0WHZDKv8wGlJEkIh4hmMQ/L5p8kw5S4O/ReHoxH3hUs2mIVJPSMXOJC",
+	"8Vx84oPhoAdMbidYvTqPO8p1lk1wkkiOmPoUVXuA5ryHx9ZMWkwAs9eYiXfmnf1TUqBv7tPQXd/M0xbv",
+	"XlUaorRKW7YZKYSjSE6tUwJD7wdl/MzBhEN65KQek8R+QTnfTmkbHgqh8wjSPtNj/Sg2WWimpWsubQ6B",
+	"cRIyHQvEfIrdv4L3SFA7K3tPIFCvDb0E+BgInlcpfG4q7f4Az2M51fkHLKLta2XE/SXLMYmt/fvjEcbc",
+	"G06z7h9tFd+DAds18B4QawSU2ZgnPGC7pj+J8BqafBoKnJRTz1M2ZvhxUsRtXeWyHnCJe9BK3RX/n467",
+	"/9Rk0w/J6ShoHBwT79Jpq/hZT/DeyJJqWIC9KhyijLter1exNN+kClgzcBszlor2cFOw4xBV1e7dEBJp",
+	"D1eV60RHLUU11CoF71N63adzb4BhfVgHccchkQMwo33J4JmuSaNgOT1DdkHi/v90L2jMCQWYbzadtCWT",
+	"3WJilVMnTOrgtOL+3xtG1H+ejk709tRkCG0pFRtDX0opzKr6Dqbug/LY6Syv4dJmdbQ1FnGsPd5fuLxX",
+	"EQ/7aa7NoQ4BFQd2B3H1aNJzCDsPFCcXcz0wzCzYduib9wC0M57UOijr9IB0sMNh5ntaR31Lq69GmMaI",
+	"rhGm5lMvMRiolCRUsjFrPnU572XagVC3vfdzf3HzS8Y+CYHjhePkIqcXigOa+nREV08ZOxO6Z6JvTdCt",
+	"Ik8G6oFTiGtV0so65ajM+htp0DsGyvYhS33ct5wssobDnkd1/8jzYDaDPtP1pGP2k7DNwNwHPqHHG6rr",
+	"JqZ9HCv+Fc6jk/eQ4N3bQqzpw1wirg1hva21wOIE78AjUf5THZryOCv9WbUXAtVrgHzYr28nmIPL95AA",
+	"5vBG1QiP9UFWs60ddEQ/dmTzXvTYNohVo6EtTeJxyNHjz8OOnA9OfwK3Jz6J7Oie9sBio9tDXL0aa8tb",
+	"ozUgk8BhP5XqotXQw/KXOou3kOgCs0QGBAuSICK+kbnNJA7Cw10z2ugae7NoSEPHTriX764boGPfK7oU",
+	"e35bNKIJ9y8BvKehqoWkeSeHLMdwesHQmPUkUqFjzkPFhEiurOXFtLhb8TSnSXxc7bm+znlUUWrdpycN",
+	"39QnoY++iT+BMAwfeD2hF5/QFXDP06Bv4V/Pgz40TeZ91fG1fd7+FEV5ug15mHU5SZ0X+VWj8kH+Gr0y",
+	"SssvEkSfwoTF1rO3SXFTPW1jhkaS+rmgjCuDXu3T75Rk1q9xHSzlCyPkDM7QdbCRWbyMLxnlwH0vjKia",
+	"36U/rXGgmC9tUFKa3eicJ7JOSHbD/a98JsXN3vlK2vIoRyqNjiXEBoHT8pLfgyr//R42DPj2gywYNSfJ",
+	"VfWuSmP1L6LefDRUU5OoBh6r3Avo2vtXvhXonL0GC4/BbEoy968vmqvaj0MzuG9zKaS52CE9EkrpnakM",
+	"URK48SpKDh5imf7npTpY4Gk8/vhXKfhVCh5eCtao7RBcatEyRCp6xhKL/re0nL7+MlM4xt64oR5KpnlH",
+	"cRj9AeEHaD7xhu4wIziTZhH1fJn6Dg/EFI+5LThKCy4QF3gnW6hdGqVS/wSixD1/m3dFS0xyGEqVeRVD",
+	"InB7jR9ktlRq37e4DkiGVPvroNoR9TXa4uwGQgREbJXR8OI6WyBNa3dwoXvZoYh6cZxpm+K3ZUEVZSjk",
+	"KKWsxCT/Tg6TwQ32DxNDOYzEH7IJLfF319l1dqVaN/am1Gtlfw12XP7RVO/hZ37D5hA78C+KHZ6VYjvS",
+	"n/p36Oq2OITMskWEJ7/MfCS+lISrOcOyoeUYK38kfccM31fXOxWdqToF4UxEHihX36Go0TX+GmnTpZB1",
+	"ecOMqx6wVj+RJGruqznW2NAm17WGNu0boRBHpIMRyX7akJ3bWDM9he1eX6L/YJe1GMz2vtONP5lyDBIN",
+	"EBWMiN2VlCt6sjVgBuyy0FogUbVXAeuyclp+Bf9/IT9TRv7A9UJpOCf/F6QdTuq22UYVUhJEJPLbm4im",
+	"6PLdz4GTkxycn704O9fkChnOSXARvDo7Pzs3apQCaIlzsjTGieXdi2WEmViqHLpFRDNhn415WJg2CzWO",
+	"YAU8hf7Oxh85t7vyTC6o8o1O6apyOpd8l0ULQ/ILU3tgv1H4AseLMmV8n3HKzNXxA21MntJSmwEvch2n",
+	"sSrf/lpRW9J54oDz0KxmW65lis9C2ygXhcpIWlTlsGeMZFaziG260MzhqofQlhq6qQMY/C5MasCiFuU/",
+	"ezDroF9IAl/UgpLnjKere+3RXbtKFq5xfM5ARbb/UEZWtHlkwQWePt48qrazL2Wk9U7ye2Rr3shBcsP7",
+	"9SP2SmAmkFQZ40Ia6uUdfUMywrdIW49iWcyhHCi0cRqIAS8SdauqfFWm6oMbNPpzHFwEZaWERimeQB9D",
+	"wMUPNN5pc7uStvKneaxfDrL83dSn06rtSO+Hr4DQ05M++3hOMyPCXp6fH39mrs+7Ou4vHcSWOduq1QYX",
+	"SWfF9xL65RvGqFZHeJGmmO3koHLu2p4FYVCZ761T5SmcSlVNovTTk4lQquJ66EYVkyNCQKwKcYFRlbmu",
+	"nG5rC6pxjRmPsCoCyE9JTuTRcYmoESV1GvqpTeolHdXCIs2CszftuKMejmoAc1gYYbJw4ph6pJKJpGpH",
+	"PclKMqKUTObpXU0xJpXkHhiocJfQFCmUIkpFxZRySlCE7zBRD2OUF7FOOusI6To62fUEp52MCnvC2TxE",
+	"aVq6u8bLCLZDEKeiCfDMcjBilUNCH12qBsiXHtGgGz3SkYmkFW9xKspoeY095GA/I2Xq2n/7W6g/xJZL",
+	"E2j3fr+m2R0w4ZFDdIOq6B0lUjhOgIcoBjmwsjbTJO6w+viJRkbhHJdimpFbpyGX+qz9tCKRtjetyAnb",
+	"sVQHopjyvtBNNvJhn2EZUUZfHHfPvXFZp9l4z9QnkBRN7E/d9LsXS1yI7TKi2Yaw9E2KibmB7yLZ+gYL",
+	"uMe7RUSZiVqTz5lwuY63Vx/kdjNyQzIzqDOqMgw9mvj/p6V7gx7RavlYpSs/9XeRSbcLk5Bba6bsOa0/",
+	"WkfvxWNwAz4pWDZBggFIlT2GXGwX6l1j+8SSdamqeDD1N/2os+P19POBelPSdTcfjSzlTHV3PYEBmnQd",
+	"4YckzIaDvUWiYYvclPVZo88iBJPMBA0G6zX+t49pRNhfbj/evXi13v51+zEIA/NW6wpHylmo2+Lf4W9A",
+	"/pbffp/kL883H//33165z7hKhmWJts+ZOdTamwAp9ykWVNntzH/gvSvILAP4peRlqYNLhTxOScbV+1yd",
+	"hPJaectfV463Y0hNPYknKOapbjU3EuNotNoBh5dWX5scgdInOZNSjaE/uPi1buL/9ben31xC1vN5XaGf",
+	"MyF7pebyUYvjIIYEBIwn8zPkyFXtBS/W1bjIjQiNcPaNkNkgepL4rJNHflQNHB5Rb2yBkEu/+LUJWxkZ",
+	"o+MgdEy62FZeHPX3OtGHDgE33Uq/HZEh9MrGMcTPpbveIOxkrKGh/OJYQ7niou0M6t8h5cRTFypL7u0Q",
+	"slhFbcmhIYuxfTnEREwhnMi4LlMkpJs3tB/9WXnj8OdVZxDnic+rzmBID3v+YvbtVEyp5/uSz6vSYVpe",
+	"Y93bxLIssz+hi64239HHfPV2WT7qH+17jPZ+madAFvopvuWj+b+/bfmkfNen5aO8CD0Nt1jmGixfS8cj",
+	"++gmMHqHLZ2+HV+WjzbN5WlUo2X1MPX4xstH/WPyLG7HZflK3Ij+5WMqy8ey7Ih36oZ3evloa8B5W+ux",
+	"aoP2YLjgqm15FcZxzIBzmNZ4+Wh+tpfguoi9l+Vxth/ZaugA+gdJhLpYF9EWYY6uTTmXMxL/fUPpdSBV",
+	"xOYfi/Pzl9/LA+rva8z0/0i2UnbHv/8v/X8r/s5kdPLfTYQ1+nbK6fudPRE/FqDkqDkSNwrkoO8YDJvL",
+	"/Cd+QJmT8G7e9NP+Zd4xUYof3uEbuCJ/QG22vsdR5dS+seRb5HKwDyZr4nnUW8c28amYyT5LG0SfaeEU",
+	"JoUTWF8b8/Wat/YhpXnmgi9I6/JqTnVbQd8tfuiAsPps1wWl/kzQp3eJH315z6uc5cPT6Wcu97xKypsH",
+	"LGMVkSPtLq6zf/3rX9fZT28+oDb9kvhJff/jutuO/xOIz5Bia1kUx5Kkpaj8CcSXIicdk1GfueY5SerI",
+	"1poTqAKN+XoJ2FrTNgRkhMPxNIMv+vhf1oJyjXTuc4za1p+faK25Y/tDZS2N1uJaj6y21ty1gwG1X4DD",
+	"Vj1z5zyNmclXbmU0gAksUBZ4E89rkYXUE4JMBvwO+nV1j89L4DfcuHqJz+9OtnD0upMdmj+xR9md+et5",
+	"YcTOoKf5qsziKLmP8KoIU4hwpDJT3a861wNiBJj1hV6oQZ6ZRcO2i84u5NN3X2sMjmRAu2UnZUE16VcW",
+	"9LCgTTrujvjUObwIN/OUpdc7JjyXeRWytkrV4NsUP6AX6mlHc+6ESP7plcqIoQIn3/U4slXGsJ7iUzwv",
+	"0yIRJMdMLGWa9cLWOoAsorF9z5sk4PT6oIcnKb6B5e853IRI/8416zuQNMs2dNdZsHOUud5rkmFfyYZ2",
+	"1v2pnef+FHCPbPgRCyztY4XqAuVDAcf3ofsp/KtwMMLBczz3GXefl3tbR6mVV3+2QLABZrEGZN3qG34S",
+	"f4ee9SurtFhF1bTsPEMvYyleTAEnb+0grIuqyG9wB2xXftXFNwYumFe3xTNx2ynulqbAznNeKxUIvTdK",
+	"fluc+CZpnsr54tlu4un0fLwS+urm/tlOpW5OaDs3T8ATjaDkL4AnRjqXPmMyP7Lv6nmOmzYIvfHFJ2Ct",
+	"Rmjxl3Xc6ABKXIgtZELuaCPhUH/XRU4vowg4/2AKGXc3MvXWOxpcqWDHnmasXZVZNnsqN6WldVbQy+xu",
+	"g+GK9+Tqgja7VgnLzQ7lprc7vTZ+klYfm5np68KErz0Tnsb6efp2cxMv27kKZCKz2z1tQHfw9NvTfw8A",
+	"r4UYED8fAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -31,9 +31,10 @@ type ApiImpl struct {
 }
 
 type productsListFilter struct {
-	ProductIds []uuid.UUID
-	SellerId   *string
-	InStock    *bool
+	ProductIds   []uuid.UUID
+	SellerId     *string
+	InStock      *bool
+	CategorySlug *string
 }
 
 func parseProductsListFilter(filter string) (productsListFilter, error) {
@@ -46,6 +47,8 @@ func parseProductsListFilter(filter string) (productsListFilter, error) {
 		switch key, val := pair[0], pair[1]; key {
 		case "seller.id":
 			f.SellerId = &val
+		case "category.slug":
+			f.CategorySlug = &val
 		case "in_stock":
 			if val == "*" {
 			} else if val == "true" {
@@ -94,6 +97,9 @@ func (a *ApiImpl) ProductsList(c *gin.Context, params oapi_codegen.ProductsListP
 		if filter.InStock != nil {
 			listProductsReq.Filter.InStock = filter.InStock
 		}
+		if filter.CategorySlug != nil {
+			listProductsReq.Filter.CategorySlug = filter.CategorySlug
+		}
 	}
 
 	res, err := a.ProductsService.ListProducts(c.Request.Context(), listProductsReq)
@@ -110,6 +116,12 @@ func (a *ApiImpl) ProductsList(c *gin.Context, params oapi_codegen.ProductsListP
 			})
 			return
 		}
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
 		msg := "failed to list products"
 		a.Logger.Error(msg, zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
@@ -209,11 +221,20 @@ func (a *ApiImpl) ProductsUpdate(c *gin.Context, id string) {
 	if bodyReq.Metadata != nil {
 		metadata = *bodyReq.Metadata
 	}
+	var options []store.ProductOption
+	if bodyReq.Options != nil {
+		options = make([]store.ProductOption, 0, len(*bodyReq.Options))
+		for _, option := range *bodyReq.Options {
+			options = append(options, store.ProductOption{Name: option.Name, Values: option.Values})
+		}
+	}
 	res, err := a.ProductsService.UpdateProduct(c.Request.Context(), service.UpdateProductReq{
 		Id:          parsedProductId,
 		Name:        bodyReq.Name,
 		Description: bodyReq.Description,
 		Metadata:    metadata,
+		Options:     options,
+		CategoryId:  bodyReq.CategoryId,
 		StockDelta:  stockDelta,
 		Price:       bodyReq.Price,
 	})
@@ -224,6 +245,24 @@ func (a *ApiImpl) ProductsUpdate(c *gin.Context, id string) {
 			})
 			return
 		}
+		if errors.Is(err, service.ErrInvalidProductOptions) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrStockManagedBySkus) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
 
 		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
 			Errors: []oapi_codegen.Err{{Code: 0, Message: "failed to update product"}},
@@ -261,6 +300,12 @@ func (a *ApiImpl) ProductsCreate(c *gin.Context) {
 
 	product, err := a.ProductsService.CreateProduct(c.Request.Context(), &bodyReq, sellerId)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidProductOptions) || errors.Is(err, service.ErrCategoryNotFound) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
 		msg := "failed to create product"
 		a.Logger.Error(msg, zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
@@ -608,6 +653,453 @@ func (a *ApiImpl) ProductsDeletePicture(c *gin.Context, productId string, id str
 	c.JSON(http.StatusOK, oapi_codegen.DeleteProductPictureRes{Id: id})
 }
 
+// authorizeProductAccess aborts request unless subject is an admin or the seller of the product.
+func (a *ApiImpl) authorizeProductAccess(c *gin.Context, productId string) (*oapi_codegen.GetProductRes, bool) {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems on the server side"}},
+		})
+		return nil, false
+	}
+
+	if !slices.Contains([]string{api.SubjectTypeSeller, api.SubjectTypeAdmin}, accessToken.SubjectType) {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+		})
+		return nil, false
+	}
+
+	parsedProductId, err := uuid.Parse(productId)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "invalid product id provided"}},
+		})
+		return nil, false
+	}
+
+	product, err := a.ProductsService.GetProduct(c.Request.Context(), parsedProductId)
+	if err != nil {
+		a.Logger.Error("failed to retrieve product for access check", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "failed to retrieve product"}},
+		})
+		return nil, false
+	}
+	if product == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`product id="%s" not found`, productId)}},
+		})
+		return nil, false
+	}
+	if product.SellerId != accessToken.SubjectId && accessToken.SubjectType != api.SubjectTypeAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "permission denied"}},
+		})
+		return nil, false
+	}
+
+	return product, true
+}
+
+func (a *ApiImpl) ProductsCreateSku(c *gin.Context, productId string) {
+	product, ok := a.authorizeProductAccess(c, productId)
+	if !ok {
+		return
+	}
+
+	var bodyReq oapi_codegen.CreateProductSkuReq
+	if err := c.ShouldBindBodyWithJSON(&bodyReq); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "bad request body: " + err.Error()}},
+		})
+		return
+	}
+
+	res, err := a.ProductsService.CreateSku(c.Request.Context(), product, bodyReq)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidSku) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrSkusLimitReached) {
+			c.AbortWithStatusJSON(http.StatusPreconditionFailed, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		msg := "failed to create product sku"
+		a.Logger.Error(msg, zap.String("product_id", productId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`product id="%s" not found`, productId)}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (a *ApiImpl) ProductsUpdateSku(c *gin.Context, productId string, id string) {
+	product, ok := a.authorizeProductAccess(c, productId)
+	if !ok {
+		return
+	}
+
+	var bodyReq oapi_codegen.UpdateProductSkuReq
+	if err := c.ShouldBindBodyWithJSON(&bodyReq); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "bad request body: " + err.Error()}},
+		})
+		return
+	}
+
+	var pictureIds []string
+	if bodyReq.PictureIds != nil {
+		pictureIds = *bodyReq.PictureIds
+	}
+	res, err := a.ProductsService.UpdateSku(c.Request.Context(), product, service.UpdateSkuReq{
+		Id:         id,
+		Price:      bodyReq.Price,
+		StockDelta: bodyReq.StockDelta,
+		PictureIds: pictureIds,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidSku) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		if errors.Is(err, service.ErrInsufficientStock) {
+			c.AbortWithStatusJSON(http.StatusPreconditionFailed, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		msg := "failed to update product sku"
+		a.Logger.Error(msg, zap.String("product_id", productId), zap.String("id", id), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`sku id="%s" not found`, id)}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (a *ApiImpl) ProductsDeleteSku(c *gin.Context, productId string, id string) {
+	product, ok := a.authorizeProductAccess(c, productId)
+	if !ok {
+		return
+	}
+
+	res, err := a.ProductsService.DeleteSku(c.Request.Context(), uuid.MustParse(product.Id), id)
+	if err != nil {
+		msg := "failed to delete product sku"
+		a.Logger.Error(msg, zap.String("product_id", productId), zap.String("id", id), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`sku id="%s" not found`, id)}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (a *ApiImpl) ProductsListCampaigns(c *gin.Context, productId string) {
+	product, ok := a.authorizeProductAccess(c, productId)
+	if !ok {
+		return
+	}
+
+	res, err := a.ProductsService.ListCampaigns(c.Request.Context(), uuid.MustParse(product.Id))
+	if err != nil {
+		msg := "failed to list product campaigns"
+		a.Logger.Error(msg, zap.String("product_id", productId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (a *ApiImpl) ProductsCreateCampaign(c *gin.Context, productId string) {
+	product, ok := a.authorizeProductAccess(c, productId)
+	if !ok {
+		return
+	}
+
+	var bodyReq oapi_codegen.CreateProductCampaignReq
+	if err := c.ShouldBindBodyWithJSON(&bodyReq); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "bad request body: " + err.Error()}},
+		})
+		return
+	}
+
+	res, err := a.ProductsService.CreateCampaign(c.Request.Context(), uuid.MustParse(product.Id), product.SellerId, bodyReq)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCampaignPeriod) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		msg := "failed to create product campaign"
+		a.Logger.Error(msg, zap.String("product_id", productId), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (a *ApiImpl) ProductsCancelCampaign(c *gin.Context, productId string, id string) {
+	product, ok := a.authorizeProductAccess(c, productId)
+	if !ok {
+		return
+	}
+
+	parsedId, err := uuid.Parse(id)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "invalid campaign id provided"}},
+		})
+		return
+	}
+
+	res, err := a.ProductsService.CancelCampaign(c.Request.Context(), uuid.MustParse(product.Id), parsedId)
+	if err != nil {
+		if errors.Is(err, service.ErrCampaignAlreadyFinished) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		msg := "failed to cancel product campaign"
+		a.Logger.Error(msg, zap.String("product_id", productId), zap.String("id", id), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`campaign id="%s" not found`, id)}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// authorizeAdmin aborts request unless subject is an admin.
+func (a *ApiImpl) authorizeAdmin(c *gin.Context) bool {
+	accessToken, ok := auth.AccessTokenFromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "authentication problems on the server side"}},
+		})
+		return false
+	}
+
+	if accessToken.SubjectType != api.SubjectTypeAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "permission denied"}},
+		})
+		return false
+	}
+
+	return true
+}
+
+func (a *ApiImpl) ProductsListCategories(c *gin.Context) {
+	res, err := a.ProductsService.ListCategories(c.Request.Context())
+	if err != nil {
+		msg := "failed to list categories"
+		a.Logger.Error(msg, zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (a *ApiImpl) ProductsCreateCategory(c *gin.Context) {
+	if !a.authorizeAdmin(c) {
+		return
+	}
+
+	var bodyReq oapi_codegen.CreateProductCategoryReq
+	if err := c.ShouldBindBodyWithJSON(&bodyReq); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "bad request body: " + err.Error()}},
+		})
+		return
+	}
+
+	res, err := a.ProductsService.CreateCategory(c.Request.Context(), bodyReq)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCategory) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		msg := "failed to create category"
+		a.Logger.Error(msg, zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (a *ApiImpl) ProductsUpdateCategory(c *gin.Context, id string) {
+	if !a.authorizeAdmin(c) {
+		return
+	}
+
+	var bodyReq oapi_codegen.UpdateProductCategoryReq
+	if err := c.ShouldBindBodyWithJSON(&bodyReq); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 124, Message: "bad request body: " + err.Error()}},
+		})
+		return
+	}
+
+	res, err := a.ProductsService.UpdateCategory(c.Request.Context(), service.UpdateCategoryReq{
+		Id:       id,
+		Slug:     bodyReq.Slug,
+		Name:     bodyReq.Name,
+		ParentId: bodyReq.ParentId,
+		Position: bodyReq.Position,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCategory) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		msg := "failed to update category"
+		a.Logger.Error(msg, zap.String("id", id), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`category id="%s" not found`, id)}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (a *ApiImpl) ProductsDeleteCategory(c *gin.Context, id string) {
+	if !a.authorizeAdmin(c) {
+		return
+	}
+
+	res, err := a.ProductsService.DeleteCategory(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryInUse) {
+			c.AbortWithStatusJSON(http.StatusConflict, oapi_codegen.Error{
+				Errors: []oapi_codegen.Err{{Code: 0, Message: err.Error()}},
+			})
+			return
+		}
+		msg := "failed to delete category"
+		a.Logger.Error(msg, zap.String("id", id), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: msg}},
+		})
+		return
+	}
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`category id="%s" not found`, id)}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api *ApiImpl) ProductsApplyAdCampaigns(c *gin.Context) {
+	var requestBody oapi_codegen.PrivateApplyAdCampaignsReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		api.Logger.Info("unmarshal apply ad campaigns request body", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`bad request: %s`, err.Error())}},
+		})
+		return
+	}
+
+	if err := api.ProductsService.ApplyAdCampaigns(c.Request.Context()); err != nil {
+		api.Logger.Error("apply ad campaigns", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "failed to apply ad campaigns"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "applied ad campaigns"})
+}
+
+func (api *ApiImpl) ProductsRelayOutbox(c *gin.Context) {
+	var requestBody oapi_codegen.PrivateRelayOutboxReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		api.Logger.Info("unmarshal relay outbox request body", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`bad request: %s`, err.Error())}},
+		})
+		return
+	}
+
+	relayed, err := api.ProductsService.RelayOutbox(c.Request.Context())
+	if err != nil {
+		api.Logger.Error("relay outbox", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "failed to relay outbox"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, oapi_codegen.PrivateRelayOutboxRes{Relayed: relayed})
+}
+
 func (api *ApiImpl) ProductsReserve(c *gin.Context) {
 	var requestBody oapi_codegen.PrivateReserveProductsReq
 	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
@@ -649,6 +1141,49 @@ func (api *ApiImpl) ProductsUnreserve(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "processed unreserve products requests"})
 }
 
+func (api *ApiImpl) ProductsSell(c *gin.Context) {
+	var requestBody oapi_codegen.PrivateSellProductsReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		api.Logger.Info("unmarshal sell products request body", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`bad request: %s`, err.Error())}},
+		})
+		return
+	}
+
+	if err := api.ProductsService.SellProducts(c.Request.Context(), requestBody.Messages); err != nil {
+		api.Logger.Error("sell products", zap.Any("messages", requestBody.Messages), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`failed to process sell products messages: %s`, err.Error())}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "processed sell products requests"})
+}
+
+func (api *ApiImpl) ProductsReleaseExpiredReservations(c *gin.Context) {
+	var requestBody oapi_codegen.PrivateReleaseExpiredReservationsReq
+	if err := json.NewDecoder(c.Request.Body).Decode(&requestBody); err != nil {
+		api.Logger.Info("unmarshal release expired reservations request body", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusBadRequest, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: fmt.Sprintf(`bad request: %s`, err.Error())}},
+		})
+		return
+	}
+
+	released, err := api.ProductsService.ReleaseExpiredReservations(c.Request.Context())
+	if err != nil {
+		api.Logger.Error("release expired reservations", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, oapi_codegen.Error{
+			Errors: []oapi_codegen.Err{{Code: 0, Message: "failed to release expired reservations"}},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, oapi_codegen.PrivateReleaseExpiredReservationsRes{Released: released})
+}
+
 func (api *ApiImpl) ErrorHandlerValidation(c *gin.Context, message string, code int) {
 	api.Logger.Info("validation handled", zap.String("validation_message", message))
 	c.JSON(code, xhttp.NewErrorResponse(xhttp.ErrorResponseErr{Code: code, Message: message}))
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/products/presentation/generated"
+	"github.com/bratushkadan/floral/internal/products/store"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidCampaignPeriod   = errors.New("invalid campaign period")
+	ErrCampaignAlreadyFinished = errors.New("campaign is already finished or cancelled")
+)
+
+func (s *Products) CreateCampaign(ctx context.Context, productId uuid.UUID, sellerId string, req oapi_codegen.CreateProductCampaignReq) (oapi_codegen.CreateProductCampaignRes, error) {
+	now := time.Now()
+	if !req.EndsAt.After(req.StartsAt) {
+		return oapi_codegen.CreateProductCampaignRes{}, fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidCampaignPeriod)
+	}
+	if !req.EndsAt.After(now) {
+		return oapi_codegen.CreateProductCampaignRes{}, fmt.Errorf("%w: ends_at must be in the future", ErrInvalidCampaignPeriod)
+	}
+
+	campaign, err := s.productsStore.CreateCampaign(ctx, store.CreateCampaignDTOInput{
+		Id:        uuid.New(),
+		ProductId: productId,
+		SellerId:  sellerId,
+		Boost:     req.Boost,
+		Budget:    store.ToMinorUnits(req.Budget),
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return oapi_codegen.CreateProductCampaignRes{}, fmt.Errorf("failed to create campaign: %w", err)
+	}
+
+	return oapi_codegen.CreateProductCampaignRes{
+		Id:        campaign.Id.String(),
+		ProductId: campaign.ProductId.String(),
+		SellerId:  campaign.SellerId,
+		Boost:     campaign.Boost,
+		Budget:    store.FromMinorUnits(campaign.Budget),
+		Spent:     store.FromMinorUnits(campaign.Spent),
+		Status:    oapi_codegen.CreateProductCampaignResStatus(campaign.Status),
+		StartsAt:  campaign.StartsAt.Format(time.RFC3339),
+		EndsAt:    campaign.EndsAt.Format(time.RFC3339),
+		CreatedAt: campaign.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: campaign.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *Products) ListCampaigns(ctx context.Context, productId uuid.UUID) (oapi_codegen.ListProductCampaignsRes, error) {
+	campaigns, err := s.productsStore.ListProductCampaigns(ctx, productId)
+	if err != nil {
+		return oapi_codegen.ListProductCampaignsRes{}, fmt.Errorf("failed to list product campaigns: %w", err)
+	}
+
+	res := oapi_codegen.ListProductCampaignsRes{
+		Campaigns: make([]oapi_codegen.ListProductCampaignsResCampaign, 0, len(campaigns)),
+	}
+	for _, campaign := range campaigns {
+		res.Campaigns = append(res.Campaigns, oapi_codegen.ListProductCampaignsResCampaign{
+			Id:        campaign.Id.String(),
+			ProductId: campaign.ProductId.String(),
+			SellerId:  campaign.SellerId,
+			Boost:     campaign.Boost,
+			Budget:    store.FromMinorUnits(campaign.Budget),
+			Spent:     store.FromMinorUnits(campaign.Spent),
+			Status:    oapi_codegen.ListProductCampaignsResCampaignStatus(campaign.Status),
+			StartsAt:  campaign.StartsAt.Format(time.RFC3339),
+			EndsAt:    campaign.EndsAt.Format(time.RFC3339),
+			CreatedAt: campaign.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: campaign.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return res, nil
+}
+
+// CancelCampaign returns nil response if campaign does not exist.
+func (s *Products) CancelCampaign(ctx context.Context, productId, id uuid.UUID) (*oapi_codegen.CancelProductCampaignRes, error) {
+	campaign, err := s.productsStore.CancelCampaign(ctx, store.CancelCampaignDTOInput{
+		Id:        id,
+		ProductId: productId,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrCampaignAlreadyFinished) {
+			return nil, ErrCampaignAlreadyFinished
+		}
+		return nil, fmt.Errorf("failed to cancel campaign: %w", err)
+	}
+	if campaign == nil {
+		return nil, nil
+	}
+
+	return &oapi_codegen.CancelProductCampaignRes{
+		Id:     campaign.Id.String(),
+		Status: campaign.Status,
+	}, nil
+}
+
+func (s *Products) ApplyAdCampaigns(ctx context.Context) error {
+	messages, err := s.productsStore.ApplyAdCampaigns(ctx, time.Now(), s.adCampaignHourlyRate)
+	if err != nil {
+		return fmt.Errorf("failed to apply ad campaigns: %w", err)
+	}
+
+	s.l.Info("applied ad campaigns", zap.Int("products", len(messages)))
+	s.relayOutbox(ctx)
+	return nil
+}
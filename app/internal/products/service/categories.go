@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/products/presentation/generated"
+	"github.com/bratushkadan/floral/internal/products/store"
+	"github.com/google/uuid"
+)
+
+const categoriesLimitCount = 500
+
+var (
+	ErrInvalidCategory  = errors.New("invalid category")
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrCategoryInUse    = errors.New("category has subcategories or products")
+)
+
+var categorySlugRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+func validateCategory(tree *store.CategoryTree, c store.Category) error {
+	if !categorySlugRegexp.MatchString(c.Slug) {
+		return fmt.Errorf(`%w: slug "%s" must be lowercase latin letters and digits separated by hyphens`, ErrInvalidCategory, c.Slug)
+	}
+	if c.Name == "" {
+		return fmt.Errorf("%w: name can't be empty", ErrInvalidCategory)
+	}
+	if existing, ok := tree.CategoryBySlug(c.Slug); ok && existing.Id != c.Id {
+		return fmt.Errorf(`%w: slug "%s" is taken by category id="%s"`, ErrInvalidCategory, c.Slug, existing.Id)
+	}
+	if c.ParentId != nil {
+		if _, ok := tree.Category(*c.ParentId); !ok {
+			return fmt.Errorf(`%w: parent category id="%s" not found`, ErrInvalidCategory, *c.ParentId)
+		}
+		if slices.Contains(tree.Descendants(c.Id), *c.ParentId) {
+			return fmt.Errorf("%w: category can't be moved into itself or its subcategory", ErrInvalidCategory)
+		}
+	}
+	return nil
+}
+
+func (s *Products) ListCategories(ctx context.Context) (oapi_codegen.ListProductCategoriesRes, error) {
+	tree, err := s.productsStore.ListCategories(ctx)
+	if err != nil {
+		return oapi_codegen.ListProductCategoriesRes{}, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	res := oapi_codegen.ListProductCategoriesRes{
+		Categories: make([]oapi_codegen.ProductCategory, 0, len(tree.Categories)),
+	}
+	for _, c := range tree.Sorted() {
+		res.Categories = append(res.Categories, newCategoryRes(tree, c))
+	}
+	return res, nil
+}
+
+func (s *Products) CreateCategory(ctx context.Context, req oapi_codegen.CreateProductCategoryReq) (oapi_codegen.CreateProductCategoryRes, error) {
+	if req.Position != nil && *req.Position < 0 {
+		return oapi_codegen.CreateProductCategoryRes{}, fmt.Errorf("%w: position can't be negative", ErrInvalidCategory)
+	}
+
+	now := time.Now()
+	category := store.Category{
+		Id:        uuid.NewString(),
+		Slug:      req.Slug,
+		Name:      req.Name,
+		ParentId:  req.ParentId,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	tree, err := s.productsStore.UpdateCategoryTree(ctx, store.UpdateCategoryTreeDTOInput{
+		UpdatedAt: now,
+		Update: func(tree *store.CategoryTree) error {
+			if len(tree.Categories) >= categoriesLimitCount {
+				return fmt.Errorf("%w: max amount of categories is %d", ErrInvalidCategory, categoriesLimitCount)
+			}
+			if err := validateCategory(tree, category); err != nil {
+				return err
+			}
+			if req.Position != nil {
+				category.Position = uint32(*req.Position)
+			} else if siblings := tree.Children(category.ParentId); len(siblings) > 0 {
+				category.Position = siblings[len(siblings)-1].Position + 1
+			}
+			tree.Categories = append(tree.Categories, category)
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidCategory) {
+			return oapi_codegen.CreateProductCategoryRes{}, err
+		}
+		return oapi_codegen.CreateProductCategoryRes{}, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return newCategoryRes(tree, category), nil
+}
+
+type UpdateCategoryReq struct {
+	Id   string
+	Slug *string
+	Name *string
+	// ParentId moves the category to the root if empty.
+	ParentId *string
+	Position *int
+}
+
+// UpdateCategory returns nil response if category does not exist.
+func (s *Products) UpdateCategory(ctx context.Context, in UpdateCategoryReq) (*oapi_codegen.UpdateProductCategoryRes, error) {
+	if in.Position != nil && *in.Position < 0 {
+		return nil, fmt.Errorf("%w: position can't be negative", ErrInvalidCategory)
+	}
+
+	var updated *store.Category
+	tree, err := s.productsStore.UpdateCategoryTree(ctx, store.UpdateCategoryTreeDTOInput{
+		UpdatedAt: time.Now(),
+		Update: func(tree *store.CategoryTree) error {
+			updated = nil
+			idx := slices.IndexFunc(tree.Categories, func(c store.Category) bool { return c.Id == in.Id })
+			if idx == -1 {
+				return nil
+			}
+			category := tree.Categories[idx]
+			if in.Slug != nil {
+				category.Slug = *in.Slug
+			}
+			if in.Name != nil {
+				category.Name = *in.Name
+			}
+			if in.ParentId != nil {
+				category.ParentId = in.ParentId
+				if *in.ParentId == "" {
+					category.ParentId = nil
+				}
+			}
+			if in.Position != nil {
+				category.Position = uint32(*in.Position)
+			}
+			if err := validateCategory(tree, category); err != nil {
+				return err
+			}
+			tree.Categories[idx] = category
+			updated = &category
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidCategory) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+	if updated == nil {
+		return nil, nil
+	}
+
+	category, _ := tree.Category(updated.Id)
+	return ptr(newCategoryRes(tree, category)), nil
+}
+
+// DeleteCategory returns nil response if category does not exist.
+func (s *Products) DeleteCategory(ctx context.Context, id string) (*oapi_codegen.DeleteProductCategoryRes, error) {
+	var deleted bool
+	_, err := s.productsStore.UpdateCategoryTree(ctx, store.UpdateCategoryTreeDTOInput{
+		UpdatedAt: time.Now(),
+		Update: func(tree *store.CategoryTree) error {
+			deleted = false
+			if _, ok := tree.Category(id); !ok {
+				return nil
+			}
+			if len(tree.Children(&id)) > 0 {
+				return fmt.Errorf("%w: delete or move its subcategories first", ErrCategoryInUse)
+			}
+			tree.Categories = slices.DeleteFunc(tree.Categories, func(c store.Category) bool { return c.Id == id })
+			deleted = true
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, ErrCategoryInUse) {
+			return nil, err
+		}
+		if errors.Is(err, store.ErrCategoryInUse) {
+			return nil, fmt.Errorf("%w: move its products to other categories first", ErrCategoryInUse)
+		}
+		return nil, fmt.Errorf("failed to delete category: %w", err)
+	}
+	if !deleted {
+		return nil, nil
+	}
+
+	return &oapi_codegen.DeleteProductCategoryRes{Id: id}, nil
+}
+
+// categoryPath returns the path of the category assigned to a product.
+func (s *Products) categoryPath(ctx context.Context, id string) (string, error) {
+	tree, err := s.productsStore.ListCategories(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list categories: %w", err)
+	}
+	if _, ok := tree.Category(id); !ok {
+		return "", fmt.Errorf(`%w: id="%s"`, ErrCategoryNotFound, id)
+	}
+	return tree.Path(id), nil
+}
+
+// categoryDescendants returns ids of the category found by slug and of its descendants.
+func (s *Products) categoryDescendants(ctx context.Context, slug string) ([]string, error) {
+	tree, err := s.productsStore.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	category, ok := tree.CategoryBySlug(slug)
+	if !ok {
+		return nil, fmt.Errorf(`%w: slug="%s"`, ErrCategoryNotFound, slug)
+	}
+	return tree.Descendants(category.Id), nil
+}
+
+func newCategoryRes(tree store.CategoryTree, c store.Category) oapi_codegen.ProductCategory {
+	return oapi_codegen.ProductCategory{
+		Id:        c.Id,
+		Slug:      c.Slug,
+		Name:      c.Name,
+		ParentId:  c.ParentId,
+		Position:  int(c.Position),
+		Path:      tree.Path(c.Id),
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: c.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -20,21 +20,29 @@ type Products struct {
 
 	l                             *zap.Logger
 	encryptNextPageTokenSecretKey string
+	// reservationTtl is the time products are held for orders awaiting payment.
+	reservationTtl time.Duration
+	// adCampaignHourlyRate is the budget an ad campaign spends per hour per boost point.
+	adCampaignHourlyRate float64
 }
 
-func New(products *store.Products, pictures *store.Pictures, logger *zap.Logger, encryptNextPageTokenSecretKey string) *Products {
+func New(products *store.Products, pictures *store.Pictures, logger *zap.Logger, encryptNextPageTokenSecretKey string, reservationTtl time.Duration, adCampaignHourlyRate float64) *Products {
 	return &Products{
 		productsStore:                 products,
 		picturesStore:                 pictures,
 		l:                             logger,
 		encryptNextPageTokenSecretKey: encryptNextPageTokenSecretKey,
+		reservationTtl:                reservationTtl,
+		adCampaignHourlyRate:          adCampaignHourlyRate,
 	}
 }
 
 type ListProductsReqFilter struct {
 	SellerId *string
 	InStock  *bool
-	PageSize *int
+	// CategorySlug filters products of the category and its descendants.
+	CategorySlug *string
+	PageSize     *int
 }
 type ListProductsReq struct {
 	Filter        ListProductsReqFilter
@@ -42,11 +50,12 @@ type ListProductsReq struct {
 }
 
 type ListProductsNextPageSerialized struct {
-	CreatedAt int64     `json:"created_at"`
-	Id        uuid.UUID `json:"id"`
-	InStock   *bool     `json:"in_stock"`
-	SellerId  *string   `json:"seller_id"`
-	PageSize  int       `json:"page_size"`
+	CreatedAt   int64     `json:"created_at"`
+	Id          uuid.UUID `json:"id"`
+	InStock     *bool     `json:"in_stock"`
+	SellerId    *string   `json:"seller_id"`
+	CategoryIds []string  `json:"category_ids"`
+	PageSize    int       `json:"page_size"`
 }
 
 var (
@@ -77,17 +86,25 @@ func (s *Products) ListProducts(ctx context.Context, req ListProductsReq) (oapi_
 			return oapi_codegen.ListProductsRes{}, fmt.Errorf("%w: %w", ErrInvalidListProductsNextPageToken, err)
 		}
 		page = store.ListProductsNextPage{
-			CreatedAt: ptr(time.Unix(deserializedPage.CreatedAt, 0)),
-			Id:        ptr(deserializedPage.Id),
-			InStock:   deserializedPage.InStock,
-			SellerId:  deserializedPage.SellerId,
-			PageSize:  deserializedPage.PageSize,
+			CreatedAt:   ptr(time.Unix(deserializedPage.CreatedAt, 0)),
+			Id:          ptr(deserializedPage.Id),
+			InStock:     deserializedPage.InStock,
+			SellerId:    deserializedPage.SellerId,
+			CategoryIds: deserializedPage.CategoryIds,
+			PageSize:    deserializedPage.PageSize,
 		}
 	} else {
 		page = store.ListProductsNextPage{
 			InStock:  req.Filter.InStock,
 			SellerId: req.Filter.SellerId,
 		}
+		if req.Filter.CategorySlug != nil {
+			categoryIds, err := s.categoryDescendants(ctx, *req.Filter.CategorySlug)
+			if err != nil {
+				return oapi_codegen.ListProductsRes{}, err
+			}
+			page.CategoryIds = categoryIds
+		}
 		if req.Filter.PageSize != nil {
 			page.PageSize = *req.Filter.PageSize
 		} else {
@@ -127,6 +144,7 @@ func (s *Products) ListProducts(ctx context.Context, req ListProductsReq) (oapi_
 			SellerId:   item.SellerId,
 			Price:      item.Price,
 			PictureUrl: pictureUrl,
+			CategoryId: item.CategoryId,
 		})
 	}
 
@@ -137,11 +155,12 @@ func (s *Products) ListProducts(ctx context.Context, req ListProductsReq) (oapi_
 
 	if len(items) > page.PageSize {
 		nextPage := ListProductsNextPageSerialized{
-			CreatedAt: items[page.PageSize].CreatedAt.Unix(),
-			Id:        items[page.PageSize].Id,
-			InStock:   page.InStock,
-			SellerId:  page.SellerId,
-			PageSize:  page.PageSize,
+			CreatedAt:   items[page.PageSize].CreatedAt.Unix(),
+			Id:          items[page.PageSize].Id,
+			InStock:     page.InStock,
+			SellerId:    page.SellerId,
+			CategoryIds: page.CategoryIds,
+			PageSize:    page.PageSize,
 		}
 
 		tokenBytes, err := json.Marshal(&nextPage)
@@ -184,6 +203,9 @@ func (s *Products) GetProduct(ctx context.Context, id uuid.UUID) (*oapi_codegen.
 		Description: product.Description,
 		Pictures:    pictures,
 		Metadata:    product.Metadata,
+		Options:     newOptionsRes(product.Variants.Options),
+		Skus:        newSkusRes(product.Variants.Skus),
+		CategoryId:  product.CategoryId,
 		Stock:       int(product.Stock),
 		Price:       product.Price,
 		CreatedAt:   product.CreatedAt.Format(time.RFC3339),
@@ -192,17 +214,36 @@ func (s *Products) GetProduct(ctx context.Context, id uuid.UUID) (*oapi_codegen.
 }
 
 func (s *Products) CreateProduct(ctx context.Context, req *oapi_codegen.CreateProductReq, sellerId string) (oapi_codegen.CreateProductRes, error) {
+	options := make([]store.ProductOption, 0)
+	if req.Options != nil {
+		options = newProductOptions(*req.Options)
+	}
+	if err := validateProductOptions(options); err != nil {
+		return oapi_codegen.CreateProductRes{}, err
+	}
+	var categoryPath *string
+	if req.CategoryId != nil {
+		path, err := s.categoryPath(ctx, *req.CategoryId)
+		if err != nil {
+			return oapi_codegen.CreateProductRes{}, err
+		}
+		categoryPath = &path
+	}
+
 	product, err := s.productsStore.Upsert(ctx, store.UpsertProductDTOInput{
-		Id:          uuid.New(),
-		SellerId:    ptr(sellerId),
-		Name:        ptr(req.Name),
-		Description: ptr(req.Description),
-		Pictures:    []store.UpsertProductDTOOutputPicture{},
-		Metadata:    map[string]any{},
-		Stock:       ptr(uint32(req.Stock)),
-		Price:       ptr(req.Price),
-		CreatedAt:   ptr(time.Now()),
-		UpdatedAt:   ptr(time.Now()),
+		Id:           uuid.New(),
+		SellerId:     ptr(sellerId),
+		Name:         ptr(req.Name),
+		Description:  ptr(req.Description),
+		Pictures:     []store.UpsertProductDTOOutputPicture{},
+		Metadata:     map[string]any{},
+		Variants:     &store.ProductVariants{Options: options, Skus: make([]store.ProductSku, 0)},
+		CategoryId:   req.CategoryId,
+		CategoryPath: categoryPath,
+		Stock:        ptr(uint32(req.Stock)),
+		Price:        ptr(req.Price),
+		CreatedAt:    ptr(time.Now()),
+		UpdatedAt:    ptr(time.Now()),
 	})
 	if err != nil {
 		return oapi_codegen.CreateProductRes{}, err
@@ -224,6 +265,9 @@ func (s *Products) CreateProduct(ctx context.Context, req *oapi_codegen.CreatePr
 		Description: product.Description,
 		Pictures:    pictures,
 		Metadata:    product.Metadata,
+		Options:     newOptionsRes(product.Variants.Options),
+		Skus:        newSkusRes(product.Variants.Skus),
+		CategoryId:  product.CategoryId,
 		Stock:       int(product.Stock),
 		Price:       product.Price,
 		CreatedAt:   product.CreatedAt.Format(time.RFC3339),
@@ -237,8 +281,11 @@ type UpdateProductReq struct {
 	Description *string
 	Metadata    map[string]any
 	Pictures    []store.UpsertProductDTOOutputPicture
-	StockDelta  *int32
-	Price       *float64
+	// Options replace option axes of the product variants.
+	Options    []store.ProductOption
+	CategoryId *string
+	StockDelta *int32
+	Price      *float64
 }
 
 var (
@@ -253,6 +300,12 @@ func (s *Products) UpdateProduct(ctx context.Context, in UpdateProductReq) (oapi
 		if err != nil {
 			return oapi_codegen.UpdateProductRes{}, fmt.Errorf("failed to retrieve product for calculating in stock value: %w", err)
 		}
+		if product == nil {
+			return oapi_codegen.UpdateProductRes{}, fmt.Errorf(`failed to update product id "%s": %w`, in.Id.String(), ErrProductNotFound)
+		}
+		if len(product.Variants.Skus) > 0 {
+			return oapi_codegen.UpdateProductRes{}, ErrStockManagedBySkus
+		}
 		if int32(product.Stock)+*in.StockDelta < 0 {
 			return oapi_codegen.UpdateProductRes{}, fmt.Errorf("%w: trying to withdraw %d units from stock when there's only %d", ErrInsufficientStock, -1*(*in.StockDelta), product.Stock)
 		}
@@ -260,15 +313,38 @@ func (s *Products) UpdateProduct(ctx context.Context, in UpdateProductReq) (oapi
 		stock = &updatedStock
 	}
 
+	var categoryPath *string
+	if in.CategoryId != nil {
+		path, err := s.categoryPath(ctx, *in.CategoryId)
+		if err != nil {
+			return oapi_codegen.UpdateProductRes{}, err
+		}
+		categoryPath = &path
+	}
+
+	var variants *store.ProductVariants
+	if in.Options != nil {
+		var err error
+		variants, err = s.updateProductOptions(ctx, in.Id, in.Options, time.Now())
+		if err != nil {
+			return oapi_codegen.UpdateProductRes{}, err
+		}
+		if variants == nil {
+			return oapi_codegen.UpdateProductRes{}, fmt.Errorf(`failed to update product id "%s": %w`, in.Id.String(), ErrProductNotFound)
+		}
+	}
+
 	product, err := s.productsStore.Upsert(ctx, store.UpsertProductDTOInput{
-		Id:          in.Id,
-		Name:        in.Name,
-		Description: in.Description,
-		Metadata:    in.Metadata,
-		Pictures:    in.Pictures,
-		Stock:       stock,
-		Price:       in.Price,
-		UpdatedAt:   ptr(time.Now()),
+		Id:           in.Id,
+		Name:         in.Name,
+		Description:  in.Description,
+		Metadata:     in.Metadata,
+		Pictures:     in.Pictures,
+		CategoryId:   in.CategoryId,
+		CategoryPath: categoryPath,
+		Stock:        stock,
+		Price:        in.Price,
+		UpdatedAt:    ptr(time.Now()),
 	})
 	if err != nil {
 		return oapi_codegen.UpdateProductRes{}, err
@@ -285,6 +361,12 @@ func (s *Products) UpdateProduct(ctx context.Context, in UpdateProductReq) (oapi
 	if in.Metadata != nil {
 		res.Metadata = &product.Metadata
 	}
+	if variants != nil {
+		res.Options = ptr(newOptionsRes(variants.Options))
+	}
+	if in.CategoryId != nil {
+		res.CategoryId = product.CategoryId
+	}
 	if in.StockDelta != nil {
 		vstock := int(*stock)
 		res.Stock = &vstock
@@ -312,12 +394,63 @@ func (s *Products) DeleteProduct(ctx context.Context, id uuid.UUID) (oapi_codege
 	return oapi_codegen.DeleteProductRes{Id: out.Id.String()}, nil
 }
 
+// ReserveProducts holds products for orders until they're paid or the reservation ttl passes.
 func (s *Products) ReserveProducts(ctx context.Context, messages []oapi_codegen.PrivateReserveProductsReqMessage) error {
-	return s.productsStore.ReserveProducts(ctx, messages)
+	now := time.Now()
+	if err := s.productsStore.ReserveProducts(ctx, store.ReserveProductsDTOInput{
+		Messages:   messages,
+		ReservedAt: now,
+		ExpiresAt:  now.Add(s.reservationTtl),
+	}); err != nil {
+		return err
+	}
+	s.relayOutbox(ctx)
+	return nil
 }
 
 func (s *Products) UnreserveProducts(ctx context.Context, messages []oapi_codegen.PrivateUnreserveProductsReqMessage) error {
-	return s.productsStore.UnreserveProducts(ctx, messages)
+	if err := s.productsStore.UnreserveProducts(ctx, messages, time.Now()); err != nil {
+		return err
+	}
+	s.relayOutbox(ctx)
+	return nil
+}
+
+// SellProducts deducts products held for paid orders from stock.
+func (s *Products) SellProducts(ctx context.Context, messages []oapi_codegen.PrivateSellProductsReqMessage) error {
+	if err := s.productsStore.SellProducts(ctx, messages, time.Now()); err != nil {
+		return fmt.Errorf("sell products: %w", err)
+	}
+	return nil
+}
+
+// ReleaseExpiredReservations makes products held for orders that weren't paid in time available again.
+func (s *Products) ReleaseExpiredReservations(ctx context.Context) (int, error) {
+	released, err := s.productsStore.ReleaseExpiredReservations(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("release expired reservations: %w", err)
+	}
+	if released > 0 {
+		s.l.Info("released expired reservations", zap.Int("released", released))
+	}
+	return released, nil
+}
+
+// RelayOutbox publishes messages of committed state changes left unpublished, i.e. by failed requests.
+func (s *Products) RelayOutbox(ctx context.Context) (int, error) {
+	relayed, err := s.productsStore.RelayOutbox(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("relay outbox: %w", err)
+	}
+	return relayed, nil
+}
+
+// relayOutbox publishes messages of the committed state change right away.
+// Messages it fails to publish are published by the outbox relay timer.
+func (s *Products) relayOutbox(ctx context.Context) {
+	if _, err := s.productsStore.RelayOutbox(ctx); err != nil {
+		s.l.Error("relay outbox", zap.Error(err))
+	}
 }
 
 func ptr[T any](v T) *T {
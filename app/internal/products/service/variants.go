@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/products/presentation/generated"
+	"github.com/bratushkadan/floral/internal/products/store"
+	"github.com/google/uuid"
+)
+
+const productSkusLimitCount = 50
+
+var (
+	ErrInvalidProductOptions = errors.New("invalid product options")
+	ErrInvalidSku            = errors.New("invalid product sku")
+	ErrSkusLimitReached      = errors.New("product skus limit is reached")
+	ErrStockManagedBySkus    = errors.New("stock of the product with skus is changed with sku updates")
+)
+
+func validateProductOptions(options []store.ProductOption) error {
+	names := make(map[string]struct{}, len(options))
+	for _, option := range options {
+		if _, ok := names[option.Name]; ok {
+			return fmt.Errorf(`%w: option "%s" is duplicated`, ErrInvalidProductOptions, option.Name)
+		}
+		names[option.Name] = struct{}{}
+
+		values := make(map[string]struct{}, len(option.Values))
+		for _, value := range option.Values {
+			if _, ok := values[value]; ok {
+				return fmt.Errorf(`%w: value "%s" of option "%s" is duplicated`, ErrInvalidProductOptions, value, option.Name)
+			}
+			values[value] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// validateSkuOptions checks that the sku has a valid value of every product option and no other options.
+func validateSkuOptions(options []store.ProductOption, skuOptions map[string]string) error {
+	if len(skuOptions) != len(options) {
+		return fmt.Errorf("%w: sku must have a value of every product option", ErrInvalidSku)
+	}
+	for _, option := range options {
+		value, ok := skuOptions[option.Name]
+		if !ok {
+			return fmt.Errorf(`%w: sku has no value of option "%s"`, ErrInvalidSku, option.Name)
+		}
+		if !slices.Contains(option.Values, value) {
+			return fmt.Errorf(`%w: "%s" is not a value of option "%s"`, ErrInvalidSku, value, option.Name)
+		}
+	}
+	return nil
+}
+
+func validateSkuPictures(pictures []oapi_codegen.GetProductResPicture, pictureIds []string) error {
+	for _, id := range pictureIds {
+		if !slices.ContainsFunc(pictures, func(p oapi_codegen.GetProductResPicture) bool { return p.Id == id }) {
+			return fmt.Errorf(`%w: product has no picture id="%s"`, ErrInvalidSku, id)
+		}
+	}
+	return nil
+}
+
+func sameSkuOptions(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if b[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// updateProductOptions replaces options of the product, existing skus must have valid values of the new options.
+func (s *Products) updateProductOptions(ctx context.Context, id uuid.UUID, options []store.ProductOption, updatedAt time.Time) (*store.ProductVariants, error) {
+	if err := validateProductOptions(options); err != nil {
+		return nil, err
+	}
+
+	return s.productsStore.UpdateProductVariants(ctx, store.UpdateProductVariantsDTOInput{
+		Id:        id,
+		UpdatedAt: updatedAt,
+		Update: func(v *store.ProductVariants) error {
+			for _, sku := range v.Skus {
+				if err := validateSkuOptions(options, sku.Options); err != nil {
+					return fmt.Errorf(`%w: sku id="%s" does not match new options: %w`, ErrInvalidProductOptions, sku.Id, err)
+				}
+			}
+			v.Options = options
+			return nil
+		},
+	})
+}
+
+// CreateSku adds a variant of the product. Stock of the product becomes the sum of its skus stocks.
+// Returns nil response if product does not exist.
+func (s *Products) CreateSku(ctx context.Context, product *oapi_codegen.GetProductRes, req oapi_codegen.CreateProductSkuReq) (*oapi_codegen.CreateProductSkuRes, error) {
+	if req.Price < 0 {
+		return nil, fmt.Errorf("%w: price can't be negative", ErrInvalidSku)
+	}
+	var pictureIds []string
+	if req.PictureIds != nil {
+		pictureIds = *req.PictureIds
+	}
+	if err := validateSkuPictures(product.Pictures, pictureIds); err != nil {
+		return nil, err
+	}
+
+	sku := store.ProductSku{
+		Id:         uuid.NewString(),
+		Options:    req.Options,
+		Price:      req.Price,
+		Stock:      uint32(req.Stock),
+		PictureIds: pictureIds,
+	}
+	if sku.PictureIds == nil {
+		sku.PictureIds = make([]string, 0)
+	}
+
+	variants, err := s.productsStore.UpdateProductVariants(ctx, store.UpdateProductVariantsDTOInput{
+		Id:        uuid.MustParse(product.Id),
+		UpdatedAt: time.Now(),
+		Update: func(v *store.ProductVariants) error {
+			if len(v.Options) == 0 {
+				return fmt.Errorf("%w: product has no options, set them with product update first", ErrInvalidSku)
+			}
+			if len(v.Skus) >= productSkusLimitCount {
+				return fmt.Errorf("%w: max amount of skus is %d", ErrSkusLimitReached, productSkusLimitCount)
+			}
+			if err := validateSkuOptions(v.Options, sku.Options); err != nil {
+				return err
+			}
+			for _, existing := range v.Skus {
+				if sameSkuOptions(existing.Options, sku.Options) {
+					return fmt.Errorf(`%w: sku id="%s" has the same options`, ErrInvalidSku, existing.Id)
+				}
+			}
+			v.Skus = append(v.Skus, sku)
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidSku) || errors.Is(err, ErrSkusLimitReached) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create product sku: %w", err)
+	}
+	if variants == nil {
+		return nil, nil
+	}
+
+	return ptr(newSkuRes(sku)), nil
+}
+
+type UpdateSkuReq struct {
+	Id         string
+	Price      *float64
+	StockDelta *int
+	PictureIds []string
+}
+
+// UpdateSku returns nil response if product or sku does not exist.
+func (s *Products) UpdateSku(ctx context.Context, product *oapi_codegen.GetProductRes, in UpdateSkuReq) (*oapi_codegen.UpdateProductSkuRes, error) {
+	if in.Price != nil && *in.Price < 0 {
+		return nil, fmt.Errorf("%w: price can't be negative", ErrInvalidSku)
+	}
+	if err := validateSkuPictures(product.Pictures, in.PictureIds); err != nil {
+		return nil, err
+	}
+
+	var updated *store.ProductSku
+	_, err := s.productsStore.UpdateProductVariants(ctx, store.UpdateProductVariantsDTOInput{
+		Id:        uuid.MustParse(product.Id),
+		UpdatedAt: time.Now(),
+		Update: func(v *store.ProductVariants) error {
+			updated = nil
+			idx := slices.IndexFunc(v.Skus, func(sku store.ProductSku) bool { return sku.Id == in.Id })
+			if idx == -1 {
+				return nil
+			}
+			sku := &v.Skus[idx]
+			if in.StockDelta != nil {
+				if int(sku.Stock)+*in.StockDelta < 0 {
+					return fmt.Errorf("%w: trying to withdraw %d units from sku stock when there's only %d", ErrInsufficientStock, -1*(*in.StockDelta), sku.Stock)
+				}
+				sku.Stock = uint32(int(sku.Stock) + *in.StockDelta)
+			}
+			if in.Price != nil {
+				sku.Price = *in.Price
+			}
+			if in.PictureIds != nil {
+				sku.PictureIds = in.PictureIds
+			}
+			updated = ptr(*sku)
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, ErrInsufficientStock) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update product sku: %w", err)
+	}
+	if updated == nil {
+		return nil, nil
+	}
+
+	return ptr(newSkuRes(*updated)), nil
+}
+
+// DeleteSku returns nil response if product or sku does not exist.
+// Holds of the deleted sku are sold and released without changing stock.
+func (s *Products) DeleteSku(ctx context.Context, productId uuid.UUID, id string) (*oapi_codegen.DeleteProductSkuRes, error) {
+	var deleted bool
+	_, err := s.productsStore.UpdateProductVariants(ctx, store.UpdateProductVariantsDTOInput{
+		Id:        productId,
+		UpdatedAt: time.Now(),
+		Update: func(v *store.ProductVariants) error {
+			skus := slices.DeleteFunc(slices.Clone(v.Skus), func(sku store.ProductSku) bool { return sku.Id == id })
+			deleted = len(skus) < len(v.Skus)
+			v.Skus = skus
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete product sku: %w", err)
+	}
+	if !deleted {
+		return nil, nil
+	}
+
+	return &oapi_codegen.DeleteProductSkuRes{Id: id}, nil
+}
+
+func newOptionsRes(options []store.ProductOption) []oapi_codegen.GetProductResOption {
+	res := make([]oapi_codegen.GetProductResOption, 0, len(options))
+	for _, option := range options {
+		res = append(res, oapi_codegen.GetProductResOption{
+			Name:   option.Name,
+			Values: option.Values,
+		})
+	}
+	return res
+}
+
+func newSkusRes(skus []store.ProductSku) []oapi_codegen.GetProductResSku {
+	res := make([]oapi_codegen.GetProductResSku, 0, len(skus))
+	for _, sku := range skus {
+		res = append(res, newSkuRes(sku))
+	}
+	return res
+}
+
+func newSkuRes(sku store.ProductSku) oapi_codegen.GetProductResSku {
+	pictureIds := sku.PictureIds
+	if pictureIds == nil {
+		pictureIds = make([]string, 0)
+	}
+	return oapi_codegen.GetProductResSku{
+		Id:         sku.Id,
+		Options:    sku.Options,
+		Price:      sku.Price,
+		Stock:      int(sku.Stock),
+		PictureIds: pictureIds,
+	}
+}
+
+func newProductOptions(options []oapi_codegen.GetProductResOption) []store.ProductOption {
+	res := make([]store.ProductOption, 0, len(options))
+	for _, option := range options {
+		res = append(res, store.ProductOption{
+			Name:   option.Name,
+			Values: option.Values,
+		})
+	}
+	return res
+}
@@ -0,0 +1,531 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/products/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tableAdCampaigns = "`products/ad_campaigns`"
+
+	topicProductsAdBoost = "products/ad_boost_topic"
+
+	tableAdCampaignsIndexProductIdCreatedAt = "idx_product_id_created_at"
+)
+
+const (
+	CampaignStatusScheduled = "scheduled"
+	CampaignStatusActive    = "active"
+	CampaignStatusFinished  = "finished"
+	CampaignStatusCancelled = "cancelled"
+	// Campaign spent its whole budget before it ended.
+	CampaignStatusExhausted = "exhausted"
+
+	// DefaultAdBoost is the ranking multiplier of products without active campaigns.
+	DefaultAdBoost = 1.0
+)
+
+var (
+	ErrCampaignAlreadyFinished = errors.New("campaign is already finished or cancelled")
+)
+
+// Budgets are summed and compared in minor currency units to avoid floating point errors.
+func ToMinorUnits(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+func FromMinorUnits(amount int64) float64 {
+	return float64(amount) / 100
+}
+
+type Campaign struct {
+	Id        uuid.UUID
+	ProductId uuid.UUID
+	SellerId  string
+	Boost     float64
+	// Budget and Spent are in minor currency units.
+	Budget   int64
+	Spent    int64
+	Status   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	// ChargedAt is the time the campaign is charged up to, nil until it's charged for the first time.
+	ChargedAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+var queryCreateCampaign = template.ReplaceAllPairs(`
+DECLARE $id AS String;
+DECLARE $product_id AS String;
+DECLARE $seller_id AS Utf8;
+DECLARE $boost AS Double;
+DECLARE $budget AS Double;
+DECLARE $budget_minor AS Int64;
+DECLARE $status AS Utf8;
+DECLARE $starts_at AS Datetime;
+DECLARE $ends_at AS Datetime;
+DECLARE $created_at AS Datetime;
+
+INSERT INTO {{table.tableAdCampaigns}} (id, product_id, seller_id, boost, budget, budget_minor, spent, spent_minor, status, starts_at, ends_at, created_at, updated_at)
+VALUES ($id, $product_id, $seller_id, $boost, $budget, $budget_minor, 0.0, 0l, $status, $starts_at, $ends_at, $created_at, $created_at);
+`,
+	"{{table.tableAdCampaigns}}", tableAdCampaigns,
+)
+
+type CreateCampaignDTOInput struct {
+	Id        uuid.UUID
+	ProductId uuid.UUID
+	SellerId  string
+	Boost     float64
+	// Budget is in minor currency units.
+	Budget    int64
+	StartsAt  time.Time
+	EndsAt    time.Time
+	CreatedAt time.Time
+}
+
+// CreateCampaign stores a scheduled campaign, it is started by the next ApplyAdCampaigns run.
+func (p *Products) CreateCampaign(ctx context.Context, in CreateCampaignDTOInput) (Campaign, error) {
+	if err := p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, queryCreateCampaign, table.NewQueryParameters(
+			table.ValueParam("$id", types.StringValueFromString(in.Id.String())),
+			table.ValueParam("$product_id", types.StringValueFromString(in.ProductId.String())),
+			table.ValueParam("$seller_id", types.UTF8Value(in.SellerId)),
+			table.ValueParam("$boost", types.DoubleValue(in.Boost)),
+			table.ValueParam("$budget", types.DoubleValue(FromMinorUnits(in.Budget))),
+			table.ValueParam("$budget_minor", types.Int64Value(in.Budget)),
+			table.ValueParam("$status", types.UTF8Value(CampaignStatusScheduled)),
+			table.ValueParam("$starts_at", types.DatetimeValueFromTime(in.StartsAt)),
+			table.ValueParam("$ends_at", types.DatetimeValueFromTime(in.EndsAt)),
+			table.ValueParam("$created_at", types.DatetimeValueFromTime(in.CreatedAt)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		return res.Err()
+	}); err != nil {
+		return Campaign{}, err
+	}
+
+	return Campaign{
+		Id:        in.Id,
+		ProductId: in.ProductId,
+		SellerId:  in.SellerId,
+		Boost:     in.Boost,
+		Budget:    in.Budget,
+		Status:    CampaignStatusScheduled,
+		StartsAt:  in.StartsAt,
+		EndsAt:    in.EndsAt,
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.CreatedAt,
+	}, nil
+}
+
+var queryListProductCampaigns = template.ReplaceAllPairs(`
+DECLARE $product_id AS String;
+
+SELECT
+    id,
+    product_id,
+    seller_id,
+    boost,
+    COALESCE(budget_minor, CAST(Math::Round(budget * 100.0) AS Int64)) AS budget,
+    COALESCE(spent_minor, CAST(Math::Round(COALESCE(spent, 0.0) * 100.0) AS Int64)) AS spent,
+    status,
+    starts_at,
+    ends_at,
+    charged_at,
+    created_at,
+    updated_at
+FROM
+    {{table.tableAdCampaigns}}
+    VIEW {{index.productIdCreatedAt}}
+WHERE
+    product_id = $product_id
+ORDER BY
+    created_at DESC;
+`,
+	"{{table.tableAdCampaigns}}", tableAdCampaigns,
+	"{{index.productIdCreatedAt}}", tableAdCampaignsIndexProductIdCreatedAt,
+)
+
+func (p *Products) ListProductCampaigns(ctx context.Context, productId uuid.UUID) ([]Campaign, error) {
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	var campaigns []Campaign
+
+	if err := p.db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		campaigns = make([]Campaign, 0)
+
+		_, res, err := s.Execute(ctx, readTx, queryListProductCampaigns, table.NewQueryParameters(
+			table.ValueParam("$product_id", types.StringValueFromString(productId.String())),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				campaign, err := scanCampaign(res)
+				if err != nil {
+					return err
+				}
+				campaigns = append(campaigns, campaign)
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return campaigns, nil
+}
+
+var queryGetProductCampaign = template.ReplaceAllPairs(`
+DECLARE $id AS String;
+DECLARE $product_id AS String;
+
+SELECT
+    id,
+    product_id,
+    seller_id,
+    boost,
+    COALESCE(budget_minor, CAST(Math::Round(budget * 100.0) AS Int64)) AS budget,
+    COALESCE(spent_minor, CAST(Math::Round(COALESCE(spent, 0.0) * 100.0) AS Int64)) AS spent,
+    status,
+    starts_at,
+    ends_at,
+    charged_at,
+    created_at,
+    updated_at
+FROM
+    {{table.tableAdCampaigns}}
+WHERE
+    id = $id
+        AND
+    product_id = $product_id;
+`,
+	"{{table.tableAdCampaigns}}", tableAdCampaigns,
+)
+
+var queryUpdateCampaign = template.ReplaceAllPairs(`
+DECLARE $id AS String;
+DECLARE $status AS Utf8;
+DECLARE $ends_at AS Datetime;
+DECLARE $updated_at AS Datetime;
+
+UPDATE {{table.tableAdCampaigns}}
+SET
+    status = $status,
+    ends_at = $ends_at,
+    updated_at = $updated_at
+WHERE
+    id = $id;
+`,
+	"{{table.tableAdCampaigns}}", tableAdCampaigns,
+)
+
+type CancelCampaignDTOInput struct {
+	Id        uuid.UUID
+	ProductId uuid.UUID
+	UpdatedAt time.Time
+}
+
+// CancelCampaign cancels scheduled campaign. Active campaign is cut short instead:
+// the next ApplyAdCampaigns run finishes it and resets product ad boost.
+// Returns nil campaign if it does not exist.
+func (p *Products) CancelCampaign(ctx context.Context, in CancelCampaignDTOInput) (*Campaign, error) {
+	var out *Campaign
+
+	if err := p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		out = nil
+
+		res, err := tx.Execute(ctx, queryGetProductCampaign, table.NewQueryParameters(
+			table.ValueParam("$id", types.StringValueFromString(in.Id.String())),
+			table.ValueParam("$product_id", types.StringValueFromString(in.ProductId.String())),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		var campaign *Campaign
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				c, err := scanCampaign(res)
+				if err != nil {
+					return err
+				}
+				campaign = &c
+			}
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
+		if campaign == nil {
+			return nil
+		}
+
+		switch campaign.Status {
+		case CampaignStatusScheduled:
+			campaign.Status = CampaignStatusCancelled
+		case CampaignStatusActive:
+			if campaign.EndsAt.After(in.UpdatedAt) {
+				campaign.EndsAt = in.UpdatedAt
+			}
+		default:
+			return ErrCampaignAlreadyFinished
+		}
+		campaign.UpdatedAt = in.UpdatedAt
+
+		updateRes, err := tx.Execute(ctx, queryUpdateCampaign, table.NewQueryParameters(
+			table.ValueParam("$id", types.StringValueFromString(campaign.Id.String())),
+			table.ValueParam("$status", types.UTF8Value(campaign.Status)),
+			table.ValueParam("$ends_at", types.DatetimeValueFromTime(campaign.EndsAt)),
+			table.ValueParam("$updated_at", types.DatetimeValueFromTime(campaign.UpdatedAt)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = updateRes.Close() }()
+
+		out = campaign
+		return updateRes.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+var queryListDueCampaigns = template.ReplaceAllPairs(`
+DECLARE $now AS Datetime;
+
+SELECT
+    id,
+    product_id,
+    seller_id,
+    boost,
+    COALESCE(budget_minor, CAST(Math::Round(budget * 100.0) AS Int64)) AS budget,
+    COALESCE(spent_minor, CAST(Math::Round(COALESCE(spent, 0.0) * 100.0) AS Int64)) AS spent,
+    status,
+    starts_at,
+    ends_at,
+    charged_at,
+    created_at,
+    updated_at
+FROM
+    {{table.tableAdCampaigns}}
+WHERE
+    status IN ("{{status.scheduled}}", "{{status.active}}")
+        AND
+    starts_at <= $now;
+`,
+	"{{table.tableAdCampaigns}}", tableAdCampaigns,
+	"{{status.scheduled}}", CampaignStatusScheduled,
+	"{{status.active}}", CampaignStatusActive,
+)
+
+var queryUpdateCampaignsStatus = template.ReplaceAllPairs(`
+DECLARE $campaigns AS List<Struct<
+    id:String,
+    status:Utf8,
+    spent:Double,
+    spent_minor:Int64,
+    charged_at:Datetime,
+    updated_at:Datetime,
+>>;
+
+UPDATE {{table.tableAdCampaigns}} ON
+SELECT * FROM AS_TABLE($campaigns);
+`,
+	"{{table.tableAdCampaigns}}", tableAdCampaigns,
+)
+
+// ApplyAdCampaigns charges due campaigns, starts scheduled ones, finishes expired ones and
+// exhausts ones that spent their budget, then publishes ad boost of every product whose campaigns changed state.
+// Product ad boost is the highest boost of its active campaigns.
+//
+// Messages are written to the outbox in the same transaction as campaigns states.
+// Ad boost messages are versioned by the time they're computed at, so the catalog skips messages
+// delivered after a newer one.
+func (p *Products) ApplyAdCampaigns(ctx context.Context, now time.Time, hourlyRate float64) ([]oapi_codegen.PrivateCatalogSyncProductsAdBoostReqMessage, error) {
+	var messages []oapi_codegen.PrivateCatalogSyncProductsAdBoostReqMessage
+	version := now.UnixMilli()
+
+	if err := p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		messages = nil
+
+		res, err := tx.Execute(ctx, queryListDueCampaigns, table.NewQueryParameters(
+			table.ValueParam("$now", types.DatetimeValueFromTime(now)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		var campaigns []Campaign
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				campaign, err := scanCampaign(res)
+				if err != nil {
+					return err
+				}
+				campaigns = append(campaigns, campaign)
+			}
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
+
+		var (
+			updates          []types.Value
+			changedProducts  []uuid.UUID
+			productsAdBoosts = make(map[uuid.UUID]float64)
+		)
+		for _, campaign := range campaigns {
+			chargedAt := chargeCampaign(&campaign, now, hourlyRate)
+
+			status := CampaignStatusActive
+			if campaign.Spent >= campaign.Budget {
+				status = CampaignStatusExhausted
+			} else if !campaign.EndsAt.After(now) {
+				status = CampaignStatusFinished
+			}
+
+			if status == CampaignStatusActive {
+				productsAdBoosts[campaign.ProductId] = max(productsAdBoosts[campaign.ProductId], campaign.Boost)
+			}
+			updatedAt := campaign.UpdatedAt
+			if status != campaign.Status {
+				updatedAt = now
+				changedProducts = append(changedProducts, campaign.ProductId)
+			}
+
+			updates = append(updates, types.StructValue(
+				types.StructFieldValue("id", types.StringValueFromString(campaign.Id.String())),
+				types.StructFieldValue("status", types.UTF8Value(status)),
+				types.StructFieldValue("spent", types.DoubleValue(FromMinorUnits(campaign.Spent))),
+				types.StructFieldValue("spent_minor", types.Int64Value(campaign.Spent)),
+				types.StructFieldValue("charged_at", types.DatetimeValueFromTime(chargedAt)),
+				types.StructFieldValue("updated_at", types.DatetimeValueFromTime(updatedAt)),
+			))
+		}
+
+		if len(updates) == 0 {
+			return nil
+		}
+
+		updateRes, err := tx.Execute(ctx, queryUpdateCampaignsStatus, table.NewQueryParameters(
+			table.ValueParam("$campaigns", types.ListValue(updates...)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = updateRes.Close() }()
+		if err := updateRes.Err(); err != nil {
+			return err
+		}
+
+		published := make(map[uuid.UUID]struct{}, len(changedProducts))
+		for _, productId := range changedProducts {
+			if _, ok := published[productId]; ok {
+				continue
+			}
+			published[productId] = struct{}{}
+
+			adBoost, ok := productsAdBoosts[productId]
+			if !ok {
+				adBoost = DefaultAdBoost
+			}
+			messages = append(messages, oapi_codegen.PrivateCatalogSyncProductsAdBoostReqMessage{
+				ProductId: productId.String(),
+				AdBoost:   adBoost,
+				Version:   &version,
+			})
+		}
+
+		msgs, err := outbox.NewMessages(topicProductsAdBoost, func(msg oapi_codegen.PrivateCatalogSyncProductsAdBoostReqMessage) string {
+			return fmt.Sprintf("ad_boost:%s:%d", msg.ProductId, version)
+		}, messages...)
+		if err != nil {
+			return fmt.Errorf("products ad boost messages: %v", err)
+		}
+		return p.outbox.EnqueueTableTx(ctx, tx, msgs...)
+	}); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// chargeCampaign adds the cost of the time the campaign was active since its previous charge up to now
+// to the spent budget, the spent budget never exceeds the campaign budget. Returns the time the campaign is charged up to.
+func chargeCampaign(c *Campaign, now time.Time, hourlyRate float64) time.Time {
+	from := c.StartsAt
+	if c.ChargedAt != nil && c.ChargedAt.After(from) {
+		from = *c.ChargedAt
+	}
+	to := now
+	if c.EndsAt.Before(to) {
+		to = c.EndsAt
+	}
+	if !to.After(from) {
+		return from
+	}
+
+	c.Spent = min(c.Budget, c.Spent+ToMinorUnits(c.Boost*hourlyRate*to.Sub(from).Hours()))
+	c.ChargedAt = &to
+	return to
+}
+
+type namedScanner interface {
+	ScanNamed(namedValues ...named.Value) error
+}
+
+func scanCampaign(res namedScanner) (Campaign, error) {
+	var (
+		c                   Campaign
+		strId, strProductId string
+	)
+	if err := res.ScanNamed(
+		named.Required("id", &strId),
+		named.Required("product_id", &strProductId),
+		named.Required("seller_id", &c.SellerId),
+		named.Required("boost", &c.Boost),
+		named.OptionalWithDefault("budget", &c.Budget),
+		named.OptionalWithDefault("spent", &c.Spent),
+		named.Required("status", &c.Status),
+		named.Required("starts_at", &c.StartsAt),
+		named.Required("ends_at", &c.EndsAt),
+		named.Optional("charged_at", &c.ChargedAt),
+		named.Required("created_at", &c.CreatedAt),
+		named.Required("updated_at", &c.UpdatedAt),
+	); err != nil {
+		return Campaign{}, err
+	}
+
+	var err error
+	if c.Id, err = uuid.Parse(strId); err != nil {
+		return Campaign{}, fmt.Errorf("failed to parse campaign uuid from string id: %v", err)
+	}
+	if c.ProductId, err = uuid.Parse(strProductId); err != nil {
+		return Campaign{}, fmt.Errorf("failed to parse product uuid from string id: %v", err)
+	}
+	return c, nil
+}
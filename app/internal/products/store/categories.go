@@ -0,0 +1,358 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tableCategories = "`products/categories`"
+
+	tableProductsIndexCategoryId = "idx_category_id"
+)
+
+var (
+	ErrCategoryInUse = errors.New("category has products")
+)
+
+type Category struct {
+	Id       string
+	Slug     string
+	Name     string
+	ParentId *string
+	// Position orders the category among its siblings.
+	Position  uint32
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CategoryTree is the categories hierarchy managed by admins, it is small enough to be read as a whole.
+type CategoryTree struct {
+	Categories []Category
+}
+
+func (t CategoryTree) Category(id string) (Category, bool) {
+	for _, c := range t.Categories {
+		if c.Id == id {
+			return c, true
+		}
+	}
+	return Category{}, false
+}
+
+func (t CategoryTree) CategoryBySlug(slug string) (Category, bool) {
+	for _, c := range t.Categories {
+		if c.Slug == slug {
+			return c, true
+		}
+	}
+	return Category{}, false
+}
+
+// Children returns children of the category ordered by position, root categories if parentId is nil.
+func (t CategoryTree) Children(parentId *string) []Category {
+	children := make([]Category, 0)
+	for _, c := range t.Categories {
+		if ptrValue(c.ParentId) == ptrValue(parentId) {
+			children = append(children, c)
+		}
+	}
+	slices.SortFunc(children, func(a, b Category) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.Slug, b.Slug))
+	})
+	return children
+}
+
+// Sorted returns categories depth-first, siblings are ordered by position.
+func (t CategoryTree) Sorted() []Category {
+	sorted := make([]Category, 0, len(t.Categories))
+	var walk func(parentId *string)
+	walk = func(parentId *string) {
+		for _, c := range t.Children(parentId) {
+			sorted = append(sorted, c)
+			walk(&c.Id)
+		}
+	}
+	walk(nil)
+	return sorted
+}
+
+// Path joins slugs of the category ancestors and the category with "/", i.e. "flowers/roses".
+func (t CategoryTree) Path(id string) string {
+	var slugs []string
+	// Depth is bounded by the number of categories in case the tree is corrupted with a cycle.
+	for range len(t.Categories) {
+		c, ok := t.Category(id)
+		if !ok {
+			break
+		}
+		slugs = append(slugs, c.Slug)
+		if c.ParentId == nil {
+			break
+		}
+		id = *c.ParentId
+	}
+	slices.Reverse(slugs)
+	return strings.Join(slugs, "/")
+}
+
+// Descendants returns ids of the category and all of its descendants.
+func (t CategoryTree) Descendants(id string) []string {
+	ids := []string{id}
+	for i := 0; i < len(ids) && i < len(t.Categories); i++ {
+		for _, c := range t.Children(&ids[i]) {
+			ids = append(ids, c.Id)
+		}
+	}
+	return ids
+}
+
+var queryListCategories = template.ReplaceAllPairs(`
+SELECT
+    id,
+    slug,
+    name,
+    parent_id,
+    position,
+    created_at,
+    updated_at,
+FROM {{table.categories}};
+`,
+	"{{table.categories}}", tableCategories,
+)
+
+func (p *Products) ListCategories(ctx context.Context) (CategoryTree, error) {
+	readTx := table.TxControl(table.BeginTx(table.WithOnlineReadOnly()), table.CommitTx())
+
+	var tree CategoryTree
+
+	if err := p.db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, res, err := s.Execute(ctx, readTx, queryListCategories, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		tree, err = readCategoryTree(ctx, res)
+		return err
+	}); err != nil {
+		return CategoryTree{}, err
+	}
+
+	return tree, nil
+}
+
+func readCategoryTree(ctx context.Context, res result.Result) (CategoryTree, error) {
+	tree := CategoryTree{Categories: make([]Category, 0)}
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			var c Category
+			if err := res.ScanNamed(
+				named.Required("id", &c.Id),
+				named.Required("slug", &c.Slug),
+				named.Required("name", &c.Name),
+				named.Optional("parent_id", &c.ParentId),
+				named.Required("position", &c.Position),
+				named.Required("created_at", &c.CreatedAt),
+				named.Required("updated_at", &c.UpdatedAt),
+			); err != nil {
+				return CategoryTree{}, err
+			}
+			tree.Categories = append(tree.Categories, c)
+		}
+	}
+	if err := res.Err(); err != nil {
+		return CategoryTree{}, err
+	}
+	return tree, nil
+}
+
+var queryListCategoriesProducts = template.ReplaceAllPairs(`
+DECLARE $category_ids AS List<Utf8>;
+
+SELECT category_id
+FROM {{table.products}}
+VIEW {{index.category_id}}
+WHERE category_id IN $category_ids
+LIMIT 1;
+`,
+	"{{table.products}}", tableProducts,
+	"{{index.category_id}}", tableProductsIndexCategoryId,
+)
+
+var queryUpdateCategoryTree = template.ReplaceAllPairs(`
+DECLARE $upserts AS List<Struct<
+    id:Utf8,
+    slug:Utf8,
+    name:Utf8,
+    parent_id:Optional<Utf8>,
+    position:Uint32,
+    created_at:Datetime,
+    updated_at:Datetime,
+>>;
+DECLARE $deleted_ids AS List<Utf8>;
+DECLARE $paths AS List<Struct<
+    category_id:Utf8,
+    category_path:Utf8,
+>>;
+
+-- Products of moved or renamed categories are reindexed by catalog with their new category paths
+UPDATE {{table.products}} ON
+SELECT
+    p.id AS id,
+    Just(c.category_path) AS category_path,
+FROM {{table.products}} VIEW {{index.category_id}} p
+JOIN AS_TABLE($paths) c ON c.category_id = p.category_id;
+
+UPSERT INTO {{table.categories}}
+SELECT * FROM AS_TABLE($upserts);
+
+DELETE FROM {{table.categories}}
+WHERE id IN $deleted_ids;
+`,
+	"{{table.products}}", tableProducts,
+	"{{index.category_id}}", tableProductsIndexCategoryId,
+	"{{table.categories}}", tableCategories,
+)
+
+type UpdateCategoryTreeDTOInput struct {
+	UpdatedAt time.Time
+	// Update changes the category tree, its error aborts the update.
+	Update func(tree *CategoryTree) error
+}
+
+// UpdateCategoryTree reads and updates the category tree in one transaction.
+// Category paths of products are updated along with their categories.
+// Categories with products can't be deleted.
+func (p *Products) UpdateCategoryTree(ctx context.Context, in UpdateCategoryTreeDTOInput) (CategoryTree, error) {
+	var out CategoryTree
+
+	if err := p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, queryListCategories, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		current, err := readCategoryTree(ctx, res)
+		if err != nil {
+			return err
+		}
+		tree := CategoryTree{Categories: slices.Clone(current.Categories)}
+		if err := in.Update(&tree); err != nil {
+			return err
+		}
+
+		upserts := make([]types.Value, 0)
+		paths := make([]types.Value, 0)
+		for i, c := range tree.Categories {
+			existing, ok := current.Category(c.Id)
+			if ok && existing.Slug == c.Slug && existing.Name == c.Name && ptrValue(existing.ParentId) == ptrValue(c.ParentId) && existing.Position == c.Position {
+				continue
+			}
+			if ok {
+				tree.Categories[i].UpdatedAt = in.UpdatedAt
+				c = tree.Categories[i]
+			}
+			upserts = append(upserts, types.StructValue(
+				types.StructFieldValue("id", types.UTF8Value(c.Id)),
+				types.StructFieldValue("slug", types.UTF8Value(c.Slug)),
+				types.StructFieldValue("name", types.UTF8Value(c.Name)),
+				types.StructFieldValue("parent_id", types.NullableUTF8Value(c.ParentId)),
+				types.StructFieldValue("position", types.Uint32Value(c.Position)),
+				types.StructFieldValue("created_at", types.DatetimeValueFromTime(c.CreatedAt)),
+				types.StructFieldValue("updated_at", types.DatetimeValueFromTime(c.UpdatedAt)),
+			))
+		}
+		// Paths of descendants change along with the path of their ancestor.
+		for _, c := range tree.Categories {
+			if _, ok := current.Category(c.Id); !ok {
+				continue
+			}
+			if path := tree.Path(c.Id); path != current.Path(c.Id) {
+				paths = append(paths, types.StructValue(
+					types.StructFieldValue("category_id", types.UTF8Value(c.Id)),
+					types.StructFieldValue("category_path", types.UTF8Value(path)),
+				))
+			}
+		}
+		deletedIds := make([]types.Value, 0)
+		for _, c := range current.Categories {
+			if _, ok := tree.Category(c.Id); !ok {
+				deletedIds = append(deletedIds, types.UTF8Value(c.Id))
+			}
+		}
+
+		if len(deletedIds) > 0 {
+			res, err := tx.Execute(ctx, queryListCategoriesProducts, table.NewQueryParameters(
+				table.ValueParam("$category_ids", types.ListValue(deletedIds...)),
+			))
+			if err != nil {
+				return err
+			}
+			defer func() { _ = res.Close() }()
+
+			inUse := false
+			for res.NextResultSet(ctx) {
+				for res.NextRow() {
+					inUse = true
+				}
+			}
+			if err := res.Err(); err != nil {
+				return err
+			}
+			if inUse {
+				return ErrCategoryInUse
+			}
+		}
+
+		if _, err := tx.Execute(ctx, queryUpdateCategoryTree, table.NewQueryParameters(
+			table.ValueParam("$upserts", emptyListOr(upserts, categoryUpsertType)),
+			table.ValueParam("$deleted_ids", emptyListOr(deletedIds, types.TypeUTF8)),
+			table.ValueParam("$paths", emptyListOr(paths, categoryPathType)),
+		)); err != nil {
+			return err
+		}
+
+		out = tree
+		return nil
+	}); err != nil {
+		return CategoryTree{}, err
+	}
+
+	return out, nil
+}
+
+var (
+	categoryUpsertType = types.Struct(
+		types.StructField("id", types.TypeUTF8),
+		types.StructField("slug", types.TypeUTF8),
+		types.StructField("name", types.TypeUTF8),
+		types.StructField("parent_id", types.Optional(types.TypeUTF8)),
+		types.StructField("position", types.TypeUint32),
+		types.StructField("created_at", types.TypeDatetime),
+		types.StructField("updated_at", types.TypeDatetime),
+	)
+	categoryPathType = types.Struct(
+		types.StructField("category_id", types.TypeUTF8),
+		types.StructField("category_path", types.TypeUTF8),
+	)
+)
+
+// emptyListOr types the list of values, empty lists can't infer the type of their items.
+func emptyListOr(values []types.Value, t types.Type) types.Value {
+	if len(values) == 0 {
+		return types.ZeroValue(types.List(t))
+	}
+	return types.ListValue(values...)
+}
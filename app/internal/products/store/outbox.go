@@ -0,0 +1,17 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// RelayOutbox publishes messages of committed state changes to their topics.
+func (p *Products) RelayOutbox(ctx context.Context) (int, error) {
+	return p.outbox.Relay(ctx)
+}
+
+// dedupKey identifies the message of the kind published for the resource,
+// i.e. "operation:<id>:products_reserved".
+func dedupKey(resource, id, kind string) string {
+	return fmt.Sprintf("%s:%s:%s", resource, id, kind)
+}
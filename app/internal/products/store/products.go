@@ -5,24 +5,26 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	oapi_codegen "github.com/bratushkadan/floral/internal/products/presentation/generated"
 	"github.com/bratushkadan/floral/pkg/template"
-	ydbtopic "github.com/bratushkadan/floral/pkg/ydb/topic"
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
 	"github.com/google/uuid"
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
-	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
 	"go.uber.org/zap"
 )
 
 // TODO: read from config
 const (
 	tableProducts = "`products/products`"
+	// Path of the outbox table, the outbox quotes it in queries.
+	tableOutbox = "products/outbox"
 
 	topicProductsReservedProductsTopic = "products/reserved_products_topic"
 	topicOrdersCancelOperations        = "orders/cancel_operations_topic"
@@ -36,9 +38,8 @@ type Products struct {
 	db *ydb.Driver
 	l  *zap.Logger
 
-	topicProductsReservedProductsTopic *topicwriter.Writer
-	topicProductsUnreservedProducts    *topicwriter.Writer
-	topicOrdersCancelOperations        *topicwriter.Writer
+	// outbox publishes messages of state changes
+	outbox *outbox.Outbox
 }
 
 type ProductsBuilder struct {
@@ -69,23 +70,11 @@ func (b *ProductsBuilder) Build() (*Products, error) {
 		return nil, errors.New("YDBDriver must be set")
 	}
 
-	topicProductsReservedProductsTopic, err := ydbtopic.NewProducer(b.p.db, topicProductsReservedProductsTopic)
+	outbox, err := outbox.NewBuilder().Ydb(b.p.db).Table(tableOutbox).Logger(b.p.l).Build()
 	if err != nil {
-		return nil, errors.New("setup ProductsReservedProductsTopic topic: %w")
+		return nil, fmt.Errorf("setup outbox: %w", err)
 	}
-	b.p.topicProductsReservedProductsTopic = topicProductsReservedProductsTopic
-
-	topicProductsUnreserved, err := ydbtopic.NewProducer(b.p.db, topicProductsUnreservedTopic)
-	if err != nil {
-		return nil, errors.New("setup ProductsUnreservedTopic topic: %w")
-	}
-	b.p.topicProductsUnreservedProducts = topicProductsUnreserved
-
-	topicOrdersCancelOperations, err := ydbtopic.NewProducer(b.p.db, topicOrdersCancelOperations)
-	if err != nil {
-		return nil, errors.New("setup OrdersCancelOperations topic: %w")
-	}
-	b.p.topicOrdersCancelOperations = topicOrdersCancelOperations
+	b.p.outbox = outbox
 
 	return &b.p, nil
 }
@@ -100,6 +89,9 @@ SELECT
     description,
     pictures,
     metadata,
+    options,
+    skus,
+    category_id,
     stock,
     price,
     created_at,
@@ -122,6 +114,8 @@ type GetProductDTOOutput struct {
 	Description string
 	Pictures    []GetProductDTOOutputPicture
 	Metadata    map[string]any
+	Variants    ProductVariants
+	CategoryId  *string
 	Stock       uint32
 	Price       float64
 	CreatedAt   time.Time
@@ -152,6 +146,7 @@ func (p *Products) Get(ctx context.Context, id uuid.UUID) (*GetProductDTOOutput,
 				var out GetProductDTOOutput
 				var strId string
 				var picturesJson, metadataJson []byte
+				var optionsJson, skusJson *[]byte
 				if err := res.ScanNamed(
 					named.Required("id", &strId),
 					named.Required("seller_id", &out.SellerId),
@@ -159,6 +154,9 @@ func (p *Products) Get(ctx context.Context, id uuid.UUID) (*GetProductDTOOutput,
 					named.Required("description", &out.Description),
 					named.Required("pictures", &picturesJson),
 					named.Required("metadata", &metadataJson),
+					named.Optional("options", &optionsJson),
+					named.Optional("skus", &skusJson),
+					named.Optional("category_id", &out.CategoryId),
 					named.Required("stock", &out.Stock),
 					named.Required("price", &out.Price),
 					named.Required("created_at", &out.CreatedAt),
@@ -167,6 +165,9 @@ func (p *Products) Get(ctx context.Context, id uuid.UUID) (*GetProductDTOOutput,
 				); err != nil {
 					return err
 				}
+				if out.Variants, err = unmarshalProductVariants(optionsJson, skusJson); err != nil {
+					return err
+				}
 				if err := json.Unmarshal(picturesJson, &out.Pictures); err != nil {
 					return fmt.Errorf("failed to unmarshal product pictures json field: %v", err)
 				}
@@ -197,6 +198,10 @@ DECLARE $s AS Struct<
     description:Optional<Utf8>,
     pictures:Optional<Json>,
     metadata:Optional<Json>,
+    options:Optional<Json>,
+    skus:Optional<Json>,
+    category_id:Optional<Utf8>,
+    category_path:Optional<Utf8>,
     stock:Optional<Uint32>,
     price:Optional<Double>,
     created_at:Optional<Datetime>,
@@ -212,6 +217,10 @@ $existing = (
         description,
         pictures,
         metadata,
+        options,
+        skus,
+        category_id,
+        category_path,
         stock,
         price,
         created_at,
@@ -230,6 +239,10 @@ $sub = (
         Unwrap(COALESCE(u.description, e.description)) AS description,
         Unwrap(COALESCE(u.pictures, e.pictures)) AS pictures,
         Unwrap(COALESCE(u.metadata, e.metadata)) AS metadata,
+        COALESCE(u.options, e.options) AS options,
+        COALESCE(u.skus, e.skus) AS skus,
+        COALESCE(u.category_id, e.category_id) AS category_id,
+        COALESCE(u.category_path, e.category_path) AS category_path,
         Unwrap(COALESCE(u.stock, e.stock)) AS stock,
         Unwrap(COALESCE(u.price, e.price)) AS price,
         Unwrap(COALESCE(u.created_at, e.created_at)) AS created_at,
@@ -255,11 +268,16 @@ type UpsertProductDTOInput struct {
 	Description *string
 	Pictures    []UpsertProductDTOOutputPicture
 	Metadata    map[string]any
-	Stock       *uint32
-	Price       *float64
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
-	DeletedAt   *time.Time
+	// Variants are set on product creation only, they are updated with UpdateProductVariants.
+	Variants *ProductVariants
+	// CategoryPath is set along with CategoryId, it is the path of the category in the category tree.
+	CategoryId   *string
+	CategoryPath *string
+	Stock        *uint32
+	Price        *float64
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	DeletedAt    *time.Time
 }
 type UpsertProductDTOOutput struct {
 	Id          uuid.UUID
@@ -268,6 +286,8 @@ type UpsertProductDTOOutput struct {
 	Description string
 	Pictures    []UpsertProductDTOOutputPicture
 	Metadata    map[string]any
+	Variants    ProductVariants
+	CategoryId  *string
 	Stock       uint32
 	Price       float64
 	CreatedAt   time.Time
@@ -289,6 +309,8 @@ func (p *Products) Upsert(ctx context.Context, in UpsertProductDTOInput) (Upsert
 	opts = append(opts, types.StructFieldValue("seller_id", types.NullableUTF8Value(in.SellerId)))
 	opts = append(opts, types.StructFieldValue("name", types.NullableUTF8Value(in.Name)))
 	opts = append(opts, types.StructFieldValue("description", types.NullableUTF8Value(in.Description)))
+	opts = append(opts, types.StructFieldValue("category_id", types.NullableUTF8Value(in.CategoryId)))
+	opts = append(opts, types.StructFieldValue("category_path", types.NullableUTF8Value(in.CategoryPath)))
 	opts = append(opts, types.StructFieldValue("stock", types.NullableUint32Value(in.Stock)))
 	opts = append(opts, types.StructFieldValue("price", types.NullableDoubleValue(in.Price)))
 	opts = append(opts, types.StructFieldValue("created_at", types.NullableDatetimeValueFromTime(in.CreatedAt)))
@@ -315,6 +337,21 @@ func (p *Products) Upsert(ctx context.Context, in UpsertProductDTOInput) (Upsert
 	} else {
 		opts = append(opts, types.StructFieldValue("metadata", types.NullableJSONValue(nil)))
 	}
+	if in.Variants != nil {
+		options, err := marshalProductOptions(in.Variants.Options)
+		if err != nil {
+			return UpsertProductDTOOutput{}, err
+		}
+		skus, err := marshalProductSkus(in.Variants.Skus)
+		if err != nil {
+			return UpsertProductDTOOutput{}, err
+		}
+		opts = append(opts, types.StructFieldValue("options", types.NullableJSONValue(&options)))
+		opts = append(opts, types.StructFieldValue("skus", types.NullableJSONValue(&skus)))
+	} else {
+		opts = append(opts, types.StructFieldValue("options", types.NullableJSONValue(nil)))
+		opts = append(opts, types.StructFieldValue("skus", types.NullableJSONValue(nil)))
+	}
 
 	inYql := types.StructValue(opts...)
 
@@ -331,6 +368,7 @@ func (p *Products) Upsert(ctx context.Context, in UpsertProductDTOInput) (Upsert
 			for res.NextRow() {
 				var strId string
 				var picturesJson, metadataJson []byte
+				var optionsJson, skusJson *[]byte
 				if err := res.ScanNamed(
 					named.Required("id", &strId),
 					named.Required("seller_id", &out.SellerId),
@@ -338,6 +376,9 @@ func (p *Products) Upsert(ctx context.Context, in UpsertProductDTOInput) (Upsert
 					named.Required("description", &out.Description),
 					named.Required("pictures", &picturesJson),
 					named.Required("metadata", &metadataJson),
+					named.Optional("options", &optionsJson),
+					named.Optional("skus", &skusJson),
+					named.Optional("category_id", &out.CategoryId),
 					named.Required("stock", &out.Stock),
 					named.Required("price", &out.Price),
 					named.Required("created_at", &out.CreatedAt),
@@ -346,6 +387,9 @@ func (p *Products) Upsert(ctx context.Context, in UpsertProductDTOInput) (Upsert
 				); err != nil {
 					return err
 				}
+				if out.Variants, err = unmarshalProductVariants(optionsJson, skusJson); err != nil {
+					return err
+				}
 
 				if err := json.Unmarshal(picturesJson, &out.Pictures); err != nil {
 					return fmt.Errorf("failed to unmarshal product pictures json field: %v", err)
@@ -442,6 +486,8 @@ func (p *Products) Delete(ctx context.Context, in DeleteProductDTOInput) (*Delet
 var queryListProducts = template.ReplaceAllPairs(`
 DECLARE $seller_id AS Optional<Utf8>;
 DECLARE $in_stock AS Optional<Bool>;
+-- Products of any category if empty
+DECLARE $category_ids AS List<Utf8>;
 
 DECLARE $page_created_at AS Optional<Datetime>;
 DECLARE $page_id AS Optional<String>;
@@ -454,6 +500,7 @@ SELECT
    ca.description AS description,
    ca.pictures    AS pictures,
    ca.metadata    AS metadata,
+   ca.category_id AS category_id,
    ca.stock       AS stock,
    ca.price       AS price,
    ca.created_at  AS created_at,
@@ -474,6 +521,8 @@ WHERE
     ca.deleted_at IS NULL
         AND
     ($in_stock IS NULL OR (ca.stock > 0 AND $in_stock) OR (ca.stock = 0 AND NOT $in_stock))
+        AND
+    (ListLength($category_ids) = 0 OR ca.category_id IN $category_ids)
 ORDER BY id, created_at
 LIMIT MIN_OF($page_size, 25) + 1;
 `,
@@ -489,6 +538,7 @@ type ListProductsDTOOutputItem struct {
 	Description string
 	Pictures    []ListProductsDTOOutputPicture
 	Metadata    map[string]any
+	CategoryId  *string
 	Stock       uint32
 	Price       float64
 	CreatedAt   time.Time
@@ -504,15 +554,22 @@ type ListProductsNextPage struct {
 	Id        *uuid.UUID `json:"id"`
 	InStock   *bool      `json:"in_stock"`
 	SellerId  *string    `json:"seller_id"`
-	PageSize  int        `json:"page_size"`
+	// CategoryIds are ids of the filtered category and its descendants.
+	CategoryIds []string `json:"category_ids"`
+	PageSize    int      `json:"page_size"`
 }
 
 func (p *Products) List(ctx context.Context, nextPage ListProductsNextPage) ([]ListProductsDTOOutputItem, error) {
 	readTx := table.TxControl(table.BeginTx(table.WithStaleReadOnly()), table.CommitTx())
 
-	tableParams := make([]table.ParameterOption, 0, 5)
+	tableParams := make([]table.ParameterOption, 0, 6)
 	tableParams = append(tableParams, table.ValueParam("$seller_id", types.NullableUTF8Value(nextPage.SellerId)))
 	tableParams = append(tableParams, table.ValueParam("$in_stock", types.NullableBoolValue(nextPage.InStock)))
+	categoryIds := make([]types.Value, 0, len(nextPage.CategoryIds))
+	for _, id := range nextPage.CategoryIds {
+		categoryIds = append(categoryIds, types.UTF8Value(id))
+	}
+	tableParams = append(tableParams, table.ValueParam("$category_ids", emptyListOr(categoryIds, types.TypeUTF8)))
 	tableParams = append(tableParams, table.ValueParam("$page_created_at", types.NullableDatetimeValueFromTime(nextPage.CreatedAt)))
 	if nextPage.Id != nil {
 		strId := nextPage.Id.String()
@@ -543,6 +600,7 @@ func (p *Products) List(ctx context.Context, nextPage ListProductsNextPage) ([]L
 					named.Required("description", &out.Description),
 					named.Required("pictures", &picturesJson),
 					named.Required("metadata", &metadataJson),
+					named.Optional("category_id", &out.CategoryId),
 					named.Required("stock", &out.Stock),
 					named.Required("created_at", &out.CreatedAt),
 					named.Required("updated_at", &out.UpdatedAt),
@@ -573,6 +631,8 @@ func (p *Products) List(ctx context.Context, nextPage ListProductsNextPage) ([]L
 
 var queryListProductsForReservation = template.ReplaceAllPairs(`
 DECLARE $product_ids AS List<String>;
+DECLARE $order_ids AS List<Utf8>;
+DECLARE $now AS Datetime;
 
 SELECT 
   id,
@@ -580,50 +640,119 @@ SELECT
   name,
   stock,
   price,
-  pictures
+  pictures,
+  options,
+  skus
 FROM {{table.table_products}}
 WHERE 
     id IN $product_ids
         AND
     deleted_at IS NULL;
-`,
-	"{{table.table_products}}", tableProducts,
-)
-var queryReserveProducts = template.ReplaceAllPairs(`
-DECLARE $updates AS List<Struct<
-    id:String, 
-    stock:Uint32
->>;
 
-UPDATE {{table.table_products}} ON
+-- Held products are not available until their holds are released or expire
 SELECT
-  update.id AS id,
-  update.stock AS stock
-FROM
-  AS_TABLE($updates) AS update;
+  product_id,
+  sku_id,
+  SUM(count) AS held
+FROM {{table.reservations}}
+VIEW {{index.product_id_status}}
+WHERE
+    product_id IN $product_ids
+        AND
+    status = "{{status.active}}"u
+        AND
+    expires_at > $now
+GROUP BY product_id, sku_id;
+
+SELECT DISTINCT order_id
+FROM {{table.reservations}}
+WHERE order_id IN $order_ids;
 `,
 	"{{table.table_products}}", tableProducts,
+	"{{table.reservations}}", tableReservations,
+	"{{index.product_id_status}}", tableReservationsIndexProductIdStatus,
+	"{{status.active}}", ReservationStatusActive,
 )
 
-func (p *Products) ReserveProducts(ctx context.Context, messages []oapi_codegen.PrivateReserveProductsReqMessage) error {
-	reserved := make([]oapi_codegen.PrivateOrderProcessReservedProductsReqMessage, 0)
-	failedToReserve := make([]oapi_codegen.PrivateOrderCancelOperationsReqMessage, 0)
+// reservationStockKey identifies stock of the product, or of its SKU if the product has SKUs.
+type reservationStockKey struct {
+	ProductId string
+	SkuId     string
+}
+
+type reservationProduct struct {
+	position oapi_codegen.PrivateOrderProcessReservedProductsReqProduct
+	pictures []ListProductsDTOOutputPicture
+	variants ProductVariants
+}
+
+// reservedPosition prices the reserved position of the product with its SKU.
+// A SKU is required for products with SKUs and is not allowed for products without them.
+func (p reservationProduct) reservedPosition(skuId *string) (oapi_codegen.PrivateOrderProcessReservedProductsReqProduct, error) {
+	position := p.position
+	if len(p.variants.Skus) == 0 {
+		if skuId != nil {
+			return position, fmt.Errorf(`product id="%s" has no sku id="%s"`, position.Id, *skuId)
+		}
+		return position, nil
+	}
+	if skuId == nil {
+		return position, fmt.Errorf(`product id="%s" requires a sku`, position.Id)
+	}
+	sku, ok := p.variants.Sku(*skuId)
+	if !ok {
+		return position, fmt.Errorf(`product id="%s" has no sku id="%s"`, position.Id, *skuId)
+	}
+
+	position.SkuId = &sku.Id
+	position.Name = p.variants.SkuName(position.Name, sku)
+	position.Price = sku.Price
+	// Pictures deleted from the product are skipped.
+	for _, pictureId := range sku.PictureIds {
+		if idx := slices.IndexFunc(p.pictures, func(pic ListProductsDTOOutputPicture) bool { return pic.Id == pictureId }); idx != -1 {
+			position.Picture = &p.pictures[idx].Url
+			break
+		}
+	}
+	return position, nil
+}
+
+type ReserveProductsDTOInput struct {
+	Messages   []oapi_codegen.PrivateReserveProductsReqMessage
+	ReservedAt time.Time
+	// ExpiresAt is the time holds are released at unless their orders are paid.
+	ExpiresAt time.Time
+}
 
+// ReserveProducts holds products of orders to be created. Stock is not decremented until orders are paid:
+// available stock of the product, or of its SKU, is its stock minus its active holds.
+// An order holds several SKUs of the product, requested counts of the same SKU are summed up.
+// Orders that already hold products are skipped, so redelivered messages don't hold products twice.
+func (p *Products) ReserveProducts(ctx context.Context, in ReserveProductsDTOInput) error {
 	productsToQuery := make(map[string]struct{}, 0)
-	for _, m := range messages {
+	for _, m := range in.Messages {
 		for _, p := range m.Products {
 			productsToQuery[p.Id] = struct{}{}
 		}
 	}
 
 	if err := p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		reserved := make([]oapi_codegen.PrivateOrderProcessReservedProductsReqMessage, 0)
+		failedToReserve := make([]oapi_codegen.PrivateOrderCancelOperationsReqMessage, 0)
+
 		// 1. Read
 		var productIdsList []types.Value
 		for id := range productsToQuery {
 			productIdsList = append(productIdsList, types.StringValueFromString(id))
 		}
+		var orderIdsList []types.Value
+		for _, m := range in.Messages {
+			orderIdsList = append(orderIdsList, types.UTF8Value(m.OrderId))
+		}
 		res, err := tx.Execute(ctx, queryListProductsForReservation, table.NewQueryParameters(
 			table.ValueParam("$product_ids", types.ListValue(productIdsList...)),
+			table.ValueParam("$order_ids", types.ListValue(orderIdsList...)),
+			table.ValueParam("$now", types.DatetimeValueFromTime(in.ReservedAt)),
 		))
 
 		if err != nil {
@@ -631,37 +760,74 @@ func (p *Products) ReserveProducts(ctx context.Context, messages []oapi_codegen.
 		}
 		defer func() { _ = res.Close() }()
 
-		// count is "stock" here
-		products := make(map[string]oapi_codegen.PrivateOrderProcessReservedProductsReqProduct)
+		products := make(map[string]reservationProduct)
+		available := make(map[reservationStockKey]int)
 
-		for res.NextResultSet(ctx) {
+		if res.NextResultSet(ctx) {
 			for res.NextRow() {
-				var product oapi_codegen.PrivateOrderProcessReservedProductsReqProduct
+				var product reservationProduct
 				var stock uint32
 				var picturesJson []byte
+				var optionsJson, skusJson *[]byte
 				if err := res.ScanNamed(
-					named.Required("id", &product.Id),
-					named.Required("seller_id", &product.SellerId),
-					named.Required("name", &product.Name),
+					named.Required("id", &product.position.Id),
+					named.Required("seller_id", &product.position.SellerId),
+					named.Required("name", &product.position.Name),
 					named.Required("stock", &stock),
-					named.Required("price", &product.Price),
+					named.Required("price", &product.position.Price),
 					named.Required("pictures", &picturesJson),
+					named.Optional("options", &optionsJson),
+					named.Optional("skus", &skusJson),
 				); err != nil {
 					return err
 				}
 
-				var pictures []ListProductsDTOOutputPicture
-				if err := json.Unmarshal(picturesJson, &pictures); err != nil {
+				if err := json.Unmarshal(picturesJson, &product.pictures); err != nil {
 					return fmt.Errorf("failed to unmarshal product pictures json field: %v", err)
 				}
+				if len(product.pictures) > 0 {
+					product.position.Picture = &product.pictures[0].Url
+				}
+				if product.variants, err = unmarshalProductVariants(optionsJson, skusJson); err != nil {
+					return err
+				}
+
+				id := product.position.Id
+				if len(product.variants.Skus) == 0 {
+					available[reservationStockKey{ProductId: id}] = int(stock)
+				}
+				for _, sku := range product.variants.Skus {
+					available[reservationStockKey{ProductId: id, SkuId: sku.Id}] = int(sku.Stock)
+				}
+				products[id] = product
+			}
+		}
 
-				if len(pictures) > 0 {
-					picture := pictures[0]
-					product.Picture = &picture.Url
+		if res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var key reservationStockKey
+				var held uint64
+				if err := res.ScanNamed(
+					named.Required("product_id", &key.ProductId),
+					named.Required("sku_id", &key.SkuId),
+					named.OptionalWithDefault("held", &held),
+				); err != nil {
+					return err
 				}
+				if count, ok := available[key]; ok {
+					available[key] = max(count-int(held), 0)
+				}
+			}
+		}
 
-				product.Count = int(stock)
-				products[product.Id] = product
+		reservedOrders := make(map[string]struct{})
+		if res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var orderId string
+				if err := res.ScanNamed(named.Required("order_id", &orderId)); err != nil {
+					return err
+				}
+				reservedOrders[orderId] = struct{}{}
 			}
 		}
 
@@ -670,9 +836,16 @@ func (p *Products) ReserveProducts(ctx context.Context, messages []oapi_codegen.
 		}
 
 		// 2. Compute
-		for _, msg := range messages {
+		var holds []types.Value
+		for _, msg := range in.Messages {
+			if _, ok := reservedOrders[msg.OrderId]; ok {
+				p.l.Info("skip reservation of order already holding products", zap.String("operation_id", msg.OperationId), zap.String("order_id", msg.OrderId))
+				continue
+			}
+			reservedOrders[msg.OrderId] = struct{}{}
+
 			ok := true
-			reservedPositions := make(map[string]int)
+			reservedPositions := make(map[reservationStockKey]oapi_codegen.PrivateOrderProcessReservedProductsReqProduct)
 			var detailsMessages []string
 			for _, p := range msg.Products {
 				product, exists := products[p.Id]
@@ -681,11 +854,22 @@ func (p *Products) ReserveProducts(ctx context.Context, messages []oapi_codegen.
 					ok = false
 					continue
 				}
-				if product.Count < p.Count {
-					detailsMessages = append(detailsMessages, fmt.Sprintf(`product id="%s" stock (%d) is less than requested (%d)`, p.Id, product.Count, p.Count))
+				position, err := product.reservedPosition(p.SkuId)
+				if err != nil {
+					detailsMessages = append(detailsMessages, err.Error())
+					ok = false
+					continue
+				}
+				key := reservationStockKey{ProductId: p.Id, SkuId: ptrValue(position.SkuId)}
+				if reservedPosition, reserved := reservedPositions[key]; reserved {
+					position.Count = reservedPosition.Count
+				}
+				if available[key] < position.Count+p.Count {
+					detailsMessages = append(detailsMessages, fmt.Sprintf(`product id="%s" stock (%d) is less than requested (%d)`, p.Id, available[key], position.Count+p.Count))
 					ok = false
 				} else if ok {
-					reservedPositions[p.Id] = p.Count
+					position.Count += p.Count
+					reservedPositions[key] = position
 				}
 			}
 			if !ok {
@@ -697,14 +881,21 @@ func (p *Products) ReserveProducts(ctx context.Context, messages []oapi_codegen.
 			}
 
 			reservedPositionsRes := make([]oapi_codegen.PrivateOrderProcessReservedProductsReqProduct, 0, len(reservedPositions))
-			for productId, count := range reservedPositions {
-				product := products[productId]
-				product.Count -= count
-				products[productId] = product
-
-				reservedPosition := products[productId]
-				reservedPosition.Count = count
+			for key, reservedPosition := range reservedPositions {
+				available[key] -= reservedPosition.Count
 				reservedPositionsRes = append(reservedPositionsRes, reservedPosition)
+
+				holds = append(holds, types.StructValue(
+					types.StructFieldValue("order_id", types.UTF8Value(msg.OrderId)),
+					types.StructFieldValue("product_id", types.StringValueFromString(key.ProductId)),
+					types.StructFieldValue("sku_id", types.UTF8Value(key.SkuId)),
+					types.StructFieldValue("operation_id", types.UTF8Value(msg.OperationId)),
+					types.StructFieldValue("count", types.Uint32Value(uint32(reservedPosition.Count))),
+					types.StructFieldValue("status", types.UTF8Value(ReservationStatusActive)),
+					types.StructFieldValue("expires_at", types.DatetimeValueFromTime(in.ExpiresAt)),
+					types.StructFieldValue("created_at", types.DatetimeValueFromTime(in.ReservedAt)),
+					types.StructFieldValue("updated_at", types.DatetimeValueFromTime(in.ReservedAt)),
+				))
 			}
 
 			reserved = append(reserved, oapi_codegen.PrivateOrderProcessReservedProductsReqMessage{
@@ -717,47 +908,30 @@ func (p *Products) ReserveProducts(ctx context.Context, messages []oapi_codegen.
 		p.l.Info("to report reservation failure", zap.Any("products", failedToReserve))
 
 		// 3. Write
-		var updates []types.Value
-		for _, p := range products {
-			updates = append(updates, types.StructValue(
-				types.StructFieldValue("id", types.StringValueFromString(p.Id)),
-				types.StructFieldValue("stock", types.Uint32Value(uint32(p.Count))),
-			))
+		if len(holds) > 0 {
+			if _, err := tx.Execute(ctx, queryUpsertReservationHolds, table.NewQueryParameters(
+				table.ValueParam("$holds", types.ListValue(holds...)),
+			)); err != nil {
+				return err
+			}
 		}
 
-		res, err = tx.Execute(ctx, queryReserveProducts, table.NewQueryParameters(
-			table.ValueParam("$updates", types.ListValue(updates...)),
-		))
+		// 4. Publish reserved and reserve failures
+		reservedMsgs, err := outbox.NewMessages(topicProductsReservedProductsTopic, func(m oapi_codegen.PrivateOrderProcessReservedProductsReqMessage) string {
+			return dedupKey("operation", m.OperationId, "products_reserved")
+		}, reserved...)
 		if err != nil {
-			return err
-		}
-		defer func() { _ = res.Close() }()
-
-		// 4. Publish reserved
-		productsReservedData := make([][]byte, 0, len(reserved))
-		for _, r := range reserved {
-			data, err := json.Marshal(&r)
-			if err != nil {
-				return fmt.Errorf("marshal reserved products: %v", err)
-			}
-			productsReservedData = append(productsReservedData, data)
+			return fmt.Errorf("products reserved messages: %v", err)
 		}
-
-		if err := ydbtopic.Produce(ctx, p.topicProductsReservedProductsTopic, productsReservedData...); err != nil {
-			return fmt.Errorf("produce products reserved messages: %v", err)
+		cancelOperationsMsgs, err := outbox.NewMessages(topicOrdersCancelOperations, func(m oapi_codegen.PrivateOrderCancelOperationsReqMessage) string {
+			return dedupKey("operation", m.OperationId, "cancel")
+		}, failedToReserve...)
+		if err != nil {
+			return fmt.Errorf("orders cancel operations messages: %v", err)
 		}
 
-		// 5. Publish reserve failures
-		orderCancelOperationsData := make([][]byte, 0, len(failedToReserve))
-		for _, r := range failedToReserve {
-			data, err := json.Marshal(&r)
-			if err != nil {
-				return fmt.Errorf("marshal order cancel operations: %v", err)
-			}
-			orderCancelOperationsData = append(orderCancelOperationsData, data)
-		}
-		if err := ydbtopic.Produce(ctx, p.topicOrdersCancelOperations, orderCancelOperationsData...); err != nil {
-			return fmt.Errorf("produce orders cancel operations messages: %v", err)
+		if err := p.outbox.EnqueueTableTx(ctx, tx, append(reservedMsgs, cancelOperationsMsgs...)...); err != nil {
+			return fmt.Errorf("enqueue products reservation messages: %v", err)
 		}
 
 		return nil
@@ -768,97 +942,114 @@ func (p *Products) ReserveProducts(ctx context.Context, messages []oapi_codegen.
 	return nil
 }
 
-var queryUnreserveProducts = template.ReplaceAllPairs(`
-DECLARE $updates AS List<Struct<
-    id:String,
-    stock:Uint32,
->>;
-
-UPDATE {{table.table_products}} ON
-SELECT * FROM 
-(
-    SELECT
-        p.id AS id,
-        (p.stock + u.stock) AS stock,
-        CurrentUtcDatetime() AS updated_at,
-    FROM {{table.table_products}} p
-    JOIN AS_TABLE($updates) u ON p.id = u.id
-)
-RETURNING id, stock, updated_at;
-`,
-	"{{table.table_products}}", tableProducts,
-)
-
-func (p *Products) UnreserveProducts(ctx context.Context, messages []oapi_codegen.PrivateUnreserveProductsReqMessage) error {
+// UnreserveProducts releases holds of cancelled orders and restocks received returns.
+// Held products become available right away, sold products of cancelled paid orders are restocked
+// except for the shortfall that was never deducted.
+// Orders reserved before holds were introduced have no holds, their products are restocked as well.
+// Restocked products of returns are recorded in the same transaction, so redelivered returns are skipped.
+func (p *Products) UnreserveProducts(ctx context.Context, messages []oapi_codegen.PrivateUnreserveProductsReqMessage, unreservedAt time.Time) error {
 	unreserveProductsMessages := make([]oapi_codegen.PrivateOrderProcessUnreservedProductsReqMessage, 0, len(messages))
 
+	cancelledOrderIds := make([]string, 0, len(messages))
+	returnIds := make([]string, 0)
+	for _, msg := range messages {
+		if msg.ReturnId == nil {
+			cancelledOrderIds = append(cancelledOrderIds, msg.OrderId)
+		} else {
+			returnIds = append(returnIds, *msg.ReturnId)
+		}
+	}
+
 	if err := p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
-		// 1. Compute
-		toUnreserve := make(map[string]uint32)
-		for _, msg := range messages {
-			unreserveProductsMessages = append(unreserveProductsMessages, oapi_codegen.PrivateOrderProcessUnreservedProductsReqMessage{
-				OrderId: msg.OrderId,
-			})
+		unreserveProductsMessages = unreserveProductsMessages[:0]
 
-			for _, product := range msg.Products {
-				count, _ := toUnreserve[product.Id]
-				toUnreserve[product.Id] = count + uint32(product.Count)
+		// 1. Read
+		holds := make([]reservationHold, 0)
+		if len(cancelledOrderIds) > 0 {
+			var err error
+			holds, err = listOrdersReservationHoldsTx(ctx, tx, cancelledOrderIds)
+			if err != nil {
+				return err
 			}
 		}
-
-		// 2. Write
-		updates := make([]types.Value, 0, len(toUnreserve))
-		for productId, count := range toUnreserve {
-			updates = append(updates, types.StructValue(
-				types.StructFieldValue("id", types.StringValueFromString(productId)),
-				types.StructFieldValue("stock", types.Uint32Value(count)),
-			))
+		heldOrders := make(map[string]struct{}, len(holds))
+		for _, hold := range holds {
+			heldOrders[hold.OrderId] = struct{}{}
 		}
-
-		res, err := tx.Execute(ctx, queryUnreserveProducts, table.NewQueryParameters(
-			table.ValueParam("$updates", types.ListValue(updates...)),
-		))
-
+		restocked, err := listReturnsRestocksTx(ctx, tx, returnIds)
 		if err != nil {
 			return err
 		}
-		defer func() { _ = res.Close() }()
 
-		results := make([]any, 0)
+		// 2. Compute
+		toUnreserve := make([]stockDelta, 0)
+		toRelease := make([]reservationHold, 0, len(holds))
+		for _, hold := range holds {
+			switch hold.Status {
+			case ReservationStatusActive, ReservationStatusExpired:
+				toRelease = append(toRelease, hold)
+			case ReservationStatusSold:
+				toRelease = append(toRelease, hold)
+				toUnreserve = append(toUnreserve, stockDelta{ProductId: hold.ProductId, SkuId: hold.SkuId, Delta: int64(hold.Count - hold.Shortfall)})
+			}
+		}
+		restocks := make([]returnRestock, 0)
+		for _, msg := range messages {
+			unreserveProductsMessages = append(unreserveProductsMessages, oapi_codegen.PrivateOrderProcessUnreservedProductsReqMessage{
+				OrderId:  msg.OrderId,
+				ReturnId: msg.ReturnId,
+			})
 
-		for res.NextResultSet(ctx) {
-			for res.NextRow() {
-				var row struct {
-					Id        string    `json:"id"`
-					Stock     uint32    `json:"stock"`
-					UpdatedAt time.Time `json:"updated_at"`
+			if msg.ReturnId == nil {
+				if _, ok := heldOrders[msg.OrderId]; ok {
+					continue
 				}
-				if err := res.ScanNamed(
-					named.Required("id", &row.Id),
-					named.Required("stock", &row.Stock),
-					named.Required("updated_at", &row.UpdatedAt),
-				); err != nil {
-					return err
+				for _, product := range msg.Products {
+					toUnreserve = append(toUnreserve, stockDelta{ProductId: product.Id, SkuId: product.SkuId, Delta: int64(product.Count)})
 				}
-				results = append(results, row)
+				continue
+			}
+
+			// Counts of the same product of the return are summed up.
+			returned := make(map[returnRestockKey]uint32, len(msg.Products))
+			for _, product := range msg.Products {
+				key := returnRestockKey{ReturnId: *msg.ReturnId, ProductId: product.Id, SkuId: ptrValue(product.SkuId)}
+				if _, ok := restocked[key]; ok {
+					p.l.Info("skip restock of already restocked return", zap.String("return_id", key.ReturnId), zap.String("product_id", key.ProductId))
+					continue
+				}
+				returned[key] += uint32(product.Count)
+				toUnreserve = append(toUnreserve, stockDelta{ProductId: product.Id, SkuId: product.SkuId, Delta: int64(product.Count)})
+			}
+			for key, count := range returned {
+				restocked[key] = struct{}{}
+				restocks = append(restocks, returnRestock{returnRestockKey: key, OrderId: msg.OrderId, Count: count})
 			}
 		}
 
-		if err := res.Err(); err != nil {
+		// 3. Write
+		if _, err := adjustStockTx(ctx, tx, toUnreserve, unreservedAt); err != nil {
+			return err
+		}
+		if err := upsertRestocksTx(ctx, tx, restocks, unreservedAt); err != nil {
+			return err
+		}
+		if err := updateReservationHoldsStatusTx(ctx, tx, toRelease, ReservationStatusReleased, unreservedAt); err != nil {
 			return err
 		}
 
-		// Publish
-		unreserveProductsByteMessages := make([][]byte, 0, len(messages))
-		for _, msg := range unreserveProductsMessages {
-			data, err := json.Marshal(&msg)
-			if err != nil {
-				return fmt.Errorf("serialize unreserved product message: %v", err)
+		// 4. Publish
+		msgs, err := outbox.NewMessages(topicProductsUnreservedTopic, func(m oapi_codegen.PrivateOrderProcessUnreservedProductsReqMessage) string {
+			if m.ReturnId != nil {
+				return dedupKey("return", *m.ReturnId, "products_unreserved")
 			}
-			unreserveProductsByteMessages = append(unreserveProductsByteMessages, data)
+			return dedupKey("order", m.OrderId, "products_unreserved")
+		}, unreserveProductsMessages...)
+		if err != nil {
+			return fmt.Errorf("unreserved products messages: %v", err)
 		}
-		if err := ydbtopic.Produce(ctx, p.topicProductsUnreservedProducts, unreserveProductsByteMessages...); err != nil {
-			return fmt.Errorf("publish unreserved products messages: %v", err)
+		if err := p.outbox.EnqueueTableTx(ctx, tx, msgs...); err != nil {
+			return fmt.Errorf("enqueue unreserved products messages: %v", err)
 		}
 
 		return nil
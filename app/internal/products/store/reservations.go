@@ -0,0 +1,402 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	oapi_codegen "github.com/bratushkadan/floral/internal/products/presentation/generated"
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tableReservations = "`products/reservations`"
+
+	tableReservationsIndexProductIdStatus = "idx_product_id_status"
+	tableReservationsIndexStatusExpiresAt = "idx_status_expires_at"
+)
+
+// Reservation hold statuses. Active holds are subtracted from available stock until they expire,
+// sold holds are deducted from stock, released holds of cancelled orders and expired holds don't affect stock.
+const (
+	ReservationStatusActive   = "active"
+	ReservationStatusSold     = "sold"
+	ReservationStatusReleased = "released"
+	ReservationStatusExpired  = "expired"
+)
+
+// expiredReservationsBatchSize limits holds released by expiry in one transaction.
+const expiredReservationsBatchSize = 1000
+
+// noSkuId keys reservation holds of products without SKUs, an order holds several SKUs of the product.
+const noSkuId = ""
+
+// skuIdFromKey returns the sku id of the hold key, nil for products without SKUs.
+func skuIdFromKey(skuId string) *string {
+	if skuId == noSkuId {
+		return nil
+	}
+	return &skuId
+}
+
+// reservationHold holds count of the product for the order, SkuId is set for products with SKUs.
+type reservationHold struct {
+	OrderId   string
+	ProductId string
+	SkuId     *string
+	Count     uint32
+	Status    string
+	// Shortfall is the part of the sold count that was out of stock, it's not deducted from stock.
+	Shortfall uint32
+}
+
+var queryUpsertReservationHolds = template.ReplaceAllPairs(`
+DECLARE $holds AS List<Struct<
+    order_id:Utf8,
+    product_id:String,
+    sku_id:Utf8,
+    operation_id:Utf8,
+    count:Uint32,
+    status:Utf8,
+    expires_at:Datetime,
+    created_at:Datetime,
+    updated_at:Datetime,
+>>;
+
+UPSERT INTO {{table.reservations}}
+SELECT * FROM AS_TABLE($holds);
+`,
+	"{{table.reservations}}", tableReservations,
+)
+
+var queryListOrdersReservationHolds = template.ReplaceAllPairs(`
+DECLARE $order_ids AS List<Utf8>;
+
+SELECT
+    order_id,
+    product_id,
+    sku_id,
+    count,
+    status,
+    shortfall,
+FROM {{table.reservations}}
+WHERE order_id IN $order_ids;
+`,
+	"{{table.reservations}}", tableReservations,
+)
+
+func listOrdersReservationHoldsTx(ctx context.Context, tx table.TransactionActor, orderIds []string) ([]reservationHold, error) {
+	orderIdsList := make([]types.Value, 0, len(orderIds))
+	for _, id := range orderIds {
+		orderIdsList = append(orderIdsList, types.UTF8Value(id))
+	}
+
+	res, err := tx.Execute(ctx, queryListOrdersReservationHolds, table.NewQueryParameters(
+		table.ValueParam("$order_ids", types.ListValue(orderIdsList...)),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close() }()
+
+	holds := make([]reservationHold, 0)
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			var hold reservationHold
+			var skuId string
+			if err := res.ScanNamed(
+				named.Required("order_id", &hold.OrderId),
+				named.Required("product_id", &hold.ProductId),
+				named.Required("sku_id", &skuId),
+				named.Required("count", &hold.Count),
+				named.Required("status", &hold.Status),
+				named.OptionalWithDefault("shortfall", &hold.Shortfall),
+			); err != nil {
+				return nil, err
+			}
+			hold.SkuId = skuIdFromKey(skuId)
+			holds = append(holds, hold)
+		}
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+var queryUpdateReservationHoldsStatus = template.ReplaceAllPairs(`
+DECLARE $holds AS List<Struct<
+    order_id:Utf8,
+    product_id:String,
+    sku_id:Utf8,
+    shortfall:Uint32,
+>>;
+DECLARE $status AS Utf8;
+DECLARE $updated_at AS Datetime;
+
+UPDATE {{table.reservations}} ON
+SELECT
+    order_id,
+    product_id,
+    sku_id,
+    shortfall,
+    $status AS status,
+    $updated_at AS updated_at,
+FROM AS_TABLE($holds);
+`,
+	"{{table.reservations}}", tableReservations,
+)
+
+func updateReservationHoldsStatusTx(ctx context.Context, tx table.TransactionActor, holds []reservationHold, status string, updatedAt time.Time) error {
+	if len(holds) == 0 {
+		return nil
+	}
+
+	holdsList := make([]types.Value, 0, len(holds))
+	for _, hold := range holds {
+		holdsList = append(holdsList, types.StructValue(
+			types.StructFieldValue("order_id", types.UTF8Value(hold.OrderId)),
+			types.StructFieldValue("product_id", types.StringValueFromString(hold.ProductId)),
+			types.StructFieldValue("sku_id", types.UTF8Value(ptrValue(hold.SkuId))),
+			types.StructFieldValue("shortfall", types.Uint32Value(hold.Shortfall)),
+		))
+	}
+
+	_, err := tx.Execute(ctx, queryUpdateReservationHoldsStatus, table.NewQueryParameters(
+		table.ValueParam("$holds", types.ListValue(holdsList...)),
+		table.ValueParam("$status", types.UTF8Value(status)),
+		table.ValueParam("$updated_at", types.DatetimeValueFromTime(updatedAt)),
+	))
+	return err
+}
+
+var queryListOtherOrdersReservationHolds = template.ReplaceAllPairs(`
+DECLARE $product_ids AS List<String>;
+DECLARE $order_ids AS List<Utf8>;
+DECLARE $now AS Datetime;
+
+SELECT
+    product_id,
+    sku_id,
+    SUM(count) AS held
+FROM {{table.reservations}}
+VIEW {{index.product_id_status}}
+WHERE
+    product_id IN $product_ids
+        AND
+    status = "{{status.active}}"u
+        AND
+    expires_at > $now
+        AND
+    order_id NOT IN $order_ids
+GROUP BY product_id, sku_id;
+`,
+	"{{table.reservations}}", tableReservations,
+	"{{index.product_id_status}}", tableReservationsIndexProductIdStatus,
+	"{{status.active}}", ReservationStatusActive,
+)
+
+// listOtherOrdersHeldStockTx returns the stock of the products held by active holds of orders other than the given ones.
+func listOtherOrdersHeldStockTx(ctx context.Context, tx table.TransactionActor, productIds []string, orderIds []string, now time.Time) (map[reservationStockKey]int64, error) {
+	held := make(map[reservationStockKey]int64)
+	if len(productIds) == 0 {
+		return held, nil
+	}
+
+	productIdsList := make([]types.Value, 0, len(productIds))
+	for _, id := range productIds {
+		productIdsList = append(productIdsList, types.StringValueFromString(id))
+	}
+	orderIdsList := make([]types.Value, 0, len(orderIds))
+	for _, id := range orderIds {
+		orderIdsList = append(orderIdsList, types.UTF8Value(id))
+	}
+
+	res, err := tx.Execute(ctx, queryListOtherOrdersReservationHolds, table.NewQueryParameters(
+		table.ValueParam("$product_ids", types.ListValue(productIdsList...)),
+		table.ValueParam("$order_ids", types.ListValue(orderIdsList...)),
+		table.ValueParam("$now", types.DatetimeValueFromTime(now)),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close() }()
+
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			var key reservationStockKey
+			var count uint64
+			if err := res.ScanNamed(
+				named.Required("product_id", &key.ProductId),
+				named.Required("sku_id", &key.SkuId),
+				named.OptionalWithDefault("held", &count),
+			); err != nil {
+				return nil, err
+			}
+			held[key] = int64(count)
+		}
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	return held, nil
+}
+
+// SellProducts converts reservation holds of paid orders to sales and deducts sold products from stock.
+// Products of expired holds were available to other orders since the holds expired, so they're sold
+// only out of the stock that's not held by other orders. Sold count that's out of stock is recorded
+// as the shortfall of the hold for the seller to restock or for the order to be cancelled.
+// Holds that are already sold or released by order cancellation are skipped,
+// so redelivered messages don't deduct stock twice.
+func (p *Products) SellProducts(ctx context.Context, messages []oapi_codegen.PrivateSellProductsReqMessage, soldAt time.Time) error {
+	orderIds := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		orderIds = append(orderIds, msg.OrderId)
+	}
+
+	return p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		holds, err := listOrdersReservationHoldsTx(ctx, tx, orderIds)
+		if err != nil {
+			return err
+		}
+
+		// Active holds are sold before expired ones, they're not available to other orders.
+		var active, expired []reservationHold
+		var expiredProductIds []string
+		for _, hold := range holds {
+			switch hold.Status {
+			case ReservationStatusActive:
+				active = append(active, hold)
+			case ReservationStatusExpired:
+				expired = append(expired, hold)
+				expiredProductIds = append(expiredProductIds, hold.ProductId)
+			}
+		}
+		toSell := append(active, expired...)
+		if len(toSell) == 0 {
+			return nil
+		}
+
+		held, err := listOtherOrdersHeldStockTx(ctx, tx, expiredProductIds, orderIds, soldAt)
+		if err != nil {
+			return fmt.Errorf("list held stock: %w", err)
+		}
+
+		sold := make([]stockDelta, 0, len(toSell))
+		for _, hold := range toSell {
+			d := stockDelta{ProductId: hold.ProductId, SkuId: hold.SkuId, Delta: -int64(hold.Count)}
+			if hold.Status == ReservationStatusExpired {
+				d.Held = held[reservationStockKey{ProductId: hold.ProductId, SkuId: ptrValue(hold.SkuId)}]
+			}
+			sold = append(sold, d)
+		}
+
+		shortfalls, err := adjustStockTx(ctx, tx, sold, soldAt)
+		if err != nil {
+			return err
+		}
+		for i := range toSell {
+			toSell[i].Shortfall = uint32(shortfalls[i])
+			if toSell[i].Shortfall > 0 {
+				p.l.Error(
+					"sold products are out of stock",
+					zap.String("order_id", toSell[i].OrderId),
+					zap.String("product_id", toSell[i].ProductId),
+					zap.Stringp("sku_id", toSell[i].SkuId),
+					zap.String("hold_status", toSell[i].Status),
+					zap.Uint32("count", toSell[i].Count),
+					zap.Uint32("shortfall", toSell[i].Shortfall),
+				)
+			}
+		}
+
+		return updateReservationHoldsStatusTx(ctx, tx, toSell, ReservationStatusSold, soldAt)
+	})
+}
+
+var queryListExpiredReservationHolds = template.ReplaceAllPairs(`
+DECLARE $now AS Datetime;
+DECLARE $limit AS Uint64;
+
+SELECT
+    order_id,
+    product_id,
+    sku_id,
+    count,
+    status,
+FROM {{table.reservations}}
+VIEW {{index.status_expires_at}}
+WHERE status = "{{status.active}}"u AND expires_at <= $now
+LIMIT $limit;
+`,
+	"{{table.reservations}}", tableReservations,
+	"{{index.status_expires_at}}", tableReservationsIndexStatusExpiresAt,
+	"{{status.active}}", ReservationStatusActive,
+)
+
+// ReleaseExpiredReservations marks active holds that expired by now as expired, making held products available again.
+// Holds are released in batches, it returns the number of released holds.
+func (p *Products) ReleaseExpiredReservations(ctx context.Context, now time.Time) (int, error) {
+	var released int
+	for {
+		n, err := p.releaseExpiredReservationsBatch(ctx, now)
+		if err != nil {
+			return released, err
+		}
+		released += n
+		if n < expiredReservationsBatchSize {
+			return released, nil
+		}
+	}
+}
+
+func (p *Products) releaseExpiredReservationsBatch(ctx context.Context, now time.Time) (int, error) {
+	var released int
+
+	if err := p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, queryListExpiredReservationHolds, table.NewQueryParameters(
+			table.ValueParam("$now", types.DatetimeValueFromTime(now)),
+			table.ValueParam("$limit", types.Uint64Value(expiredReservationsBatchSize)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		holds := make([]reservationHold, 0)
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var hold reservationHold
+				var skuId string
+				if err := res.ScanNamed(
+					named.Required("order_id", &hold.OrderId),
+					named.Required("product_id", &hold.ProductId),
+					named.Required("sku_id", &skuId),
+					named.Required("count", &hold.Count),
+					named.Required("status", &hold.Status),
+				); err != nil {
+					return err
+				}
+				hold.SkuId = skuIdFromKey(skuId)
+				holds = append(holds, hold)
+			}
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
+
+		released = len(holds)
+		return updateReservationHoldsStatusTx(ctx, tx, holds, ReservationStatusExpired, now)
+	}); err != nil {
+		return 0, err
+	}
+
+	return released, nil
+}
@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	tableRestocks = "`products/restocks`"
+)
+
+// returnRestockKey identifies the stock of the product, or of its SKU, restocked by the received return.
+type returnRestockKey struct {
+	ReturnId  string
+	ProductId string
+	SkuId     string
+}
+
+// returnRestock is the count of the product restocked by the received return of the order.
+type returnRestock struct {
+	returnRestockKey
+	OrderId string
+	Count   uint32
+}
+
+var queryListReturnsRestocks = template.ReplaceAllPairs(`
+DECLARE $return_ids AS List<Utf8>;
+
+SELECT
+    return_id,
+    product_id,
+    sku_id,
+FROM {{table.restocks}}
+WHERE return_id IN $return_ids;
+`,
+	"{{table.restocks}}", tableRestocks,
+)
+
+// listReturnsRestocksTx returns the products already restocked by the returns.
+func listReturnsRestocksTx(ctx context.Context, tx table.TransactionActor, returnIds []string) (map[returnRestockKey]struct{}, error) {
+	restocked := make(map[returnRestockKey]struct{})
+	if len(returnIds) == 0 {
+		return restocked, nil
+	}
+
+	returnIdsList := make([]types.Value, 0, len(returnIds))
+	for _, id := range returnIds {
+		returnIdsList = append(returnIdsList, types.UTF8Value(id))
+	}
+
+	res, err := tx.Execute(ctx, queryListReturnsRestocks, table.NewQueryParameters(
+		table.ValueParam("$return_ids", types.ListValue(returnIdsList...)),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close() }()
+
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			var key returnRestockKey
+			if err := res.ScanNamed(
+				named.Required("return_id", &key.ReturnId),
+				named.Required("product_id", &key.ProductId),
+				named.Required("sku_id", &key.SkuId),
+			); err != nil {
+				return nil, err
+			}
+			restocked[key] = struct{}{}
+		}
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	return restocked, nil
+}
+
+var queryUpsertRestocks = template.ReplaceAllPairs(`
+DECLARE $restocks AS List<Struct<
+    return_id:Utf8,
+    product_id:String,
+    sku_id:Utf8,
+    order_id:Utf8,
+    count:Uint32,
+    created_at:Datetime,
+>>;
+
+UPSERT INTO {{table.restocks}}
+SELECT * FROM AS_TABLE($restocks);
+`,
+	"{{table.restocks}}", tableRestocks,
+)
+
+func upsertRestocksTx(ctx context.Context, tx table.TransactionActor, restocks []returnRestock, createdAt time.Time) error {
+	if len(restocks) == 0 {
+		return nil
+	}
+
+	restocksList := make([]types.Value, 0, len(restocks))
+	for _, r := range restocks {
+		restocksList = append(restocksList, types.StructValue(
+			types.StructFieldValue("return_id", types.UTF8Value(r.ReturnId)),
+			types.StructFieldValue("product_id", types.StringValueFromString(r.ProductId)),
+			types.StructFieldValue("sku_id", types.UTF8Value(r.SkuId)),
+			types.StructFieldValue("order_id", types.UTF8Value(r.OrderId)),
+			types.StructFieldValue("count", types.Uint32Value(r.Count)),
+			types.StructFieldValue("created_at", types.DatetimeValueFromTime(createdAt)),
+		))
+	}
+
+	_, err := tx.Execute(ctx, queryUpsertRestocks, table.NewQueryParameters(
+		table.ValueParam("$restocks", types.ListValue(restocksList...)),
+	))
+	return err
+}
@@ -0,0 +1,402 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bratushkadan/floral/pkg/template"
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// ProductOption is an option axis of the product variants, i.e. bouquet size or colour.
+type ProductOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// ProductSku is a variant of the product with a value of every product option.
+type ProductSku struct {
+	Id string `json:"id"`
+	// Options map option names to their values.
+	Options map[string]string `json:"options"`
+	Price   float64           `json:"price"`
+	Stock   uint32            `json:"stock"`
+	// PictureIds reference pictures of the product.
+	PictureIds []string `json:"picture_ids"`
+}
+
+// ProductVariants are option axes and SKUs of the product.
+// Stock of the product with SKUs is the sum of its SKUs stocks.
+type ProductVariants struct {
+	Options []ProductOption
+	Skus    []ProductSku
+}
+
+func (v ProductVariants) Sku(id string) (ProductSku, bool) {
+	for _, sku := range v.Skus {
+		if sku.Id == id {
+			return sku, true
+		}
+	}
+	return ProductSku{}, false
+}
+
+func (v ProductVariants) Stock() uint32 {
+	var stock uint32
+	for _, sku := range v.Skus {
+		stock += sku.Stock
+	}
+	return stock
+}
+
+// SkuName names the product variant after its option values in the order of product options, i.e. "Roses (L, red)".
+func (v ProductVariants) SkuName(productName string, sku ProductSku) string {
+	values := make([]string, 0, len(v.Options))
+	for _, option := range v.Options {
+		if value, ok := sku.Options[option.Name]; ok {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return productName
+	}
+	return fmt.Sprintf("%s (%s)", productName, strings.Join(values, ", "))
+}
+
+// Options and SKUs are nullable: products created before variants have neither.
+func unmarshalProductVariants(optionsJson, skusJson *[]byte) (ProductVariants, error) {
+	v := ProductVariants{
+		Options: make([]ProductOption, 0),
+		Skus:    make([]ProductSku, 0),
+	}
+	if optionsJson != nil {
+		if err := json.Unmarshal(*optionsJson, &v.Options); err != nil {
+			return ProductVariants{}, fmt.Errorf("failed to unmarshal product options json field: %v", err)
+		}
+	}
+	if skusJson != nil {
+		if err := json.Unmarshal(*skusJson, &v.Skus); err != nil {
+			return ProductVariants{}, fmt.Errorf("failed to unmarshal product skus json field: %v", err)
+		}
+	}
+	return v, nil
+}
+
+func marshalProductSkus(skus []ProductSku) (string, error) {
+	if skus == nil {
+		skus = make([]ProductSku, 0)
+	}
+	data, err := json.Marshal(skus)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal product skus: %v", err)
+	}
+	return string(data), nil
+}
+
+func marshalProductOptions(options []ProductOption) (string, error) {
+	if options == nil {
+		options = make([]ProductOption, 0)
+	}
+	data, err := json.Marshal(options)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal product options: %v", err)
+	}
+	return string(data), nil
+}
+
+var queryGetProductVariants = template.ReplaceAllPairs(`
+DECLARE $id AS String;
+
+SELECT
+    options,
+    skus,
+FROM {{table.table_products}}
+WHERE
+    id = $id
+        AND
+    deleted_at IS NULL;
+`,
+	"{{table.table_products}}", tableProducts,
+)
+
+var queryUpdateProductVariants = template.ReplaceAllPairs(`
+DECLARE $id AS String;
+DECLARE $options AS Json;
+DECLARE $skus AS Json;
+DECLARE $stock AS Optional<Uint32>;
+DECLARE $updated_at AS Datetime;
+
+UPDATE {{table.table_products}}
+SET
+    options = $options,
+    skus = $skus,
+    stock = COALESCE($stock, stock),
+    updated_at = $updated_at
+WHERE
+    id = $id;
+`,
+	"{{table.table_products}}", tableProducts,
+)
+
+type UpdateProductVariantsDTOInput struct {
+	Id        uuid.UUID
+	UpdatedAt time.Time
+	// Update changes variants of the product, its error aborts the update.
+	Update func(v *ProductVariants) error
+}
+
+// UpdateProductVariants reads and updates variants of the product in one transaction.
+// Stock of the product is recomputed from stocks of its SKUs unless it never had SKUs.
+// Returns nil variants if product does not exist.
+func (p *Products) UpdateProductVariants(ctx context.Context, in UpdateProductVariantsDTOInput) (*ProductVariants, error) {
+	var out *ProductVariants
+
+	if err := p.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		out = nil
+
+		res, err := tx.Execute(ctx, queryGetProductVariants, table.NewQueryParameters(
+			table.ValueParam("$id", types.StringValueFromString(in.Id.String())),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		var variants *ProductVariants
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var optionsJson, skusJson *[]byte
+				if err := res.ScanNamed(
+					named.Optional("options", &optionsJson),
+					named.Optional("skus", &skusJson),
+				); err != nil {
+					return err
+				}
+				v, err := unmarshalProductVariants(optionsJson, skusJson)
+				if err != nil {
+					return err
+				}
+				variants = &v
+			}
+		}
+		if err := res.Err(); err != nil {
+			return err
+		}
+		if variants == nil {
+			return nil
+		}
+
+		hadSkus := len(variants.Skus) > 0
+		if err := in.Update(variants); err != nil {
+			return err
+		}
+
+		options, err := marshalProductOptions(variants.Options)
+		if err != nil {
+			return err
+		}
+		skus, err := marshalProductSkus(variants.Skus)
+		if err != nil {
+			return err
+		}
+		var stock *uint32
+		if hadSkus || len(variants.Skus) > 0 {
+			stock = ptr(variants.Stock())
+		}
+
+		if _, err := tx.Execute(ctx, queryUpdateProductVariants, table.NewQueryParameters(
+			table.ValueParam("$id", types.StringValueFromString(in.Id.String())),
+			table.ValueParam("$options", types.JSONValue(options)),
+			table.ValueParam("$skus", types.JSONValue(skus)),
+			table.ValueParam("$stock", types.NullableUint32Value(stock)),
+			table.ValueParam("$updated_at", types.DatetimeValueFromTime(in.UpdatedAt)),
+		)); err != nil {
+			return err
+		}
+
+		out = variants
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// stockDelta changes stock of the product, or stock of its SKU if the product has SKUs.
+type stockDelta struct {
+	ProductId string
+	SkuId     *string
+	Delta     int64
+	// Held is the stock held by other orders, a deduction doesn't take it.
+	Held int64
+}
+
+// applyStockDelta returns the stock after the delta and the shortfall: the part of the deduction that's not in stock.
+func applyStockDelta(stock int64, d stockDelta) (int64, int64) {
+	if d.Delta >= 0 {
+		return stock + d.Delta, 0
+	}
+	deducted := min(-d.Delta, max(stock-d.Held, 0))
+	return stock - deducted, -d.Delta - deducted
+}
+
+var queryListProductsStock = template.ReplaceAllPairs(`
+DECLARE $ids AS List<String>;
+
+SELECT
+    id,
+    stock,
+    options,
+    skus,
+FROM {{table.table_products}}
+WHERE id IN $ids;
+`,
+	"{{table.table_products}}", tableProducts,
+)
+
+var queryUpdateProductsStock = template.ReplaceAllPairs(`
+DECLARE $updates AS List<Struct<
+    id:String,
+    stock:Uint32,
+    skus:Optional<Json>,
+>>;
+DECLARE $updated_at AS Datetime;
+
+UPDATE {{table.table_products}} ON
+SELECT
+    id,
+    stock,
+    skus,
+    $updated_at AS updated_at,
+FROM AS_TABLE($updates);
+`,
+	"{{table.table_products}}", tableProducts,
+)
+
+// adjustStockTx applies stock deltas to products and their SKUs in order. Deductions never take more than in stock
+// less the stock held by other orders, the rest is returned as the shortfall of the delta: stock may be less
+// than sold count if the seller lowered it while products were held or the hold expired before the sale.
+// Deductions of deleted products or SKUs and deductions without SKU of products with SKUs fall short entirely.
+func adjustStockTx(ctx context.Context, tx table.TransactionActor, deltas []stockDelta, updatedAt time.Time) ([]int64, error) {
+	shortfalls := make([]int64, len(deltas))
+	if len(deltas) == 0 {
+		return shortfalls, nil
+	}
+
+	ids := make(map[string]struct{}, len(deltas))
+	idsList := make([]types.Value, 0, len(deltas))
+	for _, d := range deltas {
+		if _, ok := ids[d.ProductId]; ok {
+			continue
+		}
+		ids[d.ProductId] = struct{}{}
+		idsList = append(idsList, types.StringValueFromString(d.ProductId))
+	}
+
+	res, err := tx.Execute(ctx, queryListProductsStock, table.NewQueryParameters(
+		table.ValueParam("$ids", types.ListValue(idsList...)),
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Close() }()
+
+	type productStock struct {
+		stock    int64
+		variants ProductVariants
+	}
+	products := make(map[string]*productStock, len(ids))
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			var id string
+			var stock uint32
+			var optionsJson, skusJson *[]byte
+			if err := res.ScanNamed(
+				named.Required("id", &id),
+				named.Required("stock", &stock),
+				named.Optional("options", &optionsJson),
+				named.Optional("skus", &skusJson),
+			); err != nil {
+				return nil, err
+			}
+			variants, err := unmarshalProductVariants(optionsJson, skusJson)
+			if err != nil {
+				return nil, err
+			}
+			products[id] = &productStock{stock: int64(stock), variants: variants}
+		}
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, d := range deltas {
+		shortfalls[i] = max(-d.Delta, 0)
+		product, ok := products[d.ProductId]
+		if !ok {
+			continue
+		}
+		if len(product.variants.Skus) == 0 {
+			product.stock, shortfalls[i] = applyStockDelta(product.stock, d)
+			continue
+		}
+		if d.SkuId == nil {
+			continue
+		}
+		for j, sku := range product.variants.Skus {
+			if sku.Id == *d.SkuId {
+				var stock int64
+				stock, shortfalls[i] = applyStockDelta(int64(sku.Stock), d)
+				product.variants.Skus[j].Stock = uint32(stock)
+				break
+			}
+		}
+	}
+
+	updates := make([]types.Value, 0, len(products))
+	for id, product := range products {
+		stock := uint32(product.stock)
+		var skus *string
+		if len(product.variants.Skus) > 0 {
+			stock = product.variants.Stock()
+			v, err := marshalProductSkus(product.variants.Skus)
+			if err != nil {
+				return nil, err
+			}
+			skus = &v
+		}
+		updates = append(updates, types.StructValue(
+			types.StructFieldValue("id", types.StringValueFromString(id)),
+			types.StructFieldValue("stock", types.Uint32Value(stock)),
+			types.StructFieldValue("skus", types.NullableJSONValue(skus)),
+		))
+	}
+	if len(updates) == 0 {
+		return shortfalls, nil
+	}
+
+	_, err = tx.Execute(ctx, queryUpdateProductsStock, table.NewQueryParameters(
+		table.ValueParam("$updates", types.ListValue(updates...)),
+		table.ValueParam("$updated_at", types.DatetimeValueFromTime(updatedAt)),
+	))
+	return shortfalls, err
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func ptrValue[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Promo code applied to the cart, it's redeemed when the order is created
+CREATE TABLE `cart/promo_codes` (
+  user_id Utf8 NOT NULL,
+  code Utf8 NOT NULL,
+  applied_at Timestamp NOT NULL,
+  PRIMARY KEY (user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `cart/promo_codes`;
+-- +goose StatementEnd
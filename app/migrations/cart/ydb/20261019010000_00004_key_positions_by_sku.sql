@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Each SKU of the product is a separate position, positions of products without SKUs have an empty sku_id
+CREATE TABLE `cart/positions_by_sku` (
+    user_id Utf8 NOT NULL,
+    product_id Utf8 NOT NULL,
+    sku_id Utf8 NOT NULL,
+    count Uint32 NOT NULL,
+    PRIMARY KEY (user_id, product_id, sku_id),
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Products had no SKUs
+UPSERT INTO `cart/positions_by_sku`
+SELECT user_id, product_id, ""u AS sku_id, count
+FROM `cart/positions`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `cart/positions`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `cart/positions_by_sku` RENAME TO `cart/positions`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE `cart/positions_by_product` (
+    user_id Utf8 NOT NULL,
+    product_id Utf8 NOT NULL,
+    count Uint32 NOT NULL,
+    PRIMARY KEY (user_id, product_id),
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Only one SKU position of the product is kept
+UPSERT INTO `cart/positions_by_product`
+SELECT user_id, product_id, count
+FROM `cart/positions`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `cart/positions`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `cart/positions_by_product` RENAME TO `cart/positions`;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Orders the carts were cleared for, redelivered clear requests are skipped.
+-- Redeliveries come within minutes, so the records expire in a month.
+CREATE TABLE `cart/cleared_orders` (
+  order_id Utf8 NOT NULL,
+  user_id Utf8 NOT NULL,
+  cleared_at Timestamp NOT NULL,
+  PRIMARY KEY (order_id)
+) WITH (
+  TTL = Interval("P30D") ON cleared_at
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `cart/cleared_orders`;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `feedback/product_ratings` (
+    product_id String NOT NULL,
+    reviews_count Int64 NOT NULL,
+    rating_sum Double NOT NULL,
+    rating_1_count Int64 NOT NULL,
+    rating_2_count Int64 NOT NULL,
+    rating_3_count Int64 NOT NULL,
+    rating_4_count Int64 NOT NULL,
+    rating_5_count Int64 NOT NULL,
+    updated_at Datetime NOT NULL,
+    PRIMARY KEY (product_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Backfill aggregates for reviews left before the table existed.
+UPSERT INTO `feedback/product_ratings`
+SELECT
+    product_id,
+    CAST(COUNT(*) AS Int64) AS reviews_count,
+    COALESCE(SUM(rating), 0.0) AS rating_sum,
+    CAST(COUNT_IF(Math::Round(rating) <= 1) AS Int64) AS rating_1_count,
+    CAST(COUNT_IF(Math::Round(rating) = 2) AS Int64) AS rating_2_count,
+    CAST(COUNT_IF(Math::Round(rating) = 3) AS Int64) AS rating_3_count,
+    CAST(COUNT_IF(Math::Round(rating) = 4) AS Int64) AS rating_4_count,
+    CAST(COUNT_IF(Math::Round(rating) >= 5) AS Int64) AS rating_5_count,
+    CurrentUtcDatetime() AS updated_at
+FROM `feedback/reviews`
+GROUP BY product_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `feedback/product_ratings`;
+-- +goose StatementEnd
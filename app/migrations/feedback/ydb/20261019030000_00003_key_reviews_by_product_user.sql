@@ -0,0 +1,90 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `feedback/reviews_by_product_user` (
+    id String NOT NULL,
+    product_id String NOT NULL,
+    user_id String NOT NULL,
+    rating Double NOT NULL,
+    review Utf8 NOT NULL,
+    created_at Datetime NOT NULL,
+    updated_at Datetime NOT NULL,
+    PRIMARY KEY (product_id, user_id),
+    INDEX idx_id GLOBAL SYNC ON (id),
+    INDEX idx_product_created_at GLOBAL ASYNC ON (product_id, created_at)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- A user keeps the latest of their reviews of a product.
+UPSERT INTO `feedback/reviews_by_product_user`
+SELECT
+    product_id,
+    user_id,
+    r.id AS id,
+    r.rating AS rating,
+    r.review AS review,
+    r.created_at AS created_at,
+    r.updated_at AS updated_at,
+FROM (
+    SELECT
+        product_id,
+        user_id,
+        MAX_BY(AsStruct(id AS id, rating AS rating, review AS review, created_at AS created_at, updated_at AS updated_at), updated_at) AS r,
+    FROM `feedback/reviews`
+    GROUP BY product_id, user_id
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Rating aggregates are recomputed without the dropped duplicate reviews.
+UPSERT INTO `feedback/product_ratings`
+SELECT
+    product_id,
+    CAST(COUNT(*) AS Int64) AS reviews_count,
+    COALESCE(SUM(rating), 0.0) AS rating_sum,
+    CAST(COUNT_IF(Math::Round(rating) <= 1) AS Int64) AS rating_1_count,
+    CAST(COUNT_IF(Math::Round(rating) = 2) AS Int64) AS rating_2_count,
+    CAST(COUNT_IF(Math::Round(rating) = 3) AS Int64) AS rating_3_count,
+    CAST(COUNT_IF(Math::Round(rating) = 4) AS Int64) AS rating_4_count,
+    CAST(COUNT_IF(Math::Round(rating) >= 5) AS Int64) AS rating_5_count,
+    CurrentUtcDatetime() AS updated_at
+FROM `feedback/reviews_by_product_user`
+GROUP BY product_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `feedback/reviews`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `feedback/reviews_by_product_user` RENAME TO `feedback/reviews`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE `feedback/reviews_by_id` (
+    id String NOT NULL,
+    product_id String NOT NULL,
+    user_id String NOT NULL,
+    rating Double NOT NULL,
+    review Utf8 NOT NULL,
+    created_at Datetime NOT NULL,
+    updated_at Datetime NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_product_created_at GLOBAL ASYNC ON (product_id, created_at)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPSERT INTO `feedback/reviews_by_id`
+SELECT id, product_id, user_id, rating, review, created_at, updated_at
+FROM `feedback/reviews`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `feedback/reviews`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `feedback/reviews_by_id` RENAME TO `feedback/reviews`;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Topic messages written in the same transactions as the state changes, published by the outbox relay
+CREATE TABLE `feedback/outbox` (
+  created_at Timestamp NOT NULL,
+  id Utf8 NOT NULL,
+  topic Utf8 NOT NULL,
+  dedup_key Utf8 NOT NULL,
+  data String NOT NULL,
+  -- Hides the message from other relays while it's being published
+  claimed_until Timestamp,
+  PRIMARY KEY (created_at, id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `feedback/outbox`;
+-- +goose StatementEnd
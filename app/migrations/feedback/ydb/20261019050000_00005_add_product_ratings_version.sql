@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Incremented on every rating change, the catalog skips rating updates older than the applied one
+ALTER TABLE `feedback/product_ratings` ADD COLUMN version Uint64;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `feedback/product_ratings` DROP COLUMN version;
+-- +goose StatementEnd
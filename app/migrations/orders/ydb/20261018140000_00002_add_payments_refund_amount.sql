@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Part of payment amount to be returned to the customer: overpayment or a payment in an unsupported currency
+ALTER TABLE `orders/payments` ADD COLUMN refund_amount Double;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `orders/payments` DROP COLUMN refund_amount;
+-- +goose StatementEnd
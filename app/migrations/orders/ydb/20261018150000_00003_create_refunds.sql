@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One refund per payment of a cancelled order
+CREATE TABLE `orders/refunds` (
+  payment_id Utf8 NOT NULL,
+  order_id Utf8 NOT NULL,
+  amount Double NOT NULL,
+  currency_iso_4217 Uint32 NOT NULL,
+  provider Utf8 NOT NULL,
+  status Utf8 NOT NULL,
+  provider_refund_id Utf8,
+  details Utf8,
+  created_at Timestamp NOT NULL,
+  updated_at Timestamp NOT NULL,
+  PRIMARY KEY (payment_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `orders/refunds`;
+-- +goose StatementEnd
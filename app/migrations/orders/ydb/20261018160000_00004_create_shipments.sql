@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-seller fulfillment unit of an order
+CREATE TABLE `orders/shipments` (
+  order_id Utf8 NOT NULL,
+  seller_id Utf8 NOT NULL,
+  status Utf8 NOT NULL,
+  created_at Datetime NOT NULL,
+  updated_at Datetime NOT NULL,
+  PRIMARY KEY (order_id, seller_id),
+  INDEX idx_seller_id GLOBAL ASYNC on (seller_id, created_at)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Backfill shipments of orders created before the table existed.
+UPSERT INTO `orders/shipments`
+SELECT DISTINCT
+  i.order_id AS order_id,
+  i.seller_id AS seller_id,
+  CASE o.status
+    WHEN "cancelled" THEN "cancelled"u
+    WHEN "processed" THEN "processed"u
+    WHEN "shipped" THEN "shipped"u
+    WHEN "delivered" THEN "delivered"u
+    WHEN "completed" THEN "completed"u
+    ELSE "created"u
+  END AS status,
+  o.created_at AS created_at,
+  o.updated_at AS updated_at,
+FROM `orders/order_items` i
+JOIN `orders/orders` o ON o.id = i.order_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `orders/shipments`;
+-- +goose StatementEnd
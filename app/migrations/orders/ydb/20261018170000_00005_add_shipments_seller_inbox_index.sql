@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Seller order inbox: seller shipments by creation time, status is covered for filtering
+ALTER TABLE `orders/shipments` ADD INDEX idx_seller_inbox GLOBAL ASYNC ON (seller_id, created_at, order_id) COVER (status, updated_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/shipments` DROP INDEX idx_seller_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `orders/shipments` ADD INDEX idx_seller_id GLOBAL ASYNC ON (seller_id, created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/shipments` DROP INDEX idx_seller_inbox;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Audit trail of order status transitions
+CREATE TABLE `orders/order_status_history` (
+  order_id Utf8 NOT NULL,
+  created_at Timestamp NOT NULL,
+  id Utf8 NOT NULL,
+  -- NULL for order creation
+  from_status Utf8,
+  status Utf8 NOT NULL,
+  actor_type Utf8 NOT NULL,
+  actor_id Utf8 NOT NULL,
+  reason Utf8 NOT NULL,
+  PRIMARY KEY (order_id, created_at, id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Only creation of orders placed before the table existed is known.
+UPSERT INTO `orders/order_status_history`
+SELECT
+  id AS order_id,
+  CAST(created_at AS Timestamp) AS created_at,
+  CAST(RandomUuid(id) AS Utf8) AS id,
+  CAST(NULL AS Utf8?) AS from_status,
+  "created"u AS status,
+  "user"u AS actor_type,
+  user_id AS actor_id,
+  "order created"u AS reason,
+FROM `orders/orders`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `orders/order_status_history`;
+-- +goose StatementEnd
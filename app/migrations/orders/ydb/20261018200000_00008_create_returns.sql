@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Return of delivered items of a single seller shipment, returns are read by order so they're keyed by it
+CREATE TABLE `orders/returns` (
+  order_id Utf8 NOT NULL,
+  id Utf8 NOT NULL,
+  user_id Utf8 NOT NULL,
+  seller_id Utf8 NOT NULL,
+  status Utf8 NOT NULL,
+  reason Utf8 NOT NULL,
+  comment Utf8,
+  refund_amount Double NOT NULL,
+  photos Json NOT NULL,
+  created_at Timestamp NOT NULL,
+  updated_at Timestamp NOT NULL,
+  PRIMARY KEY (order_id, id)
+);
+CREATE TABLE `orders/return_items` (
+  order_id Utf8 NOT NULL,
+  return_id Utf8 NOT NULL,
+  product_id Utf8 NOT NULL,
+  count Uint32 NOT NULL,
+  PRIMARY KEY (order_id, return_id, product_id)
+);
+-- Partial refunds of received returns, refund amount is split between order payments
+CREATE TABLE `orders/return_refunds` (
+  return_id Utf8 NOT NULL,
+  payment_id Utf8 NOT NULL,
+  order_id Utf8 NOT NULL,
+  amount Double NOT NULL,
+  currency_iso_4217 Uint32 NOT NULL,
+  provider Utf8 NOT NULL,
+  status Utf8 NOT NULL,
+  provider_refund_id Utf8,
+  details Utf8,
+  created_at Timestamp NOT NULL,
+  updated_at Timestamp NOT NULL,
+  PRIMARY KEY (return_id, payment_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `orders/returns`;
+DROP TABLE `orders/return_items`;
+DROP TABLE `orders/return_refunds`;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- User address book, orders keep a snapshot of the address they were placed with
+CREATE TABLE `orders/addresses` (
+  user_id Utf8 NOT NULL,
+  id Utf8 NOT NULL,
+  recipient_name Utf8 NOT NULL,
+  phone Utf8 NOT NULL,
+  country Utf8 NOT NULL,
+  city Utf8 NOT NULL,
+  street Utf8 NOT NULL,
+  postal_code Utf8 NOT NULL,
+  comment Utf8,
+  created_at Timestamp NOT NULL,
+  updated_at Timestamp NOT NULL,
+  PRIMARY KEY (user_id, id)
+);
+-- Delivery method and address snapshot, the operation carries it until the order is created
+ALTER TABLE `orders/operations` ADD COLUMN delivery Json;
+ALTER TABLE `orders/orders` ADD COLUMN delivery Json;
+ALTER TABLE `orders/shipments` ADD COLUMN tracking_number Utf8, ADD COLUMN carrier Utf8;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `orders/addresses`;
+ALTER TABLE `orders/operations` DROP COLUMN delivery;
+ALTER TABLE `orders/orders` DROP COLUMN delivery;
+ALTER TABLE `orders/shipments` DROP COLUMN tracking_number, DROP COLUMN carrier;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Time the carrier reported delivery, carrier delivered shipments are completed after a grace period
+ALTER TABLE `orders/shipments` ADD COLUMN carrier_delivered_at Timestamp;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Carrier webhooks refer to shipments by tracking number
+ALTER TABLE `orders/shipments` ADD INDEX idx_tracking GLOBAL ASYNC ON (carrier, tracking_number);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `orders/shipments` DROP INDEX idx_tracking;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/shipments` DROP COLUMN carrier_delivered_at;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Transitions to a status within a time range are looked up by the purchases stats job
+ALTER TABLE `orders/order_status_history` ADD INDEX idx_status_created_at GLOBAL SYNC ON (status, created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Purchases counters of products last published to the catalog
+CREATE TABLE `orders/products_purchases_stats` (
+  product_id Utf8 NOT NULL,
+  purchases_alltime Int64 NOT NULL,
+  -- Items of orders paid within the rolling window which are still not cancelled
+  purchases_window Int64 NOT NULL,
+  updated_at Timestamp NOT NULL,
+  PRIMARY KEY (product_id)
+);
+-- Status transitions up to the watermark are accounted by the job
+CREATE TABLE `orders/job_watermarks` (
+  job Utf8 NOT NULL,
+  watermark Timestamp NOT NULL,
+  PRIMARY KEY (job)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Orders paid before the status history existed only count towards the all-time counter.
+UPSERT INTO `orders/products_purchases_stats`
+SELECT
+  oi.product_id AS product_id,
+  SUM(CAST(oi.count AS Int64)) AS purchases_alltime,
+  SUM(IF(p.order_id IS NOT NULL, CAST(oi.count AS Int64), 0l)) AS purchases_window,
+  CurrentUtcTimestamp() AS updated_at,
+FROM `orders/orders` AS o
+JOIN `orders/order_items` AS oi ON oi.order_id = o.id
+LEFT JOIN (
+  SELECT DISTINCT order_id
+  FROM `orders/order_status_history`
+  WHERE status = "paid"u AND created_at > CurrentUtcTimestamp() - Interval("P30D")
+) AS p ON p.order_id = o.id
+WHERE o.status IN ("paid"u, "processed"u, "shipped"u, "delivered"u, "completed"u)
+GROUP BY oi.product_id;
+
+UPSERT INTO `orders/job_watermarks` (job, watermark)
+VALUES ("products_purchases_stats"u, CurrentUtcTimestamp());
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `orders/products_purchases_stats`;
+DROP TABLE `orders/job_watermarks`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/order_status_history` DROP INDEX idx_status_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Payments of an order are read in the payment processing transaction, so the index must be consistent
+ALTER TABLE `orders/payments` DROP INDEX idx_order_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/payments` ADD INDEX idx_order_id GLOBAL SYNC ON (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `orders/payments` DROP INDEX idx_order_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/payments` ADD INDEX idx_order_id GLOBAL ASYNC ON (order_id);
+-- +goose StatementEnd
@@ -0,0 +1,95 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A payment is refunded by a cancellation refund of the amount kept by the shop
+-- and an overpayment refund of its refund_amount
+CREATE TABLE `orders/refunds_by_payment_refund` (
+  payment_id Utf8 NOT NULL,
+  id Utf8 NOT NULL,
+  order_id Utf8 NOT NULL,
+  amount Double NOT NULL,
+  currency_iso_4217 Uint32 NOT NULL,
+  provider Utf8 NOT NULL,
+  status Utf8 NOT NULL,
+  provider_refund_id Utf8,
+  details Utf8,
+  created_at Timestamp NOT NULL,
+  updated_at Timestamp NOT NULL,
+  PRIMARY KEY (payment_id, id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPSERT INTO `orders/refunds_by_payment_refund`
+SELECT
+  payment_id,
+  payment_id || ":cancellation"u AS id,
+  order_id,
+  amount,
+  currency_iso_4217,
+  provider,
+  status,
+  provider_refund_id,
+  details,
+  created_at,
+  updated_at,
+FROM `orders/refunds`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Payments flagged for refund before overpayments were refunded
+UPSERT INTO `orders/refunds_by_payment_refund`
+SELECT
+  id AS payment_id,
+  id || ":overpayment"u AS id,
+  order_id,
+  Unwrap(refund_amount) AS amount,
+  currency_iso_4217,
+  COALESCE(CAST(ListHead(DictKeys(Yson::ConvertToDict(Yson::ParseJson(CAST(provider AS String))))) AS Utf8), ""u) AS provider,
+  "pending"u AS status,
+  CAST(NULL AS Utf8?) AS provider_refund_id,
+  CAST(NULL AS Utf8?) AS details,
+  CurrentUtcTimestamp() AS created_at,
+  CurrentUtcTimestamp() AS updated_at,
+FROM `orders/payments`
+WHERE refund_amount > 0.0 AND refunded_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `orders/refunds`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/refunds_by_payment_refund` RENAME TO `orders/refunds`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE `orders/refunds_by_payment` (
+  payment_id Utf8 NOT NULL,
+  order_id Utf8 NOT NULL,
+  amount Double NOT NULL,
+  currency_iso_4217 Uint32 NOT NULL,
+  provider Utf8 NOT NULL,
+  status Utf8 NOT NULL,
+  provider_refund_id Utf8,
+  details Utf8,
+  created_at Timestamp NOT NULL,
+  updated_at Timestamp NOT NULL,
+  PRIMARY KEY (payment_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPSERT INTO `orders/refunds_by_payment`
+SELECT payment_id, order_id, amount, currency_iso_4217, provider, status, provider_refund_id, details, created_at, updated_at
+FROM `orders/refunds`
+WHERE id = payment_id || ":cancellation"u;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `orders/refunds`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/refunds_by_payment` RENAME TO `orders/refunds`;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Processing refunds are reclaimed once the claim expires, e.g. after the run that claimed them crashed
+ALTER TABLE `orders/refunds` ADD COLUMN claimed_until Timestamp;
+ALTER TABLE `orders/return_refunds` ADD COLUMN claimed_until Timestamp;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `orders/refunds` DROP COLUMN claimed_until;
+ALTER TABLE `orders/return_refunds` DROP COLUMN claimed_until;
+-- +goose StatementEnd
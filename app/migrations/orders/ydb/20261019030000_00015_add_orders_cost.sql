@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Order cost computed at order creation in minor units of the order currency, prices include VAT
+ALTER TABLE `orders/orders`
+  ADD COLUMN currency_iso_4217 Uint32,
+  ADD COLUMN subtotal Int64,
+  ADD COLUMN discount Int64,
+  ADD COLUMN shipping_fee Int64,
+  ADD COLUMN vat Int64,
+  ADD COLUMN total Int64;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `orders/orders`
+  DROP COLUMN currency_iso_4217,
+  DROP COLUMN subtotal,
+  DROP COLUMN discount,
+  DROP COLUMN shipping_fee,
+  DROP COLUMN vat,
+  DROP COLUMN total;
+-- +goose StatementEnd
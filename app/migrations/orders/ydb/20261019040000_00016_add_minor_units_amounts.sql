@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Amounts in minor units of their currency (kopecks) are summed and compared exactly,
+-- Double amounts are kept for reading.
+ALTER TABLE `orders/order_items` ADD COLUMN price_minor Int64;
+ALTER TABLE `orders/payments` ADD COLUMN amount_minor Int64;
+ALTER TABLE `orders/payments` ADD COLUMN refund_amount_minor Int64;
+ALTER TABLE `orders/refunds` ADD COLUMN amount_minor Int64;
+ALTER TABLE `orders/returns` ADD COLUMN refund_amount_minor Int64;
+ALTER TABLE `orders/return_refunds` ADD COLUMN amount_minor Int64;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE `orders/order_items`
+SET price_minor = CAST(Math::Round(price * 100.0) AS Int64)
+WHERE price_minor IS NULL;
+
+UPDATE `orders/payments`
+SET
+  amount_minor = CAST(Math::Round(amount * 100.0) AS Int64),
+  refund_amount_minor = CAST(Math::Round(refund_amount * 100.0) AS Int64)
+WHERE amount_minor IS NULL;
+
+UPDATE `orders/refunds`
+SET amount_minor = CAST(Math::Round(amount * 100.0) AS Int64)
+WHERE amount_minor IS NULL;
+
+UPDATE `orders/returns`
+SET refund_amount_minor = CAST(Math::Round(refund_amount * 100.0) AS Int64)
+WHERE refund_amount_minor IS NULL;
+
+UPDATE `orders/return_refunds`
+SET amount_minor = CAST(Math::Round(amount * 100.0) AS Int64)
+WHERE amount_minor IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `orders/order_items` DROP COLUMN price_minor;
+ALTER TABLE `orders/payments` DROP COLUMN amount_minor;
+ALTER TABLE `orders/payments` DROP COLUMN refund_amount_minor;
+ALTER TABLE `orders/refunds` DROP COLUMN amount_minor;
+ALTER TABLE `orders/returns` DROP COLUMN refund_amount_minor;
+ALTER TABLE `orders/return_refunds` DROP COLUMN amount_minor;
+-- +goose StatementEnd
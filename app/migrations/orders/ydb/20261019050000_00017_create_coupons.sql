@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Coupons created by admins and sellers, amounts are in minor units of the order currency
+CREATE TABLE `orders/coupons` (
+  code Utf8 NOT NULL,
+  owner_type Utf8 NOT NULL,
+  owner_id Utf8 NOT NULL,
+  discount_type Utf8 NOT NULL,
+  discount_value Int64 NOT NULL,
+  min_subtotal Int64 NOT NULL,
+  -- Zero limits are unlimited
+  max_uses Uint64 NOT NULL,
+  max_uses_per_user Uint64 NOT NULL,
+  starts_at Timestamp,
+  expires_at Timestamp,
+  -- Product and seller ids the discount is scoped to
+  product_ids Json,
+  seller_ids Json,
+  -- Count of unreleased redemptions
+  uses Uint64 NOT NULL,
+  created_at Timestamp NOT NULL,
+  PRIMARY KEY (code),
+  INDEX idx_owner GLOBAL ASYNC ON (owner_type, owner_id)
+);
+-- Redemptions are released when their orders are cancelled
+CREATE TABLE `orders/coupon_redemptions` (
+  code Utf8 NOT NULL,
+  user_id Utf8 NOT NULL,
+  order_id Utf8 NOT NULL,
+  discount Int64 NOT NULL,
+  created_at Timestamp NOT NULL,
+  released_at Timestamp,
+  PRIMARY KEY (code, user_id, order_id)
+);
+-- Promo code applied to the cart at checkout, the operation carries it until the order is created
+ALTER TABLE `orders/operations` ADD COLUMN promo_code Utf8;
+ALTER TABLE `orders/orders` ADD COLUMN promo_code Utf8;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `orders/coupons`;
+DROP TABLE `orders/coupon_redemptions`;
+ALTER TABLE `orders/operations` DROP COLUMN promo_code;
+ALTER TABLE `orders/orders` DROP COLUMN promo_code;
+-- +goose StatementEnd
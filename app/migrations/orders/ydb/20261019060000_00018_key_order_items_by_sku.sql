@@ -0,0 +1,124 @@
+-- +goose Up
+-- +goose StatementBegin
+-- An order has several SKUs of the product, items of products without SKUs have an empty sku_id
+CREATE TABLE `orders/order_items_by_sku` (
+  order_id Utf8 NOT NULL,
+  product_id Utf8 NOT NULL,
+  sku_id Utf8 NOT NULL,
+  name Utf8 NOT NULL,
+  seller_id Utf8 NOT NULL,
+  count Uint32 NOT NULL,
+  price Double NOT NULL,
+  price_minor Int64,
+  picture Utf8,
+  PRIMARY KEY (order_id, product_id, sku_id)
+);
+-- Returned SKUs of the product
+CREATE TABLE `orders/return_items_by_sku` (
+  order_id Utf8 NOT NULL,
+  return_id Utf8 NOT NULL,
+  product_id Utf8 NOT NULL,
+  sku_id Utf8 NOT NULL,
+  count Uint32 NOT NULL,
+  PRIMARY KEY (order_id, return_id, product_id, sku_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Products had no SKUs
+UPSERT INTO `orders/order_items_by_sku`
+SELECT
+  order_id,
+  product_id,
+  ""u AS sku_id,
+  name,
+  seller_id,
+  count,
+  price,
+  price_minor,
+  picture,
+FROM `orders/order_items`;
+
+UPSERT INTO `orders/return_items_by_sku`
+SELECT
+  order_id,
+  return_id,
+  product_id,
+  ""u AS sku_id,
+  count,
+FROM `orders/return_items`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `orders/order_items`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `orders/return_items`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/order_items_by_sku` RENAME TO `orders/order_items`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/return_items_by_sku` RENAME TO `orders/return_items`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE `orders/order_items_by_product` (
+  order_id Utf8 NOT NULL,
+  product_id Utf8 NOT NULL,
+  name Utf8 NOT NULL,
+  seller_id Utf8 NOT NULL,
+  count Uint32 NOT NULL,
+  price Double NOT NULL,
+  price_minor Int64,
+  picture Utf8,
+  PRIMARY KEY (order_id, product_id)
+);
+CREATE TABLE `orders/return_items_by_product` (
+  order_id Utf8 NOT NULL,
+  return_id Utf8 NOT NULL,
+  product_id Utf8 NOT NULL,
+  count Uint32 NOT NULL,
+  PRIMARY KEY (order_id, return_id, product_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Only one SKU item of the product is kept
+UPSERT INTO `orders/order_items_by_product`
+SELECT
+  order_id,
+  product_id,
+  name,
+  seller_id,
+  count,
+  price,
+  price_minor,
+  picture,
+FROM `orders/order_items`;
+
+UPSERT INTO `orders/return_items_by_product`
+SELECT order_id, return_id, product_id, SUM(count) AS count
+FROM `orders/return_items`
+GROUP BY order_id, return_id, product_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `orders/order_items`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `orders/return_items`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/order_items_by_product` RENAME TO `orders/order_items`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `orders/return_items_by_product` RENAME TO `orders/return_items`;
+-- +goose StatementEnd
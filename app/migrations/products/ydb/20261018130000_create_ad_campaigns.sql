@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `products/ad_campaigns` (
+    id String NOT NULL,
+    product_id String NOT NULL,
+    seller_id Utf8 NOT NULL,
+    boost Double NOT NULL,
+    budget Double NOT NULL,
+    status Utf8 NOT NULL,
+    starts_at Datetime NOT NULL,
+    ends_at Datetime NOT NULL,
+    created_at Datetime NOT NULL,
+    updated_at Datetime NOT NULL,
+    PRIMARY KEY (id),
+    INDEX idx_product_id_created_at GLOBAL ASYNC ON (product_id, created_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `products/ad_campaigns`;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Budget spent by the campaign while active up to charged_at
+ALTER TABLE `products/ad_campaigns`
+    ADD COLUMN spent Double,
+    ADD COLUMN charged_at Datetime;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `products/ad_campaigns`
+    DROP COLUMN spent,
+    DROP COLUMN charged_at;
+-- +goose StatementEnd
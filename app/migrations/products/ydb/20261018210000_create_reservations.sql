@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `products/reservations` (
+    order_id Utf8 NOT NULL,
+    product_id String NOT NULL,
+    operation_id Utf8 NOT NULL,
+    count Uint32 NOT NULL,
+    status Utf8 NOT NULL,
+    -- Part of the sold count that was out of stock
+    shortfall Uint32,
+    expires_at Datetime NOT NULL,
+    created_at Datetime NOT NULL,
+    updated_at Datetime NOT NULL,
+    PRIMARY KEY (order_id, product_id),
+    INDEX idx_product_id_status GLOBAL SYNC ON (product_id, status),
+    INDEX idx_status_expires_at GLOBAL SYNC ON (status, expires_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `products/reservations`;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `products/products`
+    ADD COLUMN options Json,
+    ADD COLUMN skus Json;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `products/products`
+    DROP COLUMN options,
+    DROP COLUMN skus;
+-- +goose StatementEnd
@@ -0,0 +1,86 @@
+-- +goose Up
+-- +goose StatementBegin
+-- An order holds several SKUs of the product, holds of products without SKUs have an empty sku_id
+CREATE TABLE `products/reservations_by_sku` (
+    order_id Utf8 NOT NULL,
+    product_id String NOT NULL,
+    sku_id Utf8 NOT NULL,
+    operation_id Utf8 NOT NULL,
+    count Uint32 NOT NULL,
+    status Utf8 NOT NULL,
+    shortfall Uint32,
+    expires_at Datetime NOT NULL,
+    created_at Datetime NOT NULL,
+    updated_at Datetime NOT NULL,
+    PRIMARY KEY (order_id, product_id, sku_id),
+    INDEX idx_product_id_status GLOBAL SYNC ON (product_id, status),
+    INDEX idx_status_expires_at GLOBAL SYNC ON (status, expires_at)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Products had no SKUs
+UPSERT INTO `products/reservations_by_sku`
+SELECT
+    order_id,
+    product_id,
+    ""u AS sku_id,
+    operation_id,
+    count,
+    status,
+    shortfall,
+    expires_at,
+    created_at,
+    updated_at,
+FROM `products/reservations`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `products/reservations`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `products/reservations_by_sku` RENAME TO `products/reservations`;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE `products/reservations_by_product` (
+    order_id Utf8 NOT NULL,
+    product_id String NOT NULL,
+    operation_id Utf8 NOT NULL,
+    count Uint32 NOT NULL,
+    status Utf8 NOT NULL,
+    shortfall Uint32,
+    expires_at Datetime NOT NULL,
+    created_at Datetime NOT NULL,
+    updated_at Datetime NOT NULL,
+    PRIMARY KEY (order_id, product_id),
+    INDEX idx_product_id_status GLOBAL SYNC ON (product_id, status),
+    INDEX idx_status_expires_at GLOBAL SYNC ON (status, expires_at)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Only one SKU hold of the product is kept
+UPSERT INTO `products/reservations_by_product`
+SELECT
+    order_id,
+    product_id,
+    operation_id,
+    count,
+    status,
+    shortfall,
+    expires_at,
+    created_at,
+    updated_at,
+FROM `products/reservations`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE `products/reservations`;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE `products/reservations_by_product` RENAME TO `products/reservations`;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE `products/categories` (
+    id Utf8 NOT NULL,
+    slug Utf8 NOT NULL,
+    name Utf8 NOT NULL,
+    parent_id Utf8,
+    position Uint32 NOT NULL,
+    created_at Datetime NOT NULL,
+    updated_at Datetime NOT NULL,
+    PRIMARY KEY (id)
+);
+-- Category path is the slugs of the category ancestors and the category joined with "/", it is indexed by catalog
+ALTER TABLE `products/products`
+    ADD COLUMN category_id Utf8,
+    ADD COLUMN category_path Utf8;
+ALTER TABLE `products/products` ADD INDEX idx_category_id GLOBAL SYNC ON (category_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `products/products` DROP INDEX idx_category_id;
+ALTER TABLE `products/products`
+    DROP COLUMN category_id,
+    DROP COLUMN category_path;
+DROP TABLE `products/categories`;
+-- +goose StatementEnd
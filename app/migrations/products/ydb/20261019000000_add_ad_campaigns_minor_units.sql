@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Budget amounts in minor units of the currency (kopecks) are summed and compared exactly,
+-- Double amounts are kept for reading.
+ALTER TABLE `products/ad_campaigns`
+    ADD COLUMN budget_minor Int64,
+    ADD COLUMN spent_minor Int64;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE `products/ad_campaigns`
+SET
+    budget_minor = CAST(Math::Round(budget * 100.0) AS Int64),
+    spent_minor = CAST(Math::Round(COALESCE(spent, 0.0) * 100.0) AS Int64)
+WHERE budget_minor IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `products/ad_campaigns`
+    DROP COLUMN budget_minor,
+    DROP COLUMN spent_minor;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Products restocked by received returns, redelivered returns are not restocked twice
+CREATE TABLE `products/restocks` (
+    return_id Utf8 NOT NULL,
+    product_id String NOT NULL,
+    -- Empty for products without SKUs
+    sku_id Utf8 NOT NULL,
+    order_id Utf8 NOT NULL,
+    count Uint32 NOT NULL,
+    created_at Datetime NOT NULL,
+    PRIMARY KEY (return_id, product_id, sku_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE `products/restocks`;
+-- +goose StatementEnd
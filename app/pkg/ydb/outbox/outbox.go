@@ -0,0 +1,429 @@
+// Package outbox implements transactional outbox for YDB Topics.
+//
+// Messages are written to the outbox table in the same transaction as the state change they describe
+// and are published to topics by the relay afterwards. Delivery is at-least-once: consumers must be idempotent,
+// skipping redeliveries by the ids or versions the messages carry. The dedup key is passed in the message
+// metadata for consumers reading the topics directly.
+//
+// Outbox table schema:
+//
+//	CREATE TABLE `<service>/outbox` (
+//	  created_at Timestamp NOT NULL,
+//	  id Utf8 NOT NULL,
+//	  topic Utf8 NOT NULL,
+//	  dedup_key Utf8 NOT NULL,
+//	  data String NOT NULL,
+//	  claimed_until Timestamp,
+//	  PRIMARY KEY (created_at, id)
+//	);
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bratushkadan/floral/pkg/template"
+	ydbtopic "github.com/bratushkadan/floral/pkg/ydb/topic"
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+	"go.uber.org/zap"
+)
+
+const (
+	// MetadataKeyDedupKey is the topic message metadata key of the message dedup key.
+	MetadataKeyDedupKey = "dedup_key"
+
+	DefaultBatchSize uint64 = 100
+	// DefaultClaimTimeout is the time claimed messages are hidden from other relays.
+	// Messages of a relay that failed to publish them are published again after it expires.
+	DefaultClaimTimeout = time.Minute
+)
+
+type Message struct {
+	Topic string
+	// DedupKey identifies the message for consumers deduplication.
+	// Messages describing the same fact must have the same dedup key.
+	DedupKey string
+	Data     []byte
+}
+
+// NewMessage creates a message with JSON serialized data.
+// Random dedup key is used if dedupKey is empty.
+func NewMessage(topic, dedupKey string, v any) (Message, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Message{}, fmt.Errorf("serialize outbox message for topic %s: %w", topic, err)
+	}
+	if dedupKey == "" {
+		dedupKey = uuid.NewString()
+	}
+	return Message{Topic: topic, DedupKey: dedupKey, Data: data}, nil
+}
+
+// NewMessages creates messages with JSON serialized data of values and dedup keys computed by dedupKey.
+func NewMessages[T any](topic string, dedupKey func(T) string, values ...T) ([]Message, error) {
+	msgs := make([]Message, 0, len(values))
+	for _, v := range values {
+		msg, err := NewMessage(topic, dedupKey(v), v)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+type OutboxBuilder struct {
+	outbox Outbox
+}
+
+func NewBuilder() *OutboxBuilder {
+	return &OutboxBuilder{
+		outbox: Outbox{
+			batchSize:    DefaultBatchSize,
+			claimTimeout: DefaultClaimTimeout,
+		},
+	}
+}
+
+func (b *OutboxBuilder) Ydb(db *ydb.Driver) *OutboxBuilder {
+	b.outbox.db = db
+	return b
+}
+
+// Table sets path of the outbox table, i.e. "orders/outbox".
+func (b *OutboxBuilder) Table(path string) *OutboxBuilder {
+	b.outbox.table = path
+	return b
+}
+func (b *OutboxBuilder) BatchSize(size uint64) *OutboxBuilder {
+	b.outbox.batchSize = size
+	return b
+}
+func (b *OutboxBuilder) ClaimTimeout(d time.Duration) *OutboxBuilder {
+	b.outbox.claimTimeout = d
+	return b
+}
+func (b *OutboxBuilder) Logger(l *zap.Logger) *OutboxBuilder {
+	b.outbox.logger = l
+	return b
+}
+
+func (b *OutboxBuilder) Build() (*Outbox, error) {
+	if b.outbox.db == nil {
+		return nil, errors.New("ydb driver is nil")
+	}
+	if b.outbox.table == "" {
+		return nil, errors.New("outbox table path is empty")
+	}
+	if b.outbox.batchSize == 0 {
+		return nil, errors.New("outbox batch size must be positive")
+	}
+	if b.outbox.logger == nil {
+		b.outbox.logger = zap.NewNop()
+	}
+
+	table := "`" + b.outbox.table + "`"
+	b.outbox.queryEnqueue = template.ReplaceAllPairs(queryEnqueue, "{{table.outbox}}", table)
+	b.outbox.queryClaim = template.ReplaceAllPairs(queryClaim, "{{table.outbox}}", table)
+	b.outbox.queryDelete = template.ReplaceAllPairs(queryDelete, "{{table.outbox}}", table)
+	b.outbox.writers = make(map[string]*topicwriter.Writer)
+
+	return &b.outbox, nil
+}
+
+type Outbox struct {
+	db           *ydb.Driver
+	logger       *zap.Logger
+	table        string
+	batchSize    uint64
+	claimTimeout time.Duration
+
+	queryEnqueue string
+	queryClaim   string
+	queryDelete  string
+
+	mu      sync.Mutex
+	writers map[string]*topicwriter.Writer
+}
+
+const queryEnqueue = `
+DECLARE $messages AS List<Struct<
+  created_at:Timestamp,
+  id:Utf8,
+  topic:Utf8,
+  dedup_key:Utf8,
+  data:String,
+>>;
+
+UPSERT INTO {{table.outbox}} (created_at, id, topic, dedup_key, data)
+SELECT created_at, id, topic, dedup_key, data
+FROM AS_TABLE($messages);
+`
+
+func (o *Outbox) enqueueParams(msgs []Message) *table.QueryParameters {
+	now := time.Now()
+	values := make([]types.Value, 0, len(msgs))
+	for _, msg := range msgs {
+		values = append(values, types.StructValue(
+			types.StructFieldValue("created_at", types.TimestampValueFromTime(now)),
+			types.StructFieldValue("id", types.UTF8Value(uuid.NewString())),
+			types.StructFieldValue("topic", types.UTF8Value(msg.Topic)),
+			types.StructFieldValue("dedup_key", types.UTF8Value(msg.DedupKey)),
+			types.StructFieldValue("data", types.BytesValue(msg.Data)),
+		))
+	}
+	return table.NewQueryParameters(
+		table.ValueParam("$messages", types.ListValue(values...)),
+	)
+}
+
+// EnqueueTx writes messages to the outbox within the query service transaction.
+// Messages are published by the relay only if the transaction is committed.
+// The transaction must not read the outbox table afterwards.
+func (o *Outbox) EnqueueTx(ctx context.Context, tx query.TxActor, msgs ...Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	if err := tx.Exec(ctx, o.queryEnqueue, query.WithParameters(o.enqueueParams(msgs))); err != nil {
+		return fmt.Errorf("enqueue outbox messages: %w", err)
+	}
+	return nil
+}
+
+// EnqueueTableTx writes messages to the outbox within the table service transaction.
+// Messages are published by the relay only if the transaction is committed.
+// The transaction must not read the outbox table afterwards.
+func (o *Outbox) EnqueueTableTx(ctx context.Context, tx table.TransactionActor, msgs ...Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	res, err := tx.Execute(ctx, o.queryEnqueue, o.enqueueParams(msgs))
+	if err != nil {
+		return fmt.Errorf("enqueue outbox messages: %w", err)
+	}
+	defer func() { _ = res.Close() }()
+	return res.Err()
+}
+
+const queryClaim = `
+DECLARE $limit AS Uint64;
+DECLARE $now AS Timestamp;
+DECLARE $claimed_until AS Timestamp;
+
+$claimed = (
+  SELECT created_at, id, topic, dedup_key, data
+  FROM {{table.outbox}}
+  WHERE claimed_until IS NULL OR claimed_until < $now
+  ORDER BY created_at, id
+  LIMIT $limit
+);
+
+SELECT created_at, id, topic, dedup_key, data
+FROM $claimed
+ORDER BY created_at, id;
+
+UPDATE {{table.outbox}} ON
+SELECT created_at, id, $claimed_until AS claimed_until
+FROM $claimed;
+`
+
+const queryDelete = `
+DECLARE $keys AS List<Struct<
+  created_at:Timestamp,
+  id:Utf8,
+>>;
+
+DELETE FROM {{table.outbox}} ON
+SELECT created_at, id FROM AS_TABLE($keys);
+`
+
+type record struct {
+	createdAt time.Time
+	id        string
+	Message
+}
+
+// claim hides the oldest messages from concurrent relays for the claim timeout.
+func (o *Outbox) claim(ctx context.Context) ([]record, error) {
+	var out []record
+
+	now := time.Now()
+	if err := o.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		out = make([]record, 0)
+
+		res, err := tx.Execute(ctx, o.queryClaim, table.NewQueryParameters(
+			table.ValueParam("$limit", types.Uint64Value(o.batchSize)),
+			table.ValueParam("$now", types.TimestampValueFromTime(now)),
+			table.ValueParam("$claimed_until", types.TimestampValueFromTime(now.Add(o.claimTimeout))),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var r record
+				if err := res.ScanNamed(
+					named.Required("created_at", &r.createdAt),
+					named.Required("id", &r.id),
+					named.Required("topic", &r.Topic),
+					named.Required("dedup_key", &r.DedupKey),
+					named.Required("data", &r.Data),
+				); err != nil {
+					return err
+				}
+				out = append(out, r)
+			}
+		}
+
+		return res.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (o *Outbox) delete(ctx context.Context, records []record) error {
+	keys := make([]types.Value, 0, len(records))
+	for _, r := range records {
+		keys = append(keys, types.StructValue(
+			types.StructFieldValue("created_at", types.TimestampValueFromTime(r.createdAt)),
+			types.StructFieldValue("id", types.UTF8Value(r.id)),
+		))
+	}
+
+	return o.db.Table().DoTx(ctx, func(ctx context.Context, tx table.TransactionActor) error {
+		res, err := tx.Execute(ctx, o.queryDelete, table.NewQueryParameters(
+			table.ValueParam("$keys", types.ListValue(keys...)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Close() }()
+		return res.Err()
+	})
+}
+
+// Relay publishes outbox messages to their topics until the outbox is drained
+// and returns the number of published messages.
+//
+// Messages are deleted from the outbox only after the topics acknowledged them,
+// so a message may be published more than once but is never lost.
+func (o *Outbox) Relay(ctx context.Context) (int, error) {
+	var relayed int
+	for {
+		records, err := o.claim(ctx)
+		if err != nil {
+			return relayed, fmt.Errorf("claim outbox messages: %w", err)
+		}
+		if len(records) == 0 {
+			return relayed, nil
+		}
+
+		if err := o.publish(ctx, records); err != nil {
+			return relayed, fmt.Errorf("publish outbox messages: %w", err)
+		}
+		if err := o.delete(ctx, records); err != nil {
+			return relayed, fmt.Errorf("delete published outbox messages: %w", err)
+		}
+		relayed += len(records)
+
+		o.logger.Info("relayed outbox messages", zap.String("table", o.table), zap.Int("count", len(records)))
+
+		if uint64(len(records)) < o.batchSize {
+			return relayed, nil
+		}
+	}
+}
+
+// publish writes records to their topics and waits for acknowledgement.
+func (o *Outbox) publish(ctx context.Context, records []record) error {
+	batches := groupByTopic(records)
+
+	writers := make([]*topicwriter.Writer, 0, len(batches))
+	for _, batch := range batches {
+		w, err := o.writer(batch.topic)
+		if err != nil {
+			return err
+		}
+		if err := w.Write(ctx, batch.messages...); err != nil {
+			return fmt.Errorf("write messages to topic %s: %w", batch.topic, err)
+		}
+		writers = append(writers, w)
+	}
+
+	for i, w := range writers {
+		if err := w.Flush(ctx); err != nil {
+			return fmt.Errorf("flush messages to topic %s: %w", batches[i].topic, err)
+		}
+	}
+	return nil
+}
+
+func (o *Outbox) writer(topic string) (*topicwriter.Writer, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if w, ok := o.writers[topic]; ok {
+		return w, nil
+	}
+	w, err := ydbtopic.NewProducer(o.db, topic)
+	if err != nil {
+		return nil, err
+	}
+	o.writers[topic] = w
+	return w, nil
+}
+
+// Close closes topic producers of the relay.
+func (o *Outbox) Close(ctx context.Context) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var errs []error
+	for topic, w := range o.writers {
+		if err := w.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("close producer for topic %s: %w", topic, err))
+		}
+		delete(o.writers, topic)
+	}
+	return errors.Join(errs...)
+}
+
+type topicBatch struct {
+	topic    string
+	messages []topicwriter.Message
+}
+
+// groupByTopic groups records by topics preserving order of messages within each topic.
+func groupByTopic(records []record) []topicBatch {
+	var batches []topicBatch
+	idxs := make(map[string]int)
+	for _, r := range records {
+		idx, ok := idxs[r.Topic]
+		if !ok {
+			idx = len(batches)
+			idxs[r.Topic] = idx
+			batches = append(batches, topicBatch{topic: r.Topic})
+		}
+		batches[idx].messages = append(batches[idx].messages, topicwriter.Message{
+			CreatedAt: r.createdAt,
+			Data:      bytes.NewReader(r.Data),
+			Metadata:  map[string][]byte{MetadataKeyDedupKey: []byte(r.DedupKey)},
+		})
+	}
+	return batches
+}
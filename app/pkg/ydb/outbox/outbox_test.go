@@ -0,0 +1,51 @@
+package outbox_test
+
+import (
+	"testing"
+
+	"github.com/bratushkadan/floral/pkg/ydb/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type message struct {
+	OrderId string `json:"order_id"`
+}
+
+func TestNewMessage(t *testing.T) {
+	msg, err := outbox.NewMessage("orders/topic", "order:1", message{OrderId: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "orders/topic", msg.Topic)
+	assert.Equal(t, "order:1", msg.DedupKey)
+	assert.JSONEq(t, `{"order_id":"1"}`, string(msg.Data))
+
+	first, err := outbox.NewMessage("orders/topic", "", message{OrderId: "1"})
+	require.NoError(t, err)
+	second, err := outbox.NewMessage("orders/topic", "", message{OrderId: "1"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, first.DedupKey)
+	assert.NotEqual(t, first.DedupKey, second.DedupKey)
+
+	_, err = outbox.NewMessage("orders/topic", "", func() {})
+	assert.Error(t, err)
+}
+
+func TestNewMessages(t *testing.T) {
+	msgs, err := outbox.NewMessages("orders/topic", func(m message) string {
+		return "order:" + m.OrderId
+	}, message{OrderId: "1"}, message{OrderId: "2"})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "order:1", msgs[0].DedupKey)
+	assert.Equal(t, "order:2", msgs[1].DedupKey)
+	assert.JSONEq(t, `{"order_id":"2"}`, string(msgs[1].Data))
+
+	msgs, err = outbox.NewMessages("orders/topic", func(m message) string { return m.OrderId })
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+}
+
+func TestBuild(t *testing.T) {
+	_, err := outbox.NewBuilder().Table("orders/outbox").Build()
+	assert.Error(t, err)
+}
@@ -31,8 +31,7 @@ locals {
           sa_id = yandex_iam_service_account.auth_caller.id
         }
         feedback = {
-          id = ""
-          # id    = local.containers.feedback.count > 0 ? yandex_serverless_container.feedback[0].id : ""
+          id    = local.containers.feedback.count > 0 ? yandex_serverless_container.feedback[0].id : ""
           sa_id = yandex_iam_service_account.auth_caller.id
         }
       }
@@ -12,6 +12,10 @@ data "yandex_lockbox_secret" "yoomoney_payment_provider_notifications_secret" {
   name = "yoomoney-payment-provider-notifications-secret"
 }
 
+data "yandex_lockbox_secret" "yoomoney_payment_provider_oauth_token" {
+  name = "yoomoney-payment-provider-oauth-token"
+}
+
 
 locals {
   versions = {
@@ -77,6 +81,13 @@ locals {
     "YMQ_TRIGGER_HTTP_ENDPOINTS_ENABLED",
 
     "YOOMONEY_NOTIFICATIONS_SECRET",
+    "YOOMONEY_OAUTH_TOKEN",
+    "YOOMONEY_WALLET",
+
+    "ORDER_COMPLETION_DELAY_DAYS",
+
+    "PRODUCTS_RESERVATION_TTL",
+    "PRODUCTS_AD_CAMPAIGN_HOURLY_RATE",
 
     "OPENSEARCH_USER",
     "OPENSEARCH_PASSWORD",
@@ -188,6 +199,18 @@ locals {
       },
     ]
     orders = [
+      {
+        id                   = data.yandex_lockbox_secret.app_sa_static_key.id
+        version_id           = data.yandex_lockbox_secret.app_sa_static_key.current_version[0].id
+        key                  = "access_key_id"
+        environment_variable = local.env.AWS_ACCESS_KEY_ID
+      },
+      {
+        id                   = data.yandex_lockbox_secret.app_sa_static_key.id
+        version_id           = data.yandex_lockbox_secret.app_sa_static_key.current_version[0].id
+        key                  = "secret_access_key"
+        environment_variable = local.env.AWS_SECRET_ACCESS_KEY
+      },
       {
         id                   = data.yandex_lockbox_secret.token_infra.id
         version_id           = data.yandex_lockbox_secret.token_infra.current_version[0].id
@@ -199,9 +222,21 @@ locals {
         version_id           = data.yandex_lockbox_secret.yoomoney_payment_provider_notifications_secret.current_version[0].id
         key                  = "notification_secret"
         environment_variable = local.env.YOOMONEY_NOTIFICATIONS_SECRET
+      },
+      {
+        id                   = data.yandex_lockbox_secret.yoomoney_payment_provider_oauth_token.id
+        version_id           = data.yandex_lockbox_secret.yoomoney_payment_provider_oauth_token.current_version[0].id
+        key                  = "oauth_token"
+        environment_variable = local.env.YOOMONEY_OAUTH_TOKEN
       }
     ]
-    feedback = []
+    feedback = [{
+      id                   = data.yandex_lockbox_secret.token_infra.id
+      version_id           = data.yandex_lockbox_secret.token_infra.current_version[0].id
+      key                  = "auth_token_public.key"
+      environment_variable = local.env.APP_AUTH_TOKEN_PUBLIC_KEY
+      },
+    ]
   }
 }
 
@@ -434,6 +469,66 @@ resource "yandex_function_trigger" "catalog" {
   }
 }
 
+resource "yandex_function_trigger" "catalog_product_ratings" {
+  count       = local.containers.catalog.count
+  name        = "catalog-product-ratings-sync"
+  description = "trigger for syncing product ratings from feedback service"
+
+  container {
+    id                 = yandex_serverless_container.catalog[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/catalog/sync-product-ratings"
+  }
+
+  data_streams {
+    database           = yandex_ydb_database_serverless.this.database_path
+    stream_name        = yandex_ydb_topic.feedback_product_ratings.name
+    service_account_id = yandex_iam_service_account.app.id
+    batch_cutoff       = "1"
+    batch_size         = 1
+  }
+}
+
+resource "yandex_function_trigger" "catalog_products_purchases" {
+  count       = local.containers.catalog.count
+  name        = "catalog-products-purchases-sync"
+  description = "trigger for syncing products purchases counters from orders service"
+
+  container {
+    id                 = yandex_serverless_container.catalog[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/catalog/sync-products-purchases"
+  }
+
+  data_streams {
+    database           = yandex_ydb_database_serverless.this.database_path
+    stream_name        = yandex_ydb_topic.orders_products_purchases_stats.name
+    service_account_id = yandex_iam_service_account.app.id
+    batch_cutoff       = "1"
+    batch_size         = 50
+  }
+}
+
+resource "yandex_function_trigger" "catalog_products_ad_boost" {
+  count       = local.containers.catalog.count
+  name        = "catalog-products-ad-boost-sync"
+  description = "trigger for syncing products ad boost from products service"
+
+  container {
+    id                 = yandex_serverless_container.catalog[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/catalog/sync-products-ad-boost"
+  }
+
+  data_streams {
+    database           = yandex_ydb_database_serverless.this.database_path
+    stream_name        = yandex_ydb_topic.products_ad_boost.name
+    service_account_id = yandex_iam_service_account.app.id
+    batch_cutoff       = "1"
+    batch_size         = 50
+  }
+}
+
 resource "yandex_serverless_container" "cart" {
   count = local.containers.cart.count
 
@@ -540,6 +635,25 @@ resource "yandex_function_trigger" "cart_contents" {
   }
 }
 
+resource "yandex_function_trigger" "relay_cart_outbox" {
+  count       = local.containers.cart.count
+  name        = "relay-cart-outbox"
+  description = "trigger for publishing cart outbox messages left unpublished"
+
+  container {
+    id                 = yandex_serverless_container.cart[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/cart/relay-outbox"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every minute
+    cron_expression = "* * ? * * *"
+    payload         = "{}"
+  }
+}
+
 
 resource "yandex_serverless_container" "orders" {
   count = local.containers.orders.count
@@ -559,7 +673,10 @@ resource "yandex_serverless_container" "orders" {
   image {
     url = "cr.yandex/${yandex_container_repository.orders_repository.name}:${local.versions.orders}"
     environment = {
-      (local.env.YDB_ENDPOINT) = yandex_ydb_database_serverless.this.ydb_full_endpoint
+      (local.env.YDB_ENDPOINT)                = yandex_ydb_database_serverless.this.ydb_full_endpoint
+      (local.env.YOOMONEY_WALLET)             = var.yoomoney_wallet
+      (local.env.PICTURES_BUCKET)             = yandex_storage_bucket.ecom.id
+      (local.env.ORDER_COMPLETION_DELAY_DAYS) = tostring(var.order_completion_delay_days)
     }
   }
 
@@ -648,6 +765,82 @@ resource "yandex_function_trigger" "cancel_unpaid_orders" {
   }
 }
 
+resource "yandex_function_trigger" "complete_delivered_orders" {
+  count       = local.containers.orders.count
+  name        = "complete-delivered-orders"
+  description = "trigger for completing shipments and orders delivered longer than the order completion delay ago"
+
+  container {
+    id                 = yandex_serverless_container.orders[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/order/batch-complete-delivered-orders"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every hour
+    cron_expression = "0 * ? * * *"
+    payload         = "123"
+  }
+}
+
+resource "yandex_function_trigger" "publish_products_purchases_stats" {
+  count       = local.containers.orders.count
+  name        = "publish-products-purchases-stats"
+  description = "trigger for recomputing products purchases counters"
+
+  container {
+    id                 = yandex_serverless_container.orders[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/order/publish-products-purchases-stats"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every hour
+    cron_expression = "0 * ? * * *"
+    payload         = "{}"
+  }
+}
+
+resource "yandex_function_trigger" "process_refunds" {
+  count       = local.containers.orders.count
+  name        = "process-refunds"
+  description = "trigger for refunding payments of cancelled orders"
+
+  container {
+    id                 = yandex_serverless_container.orders[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/order/process-refunds"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every 5 minutes
+    cron_expression = "*/5 * ? * * *"
+    payload         = "{}"
+  }
+}
+
+resource "yandex_function_trigger" "relay_orders_outbox" {
+  count       = local.containers.orders.count
+  name        = "relay-orders-outbox"
+  description = "trigger for publishing orders outbox messages left unpublished"
+
+  container {
+    id                 = yandex_serverless_container.orders[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/order/relay-outbox"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every minute
+    cron_expression = "* * ? * * *"
+    payload         = "{}"
+  }
+}
+
 resource "yandex_serverless_container" "products" {
   count = local.containers.products.count
 
@@ -666,8 +859,10 @@ resource "yandex_serverless_container" "products" {
   image {
     url = "cr.yandex/${yandex_container_repository.products_repository.name}:${local.versions.products}"
     environment = {
-      (local.env.YDB_ENDPOINT)    = yandex_ydb_database_serverless.this.ydb_full_endpoint
-      (local.env.PICTURES_BUCKET) = yandex_storage_bucket.ecom.id
+      (local.env.YDB_ENDPOINT)                     = yandex_ydb_database_serverless.this.ydb_full_endpoint
+      (local.env.PICTURES_BUCKET)                  = yandex_storage_bucket.ecom.id
+      (local.env.PRODUCTS_RESERVATION_TTL)         = var.products_reservation_ttl
+      (local.env.PRODUCTS_AD_CAMPAIGN_HOURLY_RATE) = var.products_ad_campaign_hourly_rate
     }
   }
 
@@ -737,6 +932,83 @@ resource "yandex_function_trigger" "process_products_unreserve" {
   }
 }
 
+resource "yandex_function_trigger" "process_products_sales" {
+  count       = local.containers.products.count
+  name        = "process-products-sales"
+  description = "trigger for directing products sales messages of paid orders to products service"
+
+  container {
+    id                 = yandex_serverless_container.products[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/products/sell"
+  }
+
+  data_streams {
+    database           = yandex_ydb_database_serverless.this.database_path
+    stream_name        = yandex_ydb_topic.products_sales.name
+    service_account_id = yandex_iam_service_account.app.id
+    batch_cutoff       = "1"
+    batch_size         = 1
+  }
+}
+
+resource "yandex_function_trigger" "release_expired_products_reservations" {
+  count       = local.containers.products.count
+  name        = "release-expired-products-reservations"
+  description = "trigger for releasing products held for orders that were not paid in time"
+
+  container {
+    id                 = yandex_serverless_container.products[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/products/release-expired-reservations"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every 10 minutes
+    cron_expression = "*/10 * ? * * *"
+    payload         = "{}"
+  }
+}
+
+resource "yandex_function_trigger" "apply_ad_campaigns" {
+  count       = local.containers.products.count
+  name        = "apply-ad-campaigns"
+  description = "trigger for starting and finishing seller ad campaigns"
+
+  container {
+    id                 = yandex_serverless_container.products[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/products/apply-ad-campaigns"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every 10 minutes
+    cron_expression = "*/10 * ? * * *"
+    payload         = "{}"
+  }
+}
+
+resource "yandex_function_trigger" "relay_products_outbox" {
+  count       = local.containers.products.count
+  name        = "relay-products-outbox"
+  description = "trigger for publishing products outbox messages left unpublished"
+
+  container {
+    id                 = yandex_serverless_container.products[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/products/relay-outbox"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every minute
+    cron_expression = "* * ? * * *"
+    payload         = "{}"
+  }
+}
+
 resource "yandex_function_trigger" "process_orders_with_unreserved_products" {
   count       = local.containers.orders.count
   name        = "process-products-unreservations"
@@ -776,3 +1048,90 @@ resource "yandex_function_trigger" "process_payment_notifications" {
     batch_size         = 1
   }
 }
+
+resource "yandex_serverless_container" "feedback" {
+  count = local.containers.feedback.count
+
+  name        = "feedback"
+  description = "feedback container"
+
+  cores              = 1
+  core_fraction      = 50
+  memory             = 128
+  execution_timeout  = "10s"
+  service_account_id = yandex_iam_service_account.app.id
+  runtime {
+    type = "http"
+  }
+
+  image {
+    url = "cr.yandex/${yandex_container_repository.feedback_repository.name}:${local.versions.feedback}"
+    environment = {
+      (local.env.YDB_ENDPOINT) = yandex_ydb_database_serverless.this.ydb_full_endpoint
+    }
+  }
+
+  dynamic "secrets" {
+    for_each = toset(local.lockbox.feedback)
+    content {
+      id                   = secrets.value.id
+      version_id           = secrets.value.version_id
+      key                  = secrets.value.key
+      environment_variable = secrets.value.environment_variable
+    }
+  }
+
+  depends_on = [
+    yandex_resourcemanager_folder_iam_member.app_lockbox_payload_viewer,
+    yandex_resourcemanager_folder_iam_member.app_images_puller,
+  ]
+}
+
+resource "yandex_serverless_container_iam_binding" "feedback_sls_container_invoker" {
+  count        = local.containers.feedback.count
+  container_id = yandex_serverless_container.feedback[0].id
+  role         = "serverless.containers.invoker"
+
+  members = [
+    "serviceAccount:${yandex_iam_service_account.auth_caller.id}",
+  ]
+}
+
+resource "yandex_function_trigger" "relay_feedback_outbox" {
+  count       = local.containers.feedback.count
+  name        = "relay-feedback-outbox"
+  description = "trigger for publishing feedback outbox messages left unpublished"
+
+  container {
+    id                 = yandex_serverless_container.feedback[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/feedback/relay-outbox"
+    retry_attempts     = 1
+    retry_interval     = 10
+  }
+  timer {
+    // every minute
+    cron_expression = "* * ? * * *"
+    payload         = "{}"
+  }
+}
+
+resource "yandex_function_trigger" "process_completed_orders" {
+  count       = local.containers.feedback.count
+  name        = "process-completed-orders"
+  description = "trigger for directing completed orders messages to feedback service"
+
+  container {
+    id                 = yandex_serverless_container.feedback[0].id
+    service_account_id = yandex_iam_service_account.auth_caller.id
+    path               = "/api/private/v1/feedback/orders:process_completed_order"
+  }
+
+  data_streams {
+    database           = yandex_ydb_database_serverless.this.database_path
+    stream_name        = yandex_ydb_topic.orders_completed_orders.name
+    service_account_id = yandex_iam_service_account.app.id
+    batch_cutoff       = "1"
+    batch_size         = 1
+  }
+}
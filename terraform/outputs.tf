@@ -46,6 +46,10 @@ output "yoomoney_payment_provider_notifications_secret_secret_id" {
   value = data.yandex_lockbox_secret.yoomoney_payment_provider_notifications_secret.id
 }
 
+output "yoomoney_payment_provider_oauth_token_secret_id" {
+  value = data.yandex_lockbox_secret.yoomoney_payment_provider_oauth_token.id
+}
+
 
 output "container_registry" {
   value = {
@@ -81,6 +81,17 @@ resource "yandex_ydb_topic" "products_unreservations" {
 
   partition_write_speed_kbps = 128
 }
+resource "yandex_ydb_topic" "products_sales" {
+  database_endpoint = yandex_ydb_database_serverless.this.ydb_full_endpoint
+  name              = "products/products_sales_topic"
+  description       = "topic for products sales messages of paid orders"
+
+  supported_codecs       = []
+  partitions_count       = 1
+  retention_period_hours = 1
+
+  partition_write_speed_kbps = 128
+}
 resource "yandex_ydb_topic" "products_unreserved" {
   database_endpoint = yandex_ydb_database_serverless.this.ydb_full_endpoint
   name              = "products/unreserved_products_topic"
@@ -93,6 +104,18 @@ resource "yandex_ydb_topic" "products_unreserved" {
   partition_write_speed_kbps = 128
 }
 
+resource "yandex_ydb_topic" "products_ad_boost" {
+  database_endpoint = yandex_ydb_database_serverless.this.ydb_full_endpoint
+  name              = "products/ad_boost_topic"
+  description       = "topic for products ad boost updates of seller ad campaigns"
+
+  supported_codecs       = []
+  partitions_count       = 1
+  retention_period_hours = 1
+
+  partition_write_speed_kbps = 128
+}
+
 resource "yandex_ydb_topic" "orders_cancel_operations" {
   database_endpoint = yandex_ydb_database_serverless.this.ydb_full_endpoint
   name              = "orders/cancel_operations_topic"
@@ -116,3 +139,52 @@ resource "yandex_ydb_topic" "orders_payment_notifications" {
 
   partition_write_speed_kbps = 128
 }
+
+resource "yandex_ydb_topic" "orders_completed_orders" {
+  database_endpoint = yandex_ydb_database_serverless.this.ydb_full_endpoint
+  name              = "orders/completed_orders_topic"
+  description       = "topic for completed orders contents"
+
+  supported_codecs       = []
+  partitions_count       = 1
+  retention_period_hours = 1
+
+  partition_write_speed_kbps = 128
+}
+
+# Order lifecycle domain events, consumers subscribe with their own consumers.
+resource "yandex_ydb_topic" "orders_order_events" {
+  database_endpoint = yandex_ydb_database_serverless.this.ydb_full_endpoint
+  name              = "orders/order_events_topic"
+  description       = "topic for order lifecycle domain events"
+
+  supported_codecs       = []
+  partitions_count       = 1
+  retention_period_hours = 24
+
+  partition_write_speed_kbps = 128
+}
+
+resource "yandex_ydb_topic" "orders_products_purchases_stats" {
+  database_endpoint = yandex_ydb_database_serverless.this.ydb_full_endpoint
+  name              = "orders/products_purchases_stats_topic"
+  description       = "topic for products purchases counters"
+
+  supported_codecs       = []
+  partitions_count       = 1
+  retention_period_hours = 1
+
+  partition_write_speed_kbps = 128
+}
+
+resource "yandex_ydb_topic" "feedback_product_ratings" {
+  database_endpoint = yandex_ydb_database_serverless.this.ydb_full_endpoint
+  name              = "feedback/product_ratings_topic"
+  description       = "topic for aggregated product ratings updates"
+
+  supported_codecs       = []
+  partitions_count       = 1
+  retention_period_hours = 1
+
+  partition_write_speed_kbps = 128
+}
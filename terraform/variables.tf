@@ -2,3 +2,31 @@ variable "ydb_migrations_applied" {
   type     = bool
   nullable = false
 }
+
+variable "yoomoney_wallet" {
+  description = "YooMoney wallet receiving order payments, checkout links are not available without it"
+  type        = string
+  default     = ""
+  nullable    = false
+}
+
+variable "order_completion_delay_days" {
+  description = "Days buyers have to open a return of a delivered shipment before it's completed"
+  type        = number
+  default     = 14
+  nullable    = false
+}
+
+variable "products_reservation_ttl" {
+  description = "Time products are held for orders awaiting payment, Go duration format. Should exceed the order payment window"
+  type        = string
+  default     = "2h"
+  nullable    = false
+}
+
+variable "products_ad_campaign_hourly_rate" {
+  description = "Budget an ad campaign spends per hour per boost point"
+  type        = string
+  default     = "1"
+  nullable    = false
+}